@@ -56,6 +56,48 @@ func IsNotFound(err error) bool {
 	return st.Code() == codes.NotFound
 }
 
+// IsInvalidArgument reports whether the services rejected the parameters of the request
+func IsInvalidArgument(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	return st.Code() == codes.InvalidArgument
+}
+
+// IsPrecondition reports whether the state of the resource doesn't allow the request, e.g. the
+// client is hidden already
+func IsPrecondition(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	return st.Code() == codes.FailedPrecondition
+}
+
+// IsPending reports whether the services accepted the request and finish it in background
+func IsPending(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	return st.Code() == codes.Aborted
+}
+
+// IsConflict reports whether the request clashes with the state of the resource, e.g. the
+// same deletion is already in progress
+func IsConflict(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	return st.Code() == codes.AlreadyExists
+}
+
 func ErrAuthData(err error) render.Renderer {
 	return &ErrResponse{
 		Err:            err,
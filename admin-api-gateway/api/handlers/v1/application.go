@@ -0,0 +1,225 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Applications
+// @Description 	This API for get a list of job applications, newest first
+// @Tags 			applications
+// @Accept 			json
+// @Produce 		json
+// @Param           job_id query string false "Job ID"
+// @Param           client_id query string false "Client ID"
+// @Param           status query string false "applied, screening, interview, offer, hired, rejected or withdrawn"
+// @Param           page query string true "Page"
+// @Param 			limit query string true "Limit"
+// @Success 		200 {object} []models.JobApplication
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/applications [GET]
+func (h HandlerV1) ListApplications(c *gin.Context) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetApplications(ctx, &jobproto.ListApplicationsRequest{
+		JobId:    c.Query("job_id"),
+		ClientId: c.Query("client_id"),
+		Status:   c.Query("status"),
+		Page:     uint64(page),
+		Limit:    uint64(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.JobApplication{}
+	for _, application := range list.Applications {
+		response = append(response, applicationFromProto(application))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Get Application
+// @Description 	This API for get a job application
+// @Tags 			applications
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Application ID"
+// @Success 		200 {object} models.JobApplication
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/applications/{id} [GET]
+func (h HandlerV1) GetApplication(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	application, err := h.Service.JobService().GetApplication(ctx, &jobproto.ApplicationWithGUID{
+		ApplicationId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, applicationFromProto(application))
+}
+
+// @Summary 		Move Application
+// @Description 	This API for move a job application to another stage: applied -> screening -> interview -> offer -> hired, or rejected/withdrawn before hired. Hiring assigns the client to the job.
+// @Tags 			applications
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Application ID"
+// @Param           Request body models.MoveApplicationRequest true "Stage"
+// @Success 		200 {object} models.JobApplication
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/applications/{id}/move [POST]
+func (h HandlerV1) MoveApplication(c *gin.Context) {
+	var body models.MoveApplicationRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	application, err := h.Service.JobService().MoveApplication(ctx, &jobproto.MoveApplicationRequest{
+		ApplicationId: c.Param("id"),
+		Status:        body.Status,
+		Actor:         body.Actor,
+		Note:          body.Note,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, applicationFromProto(application))
+}
+
+// @Summary 		Get Application History
+// @Description 	This API for get the stage changes of a job application, oldest first
+// @Tags 			applications
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Application ID"
+// @Success 		200 {object} []models.ApplicationStatusChange
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/applications/{id}/history [GET]
+func (h HandlerV1) GetApplicationHistory(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	history, err := h.Service.JobService().GetApplicationHistory(ctx, &jobproto.ApplicationWithGUID{
+		ApplicationId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.ApplicationStatusChange{}
+	for _, change := range history.Changes {
+		response = append(response, models.ApplicationStatusChange{
+			ID:            change.Id,
+			ApplicationID: change.ApplicationId,
+			FromStatus:    change.FromStatus,
+			ToStatus:      change.ToStatus,
+			Actor:         change.Actor,
+			Note:          change.Note,
+			CreatedAt:     change.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func applicationFromProto(application *jobproto.JobApplication) models.JobApplication {
+	return models.JobApplication{
+		ID:          application.Id,
+		JobID:       application.JobId,
+		ClientID:    application.ClientId,
+		Status:      application.Status,
+		CoverLetter: application.CoverLetter,
+		CreatedAt:   application.CreatedAt,
+		UpdatedAt:   application.UpdatedAt,
+	}
+}
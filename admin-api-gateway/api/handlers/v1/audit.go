@@ -0,0 +1,145 @@
+package v1
+
+import (
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Audit Records
+// @Description 	This API for get who changed what: every create, update, delete and restore of clients, jobs and the other entities, newest first
+// @Tags 			audit
+// @Accept 			json
+// @Produce 		json
+// @Param           entity query string false "Entity like client, job, company, application"
+// @Param           entity_id query string false "Entity ID"
+// @Param           actor query string false "Actor like admin:<id>, client:<id> or system"
+// @Param           action query string false "create, update, delete or restore"
+// @Param           service query string false "client-service or job-service"
+// @Param           from query string false "From time, RFC3339"
+// @Param           to query string false "To time (exclusive), RFC3339"
+// @Param           page query string true "Page"
+// @Param 			limit query string true "Limit"
+// @Success 		200 {object} []models.AuditRecord
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/audit [GET]
+func (h HandlerV1) ListAuditRecords(c *gin.Context) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetAuditRecords(ctx, &jobproto.ListAuditRecordsRequest{
+		Entity:   c.Query("entity"),
+		EntityId: c.Query("entity_id"),
+		Actor:    c.Query("actor"),
+		Action:   c.Query("action"),
+		Service:  c.Query("service"),
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+		Page:     uint64(page),
+		Limit:    uint64(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.AuditRecord{}
+	for _, record := range list.Records {
+		response = append(response, models.AuditRecord{
+			ID:         record.Id,
+			OccurredAt: record.OccurredAt,
+			Service:    record.Service,
+			Actor:      record.Actor,
+			Entity:     record.Entity,
+			EntityID:   record.EntityId,
+			Action:     record.Action,
+			Before:     auditJSON(record.Before),
+			After:      auditJSON(record.After),
+			Diff:       auditJSON(record.Diff),
+			PrevHash:   record.PrevHash,
+			Hash:       record.Hash,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Verify Audit Log
+// @Description 	This API for check the hash chain of the audit log, broken_at is the first record that was changed or whose predecessor was removed
+// @Tags 			audit
+// @Accept 			json
+// @Produce 		json
+// @Success 		200 {object} models.AuditVerification
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/audit/verify [GET]
+func (h HandlerV1) VerifyAuditLog(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	verification, err := h.Service.JobService().VerifyAuditLog(ctx, &jobproto.VerifyAuditLogRequest{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.AuditVerification{
+		Valid:    verification.Valid,
+		Checked:  verification.Checked,
+		BrokenAt: verification.BrokenAt,
+		Reason:   verification.Reason,
+	})
+}
+
+// auditJSON keeps an empty snapshot null in the response
+func auditJSON(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+	return json.RawMessage(value)
+}
@@ -2,15 +2,18 @@ package v1
 
 import (
 	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
 	"context"
 	"net/http"
 	"strconv"
 	"time"
 
+	apierrors "admin-api-gateway/api/errors"
 	"admin-api-gateway/api/models"
 	clientproto "admin-api-gateway/genproto/client_service"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -48,7 +51,7 @@ func (h HandlerV1) CreateClient(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.ClientService().CreateClient(ctx, &clientproto.Client{
@@ -108,7 +111,7 @@ func (h HandlerV1) UpdateClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.ClientService().UpdateClient(ctx, &clientproto.Client{
@@ -141,9 +144,11 @@ func (h HandlerV1) UpdateClient(c *gin.Context) {
 // @Produce 	json
 // @Param 		id path string true "Client ID"
 // @Success 	200 {object} models.Status
+// @Success 	202 {object} models.Error
 // @Failure 	400 {object} models.Error
 // @Failure    	401 {object} models.Error
 // @Failure     403 {object} models.Error
+// @Failure 	409 {object} models.Error
 // @Failure 	500 {object} models.Error
 // @Router 		/v1/client/{id} [DELETE]
 func (h HandlerV1) DeleteClient(c *gin.Context) {
@@ -162,12 +167,26 @@ func (h HandlerV1) DeleteClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	_, err = h.Service.ClientService().DeleteClient(ctx, &clientproto.ClientWithGUID{
 		Guid: clientID,
 	})
+	// the deletion is a saga, a failed step is retried in background and the same
+	// deletion can't run twice at a time
+	if apierrors.IsPending(err) {
+		c.JSON(http.StatusAccepted, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
+	if apierrors.IsConflict(err) {
+		c.JSON(http.StatusConflict, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
@@ -206,7 +225,7 @@ func (h HandlerV1) GetClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.ClientService().GetClient(ctx, &clientproto.ClientWithGUID{
@@ -260,7 +279,7 @@ func (h HandlerV1) ListClients(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), time.Second*10)
 	defer cancel()
 
 	listClients, err := h.Service.ClientService().GetAllClients(ctx, &clientproto.ListRequest{
@@ -330,7 +349,7 @@ func (h HandlerV1) ListDeletedClients(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), time.Second*10)
 	defer cancel()
 
 	listClients, err := h.Service.ClientService().GetAllDeletedClients(ctx, &clientproto.ListRequest{
@@ -400,7 +419,7 @@ func (h HandlerV1) ListHiddenClients(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), time.Second*10)
 	defer cancel()
 
 	listClients, err := h.Service.ClientService().GetAllHiddenClients(ctx, &clientproto.ListRequest{
@@ -432,3 +451,146 @@ func (h HandlerV1) ListHiddenClients(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, response)
 }
+
+// @Summary 	Hide Client
+// @Description This API for hide a client, the reason and the principal of the request are kept in the status history
+// @Tags 		clients
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "Client ID"
+// @Param 		Request body models.ClientVisibilityRequest true "Reason"
+// @Success 	200 {object} models.ClientStatusChange
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure     404 {object} models.Error
+// @Failure     409 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/client/{id}/hide [POST]
+func (h HandlerV1) HideClient(c *gin.Context) {
+	h.changeClientVisibility(c, false)
+}
+
+// @Summary 	Unhide Client
+// @Description This API for unhide a hidden client, the reason and the principal of the request are kept in the status history
+// @Tags 		clients
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "Client ID"
+// @Param 		Request body models.ClientVisibilityRequest true "Reason"
+// @Success 	200 {object} models.ClientStatusChange
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure     404 {object} models.Error
+// @Failure     409 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/client/{id}/unhide [POST]
+func (h HandlerV1) UnhideClient(c *gin.Context) {
+	h.changeClientVisibility(c, true)
+}
+
+func (h HandlerV1) changeClientVisibility(c *gin.Context, visible bool) {
+	var body models.ClientVisibilityRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	request := &clientproto.ClientStatusRequest{
+		ClientId: c.Param("id"),
+		Reason:   body.Reason,
+	}
+
+	var change *clientproto.ClientStatusChange
+	if visible {
+		change, err = h.Service.ClientService().UnhideClient(ctx, request)
+	} else {
+		change, err = h.Service.ClientService().HideClient(ctx, request)
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case apierrors.IsInvalidArgument(err):
+			status = http.StatusBadRequest
+		case apierrors.IsNotFound(err):
+			status = http.StatusNotFound
+		case apierrors.IsPrecondition(err):
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, clientStatusChangeModel(change))
+}
+
+// @Summary 	Client Status History
+// @Description This API for get the hide/unhide timeline of a client, oldest first
+// @Tags 		clients
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "Client ID"
+// @Success 	200 {object} []models.ClientStatusChange
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/client/{id}/status-history [GET]
+func (h HandlerV1) GetClientStatusHistory(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	history, err := h.Service.ClientService().GetClientStatusHistory(ctx, &clientproto.ClientWithGUID{
+		Guid: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.ClientStatusChange{}
+	for _, change := range history.Changes {
+		response = append(response, clientStatusChangeModel(change))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func clientStatusChangeModel(change *clientproto.ClientStatusChange) models.ClientStatusChange {
+	return models.ClientStatusChange{
+		ID:        change.Id,
+		ClientID:  change.ClientId,
+		Status:    change.Status,
+		Reason:    change.Reason,
+		Actor:     change.Actor,
+		CreatedAt: change.CreatedAt,
+	}
+}
@@ -0,0 +1,249 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"admin-api-gateway/api/models"
+	clientproto "admin-api-gateway/genproto/client_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 	Scan Duplicate Clients
+// @Description This API for run the duplicate finder, pairs scored by normalized email, phone and name similarity are added to the review queue
+// @Tags 		duplicates
+// @Accept 		json
+// @Produce 	json
+// @Success 	200 {object} models.DuplicateScan
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/clients/duplicates/scan [POST]
+func (h HandlerV1) ScanDuplicateClients(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	response, err := h.Service.ClientService().FindDuplicates(ctx, &clientproto.DuplicateScanRequest{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.DuplicateScan{
+		Found: response.Found,
+	})
+}
+
+// @Summary 	List Duplicate Clients
+// @Description This API for get the duplicate review queue, highest score first
+// @Tags 		duplicates
+// @Accept 		json
+// @Produce 	json
+// @Param 		status query string false "pending, merged or dismissed" default(pending)
+// @Param 		page query uint64 true "Page"
+// @Param 		limit query uint64 true "Limit"
+// @Success 	200 {object} []models.ClientDuplicate
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/clients/duplicates [GET]
+func (h HandlerV1) ListDuplicateClients(c *gin.Context) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.ClientService().GetDuplicates(ctx, &clientproto.DuplicateListRequest{
+		Status: c.DefaultQuery("status", "pending"),
+		Page:   int64(page),
+		Limit:  int64(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.ClientDuplicate{}
+	for _, duplicate := range list.Duplicates {
+		item := models.ClientDuplicate{
+			ID:         duplicate.Id,
+			Score:      duplicate.Score,
+			Reasons:    duplicate.Reasons,
+			Status:     duplicate.Status,
+			ResolvedBy: duplicate.ResolvedBy,
+			CreatedAt:  duplicate.CreatedAt,
+			UpdatedAt:  duplicate.UpdatedAt,
+		}
+		if duplicate.Client != nil {
+			item.Client = models.Client{
+				Id:          duplicate.Client.Id,
+				FirstName:   duplicate.Client.FirstName,
+				LastName:    duplicate.Client.LastName,
+				Email:       duplicate.Client.Email,
+				PhoneNumber: duplicate.Client.PhoneNumber,
+			}
+		}
+		if duplicate.Duplicate != nil {
+			item.Duplicate = models.Client{
+				Id:          duplicate.Duplicate.Id,
+				FirstName:   duplicate.Duplicate.FirstName,
+				LastName:    duplicate.Duplicate.LastName,
+				Email:       duplicate.Duplicate.Email,
+				PhoneNumber: duplicate.Duplicate.PhoneNumber,
+			}
+		}
+		response = append(response, item)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 	Dismiss Duplicate Clients
+// @Description This API for mark a pair of the review queue as not a duplicate, later scans keep it dismissed
+// @Tags 		duplicates
+// @Accept 		json
+// @Produce 	json
+// @Param 		id path string true "Duplicate pair ID"
+// @Param 		Request body models.DismissDuplicateRequest true "Actor"
+// @Success 	200 {object} models.Status
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/clients/duplicates/{id}/dismiss [POST]
+func (h HandlerV1) DismissDuplicateClients(c *gin.Context) {
+	var body models.DismissDuplicateRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	response, err := h.Service.ClientService().DismissDuplicate(ctx, &clientproto.ResolveDuplicateRequest{
+		Id:    c.Param("id"),
+		Actor: body.Actor,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{
+		Status: response.Status,
+	})
+}
+
+// @Summary 	Merge Clients
+// @Description This API for merge two clients, the assignments of merge_id move to keep_id and merge_id is soft-deleted with a merged_into reference
+// @Tags 		duplicates
+// @Accept 		json
+// @Produce 	json
+// @Param 		Request body models.MergeClientsRequest true "Clients to merge"
+// @Success 	200 {object} models.Client
+// @Failure 	400 {object} models.Error
+// @Failure    	401 {object} models.Error
+// @Failure     403 {object} models.Error
+// @Failure 	500 {object} models.Error
+// @Router 		/v1/clients/merge [POST]
+func (h HandlerV1) MergeClients(c *gin.Context) {
+	var body models.MergeClientsRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	client, err := h.Service.ClientService().MergeClients(ctx, &clientproto.MergeClientsRequest{
+		KeepId:  body.KeepID,
+		MergeId: body.MergeID,
+		Actor:   body.Actor,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Client{
+		Id:          client.Id,
+		FirstName:   client.FirstName,
+		LastName:    client.LastName,
+		Age:         uint64(client.Age),
+		Gender:      client.Gender,
+		PhoneNumber: client.PhoneNumber,
+		Address:     client.Address,
+		Email:       client.Email,
+		Status:      client.Status,
+	})
+}
@@ -0,0 +1,194 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	apierrors "admin-api-gateway/api/errors"
+	"admin-api-gateway/api/models"
+	clientproto "admin-api-gateway/genproto/client_service"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"admin-api-gateway/internal/usecase/exporter"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// @Summary 		Export Clients
+// @Description 	This API for streaming export of all clients of a list (active, deleted or hidden) as CSV, NDJSON or XLSX
+// @Tags 			exports
+// @Produce 		text/csv
+// @Produce 		application/x-ndjson
+// @Produce 		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param           format query string false "csv, ndjson or xlsx" default(csv)
+// @Param           scope query string false "active, deleted or hidden" default(active)
+// @Param 			gender query string false "Gender, e.g. male"
+// @Param 			age_from query string false "Clients at least this old"
+// @Param 			age_to query string false "Clients at most this old"
+// @Param 			skills query string false "Comma separated skills, clients having at least one of them"
+// @Param 			level query string false "Desired level, e.g. Senior"
+// @Param 			location_type query string false "Desired location type, e.g. Remote"
+// @Param 			employment_type query string false "Desired employment type, e.g. Full-Time"
+// @Param 			salary_from query string false "Clients expecting at least this much, e.g. 1000.50"
+// @Param 			salary_to query string false "Clients expecting at most this much"
+// @Param 			currency query string false "ISO 4217 currency of the expected salary, e.g. UZS"
+// @Param 			pay_period query string false "hour, month or year"
+// @Success 		200 {file} file
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/exports/clients [GET]
+func (h HandlerV1) ExportClients(c *gin.Context) {
+	format := c.DefaultQuery("format", exporter.FormatCSV)
+	scope := c.DefaultQuery("scope", "active")
+	if scope != "active" && scope != "deleted" && scope != "hidden" {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: "scope should be active, deleted or hidden",
+		})
+		return
+	}
+
+	h.export(c, "clients-"+scope, format, func(ctx context.Context, w io.Writer) error {
+		return h.Exporter.Clients(ctx, w, format, &clientproto.StreamClientsRequest{
+			Scope:          scope,
+			Gender:         c.Query("gender"),
+			AgeFrom:        c.Query("age_from"),
+			AgeTo:          c.Query("age_to"),
+			Skills:         splitQuery(c.Query("skills")),
+			Level:          c.Query("level"),
+			LocationType:   c.Query("location_type"),
+			EmploymentType: c.Query("employment_type"),
+			SalaryFrom:     c.Query("salary_from"),
+			SalaryTo:       c.Query("salary_to"),
+			Currency:       c.Query("currency"),
+			PayPeriod:      c.Query("pay_period"),
+		})
+	})
+}
+
+// @Summary 		Export Jobs
+// @Description 	This API for streaming export of all jobs of a list (active or deleted) as CSV, NDJSON or XLSX
+// @Tags 			exports
+// @Produce 		text/csv
+// @Produce 		application/x-ndjson
+// @Produce 		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param           format query string false "csv, ndjson or xlsx" default(csv)
+// @Param           scope query string false "active or deleted" default(active)
+// @Param 			salary_from query string false "Jobs paying at least this much, e.g. 1000.50"
+// @Param 			salary_to query string false "Jobs paying at most this much"
+// @Param 			currency query string false "ISO 4217 currency, e.g. UZS"
+// @Param 			pay_period query string false "hour, month or year"
+// @Param 			level query string false "Level, e.g. Senior"
+// @Param 			location_type query string false "Location type, e.g. Remote"
+// @Param 			employment_type query string false "Employment type, e.g. Full-Time"
+// @Param 			company_id query string false "Company ID"
+// @Param 			skills query string false "Comma separated skills, jobs asking for at least one of them"
+// @Param 			near query string false "Place name, e.g. Tashkent, searched around with radius_km"
+// @Param 			latitude query string false "Latitude of the search center, e.g. 41.3111"
+// @Param 			longitude query string false "Longitude of the search center, e.g. 69.2797"
+// @Param 			radius_km query string false "Search radius in kilometers, jobs come closest first"
+// @Param 			bbox query string false "Bounding box south,west,north,east, e.g. 37.1,55.9,45.6,73.2"
+// @Param 			category_id query string false "Category ID, jobs in it or its subcategories"
+// @Param 			tags query string false "Comma separated tags, jobs with at least one of them"
+// @Param 			status query string false "draft, scheduled, published, closed or archived"
+// @Success 		200 {file} file
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/exports/jobs [GET]
+func (h HandlerV1) ExportJobs(c *gin.Context) {
+	format := c.DefaultQuery("format", exporter.FormatCSV)
+	scope := c.DefaultQuery("scope", "active")
+	if scope != "active" && scope != "deleted" {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: "scope should be active or deleted",
+		})
+		return
+	}
+
+	h.export(c, "jobs-"+scope, format, func(ctx context.Context, w io.Writer) error {
+		return h.Exporter.Jobs(ctx, w, format, &jobproto.StreamJobsRequest{
+			Scope:          scope,
+			SalaryFrom:     c.Query("salary_from"),
+			SalaryTo:       c.Query("salary_to"),
+			Currency:       c.Query("currency"),
+			PayPeriod:      c.Query("pay_period"),
+			Status:         c.Query("status"),
+			Level:          c.Query("level"),
+			LocationType:   c.Query("location_type"),
+			EmploymentType: c.Query("employment_type"),
+			CompanyId:      c.Query("company_id"),
+			Skills:         splitQuery(c.Query("skills")),
+			Near:           c.Query("near"),
+			Latitude:       c.Query("latitude"),
+			Longitude:      c.Query("longitude"),
+			RadiusKm:       c.Query("radius_km"),
+			Bbox:           c.Query("bbox"),
+			CategoryId:     c.Query("category_id"),
+			Tags:           splitQuery(c.Query("tags")),
+		})
+	})
+}
+
+// @Summary 		Export Client Jobs
+// @Description 	This API for streaming export of client-job assignments as CSV, NDJSON or XLSX, optionally filtered by client or job
+// @Tags 			exports
+// @Produce 		text/csv
+// @Produce 		application/x-ndjson
+// @Produce 		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param           format query string false "csv, ndjson or xlsx" default(csv)
+// @Param           client_id query string false "Client ID"
+// @Param           job_id query string false "Job ID"
+// @Success 		200 {file} file
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/exports/client-jobs [GET]
+func (h HandlerV1) ExportClientJobs(c *gin.Context) {
+	format := c.DefaultQuery("format", exporter.FormatCSV)
+
+	h.export(c, "client-jobs", format, func(ctx context.Context, w io.Writer) error {
+		return h.Exporter.ClientJobs(ctx, w, format, c.Query("client_id"), c.Query("job_id"))
+	})
+}
+
+// export streams straight into the response. It runs under EXPORT_TIMEOUT instead of
+// CONTEXT_TIMEOUT and lifts the server write deadline for the same period.
+func (h HandlerV1) export(c *gin.Context, name, format string, fn func(ctx context.Context, w io.Writer) error) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.Config.Export.Timeout)
+	defer cancel()
+
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(h.Config.Export.Timeout)); err != nil {
+		h.Logger.Warn("export: can't extend write deadline", zap.Error(err))
+	}
+
+	c.Header("Content-Type", exporter.ContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", name, format))
+
+	err := fn(ctx, c.Writer)
+	if err == nil {
+		return
+	}
+
+	// the response is already on its way, the client gets a truncated file
+	if c.Writer.Written() {
+		h.Logger.Error("export "+name, zap.Error(err))
+		return
+	}
+
+	c.Writer.Header().Del("Content-Disposition")
+	status := http.StatusInternalServerError
+	if errors.Is(err, exporter.ErrUnknownFormat) || apierrors.IsInvalidArgument(err) {
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, models.Error{
+		Message: err.Error(),
+	})
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	grpc_service_clients "admin-api-gateway/internal/infrastructure/grpc_service_client"
 	"admin-api-gateway/internal/pkg/config"
+	"admin-api-gateway/internal/usecase/exporter"
+	"admin-api-gateway/internal/usecase/importer"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,6 +15,8 @@ type HandlerV1 struct {
 	Logger         *zap.Logger
 	ContextTimeout time.Duration
 	Service        grpc_service_clients.ServiceClient
+	Importer       importer.Importer
+	Exporter       exporter.Exporter
 }
 
 // HandlerV1Config ...
@@ -21,6 +25,8 @@ type HandlerV1Config struct {
 	Logger         *zap.Logger
 	ContextTimeout time.Duration
 	Service        grpc_service_clients.ServiceClient
+	Importer       importer.Importer
+	Exporter       exporter.Exporter
 }
 
 // New ...
@@ -30,5 +36,7 @@ func New(c *HandlerV1Config) *HandlerV1 {
 		Logger:         c.Logger,
 		Service:        c.Service,
 		ContextTimeout: c.ContextTimeout,
+		Importer:       c.Importer,
+		Exporter:       c.Exporter,
 	}
 }
@@ -0,0 +1,205 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	"admin-api-gateway/internal/entity"
+	"admin-api-gateway/internal/usecase/importer"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Create Import
+// @Description 	This API for bulk import of clients or jobs from a CSV/XLSX file. Rows are validated in background, a dry run stops at preview until it is committed
+// @Tags 			imports
+// @Accept 			multipart/form-data
+// @Produce 		json
+// @Param           file formData file true "CSV or XLSX file"
+// @Param           entity formData string true "clients or jobs"
+// @Param           mapping formData string false "JSON object of field to file column, e.g. {\"first_name\":\"First Name\"}"
+// @Param           dry_run formData bool false "Only validate and preview"
+// @Success 		202 {object} models.Import
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/imports [POST]
+func (h HandlerV1) CreateImport(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	mapping := make(map[string]string)
+	if rawMapping := c.PostForm("mapping"); rawMapping != "" {
+		if err := json.Unmarshal([]byte(rawMapping), &mapping); err != nil {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: "mapping should be a JSON object: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	dryRun := false
+	if rawDryRun := c.PostForm("dry_run"); rawDryRun != "" {
+		dryRun, err = strconv.ParseBool(rawDryRun)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	imp, err := h.Importer.Create(middleware.PrincipalContext(c), &entity.ImportRequest{
+		Entity:   c.PostForm("entity"),
+		FileName: fileHeader.Filename,
+		Content:  content,
+		Mapping:  mapping,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, h.importModel(imp))
+}
+
+// @Summary 		Get Import
+// @Description 	This API for get status, counters and preview rows of an import
+// @Tags 			imports
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Import ID"
+// @Success 		200 {object} models.Import
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Router 			/v1/imports/{id} [GET]
+func (h HandlerV1) GetImport(c *gin.Context) {
+	imp, err := h.Importer.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.importModel(imp))
+}
+
+// @Summary 		Commit Import
+// @Description 	This API for commit a previewed dry-run import, rows are created in batches
+// @Tags 			imports
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Import ID"
+// @Success 		202 {object} models.Import
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Router 			/v1/imports/{id}/commit [POST]
+func (h HandlerV1) CommitImport(c *gin.Context) {
+	imp, err := h.Importer.Commit(middleware.PrincipalContext(c), c.Param("id"))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, importer.ErrImportNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, h.importModel(imp))
+}
+
+// @Summary 		Import Report
+// @Description 	This API for download per-row report of an import as CSV
+// @Tags 			imports
+// @Produce 		text/csv
+// @Param           id path string true "Import ID"
+// @Success 		200 {file} file
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Router 			/v1/imports/{id}/report [GET]
+func (h HandlerV1) ImportReport(c *gin.Context) {
+	importID := c.Param("id")
+	if _, err := h.Importer.Get(c.Request.Context(), importID); err != nil {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment; filename=import-"+importID+"-report.csv")
+	c.Status(http.StatusOK)
+	if err := h.Importer.WriteReport(c.Request.Context(), importID, c.Writer); err != nil {
+		h.Logger.Error("import report: " + err.Error())
+	}
+}
+
+func (h HandlerV1) importModel(imp *entity.Import) models.Import {
+	response := models.Import{
+		ID:        imp.ID,
+		Entity:    imp.Entity,
+		FileName:  imp.FileName,
+		DryRun:    imp.DryRun,
+		Status:    imp.Status,
+		Error:     imp.Error,
+		TotalRows: imp.TotalRows,
+		ValidRows: imp.ValidRows,
+		Created:   imp.Created,
+		Failed:    imp.Failed,
+		Preview:   []models.ImportRow{},
+		CreatedAt: imp.CreatedAt,
+		UpdatedAt: imp.UpdatedAt,
+	}
+	for index, row := range imp.Rows {
+		if index >= h.Config.Import.PreviewRows {
+			break
+		}
+		response.Preview = append(response.Preview, models.ImportRow{
+			Row:    row.Number,
+			Fields: row.Fields,
+			ID:     row.ID,
+			Errors: row.Errors,
+		})
+	}
+
+	return response
+}
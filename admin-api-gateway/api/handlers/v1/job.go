@@ -2,15 +2,19 @@ package v1
 
 import (
 	_ "admin-api-gateway/api/docs"
+	apierrors "admin-api-gateway/api/errors"
+	"admin-api-gateway/api/middleware"
 	"admin-api-gateway/api/models"
 	clientproto "admin-api-gateway/genproto/client_service"
 	jobproto "admin-api-gateway/genproto/job_service"
 	"context"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -47,17 +51,33 @@ func (h HandlerV1) CreateJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.JobService().CreateJob(ctx, &jobproto.Job{
-		Name:           body.Name,
-		Salary:         body.Salary,
-		Level:          body.Level,
-		LocationType:   body.LocationType,
-		EmploymentType: body.EmploymentType,
-		Address:        body.Address,
-		Company:        body.Company,
+		Name:             body.Name,
+		SalaryMin:        body.SalaryMin,
+		SalaryMax:        body.SalaryMax,
+		Currency:         body.Currency,
+		PayPeriod:        body.PayPeriod,
+		Level:            body.Level,
+		LocationType:     body.LocationType,
+		EmploymentType:   body.EmploymentType,
+		Address:          body.Address,
+		CompanyId:        body.CompanyID,
+		Company:          body.Company,
+		Description:      body.Description,
+		Responsibilities: body.Responsibilities,
+		Requirements:     body.Requirements,
+		Benefits:         body.Benefits,
+		Skills:           body.Skills,
+		Latitude:         body.Latitude,
+		Longitude:        body.Longitude,
+		CategoryIds:      body.CategoryIDs,
+		Tags:             body.Tags,
+		Status:           body.Status,
+		PublishAt:        body.PublishAt,
+		CloseAt:          body.CloseAt,
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
@@ -101,18 +121,34 @@ func (h HandlerV1) UpdateJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.JobService().UpdateJob(ctx, &jobproto.Job{
-		Id:             body.ID,
-		Name:           body.Name,
-		Salary:         body.Salary,
-		Level:          body.Level,
-		LocationType:   body.LocationType,
-		EmploymentType: body.EmploymentType,
-		Address:        body.Address,
-		Company:        body.Company,
+		Id:               body.ID,
+		Name:             body.Name,
+		SalaryMin:        body.SalaryMin,
+		SalaryMax:        body.SalaryMax,
+		Currency:         body.Currency,
+		PayPeriod:        body.PayPeriod,
+		Level:            body.Level,
+		LocationType:     body.LocationType,
+		EmploymentType:   body.EmploymentType,
+		Address:          body.Address,
+		CompanyId:        body.CompanyID,
+		Company:          body.Company,
+		Description:      body.Description,
+		Responsibilities: body.Responsibilities,
+		Requirements:     body.Requirements,
+		Benefits:         body.Benefits,
+		Skills:           body.Skills,
+		Latitude:         body.Latitude,
+		Longitude:        body.Longitude,
+		CategoryIds:      body.CategoryIDs,
+		Tags:             body.Tags,
+		Status:           body.Status,
+		PublishAt:        body.PublishAt,
+		CloseAt:          body.CloseAt,
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
@@ -130,9 +166,11 @@ func (h HandlerV1) UpdateJob(c *gin.Context) {
 // @Produce 		json
 // @Param           id path string true "Job ID"
 // @Success 		200 {object} models.Job
+// @Success 		202 {object} models.Error
 // @Failure 		400 {object} models.Error
 // @Failure 		401 {object} models.Error
 // @Failure 		403 {object} models.Error
+// @Failure 		409 {object} models.Error
 // @Failure 		500 {object} models.Error
 // @Router 			/v1/job/{id} [DELETE]
 func (h HandlerV1) DeleteJob(c *gin.Context) {
@@ -148,7 +186,7 @@ func (h HandlerV1) DeleteJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	jobID := c.Param("id")
@@ -156,6 +194,20 @@ func (h HandlerV1) DeleteJob(c *gin.Context) {
 	_, err = h.Service.JobService().DeleteJob(ctx, &jobproto.JobWithGUID{
 		JobId: jobID,
 	})
+	// the deletion is a saga, a failed step is retried in background and the same
+	// deletion can't run twice at a time
+	if apierrors.IsPending(err) {
+		c.JSON(http.StatusAccepted, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
+	if apierrors.IsConflict(err) {
+		c.JSON(http.StatusConflict, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
@@ -191,7 +243,7 @@ func (h HandlerV1) GetJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	jobID := c.Param("id")
@@ -216,6 +268,23 @@ func (h HandlerV1) GetJob(c *gin.Context) {
 // @Produce 		json
 // @Param           page query string true "Page"
 // @Param 			limit query string true "Limit"
+// @Param 			salary_from query string false "Jobs paying at least this much, e.g. 1000.50"
+// @Param 			salary_to query string false "Jobs paying at most this much"
+// @Param 			currency query string false "ISO 4217 currency, e.g. UZS"
+// @Param 			pay_period query string false "hour, month or year"
+// @Param 			level query string false "Level, e.g. Senior"
+// @Param 			location_type query string false "Location type, e.g. Remote"
+// @Param 			employment_type query string false "Employment type, e.g. Full-Time"
+// @Param 			company_id query string false "Company ID"
+// @Param 			skills query string false "Comma separated skills, jobs asking for at least one of them"
+// @Param 			near query string false "Place name, e.g. Tashkent, searched around with radius_km"
+// @Param 			latitude query string false "Latitude of the search center, e.g. 41.3111"
+// @Param 			longitude query string false "Longitude of the search center, e.g. 69.2797"
+// @Param 			radius_km query string false "Search radius in kilometers, jobs come closest first with distance_km"
+// @Param 			bbox query string false "Bounding box south,west,north,east, e.g. 37.1,55.9,45.6,73.2"
+// @Param 			category_id query string false "Category ID, jobs in it or its subcategories"
+// @Param 			tags query string false "Comma separated tags, jobs with at least one of them"
+// @Param 			status query string false "draft, scheduled, published, closed or archived"
 // @Success 		200 {object} []models.Job
 // @Failure 		400 {object} models.Error
 // @Failure 		401 {object} models.Error
@@ -235,7 +304,7 @@ func (h HandlerV1) ListJobs(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	page := c.Query("page")
@@ -257,8 +326,25 @@ func (h HandlerV1) ListJobs(c *gin.Context) {
 	}
 
 	response, err := h.Service.JobService().GetAllJobs(ctx, &jobproto.ListRequest{
-		Page:  uint64(pageInt),
-		Limit: uint64(limitInt),
+		Page:           uint64(pageInt),
+		Limit:          uint64(limitInt),
+		SalaryFrom:     c.Query("salary_from"),
+		SalaryTo:       c.Query("salary_to"),
+		Currency:       c.Query("currency"),
+		PayPeriod:      c.Query("pay_period"),
+		Status:         c.Query("status"),
+		Level:          c.Query("level"),
+		LocationType:   c.Query("location_type"),
+		EmploymentType: c.Query("employment_type"),
+		CompanyId:      c.Query("company_id"),
+		Skills:         splitQuery(c.Query("skills")),
+		Near:           c.Query("near"),
+		Latitude:       c.Query("latitude"),
+		Longitude:      c.Query("longitude"),
+		RadiusKm:       c.Query("radius_km"),
+		Bbox:           c.Query("bbox"),
+		CategoryId:     c.Query("category_id"),
+		Tags:           splitQuery(c.Query("tags")),
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
@@ -270,14 +356,35 @@ func (h HandlerV1) ListJobs(c *gin.Context) {
 	var listJobs []*models.Job
 	for _, job := range response.Jobs {
 		listJobs = append(listJobs, &models.Job{
-			ID:             job.Id,
-			Name:           job.Name,
-			Salary:         job.Salary,
-			Level:          job.Level,
-			LocationType:   job.LocationType,
-			EmploymentType: job.EmploymentType,
-			Address:        job.Address,
-			Company:        job.Company,
+			ID:                   job.Id,
+			Name:                 job.Name,
+			SalaryMin:            job.SalaryMin,
+			SalaryMax:            job.SalaryMax,
+			Currency:             job.Currency,
+			PayPeriod:            job.PayPeriod,
+			Level:                job.Level,
+			LocationType:         job.LocationType,
+			EmploymentType:       job.EmploymentType,
+			Address:              job.Address,
+			CompanyID:            job.CompanyId,
+			Company:              job.Company,
+			Description:          job.Description,
+			Responsibilities:     job.Responsibilities,
+			Requirements:         job.Requirements,
+			Benefits:             job.Benefits,
+			DescriptionHTML:      job.DescriptionHtml,
+			ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+			RequirementsHTML:     job.RequirementsHtml,
+			BenefitsHTML:         job.BenefitsHtml,
+			Skills:               job.Skills,
+			Latitude:             job.Latitude,
+			Longitude:            job.Longitude,
+			CategoryIDs:          job.CategoryIds,
+			Tags:                 job.Tags,
+			DistanceKm:           job.DistanceKm,
+			Status:               job.Status,
+			PublishAt:            job.PublishAt,
+			CloseAt:              job.CloseAt,
 		})
 	}
 
@@ -310,7 +417,7 @@ func (h HandlerV1) ListDeletedJobs(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	page := c.Query("page")
@@ -345,14 +452,35 @@ func (h HandlerV1) ListDeletedJobs(c *gin.Context) {
 	var listJobs []*models.Job
 	for _, job := range response.Jobs {
 		listJobs = append(listJobs, &models.Job{
-			ID:             job.Id,
-			Name:           job.Name,
-			Salary:         job.Salary,
-			Level:          job.Level,
-			LocationType:   job.LocationType,
-			EmploymentType: job.EmploymentType,
-			Address:        job.Address,
-			Company:        job.Company,
+			ID:                   job.Id,
+			Name:                 job.Name,
+			SalaryMin:            job.SalaryMin,
+			SalaryMax:            job.SalaryMax,
+			Currency:             job.Currency,
+			PayPeriod:            job.PayPeriod,
+			Level:                job.Level,
+			LocationType:         job.LocationType,
+			EmploymentType:       job.EmploymentType,
+			Address:              job.Address,
+			CompanyID:            job.CompanyId,
+			Company:              job.Company,
+			Description:          job.Description,
+			Responsibilities:     job.Responsibilities,
+			Requirements:         job.Requirements,
+			Benefits:             job.Benefits,
+			DescriptionHTML:      job.DescriptionHtml,
+			ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+			RequirementsHTML:     job.RequirementsHtml,
+			BenefitsHTML:         job.BenefitsHtml,
+			Skills:               job.Skills,
+			Latitude:             job.Latitude,
+			Longitude:            job.Longitude,
+			CategoryIDs:          job.CategoryIds,
+			Tags:                 job.Tags,
+			DistanceKm:           job.DistanceKm,
+			Status:               job.Status,
+			PublishAt:            job.PublishAt,
+			CloseAt:              job.CloseAt,
 		})
 	}
 
@@ -392,7 +520,7 @@ func (h HandlerV1) AddClientToJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.JobService().AddClientJob(ctx, &jobproto.ClientJobs{
@@ -443,7 +571,7 @@ func (h HandlerV1) RemoveClientFromJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	_, err = h.Service.JobService().DeleteClientJob(ctx, &jobproto.ClientJobs{
@@ -494,7 +622,7 @@ func (h HandlerV1) GetClientsWithJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	clientJobs, err := h.Service.JobService().GetClientJobs(ctx, &jobproto.ClientJobRequest{
@@ -532,12 +660,31 @@ func (h HandlerV1) GetClientsWithJob(c *gin.Context) {
 		Status:      client.Status,
 		Refresh:     client.Refresh,
 	}
+
+	jobIDs := make([]string, 0, len(clientJobs.ClientJobs))
 	for _, clientjob := range clientJobs.ClientJobs {
-		job, err := h.Service.JobService().GetJob(ctx, &jobproto.JobWithGUID{
-			JobId: clientjob.JobId,
+		jobIDs = append(jobIDs, clientjob.JobId)
+	}
+	jobs, err := h.Service.JobService().GetJobsByIDs(ctx, &jobproto.JobsByIDsRequest{
+		Ids: jobIDs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
 		})
-		if err != nil {
-			log.Println("job deleted", clientjob.JobId)
+		return
+	}
+	for _, id := range jobs.MissingIds {
+		log.Println("job deleted", id)
+	}
+	jobsByID := make(map[string]*jobproto.Job, len(jobs.Jobs))
+	for _, job := range jobs.Jobs {
+		jobsByID[job.Id] = job
+	}
+
+	for _, clientjob := range clientJobs.ClientJobs {
+		job, ok := jobsByID[clientjob.JobId]
+		if !ok {
 			continue
 		}
 		startDate, err := time.Parse(time.RFC3339, clientjob.StartDate)
@@ -547,25 +694,49 @@ func (h HandlerV1) GetClientsWithJob(c *gin.Context) {
 			})
 			return
 		}
-		endDate, err := time.Parse(time.RFC3339, clientjob.EndDate)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, models.Error{
-				Message: err.Error(),
-			})
-			return
+		// current jobs have no end date
+		var endDate time.Time
+		if clientjob.EndDate != "" {
+			endDate, err = time.Parse(time.RFC3339, clientjob.EndDate)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, models.Error{
+					Message: err.Error(),
+				})
+				return
+			}
 		}
 
 		response.Jobs = append(response.Jobs, models.ResponseJob{
-			ID:             job.Id,
-			Name:           job.Name,
-			Salary:         job.Salary,
-			Level:          job.Level,
-			LocationType:   job.LocationType,
-			EmploymentType: job.EmploymentType,
-			Address:        job.Address,
-			Company:        job.Company,
-			StartDate:      startDate,
-			EndDate:        endDate,
+			ID:                   job.Id,
+			Name:                 job.Name,
+			SalaryMin:            job.SalaryMin,
+			SalaryMax:            job.SalaryMax,
+			Currency:             job.Currency,
+			PayPeriod:            job.PayPeriod,
+			Level:                job.Level,
+			LocationType:         job.LocationType,
+			EmploymentType:       job.EmploymentType,
+			Address:              job.Address,
+			CompanyID:            job.CompanyId,
+			Company:              job.Company,
+			Description:          job.Description,
+			Responsibilities:     job.Responsibilities,
+			Requirements:         job.Requirements,
+			Benefits:             job.Benefits,
+			DescriptionHTML:      job.DescriptionHtml,
+			ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+			RequirementsHTML:     job.RequirementsHtml,
+			BenefitsHTML:         job.BenefitsHtml,
+			Skills:               job.Skills,
+			Latitude:             job.Latitude,
+			Longitude:            job.Longitude,
+			CategoryIDs:          job.CategoryIds,
+			Tags:                 job.Tags,
+			Status:               job.Status,
+			PublishAt:            job.PublishAt,
+			CloseAt:              job.CloseAt,
+			StartDate:            startDate,
+			EndDate:              endDate,
 		})
 	}
 
@@ -606,7 +777,7 @@ func (h HandlerV1) GetJobsWithClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	jobClients, err := h.Service.JobService().GetJobClients(ctx, &jobproto.ClientJobRequest{
@@ -632,40 +803,89 @@ func (h HandlerV1) GetJobsWithClient(c *gin.Context) {
 		return
 	}
 
-	startDate, err := time.Parse(time.RFC3339, jobClients.ClientJobs[0].StartDate)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, models.Error{
-			Message: err.Error(),
-		})
-		return
+	// a job nobody holds has no dates, current jobs have no end date
+	var startDate, endDate time.Time
+	if len(jobClients.ClientJobs) != 0 {
+		startDate, err = time.Parse(time.RFC3339, jobClients.ClientJobs[0].StartDate)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		if jobClients.ClientJobs[0].EndDate != "" {
+			endDate, err = time.Parse(time.RFC3339, jobClients.ClientJobs[0].EndDate)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, models.Error{
+					Message: err.Error(),
+				})
+				return
+			}
+		}
+	}
+
+	response.Job = models.ResponseJob{
+		ID:                   job.Id,
+		Name:                 job.Name,
+		SalaryMin:            job.SalaryMin,
+		SalaryMax:            job.SalaryMax,
+		Currency:             job.Currency,
+		PayPeriod:            job.PayPeriod,
+		Level:                job.Level,
+		LocationType:         job.LocationType,
+		EmploymentType:       job.EmploymentType,
+		Address:              job.Address,
+		CompanyID:            job.CompanyId,
+		Company:              job.Company,
+		Description:          job.Description,
+		Responsibilities:     job.Responsibilities,
+		Requirements:         job.Requirements,
+		Benefits:             job.Benefits,
+		DescriptionHTML:      job.DescriptionHtml,
+		ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+		RequirementsHTML:     job.RequirementsHtml,
+		BenefitsHTML:         job.BenefitsHtml,
+		Skills:               job.Skills,
+		Latitude:             job.Latitude,
+		Longitude:            job.Longitude,
+		CategoryIDs:          job.CategoryIds,
+		Tags:                 job.Tags,
+		Status:               job.Status,
+		PublishAt:            job.PublishAt,
+		CloseAt:              job.CloseAt,
+		StartDate:            startDate,
+		EndDate:              endDate,
+	}
+	response.Clients = []models.Client{}
+	if len(jobClients.ClientJobs) == 0 {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	clientIDs := make([]string, 0, len(jobClients.ClientJobs))
+	for _, clientInfo := range jobClients.ClientJobs {
+		clientIDs = append(clientIDs, clientInfo.ClientId)
 	}
-	endDate, err := time.Parse(time.RFC3339, jobClients.ClientJobs[0].EndDate)
+	clients, err := h.Service.ClientService().GetClientsByIDs(ctx, &clientproto.ClientsByIDsRequest{
+		Ids: clientIDs,
+	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
 		})
 		return
 	}
-
-	response.Job = models.ResponseJob{
-		ID:             job.Id,
-		Name:           job.Name,
-		Salary:         job.Salary,
-		Level:          job.Level,
-		LocationType:   job.LocationType,
-		EmploymentType: job.EmploymentType,
-		Address:        job.Address,
-		Company:        job.Company,
-		StartDate:      startDate,
-		EndDate:        endDate,
+	for _, id := range clients.MissingIds {
+		log.Println("client deleted", id)
+	}
+	clientsByID := make(map[string]*clientproto.Client, len(clients.Clients))
+	for _, client := range clients.Clients {
+		clientsByID[client.Id] = client
 	}
 
 	for _, clientInfo := range jobClients.ClientJobs {
-		client, err := h.Service.ClientService().GetClient(ctx, &clientproto.ClientWithGUID{
-			Guid: clientInfo.ClientId,
-		})
-		if err != nil {
-			log.Println("client deleted", clientInfo.ClientId)
+		client, ok := clientsByID[clientInfo.ClientId]
+		if !ok {
 			continue
 		}
 		response.Clients = append(response.Clients, models.Client{
@@ -685,3 +905,14 @@ func (h HandlerV1) GetJobsWithClient(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// splitQuery reads a comma separated query parameter
+func splitQuery(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
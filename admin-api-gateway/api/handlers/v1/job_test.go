@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	grpc_service_clients "admin-api-gateway/internal/infrastructure/grpc_service_client"
+	"admin-api-gateway/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// unheldJob is a job nobody holds, client-service isn't asked then
+type unheldJob struct {
+	grpc_service_clients.ServiceClient
+	jobproto.JobServiceClient
+}
+
+func (s unheldJob) JobService() jobproto.JobServiceClient {
+	return s
+}
+
+func (s unheldJob) GetJobClients(context.Context, *jobproto.ClientJobRequest, ...grpc.CallOption) (*jobproto.ListClientJobs, error) {
+	return &jobproto.ListClientJobs{}, nil
+}
+
+func (s unheldJob) GetJob(_ context.Context, in *jobproto.JobWithGUID, _ ...grpc.CallOption) (*jobproto.Job, error) {
+	return &jobproto.Job{Id: in.JobId, Name: "Go developer"}, nil
+}
+
+func TestGetJobsWithClientWithoutClients(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.Context.Timeout = "1s"
+	handler := New(&HandlerV1Config{Config: cfg, Logger: zap.NewNop(), Service: unheldJob{}})
+	router := gin.New()
+	router.POST("/v1/jobs/job-clients", handler.GetJobsWithClient)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/jobs/job-clients", strings.NewReader(`{"job_id":"j1"}`)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", recorder.Code, recorder.Body)
+	}
+
+	var response models.JobWithClients
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Job.ID != "j1" || response.Clients == nil || len(response.Clients) != 0 {
+		t.Errorf("response = %+v, want the job without clients", response)
+	}
+	if !response.Job.StartDate.IsZero() || !response.Job.EndDate.IsZero() {
+		t.Errorf("dates = %v, %v, want none", response.Job.StartDate, response.Job.EndDate)
+	}
+}
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	clientproto "admin-api-gateway/genproto/client_service"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Notifications
+// @Description 	This API for get the notification queue, newest first: verification codes, password resets and job alerts sent or waiting to be sent by email and SMS
+// @Tags 			notifications
+// @Accept 			json
+// @Produce 		json
+// @Param           client_id query string false "Client ID"
+// @Param           channel query string false "email or sms"
+// @Param           template query string false "verification, password_reset or job_alert"
+// @Param           status query string false "pending, sent or dead"
+// @Param           page query string true "Page"
+// @Param 			limit query string true "Limit"
+// @Success 		200 {object} []models.Notification
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/notifications [GET]
+func (h HandlerV1) ListNotifications(c *gin.Context) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.ClientService().GetNotifications(ctx, &clientproto.ListNotificationsRequest{
+		Page:     int64(page),
+		Limit:    int64(limit),
+		ClientId: c.Query("client_id"),
+		Channel:  c.Query("channel"),
+		Template: c.Query("template"),
+		Status:   c.Query("status"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Notification{}
+	for _, notification := range list.Notifications {
+		response = append(response, models.Notification{
+			ID:            notification.Id,
+			ClientID:      notification.ClientId,
+			Channel:       notification.Channel,
+			Template:      notification.Template,
+			Locale:        notification.Locale,
+			To:            notification.To,
+			Subject:       notification.Subject,
+			Body:          notification.Body,
+			Status:        notification.Status,
+			Attempts:      notification.Attempts,
+			NextAttemptAt: notification.NextAttemptAt,
+			LastError:     notification.LastError,
+			SentAt:        notification.SentAt,
+			CreatedAt:     notification.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Get Notification Preferences
+// @Description 	This API for get how a client is notified and whether the email of the client is verified
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} models.NotificationPreferences
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/notification-preferences [GET]
+func (h HandlerV1) GetNotificationPreferences(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	preferences, err := h.Service.ClientService().GetNotificationPreferences(ctx, &clientproto.ClientWithGUID{
+		Guid: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.NotificationPreferences{
+		Locale:        preferences.Locale,
+		Email:         preferences.Email,
+		SMS:           preferences.Sms,
+		JobAlerts:     preferences.JobAlerts,
+		EmailVerified: preferences.EmailVerified,
+		UpdatedAt:     preferences.UpdatedAt,
+	})
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	clientproto "admin-api-gateway/genproto/client_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Get Client Profile
+// @Description 	This API for get the skills and job preferences of a client, empty when the client has not filled them yet
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} models.ClientProfile
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/profile [GET]
+func (h HandlerV1) GetClientProfile(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	profile, err := h.Service.ClientService().GetClientProfile(ctx, &clientproto.ClientWithGUID{
+		Guid: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, profileFromProto(profile))
+}
+
+// @Summary 		Update Client Profile
+// @Description 	This API for set the skills and job preferences of a client, the expected salary needs a currency and a pay period
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           Profile body models.ClientProfile true "Client Profile Model"
+// @Success 		200 {object} models.ClientProfile
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/profile [PUT]
+func (h HandlerV1) UpsertClientProfile(c *gin.Context) {
+	var body models.ClientProfile
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	profile, err := h.Service.ClientService().UpsertClientProfile(ctx, &clientproto.ClientProfile{
+		ClientId:              c.Param("id"),
+		Skills:                body.Skills,
+		DesiredLevel:          body.DesiredLevel,
+		DesiredLocationType:   body.DesiredLocationType,
+		DesiredEmploymentType: body.DesiredEmploymentType,
+		ExpectedSalary:        body.ExpectedSalary,
+		ExpectedCurrency:      body.ExpectedCurrency,
+		ExpectedPayPeriod:     body.ExpectedPayPeriod,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, profileFromProto(profile))
+}
+
+func profileFromProto(profile *clientproto.ClientProfile) models.ClientProfile {
+	response := models.ClientProfile{
+		ClientID:              profile.ClientId,
+		FirstName:             profile.FirstName,
+		LastName:              profile.LastName,
+		Skills:                profile.Skills,
+		DesiredLevel:          profile.DesiredLevel,
+		DesiredLocationType:   profile.DesiredLocationType,
+		DesiredEmploymentType: profile.DesiredEmploymentType,
+		ExpectedSalary:        profile.ExpectedSalary,
+		ExpectedCurrency:      profile.ExpectedCurrency,
+		ExpectedPayPeriod:     profile.ExpectedPayPeriod,
+		UpdatedAt:             profile.UpdatedAt,
+	}
+	if response.Skills == nil {
+		response.Skills = []string{}
+	}
+	return response
+}
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Recommend Jobs
+// @Description 	This API for get the published jobs matching the profile of a client best, every score is explained by its factors
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           limit query int false "Limit, 10 by default, at most 100"
+// @Success 		200 {object} []models.JobRecommendation
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/recommended-jobs [GET]
+func (h HandlerV1) RecommendJobsForClient(c *gin.Context) {
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	recommendations, err := h.Service.JobService().RecommendJobsForClient(ctx, &jobproto.RecommendJobsRequest{
+		ClientId: c.Param("id"),
+		Limit:    limit,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.JobRecommendation{}
+	for _, recommendation := range recommendations.Recommendations {
+		job := recommendation.Job
+		response = append(response, models.JobRecommendation{
+			Job: models.Job{
+				ID:                   job.Id,
+				Name:                 job.Name,
+				SalaryMin:            job.SalaryMin,
+				SalaryMax:            job.SalaryMax,
+				Currency:             job.Currency,
+				PayPeriod:            job.PayPeriod,
+				Level:                job.Level,
+				LocationType:         job.LocationType,
+				EmploymentType:       job.EmploymentType,
+				Address:              job.Address,
+				CompanyID:            job.CompanyId,
+				Company:              job.Company,
+				Description:          job.Description,
+				Responsibilities:     job.Responsibilities,
+				Requirements:         job.Requirements,
+				Benefits:             job.Benefits,
+				DescriptionHTML:      job.DescriptionHtml,
+				ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+				RequirementsHTML:     job.RequirementsHtml,
+				BenefitsHTML:         job.BenefitsHtml,
+				Skills:               job.Skills,
+				Latitude:             job.Latitude,
+				Longitude:            job.Longitude,
+				CategoryIDs:          job.CategoryIds,
+				Tags:                 job.Tags,
+				DistanceKm:           job.DistanceKm,
+				Status:               job.Status,
+				PublishAt:            job.PublishAt,
+				CloseAt:              job.CloseAt,
+			},
+			Score:       recommendation.Score,
+			Explanation: recommendation.Explanation,
+			Factors:     factorsFromProto(recommendation.Factors),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Recommend Clients
+// @Description 	This API for shortlist the clients whose profiles match a job best, clients who already applied are left out
+// @Tags 			jobs
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Job ID"
+// @Param           limit query int false "Limit, 10 by default, at most 100"
+// @Success 		200 {object} []models.ClientRecommendation
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/job/{id}/recommended-clients [GET]
+func (h HandlerV1) RecommendClientsForJob(c *gin.Context) {
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	recommendations, err := h.Service.JobService().RecommendClientsForJob(ctx, &jobproto.RecommendClientsRequest{
+		JobId: c.Param("id"),
+		Limit: limit,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.ClientRecommendation{}
+	for _, recommendation := range recommendations.Recommendations {
+		response = append(response, models.ClientRecommendation{
+			ClientID:    recommendation.ClientId,
+			FirstName:   recommendation.FirstName,
+			LastName:    recommendation.LastName,
+			Score:       recommendation.Score,
+			Explanation: recommendation.Explanation,
+			Factors:     factorsFromProto(recommendation.Factors),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func factorsFromProto(factors []*jobproto.RecommendationFactor) []models.RecommendationFactor {
+	response := []models.RecommendationFactor{}
+	for _, factor := range factors {
+		response = append(response, models.RecommendationFactor{
+			Name:   factor.Name,
+			Score:  factor.Score,
+			Weight: factor.Weight,
+			Detail: factor.Detail,
+		})
+	}
+	return response
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	_ "admin-api-gateway/api/docs"
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Saved Searches
+// @Description 	This API for get the job searches a client saved to be alerted about new matching jobs
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} []models.SavedSearch
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/saved-searches [GET]
+func (h HandlerV1) ListSavedSearches(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetSavedSearches(ctx, &jobproto.ListSavedSearchesRequest{
+		ClientId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.SavedSearch{}
+	for _, search := range list.SavedSearches {
+		response = append(response, savedSearchFromProto(search))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func savedSearchFromProto(search *jobproto.SavedSearch) models.SavedSearch {
+	response := models.SavedSearch{
+		ID:         search.Id,
+		ClientID:   search.ClientId,
+		Name:       search.Name,
+		Frequency:  search.Frequency,
+		LastSentAt: search.LastSentAt,
+		CreatedAt:  search.CreatedAt,
+		UpdatedAt:  search.UpdatedAt,
+	}
+	if filter := search.Filter; filter != nil {
+		response.Filter = models.JobSearchFilter{
+			SalaryFrom:     filter.SalaryFrom,
+			SalaryTo:       filter.SalaryTo,
+			Currency:       filter.Currency,
+			PayPeriod:      filter.PayPeriod,
+			Level:          filter.Level,
+			LocationType:   filter.LocationType,
+			EmploymentType: filter.EmploymentType,
+			CompanyID:      filter.CompanyId,
+			Skills:         filter.Skills,
+			Near:           filter.Near,
+			Latitude:       filter.Latitude,
+			Longitude:      filter.Longitude,
+			RadiusKm:       filter.RadiusKm,
+			Bbox:           filter.Bbox,
+			CategoryID:     filter.CategoryId,
+			Tags:           filter.Tags,
+		}
+	}
+	if response.Filter.Skills == nil {
+		response.Filter.Skills = []string{}
+	}
+	if response.Filter.Tags == nil {
+		response.Filter.Tags = []string{}
+	}
+	return response
+}
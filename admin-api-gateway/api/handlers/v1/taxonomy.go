@@ -0,0 +1,375 @@
+package v1
+
+import (
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Categories
+// @Description 	This API for get the tree of job categories with their published job counts, every category comes right after its parent
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Success 		200 {object} []models.Category
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/categories [GET]
+func (h HandlerV1) ListCategories(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetCategories(ctx, &jobproto.ListCategoriesRequest{
+		WithCounts: true,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Category{}
+	for _, category := range list.Categories {
+		response = append(response, categoryFromProto(category))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		List Tags
+// @Description 	This API for get the job tags with their published job counts
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Success 		200 {object} []models.Tag
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/tags [GET]
+func (h HandlerV1) ListTags(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetTags(ctx, &jobproto.ListTagsRequest{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Tag{}
+	for _, tag := range list.Tags {
+		response = append(response, tagFromProto(tag))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func categoryFromProto(category *jobproto.Category) models.Category {
+	return models.Category{
+		ID:        category.Id,
+		ParentID:  category.ParentId,
+		Name:      category.Name,
+		Position:  int(category.Position),
+		JobCount:  category.JobCount,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+func tagFromProto(tag *jobproto.Tag) models.Tag {
+	return models.Tag{
+		Slug:      tag.Slug,
+		Name:      tag.Name,
+		JobCount:  tag.JobCount,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+// @Summary 		Create Category
+// @Description 	This API for create a job category, under parent_id when set
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Param           Category body models.Category true "Category Model"
+// @Success 		201 {object} models.Category
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/category [POST]
+func (h HandlerV1) CreateCategory(c *gin.Context) {
+	var body models.Category
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	category, err := h.Service.JobService().CreateCategory(ctx, categoryToProto(body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, categoryFromProto(category))
+}
+
+// @Summary 		Update Category
+// @Description 	This API for rename, reorder or move a job category
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Param           Category body models.Category true "Category Model"
+// @Success 		200 {object} models.Category
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/category [PUT]
+func (h HandlerV1) UpdateCategory(c *gin.Context) {
+	var body models.Category
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	category, err := h.Service.JobService().UpdateCategory(ctx, categoryToProto(body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, categoryFromProto(category))
+}
+
+// @Summary 		Delete Category
+// @Description 	This API for delete a job category without subcategories, its jobs are unlinked
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Category ID"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/category/{id} [DELETE]
+func (h HandlerV1) DeleteCategory(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.JobService().DeleteCategory(ctx, &jobproto.CategoryWithGUID{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+// @Summary 		Create Tag
+// @Description 	This API for create a job tag, its slug is derived from the name
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Param           Tag body models.TagName true "Tag Name"
+// @Success 		201 {object} models.Tag
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/tag [POST]
+func (h HandlerV1) CreateTag(c *gin.Context) {
+	var body models.TagName
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	tag, err := h.Service.JobService().CreateTag(ctx, &jobproto.Tag{
+		Name: body.Name,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, tagFromProto(tag))
+}
+
+// @Summary 		Rename Tag
+// @Description 	This API for rename a job tag, the slug follows the name and the jobs keep the tag
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Param           slug path string true "Tag slug"
+// @Param           Tag body models.TagName true "Tag Name"
+// @Success 		200 {object} models.Tag
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/tag/{slug} [PUT]
+func (h HandlerV1) UpdateTag(c *gin.Context) {
+	var body models.TagName
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	tag, err := h.Service.JobService().UpdateTag(ctx, &jobproto.UpdateTagRequest{
+		Slug: c.Param("slug"),
+		Name: body.Name,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tagFromProto(tag))
+}
+
+// @Summary 		Delete Tag
+// @Description 	This API for delete a job tag, it is removed from its jobs
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Param           slug path string true "Tag slug"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/tag/{slug} [DELETE]
+func (h HandlerV1) DeleteTag(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.JobService().DeleteTag(ctx, &jobproto.TagWithSlug{
+		Slug: c.Param("slug"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+func categoryToProto(category models.Category) *jobproto.Category {
+	return &jobproto.Category{
+		Id:       category.ID,
+		ParentId: category.ParentID,
+		Name:     category.Name,
+		Position: int32(category.Position),
+	}
+}
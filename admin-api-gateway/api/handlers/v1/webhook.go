@@ -0,0 +1,401 @@
+package v1
+
+import (
+	"admin-api-gateway/api/middleware"
+	"admin-api-gateway/api/models"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Create Webhook
+// @Description 	This API for subscribe a partner URL to job events, the payloads are signed with HMAC-SHA256 of the secret and the secret is generated when empty. It's returned only here.
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           Webhook body models.Webhook true "Webhook Model"
+// @Success 		201 {object} models.Webhook
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhook [POST]
+func (h HandlerV1) CreateWebhook(c *gin.Context) {
+	var body models.Webhook
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	hook, err := h.Service.JobService().CreateWebhook(ctx, webhookToProto(body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhookFromProto(hook))
+}
+
+// @Summary 		Update Webhook
+// @Description 	This API for change the URL, the event types or the secret of a webhook or pause it, an empty secret keeps the current one
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           Webhook body models.Webhook true "Webhook Model"
+// @Success 		200 {object} models.Webhook
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhook [PUT]
+func (h HandlerV1) UpdateWebhook(c *gin.Context) {
+	var body models.Webhook
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	hook, err := h.Service.JobService().UpdateWebhook(ctx, webhookToProto(body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookFromProto(hook))
+}
+
+// @Summary 		Delete Webhook
+// @Description 	This API for delete a webhook, its pending deliveries are given up
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Webhook ID"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhook/{id} [DELETE]
+func (h HandlerV1) DeleteWebhook(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.JobService().DeleteWebhook(ctx, &jobproto.WebhookWithGUID{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+// @Summary 		Get Webhook
+// @Description 	This API for get a webhook
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Webhook ID"
+// @Success 		200 {object} models.Webhook
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhook/{id} [GET]
+func (h HandlerV1) GetWebhook(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	hook, err := h.Service.JobService().GetWebhook(ctx, &jobproto.WebhookWithGUID{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookFromProto(hook))
+}
+
+// @Summary 		List Webhooks
+// @Description 	This API for get the webhooks
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Success 		200 {object} []models.Webhook
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhooks [GET]
+func (h HandlerV1) ListWebhooks(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetWebhooks(ctx, &jobproto.ListWebhooksRequest{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Webhook{}
+	for _, hook := range list.Webhooks {
+		response = append(response, webhookFromProto(hook))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		List Webhook Deliveries
+// @Description 	This API for get the delivery log of the webhooks, newest first
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           webhook_id query string false "Webhook ID"
+// @Param           status query string false "pending, delivered or dead"
+// @Param           event_type query string false "Event type like JobPublished"
+// @Param           page query string true "Page"
+// @Param 			limit query string true "Limit"
+// @Success 		200 {object} []models.WebhookDelivery
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhooks/deliveries [GET]
+func (h HandlerV1) ListWebhookDeliveries(c *gin.Context) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetWebhookDeliveries(ctx, &jobproto.ListWebhookDeliveriesRequest{
+		WebhookId: c.Query("webhook_id"),
+		Status:    c.Query("status"),
+		EventType: c.Query("event_type"),
+		Page:      uint64(page),
+		Limit:     uint64(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.WebhookDelivery{}
+	for _, delivery := range list.Deliveries {
+		response = append(response, webhookDeliveryFromProto(delivery))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Get Webhook Delivery
+// @Description 	This API for get a webhook delivery with every attempt made, the oldest first
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Delivery ID"
+// @Success 		200 {object} models.WebhookDeliveryLog
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhooks/deliveries/{id} [GET]
+func (h HandlerV1) GetWebhookDelivery(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	log, err := h.Service.JobService().GetWebhookDelivery(ctx, &jobproto.WebhookDeliveryWithGUID{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := models.WebhookDeliveryLog{
+		Delivery: webhookDeliveryFromProto(log.Delivery),
+		Attempts: []models.WebhookAttempt{},
+	}
+	for _, attempt := range log.Attempts {
+		response.Attempts = append(response.Attempts, models.WebhookAttempt{
+			ID:          attempt.Id,
+			StatusCode:  int(attempt.StatusCode),
+			Error:       attempt.Error,
+			DurationMS:  attempt.DurationMs,
+			AttemptedAt: attempt.AttemptedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Redeliver Webhook
+// @Description 	This API for send a webhook delivery again right away with a fresh set of attempts, dead and delivered ones too
+// @Tags 			webhooks
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Delivery ID"
+// @Success 		200 {object} models.WebhookDelivery
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/webhooks/deliveries/{id}/redeliver [POST]
+func (h HandlerV1) RedeliverWebhook(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	delivery, err := h.Service.JobService().RedeliverWebhook(ctx, &jobproto.WebhookDeliveryWithGUID{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookDeliveryFromProto(delivery))
+}
+
+func webhookToProto(hook models.Webhook) *jobproto.Webhook {
+	return &jobproto.Webhook{
+		Id:         hook.ID,
+		Url:        hook.URL,
+		EventTypes: hook.EventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+	}
+}
+
+func webhookFromProto(hook *jobproto.Webhook) models.Webhook {
+	return models.Webhook{
+		ID:         hook.Id,
+		URL:        hook.Url,
+		EventTypes: hook.EventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+		CreatedAt:  hook.CreatedAt,
+		UpdatedAt:  hook.UpdatedAt,
+	}
+}
+
+func webhookDeliveryFromProto(delivery *jobproto.WebhookDelivery) models.WebhookDelivery {
+	return models.WebhookDelivery{
+		ID:             delivery.Id,
+		WebhookID:      delivery.WebhookId,
+		EventID:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       int(delivery.Attempts),
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: int(delivery.LastStatusCode),
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"admin-api-gateway/internal/pkg/idempotency"
+	"admin-api-gateway/internal/pkg/principal"
+	tokens "admin-api-gateway/internal/pkg/token"
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const principalKey = "principal"
+
+// Principal puts the principal of the request into the gin context: the kind and the subject
+// of the token, e.g. "client:<id>", or fallback when the request has no valid token
+func Principal(jwtsecret, kind, fallback string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Query("token")
+		if token == "" {
+			token = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		}
+
+		requestPrincipal := fallback
+		if claims, err := tokens.ParseJwtToken(token, jwtsecret); err == nil {
+			if subject, ok := claims["sub"].(string); ok && subject != "" {
+				requestPrincipal = kind + ":" + subject
+			}
+		}
+		c.Set(principalKey, requestPrincipal)
+
+		c.Next()
+	}
+}
+
+// PrincipalContext is the context for the gRPC calls of the request, the principal and the
+// Idempotency-Key of the request go along in the metadata
+func PrincipalContext(c *gin.Context) context.Context {
+	ctx := principal.NewOutgoingContext(context.Background(), c.GetString(principalKey))
+	return idempotency.NewOutgoingContext(ctx, c.GetString(idempotencyKey))
+}
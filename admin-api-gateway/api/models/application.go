@@ -0,0 +1,29 @@
+package models
+
+type (
+	JobApplication struct {
+		ID          string `json:"id"`
+		JobID       string `json:"job_id"`
+		ClientID    string `json:"client_id"`
+		Status      string `json:"status"`
+		CoverLetter string `json:"cover_letter"`
+		CreatedAt   string `json:"created_at"`
+		UpdatedAt   string `json:"updated_at"`
+	}
+
+	MoveApplicationRequest struct {
+		Status string `json:"status" binding:"required"`
+		Actor  string `json:"actor" binding:"required"`
+		Note   string `json:"note"`
+	}
+
+	ApplicationStatusChange struct {
+		ID            string `json:"id"`
+		ApplicationID string `json:"application_id"`
+		FromStatus    string `json:"from_status"`
+		ToStatus      string `json:"to_status"`
+		Actor         string `json:"actor"`
+		Note          string `json:"note"`
+		CreatedAt     string `json:"created_at"`
+	}
+)
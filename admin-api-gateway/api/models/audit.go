@@ -0,0 +1,29 @@
+package models
+
+import "encoding/json"
+
+type (
+	// AuditRecord is a change of an entity, before is null for a create and after for a hard
+	// delete. diff maps the changed fields to their before and after values.
+	AuditRecord struct {
+		ID         uint64          `json:"id"`
+		OccurredAt string          `json:"occurred_at"`
+		Service    string          `json:"service"`
+		Actor      string          `json:"actor"`
+		Entity     string          `json:"entity"`
+		EntityID   string          `json:"entity_id"`
+		Action     string          `json:"action"`
+		Before     json.RawMessage `json:"before" swaggertype:"object"`
+		After      json.RawMessage `json:"after" swaggertype:"object"`
+		Diff       json.RawMessage `json:"diff" swaggertype:"object"`
+		PrevHash   string          `json:"prev_hash"`
+		Hash       string          `json:"hash"`
+	}
+
+	AuditVerification struct {
+		Valid    bool   `json:"valid"`
+		Checked  uint64 `json:"checked"`
+		BrokenAt uint64 `json:"broken_at,omitempty"`
+		Reason   string `json:"reason,omitempty"`
+	}
+)
@@ -18,4 +18,44 @@ type (
 	Status struct {
 		Status bool `json:"status"`
 	}
+
+	// ClientVisibilityRequest has no actor, the principal of the request is recorded
+	ClientVisibilityRequest struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+
+	ClientDuplicate struct {
+		ID         string   `json:"id"`
+		Client     Client   `json:"client"`
+		Duplicate  Client   `json:"duplicate"`
+		Score      float64  `json:"score"`
+		Reasons    []string `json:"reasons"`
+		Status     string   `json:"status"`
+		ResolvedBy string   `json:"resolved_by"`
+		CreatedAt  string   `json:"created_at"`
+		UpdatedAt  string   `json:"updated_at"`
+	}
+
+	DuplicateScan struct {
+		Found uint64 `json:"found"`
+	}
+
+	DismissDuplicateRequest struct {
+		Actor string `json:"actor" binding:"required"`
+	}
+
+	MergeClientsRequest struct {
+		KeepID  string `json:"keep_id" binding:"required"`
+		MergeID string `json:"merge_id" binding:"required"`
+		Actor   string `json:"actor" binding:"required"`
+	}
+
+	ClientStatusChange struct {
+		ID        string `json:"id"`
+		ClientID  string `json:"client_id"`
+		Status    bool   `json:"status"`
+		Reason    string `json:"reason"`
+		Actor     string `json:"actor"`
+		CreatedAt string `json:"created_at"`
+	}
 )
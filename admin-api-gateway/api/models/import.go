@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type (
+	Import struct {
+		ID        string      `json:"id"`
+		Entity    string      `json:"entity"`
+		FileName  string      `json:"file_name"`
+		DryRun    bool        `json:"dry_run"`
+		Status    string      `json:"status"`
+		Error     string      `json:"error,omitempty"`
+		TotalRows int         `json:"total_rows"`
+		ValidRows int         `json:"valid_rows"`
+		Created   int         `json:"created"`
+		Failed    int         `json:"failed"`
+		Preview   []ImportRow `json:"preview"`
+		CreatedAt time.Time   `json:"created_at"`
+		UpdatedAt time.Time   `json:"updated_at"`
+	}
+
+	ImportRow struct {
+		Row    int               `json:"row"`
+		Fields map[string]string `json:"fields"`
+		ID     string            `json:"id,omitempty"`
+		Errors []string          `json:"errors,omitempty"`
+	}
+)
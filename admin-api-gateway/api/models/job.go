@@ -4,27 +4,68 @@ import "time"
 
 type (
 	Job struct {
-		ID             string  `json:"id"`
-		Name           string  `json:"name"`
-		Salary         float32 `json:"salary"`
-		Level          string  `json:"level"`
-		LocationType   string  `json:"location_type"`
-		EmploymentType string  `json:"employment_type"`
-		Address        string  `json:"address"`
-		Company        string  `json:"company"`
+		ID                   string   `json:"id"`
+		Name                 string   `json:"name"`
+		SalaryMin            string   `json:"salary_min"`
+		SalaryMax            string   `json:"salary_max"`
+		Currency             string   `json:"currency"`
+		PayPeriod            string   `json:"pay_period"`
+		Level                string   `json:"level"`
+		LocationType         string   `json:"location_type"`
+		EmploymentType       string   `json:"employment_type"`
+		Address              string   `json:"address"`
+		CompanyID            string   `json:"company_id"`
+		Company              string   `json:"company"`
+		Description          string   `json:"description"`
+		Responsibilities     string   `json:"responsibilities"`
+		Requirements         string   `json:"requirements"`
+		Benefits             string   `json:"benefits"`
+		DescriptionHTML      string   `json:"description_html"`
+		ResponsibilitiesHTML string   `json:"responsibilities_html"`
+		RequirementsHTML     string   `json:"requirements_html"`
+		BenefitsHTML         string   `json:"benefits_html"`
+		Skills               []string `json:"skills"`
+		Latitude             string   `json:"latitude"`
+		Longitude            string   `json:"longitude"`
+		DistanceKm           float64  `json:"distance_km"`
+		CategoryIDs          []string `json:"category_ids"`
+		Tags                 []string `json:"tags"`
+		Status               string   `json:"status"`
+		PublishAt            string   `json:"publish_at"`
+		CloseAt              string   `json:"close_at"`
 	}
 
 	ResponseJob struct {
-		ID             string    `json:"id"`
-		Name           string    `json:"name"`
-		Salary         float32   `json:"salary"`
-		Level          string    `json:"level"`
-		LocationType   string    `json:"location_type"`
-		EmploymentType string    `json:"employment_type"`
-		Address        string    `json:"address"`
-		Company        string    `json:"company"`
-		StartDate      time.Time `json:"start_date"`
-		EndDate        time.Time `json:"end_date"`
+		ID                   string    `json:"id"`
+		Name                 string    `json:"name"`
+		SalaryMin            string    `json:"salary_min"`
+		SalaryMax            string    `json:"salary_max"`
+		Currency             string    `json:"currency"`
+		PayPeriod            string    `json:"pay_period"`
+		Level                string    `json:"level"`
+		LocationType         string    `json:"location_type"`
+		EmploymentType       string    `json:"employment_type"`
+		Address              string    `json:"address"`
+		CompanyID            string    `json:"company_id"`
+		Company              string    `json:"company"`
+		Description          string    `json:"description"`
+		Responsibilities     string    `json:"responsibilities"`
+		Requirements         string    `json:"requirements"`
+		Benefits             string    `json:"benefits"`
+		DescriptionHTML      string    `json:"description_html"`
+		ResponsibilitiesHTML string    `json:"responsibilities_html"`
+		RequirementsHTML     string    `json:"requirements_html"`
+		BenefitsHTML         string    `json:"benefits_html"`
+		Skills               []string  `json:"skills"`
+		Latitude             string    `json:"latitude"`
+		Longitude            string    `json:"longitude"`
+		CategoryIDs          []string  `json:"category_ids"`
+		Tags                 []string  `json:"tags"`
+		Status               string    `json:"status"`
+		PublishAt            string    `json:"publish_at"`
+		CloseAt              string    `json:"close_at"`
+		StartDate            time.Time `json:"start_date"`
+		EndDate              time.Time `json:"end_date"`
 	}
 
 	ClientJobs struct {
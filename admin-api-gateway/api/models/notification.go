@@ -0,0 +1,29 @@
+package models
+
+type (
+	Notification struct {
+		ID            string `json:"id"`
+		ClientID      string `json:"client_id"`
+		Channel       string `json:"channel"`
+		Template      string `json:"template"`
+		Locale        string `json:"locale"`
+		To            string `json:"to"`
+		Subject       string `json:"subject"`
+		Body          string `json:"body"`
+		Status        string `json:"status"`
+		Attempts      int64  `json:"attempts"`
+		NextAttemptAt string `json:"next_attempt_at"`
+		LastError     string `json:"last_error"`
+		SentAt        string `json:"sent_at"`
+		CreatedAt     string `json:"created_at"`
+	}
+
+	NotificationPreferences struct {
+		Locale        string `json:"locale"`
+		Email         bool   `json:"email"`
+		SMS           bool   `json:"sms"`
+		JobAlerts     bool   `json:"job_alerts"`
+		EmailVerified bool   `json:"email_verified"`
+		UpdatedAt     string `json:"updated_at"`
+	}
+)
@@ -0,0 +1,18 @@
+package models
+
+type (
+	// FirstName and LastName are read only
+	ClientProfile struct {
+		ClientID              string   `json:"client_id"`
+		FirstName             string   `json:"first_name"`
+		LastName              string   `json:"last_name"`
+		Skills                []string `json:"skills"`
+		DesiredLevel          string   `json:"desired_level"`
+		DesiredLocationType   string   `json:"desired_location_type"`
+		DesiredEmploymentType string   `json:"desired_employment_type"`
+		ExpectedSalary        string   `json:"expected_salary"`
+		ExpectedCurrency      string   `json:"expected_currency"`
+		ExpectedPayPeriod     string   `json:"expected_pay_period"`
+		UpdatedAt             string   `json:"updated_at"`
+	}
+)
@@ -0,0 +1,38 @@
+package models
+
+type (
+	// empty fields match any job
+	JobSearchFilter struct {
+		SalaryFrom     string   `json:"salary_from"`
+		SalaryTo       string   `json:"salary_to"`
+		Currency       string   `json:"currency"`
+		PayPeriod      string   `json:"pay_period"`
+		Level          string   `json:"level"`
+		LocationType   string   `json:"location_type"`
+		EmploymentType string   `json:"employment_type"`
+		CompanyID      string   `json:"company_id"`
+		Skills         []string `json:"skills"`
+		// a place name, geocoded into latitude and longitude
+		Near      string `json:"near"`
+		Latitude  string `json:"latitude"`
+		Longitude string `json:"longitude"`
+		RadiusKm  string `json:"radius_km"`
+		// south,west,north,east
+		Bbox string `json:"bbox"`
+		// jobs in the category or its subcategories
+		CategoryID string `json:"category_id"`
+		// jobs with at least one of the tags
+		Tags []string `json:"tags"`
+	}
+
+	SavedSearch struct {
+		ID         string          `json:"id"`
+		ClientID   string          `json:"client_id"`
+		Name       string          `json:"name"`
+		Filter     JobSearchFilter `json:"filter"`
+		Frequency  string          `json:"frequency"`
+		LastSentAt string          `json:"last_sent_at"`
+		CreatedAt  string          `json:"created_at"`
+		UpdatedAt  string          `json:"updated_at"`
+	}
+)
@@ -0,0 +1,42 @@
+package models
+
+type (
+	Webhook struct {
+		ID         string   `json:"id"`
+		URL        string   `json:"url"`
+		EventTypes []string `json:"event_types"`
+		Secret     string   `json:"secret,omitempty"`
+		Active     bool     `json:"active"`
+		CreatedAt  string   `json:"created_at"`
+		UpdatedAt  string   `json:"updated_at"`
+	}
+
+	WebhookDelivery struct {
+		ID             string `json:"id"`
+		WebhookID      string `json:"webhook_id"`
+		EventID        string `json:"event_id"`
+		EventType      string `json:"event_type"`
+		Payload        string `json:"payload"`
+		Status         string `json:"status"`
+		Attempts       int    `json:"attempts"`
+		NextAttemptAt  string `json:"next_attempt_at"`
+		LastStatusCode int    `json:"last_status_code"`
+		LastError      string `json:"last_error"`
+		DeliveredAt    string `json:"delivered_at"`
+		CreatedAt      string `json:"created_at"`
+		UpdatedAt      string `json:"updated_at"`
+	}
+
+	WebhookAttempt struct {
+		ID          uint64 `json:"id"`
+		StatusCode  int    `json:"status_code"`
+		Error       string `json:"error"`
+		DurationMS  int64  `json:"duration_ms"`
+		AttemptedAt string `json:"attempted_at"`
+	}
+
+	WebhookDeliveryLog struct {
+		Delivery WebhookDelivery  `json:"delivery"`
+		Attempts []WebhookAttempt `json:"attempts"`
+	}
+)
@@ -14,6 +14,9 @@ import (
 
 	grpcClients "admin-api-gateway/internal/infrastructure/grpc_service_client"
 	"admin-api-gateway/internal/pkg/config"
+	"admin-api-gateway/internal/pkg/idempotency"
+	"admin-api-gateway/internal/usecase/exporter"
+	"admin-api-gateway/internal/usecase/importer"
 )
 
 type RouteOption struct {
@@ -21,6 +24,10 @@ type RouteOption struct {
 	Logger         *zap.Logger
 	ContextTimeout time.Duration
 	Service        grpcClients.ServiceClient
+	Idempotency    idempotency.Store
+	IdempotencyTTL time.Duration
+	Importer       importer.Importer
+	Exporter       exporter.Exporter
 }
 
 // NewRoute
@@ -38,6 +45,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 		Logger:         option.Logger,
 		ContextTimeout: option.ContextTimeout,
 		Service:        option.Service,
+		Importer:       option.Importer,
+		Exporter:       option.Exporter,
 	})
 
 	corsConfig := cors.DefaultConfig()
@@ -49,6 +58,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 	router.Use(cors.New(corsConfig))
 
 	router.Use(middleware.Tracing)
+	router.Use(middleware.Principal(option.Config.Token.Secret, "admin", "admin"))
+	router.Use(middleware.Idempotency(option.Idempotency, option.IdempotencyTTL, option.ContextTimeout, option.Logger))
 
 	apiV1 := router.Group("/v1")
 
@@ -60,12 +71,64 @@ func NewRoute(option RouteOption) *gin.Engine {
 	apiV1.GET("/clients/active", HandlerV1.ListClients)
 	apiV1.GET("/clients/deleted", HandlerV1.ListDeletedClients)
 	apiV1.GET("/clients/hidden", HandlerV1.ListHiddenClients)
+	apiV1.POST("/client/:id/hide", HandlerV1.HideClient)
+	apiV1.POST("/client/:id/unhide", HandlerV1.UnhideClient)
+	apiV1.GET("/client/:id/status-history", HandlerV1.GetClientStatusHistory)
+	apiV1.GET("/client/:id/employment-history", HandlerV1.GetEmploymentHistory)
+	apiV1.GET("/client/:id/profile", HandlerV1.GetClientProfile)
+	apiV1.PUT("/client/:id/profile", HandlerV1.UpsertClientProfile)
+	apiV1.GET("/client/:id/recommended-jobs", HandlerV1.RecommendJobsForClient)
+	apiV1.GET("/client/:id/saved-searches", HandlerV1.ListSavedSearches)
+	apiV1.GET("/client/:id/notification-preferences", HandlerV1.GetNotificationPreferences)
+	apiV1.POST("/clients/duplicates/scan", HandlerV1.ScanDuplicateClients)
+	apiV1.GET("/clients/duplicates", HandlerV1.ListDuplicateClients)
+	apiV1.POST("/clients/duplicates/:id/dismiss", HandlerV1.DismissDuplicateClients)
+	apiV1.POST("/clients/merge", HandlerV1.MergeClients)
+
+	// dictionaries
+	apiV1.GET("/dictionaries", HandlerV1.GetDictionaries)
+
+	// taxonomy
+	apiV1.POST("/category", HandlerV1.CreateCategory)
+	apiV1.PUT("/category", HandlerV1.UpdateCategory)
+	apiV1.DELETE("/category/:id", HandlerV1.DeleteCategory)
+	apiV1.GET("/categories", HandlerV1.ListCategories)
+	apiV1.POST("/tag", HandlerV1.CreateTag)
+	apiV1.PUT("/tag/:slug", HandlerV1.UpdateTag)
+	apiV1.DELETE("/tag/:slug", HandlerV1.DeleteTag)
+	apiV1.GET("/tags", HandlerV1.ListTags)
+
+	// notifications
+	apiV1.GET("/notifications", HandlerV1.ListNotifications)
+
+	// webhooks
+	apiV1.POST("/webhook", HandlerV1.CreateWebhook)
+	apiV1.PUT("/webhook", HandlerV1.UpdateWebhook)
+	apiV1.DELETE("/webhook/:id", HandlerV1.DeleteWebhook)
+	apiV1.GET("/webhook/:id", HandlerV1.GetWebhook)
+	apiV1.GET("/webhooks", HandlerV1.ListWebhooks)
+	apiV1.GET("/webhooks/deliveries", HandlerV1.ListWebhookDeliveries)
+	apiV1.GET("/webhooks/deliveries/:id", HandlerV1.GetWebhookDelivery)
+	apiV1.POST("/webhooks/deliveries/:id/redeliver", HandlerV1.RedeliverWebhook)
+
+	// audit
+	apiV1.GET("/audit", HandlerV1.ListAuditRecords)
+	apiV1.GET("/audit/verify", HandlerV1.VerifyAuditLog)
+
+	// companies
+	apiV1.POST("/company", HandlerV1.CreateCompany)
+	apiV1.PUT("/company", HandlerV1.UpdateCompany)
+	apiV1.DELETE("/company/:id", HandlerV1.DeleteCompany)
+	apiV1.GET("/company/:id", HandlerV1.GetCompany)
+	apiV1.GET("/companies", HandlerV1.ListCompanies)
+	apiV1.GET("/companies/:id/jobs", HandlerV1.ListCompanyJobs)
 
 	// jobs
 	apiV1.POST("/job", HandlerV1.CreateJob)
 	apiV1.PUT("/job", HandlerV1.UpdateJob)
 	apiV1.DELETE("/job/:id", HandlerV1.DeleteJob)
 	apiV1.GET("/job/:id", HandlerV1.GetJob)
+	apiV1.GET("/job/:id/recommended-clients", HandlerV1.RecommendClientsForJob)
 	apiV1.GET("/jobs/active", HandlerV1.ListJobs)
 	apiV1.GET("/jobs/deleted", HandlerV1.ListDeletedJobs)
 	apiV1.POST("/job/add-client", HandlerV1.AddClientToJob)
@@ -73,6 +136,23 @@ func NewRoute(option RouteOption) *gin.Engine {
 	apiV1.POST("/jobs/client-jobs", HandlerV1.GetClientsWithJob)
 	apiV1.POST("/jobs/job-clients", HandlerV1.GetJobsWithClient)
 
+	// applications
+	apiV1.GET("/applications", HandlerV1.ListApplications)
+	apiV1.GET("/applications/:id", HandlerV1.GetApplication)
+	apiV1.POST("/applications/:id/move", HandlerV1.MoveApplication)
+	apiV1.GET("/applications/:id/history", HandlerV1.GetApplicationHistory)
+
+	// imports
+	apiV1.POST("/imports", HandlerV1.CreateImport)
+	apiV1.GET("/imports/:id", HandlerV1.GetImport)
+	apiV1.POST("/imports/:id/commit", HandlerV1.CommitImport)
+	apiV1.GET("/imports/:id/report", HandlerV1.ImportReport)
+
+	// exports
+	apiV1.GET("/exports/clients", HandlerV1.ExportClients)
+	apiV1.GET("/exports/jobs", HandlerV1.ExportJobs)
+	apiV1.GET("/exports/client-jobs", HandlerV1.ExportClientJobs)
+
 	url := ginSwagger.URL("swagger/doc.json")
 	apiV1.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 
@@ -27,28 +27,52 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("client_service.proto", fileDescriptor_eee24fc862d835ee) }
 
 var fileDescriptor_eee24fc862d835ee = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x4a, 0xf3, 0x40,
-	0x10, 0xc7, 0xbf, 0x5e, 0x0a, 0xdf, 0xd8, 0xf6, 0x30, 0x16, 0x95, 0x08, 0x39, 0x88, 0x1e, 0xbc,
-	0x54, 0xd0, 0xbb, 0xa0, 0xa9, 0xc6, 0x82, 0x60, 0x49, 0x8d, 0x01, 0x2f, 0x12, 0xbb, 0xa3, 0x5d,
-	0xd8, 0x26, 0x69, 0x76, 0xa3, 0xaf, 0xe2, 0x23, 0xf8, 0x28, 0x1e, 0x7d, 0x04, 0x89, 0x2f, 0x22,
-	0x64, 0x5b, 0x31, 0x6b, 0x4a, 0x3c, 0xf4, 0x98, 0xff, 0x7f, 0x7e, 0xbf, 0x19, 0x48, 0x02, 0xdd,
-	0xb1, 0xe0, 0x14, 0xa9, 0x3b, 0x49, 0xe9, 0x13, 0x1f, 0x53, 0x2f, 0x49, 0x63, 0x15, 0x63, 0xa7,
-	0x9c, 0x5a, 0x38, 0x7f, 0x9e, 0xc6, 0x8c, 0x84, 0x9e, 0x39, 0x7c, 0x6d, 0x42, 0xdb, 0x29, 0xe2,
-	0x91, 0x9e, 0xc2, 0x73, 0x68, 0x39, 0x29, 0x85, 0x8a, 0x74, 0x8c, 0x1b, 0x3d, 0x43, 0xae, 0x73,
-	0xcb, 0xae, 0xce, 0x03, 0xae, 0x26, 0xae, 0x3f, 0xe8, 0xa3, 0x03, 0xff, 0x5d, 0x52, 0x73, 0x49,
-	0xcd, 0xb0, 0xb5, 0x64, 0x09, 0x1e, 0x43, 0xcb, 0x4f, 0x58, 0xfd, 0x31, 0xcb, 0xf8, 0x6b, 0x68,
-	0xf5, 0x49, 0xd0, 0x37, 0x5f, 0x77, 0xc7, 0xae, 0xd9, 0xff, 0xa4, 0x3d, 0x92, 0x49, 0x1c, 0x49,
-	0xc2, 0x21, 0xb4, 0x5d, 0x52, 0x27, 0x42, 0xe8, 0x5c, 0xe2, 0xb6, 0x89, 0x5d, 0x72, 0xa9, 0x3c,
-	0x9a, 0x65, 0x24, 0x95, 0xb5, 0x53, 0x55, 0x1a, 0xc6, 0x00, 0xba, 0xda, 0xa8, 0xf7, 0xb1, 0x95,
-	0x89, 0x6f, 0x60, 0x5d, 0x8b, 0x2f, 0x38, 0x63, 0x14, 0xad, 0xcc, 0xeb, 0xc2, 0x9a, 0x1f, 0xf1,
-	0x59, 0x46, 0x67, 0xd3, 0x90, 0x0b, 0xdc, 0x32, 0x91, 0x81, 0xd4, 0xf5, 0xef, 0xcf, 0x64, 0xa1,
-	0x18, 0xa9, 0x50, 0x65, 0x12, 0xaf, 0xa0, 0xad, 0xdf, 0xb0, 0x47, 0x0f, 0x29, 0xc9, 0x09, 0x56,
-	0x00, 0x45, 0xb1, 0xb8, 0xae, 0x4e, 0x18, 0x40, 0x47, 0x0b, 0x87, 0xa1, 0x94, 0xcf, 0x71, 0xca,
-	0x70, 0xcf, 0x24, 0xca, 0xfd, 0x1f, 0xc5, 0xa7, 0xfb, 0x6f, 0xb9, 0xdd, 0x78, 0xcf, 0xed, 0xc6,
-	0x47, 0x6e, 0x37, 0x5e, 0x3e, 0xed, 0x7f, 0xb7, 0x9b, 0x8f, 0x14, 0x15, 0xbf, 0xd1, 0x41, 0x99,
-	0xbc, 0x6f, 0x16, 0xe9, 0xd1, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x4a, 0x9f, 0x8f, 0x98,
-	0x03, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xc7, 0xed, 0x8d, 0x89, 0x87, 0x16, 0x71, 0x20, 0x48, 0xaa, 0xf4, 0x42, 0x20, 0x88, 0x17,
+	0x48, 0xf4, 0xde, 0x44, 0x5a, 0x29, 0xc4, 0x0f, 0x0a, 0xeb, 0xd2, 0x84, 0x8f, 0x98, 0x75, 0xf7,
+	0xb4, 0x1d, 0xb3, 0x9d, 0x2d, 0x33, 0x53, 0x0c, 0x6f, 0xe2, 0x0b, 0xf8, 0x2e, 0x5e, 0xfa, 0x08,
+	0x06, 0x5f, 0xc4, 0xb8, 0x33, 0xb3, 0xec, 0x27, 0xbb, 0x26, 0x78, 0xd9, 0xf9, 0xff, 0xcf, 0xef,
+	0x9c, 0x99, 0x39, 0x67, 0xb6, 0xb0, 0xe0, 0xfa, 0x14, 0x99, 0xfc, 0x24, 0x90, 0x5f, 0x50, 0x17,
+	0x37, 0x27, 0x3c, 0x90, 0x01, 0x99, 0x4d, 0xae, 0x36, 0x89, 0xfe, 0x3d, 0x0e, 0x3c, 0xf4, 0x95,
+	0xe7, 0xc5, 0xf7, 0x45, 0x68, 0xb4, 0xc3, 0x65, 0x4b, 0xb9, 0xc8, 0x0e, 0xd4, 0xdb, 0x1c, 0x1d,
+	0x89, 0x6a, 0x99, 0x2c, 0x6e, 0xa6, 0xe0, 0x6a, 0xbd, 0xd9, 0xca, 0x5f, 0xef, 0x53, 0x39, 0xea,
+	0xda, 0x7b, 0x1d, 0xd2, 0x86, 0x7b, 0x5d, 0x94, 0x1a, 0x52, 0x62, 0x6e, 0x16, 0x24, 0x21, 0xa7,
+	0x70, 0x3f, 0x82, 0x88, 0xed, 0xcb, 0xbd, 0x8e, 0x20, 0x2b, 0xf9, 0x56, 0xa5, 0x1e, 0xe2, 0xf9,
+	0x14, 0x85, 0x6c, 0xae, 0xde, 0x6c, 0x12, 0x93, 0x80, 0x09, 0x24, 0xaf, 0xa0, 0x6e, 0x4f, 0xbc,
+	0xf2, 0xad, 0x16, 0x55, 0xf7, 0x11, 0xea, 0x1d, 0xf4, 0x51, 0x62, 0xc5, 0x5d, 0x66, 0xaa, 0x8a,
+	0x47, 0x47, 0x55, 0xf5, 0xa0, 0xd1, 0x45, 0xf9, 0xda, 0xf7, 0x75, 0xcd, 0xe4, 0x51, 0x3a, 0xec,
+	0x1d, 0x15, 0xd2, 0xec, 0xf4, 0x49, 0x9e, 0x98, 0x22, 0xf6, 0x61, 0x41, 0x11, 0x55, 0x3e, 0xef,
+	0xd6, 0xc0, 0x47, 0x30, 0xaf, 0xc0, 0xbb, 0xd4, 0xf3, 0x90, 0xdd, 0x1a, 0xb7, 0x0b, 0x33, 0x36,
+	0xa3, 0xe7, 0x53, 0x7c, 0x33, 0x76, 0xa8, 0x4f, 0x96, 0xd2, 0x21, 0x7b, 0x42, 0xc9, 0xd9, 0x26,
+	0x34, 0x08, 0x4b, 0x3a, 0x72, 0x2a, 0xc8, 0x3e, 0x34, 0xd4, 0x0d, 0x1f, 0xe2, 0x80, 0xa3, 0x18,
+	0x91, 0x9c, 0x80, 0x50, 0x30, 0xd5, 0x95, 0x01, 0xfb, 0x30, 0xab, 0x80, 0x3d, 0x47, 0x88, 0xaf,
+	0x01, 0xf7, 0xc8, 0x5a, 0x3a, 0x22, 0xa9, 0x57, 0x05, 0x7b, 0x40, 0xb6, 0x1d, 0xe9, 0x8e, 0xe2,
+	0xb3, 0x27, 0xc8, 0x46, 0x3a, 0x2a, 0xeb, 0x31, 0x09, 0x56, 0x6e, 0xb0, 0x46, 0x07, 0xbb, 0x0f,
+	0x0d, 0x4b, 0x72, 0x74, 0xc6, 0x26, 0x41, 0xa6, 0x25, 0x13, 0xb2, 0x61, 0x17, 0x0c, 0xc0, 0x56,
+	0x8d, 0x58, 0x50, 0xef, 0x87, 0x79, 0x34, 0x2f, 0x53, 0x45, 0x5c, 0x35, 0xb8, 0xc7, 0xf9, 0xb8,
+	0xf6, 0xc8, 0x61, 0x43, 0xdc, 0xaa, 0x11, 0x1b, 0x60, 0x97, 0x7a, 0x66, 0xaa, 0x0a, 0x06, 0x5e,
+	0x9d, 0x5b, 0x61, 0x57, 0xc5, 0x4d, 0x0a, 0x4c, 0xfa, 0x50, 0xb7, 0xd9, 0xe8, 0x3f, 0x80, 0x1d,
+	0x58, 0x8c, 0x5e, 0x29, 0x25, 0xec, 0x52, 0x21, 0x03, 0x7e, 0x59, 0xfa, 0x22, 0xac, 0x17, 0x0f,
+	0x43, 0x12, 0x74, 0x06, 0xb3, 0x3b, 0x94, 0x79, 0x9d, 0xe9, 0xc4, 0xa7, 0xae, 0x23, 0x31, 0xe7,
+	0xe6, 0x22, 0xcd, 0x72, 0x1d, 0x66, 0xca, 0x5f, 0x2b, 0x71, 0xe9, 0xbe, 0x38, 0x09, 0xdf, 0x9c,
+	0x4a, 0xf4, 0xf8, 0x2c, 0xaf, 0x16, 0x97, 0x1f, 0x63, 0x9d, 0xc0, 0x5c, 0x87, 0x8a, 0x31, 0x15,
+	0x22, 0x5a, 0x24, 0xeb, 0x39, 0xe3, 0x10, 0xf8, 0x17, 0x18, 0x39, 0xaa, 0xce, 0xcd, 0x5b, 0xa8,
+	0xbf, 0x47, 0x3e, 0xc4, 0xc2, 0x06, 0x8c, 0xab, 0x25, 0xfd, 0x4c, 0x2c, 0x98, 0xb7, 0x27, 0x02,
+	0xb9, 0xde, 0x43, 0x8f, 0x07, 0x03, 0xea, 0x23, 0x59, 0xce, 0xb7, 0x6b, 0xb9, 0x79, 0xb3, 0x4c,
+	0x0e, 0x60, 0x2e, 0xea, 0x0e, 0xb3, 0x56, 0xd6, 0x17, 0x25, 0xc8, 0x2f, 0xf0, 0x20, 0x8d, 0xcc,
+	0x79, 0x2b, 0xae, 0x2f, 0xc3, 0x78, 0xcc, 0xfe, 0x9f, 0x55, 0xb1, 0xea, 0xd6, 0x38, 0x83, 0x39,
+	0x0b, 0x99, 0xf7, 0x21, 0x90, 0x74, 0xf0, 0xf7, 0x66, 0x68, 0xc0, 0xb2, 0x87, 0x1c, 0x57, 0x0b,
+	0x9b, 0x23, 0x69, 0xd2, 0xf8, 0x61, 0x78, 0x3a, 0x71, 0x49, 0x90, 0xa7, 0x79, 0xe5, 0x25, 0x2c,
+	0x26, 0xc7, 0x46, 0x05, 0xa7, 0x4e, 0x84, 0xd0, 0x4c, 0x25, 0xea, 0x71, 0x1c, 0x20, 0x47, 0xe6,
+	0xa2, 0xf8, 0xf7, 0x41, 0x2d, 0x02, 0x9d, 0xc3, 0xb2, 0xfa, 0x00, 0x14, 0x19, 0xaa, 0x92, 0xaa,
+	0xa7, 0x3c, 0x86, 0x25, 0x7d, 0x1e, 0xe1, 0xe7, 0xf2, 0x08, 0xf9, 0xf5, 0x4d, 0x95, 0xed, 0xab,
+	0x6c, 0xbc, 0x0e, 0x60, 0x26, 0xe4, 0x5d, 0xaa, 0x2f, 0x71, 0xe6, 0x35, 0x8c, 0x89, 0x55, 0x27,
+	0xf6, 0x14, 0x16, 0xb4, 0xf5, 0xfa, 0x1b, 0x29, 0x50, 0x66, 0x9f, 0x9c, 0x84, 0x5c, 0x95, 0x6e,
+	0x43, 0x23, 0xf4, 0x9b, 0xe0, 0x2c, 0x36, 0x21, 0x57, 0xc4, 0x6e, 0x6f, 0xfc, 0xb8, 0x6a, 0xd5,
+	0x7e, 0x5e, 0xb5, 0x6a, 0xbf, 0xae, 0x5a, 0xb5, 0x6f, 0xbf, 0x5b, 0x77, 0x8e, 0x1f, 0x0e, 0x91,
+	0x85, 0xff, 0xa1, 0x9f, 0x27, 0x23, 0x3f, 0xdf, 0x0d, 0x57, 0x5f, 0xfe, 0x19, 0x00, 0x87, 0xf9,
+	0x4a, 0x2a, 0x95, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -65,6 +89,7 @@ const _ = grpc.SupportPackageIsVersion4
 type ClientServiceClient interface {
 	CreateClient(ctx context.Context, in *Client, opts ...grpc.CallOption) (*ClientWithGUID, error)
 	GetClient(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*Client, error)
+	GetClientsByIDs(ctx context.Context, in *ClientsByIDsRequest, opts ...grpc.CallOption) (*ClientsByIDsResponse, error)
 	UpdateClient(ctx context.Context, in *Client, opts ...grpc.CallOption) (*Client, error)
 	DeleteClient(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*DeleteClientResponse, error)
 	GetAllClients(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListClientResponse, error)
@@ -73,6 +98,27 @@ type ClientServiceClient interface {
 	UniqueEmail(ctx context.Context, in *IsUnique, opts ...grpc.CallOption) (*ResponseStatus, error)
 	UpdateRefresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*ResponseStatus, error)
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*ResponseStatus, error)
+	BatchCreateClients(ctx context.Context, in *BatchCreateClientsRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
+	StreamClients(ctx context.Context, in *StreamClientsRequest, opts ...grpc.CallOption) (ClientService_StreamClientsClient, error)
+	WatchClients(ctx context.Context, in *WatchClientsRequest, opts ...grpc.CallOption) (ClientService_WatchClientsClient, error)
+	HideClient(ctx context.Context, in *ClientStatusRequest, opts ...grpc.CallOption) (*ClientStatusChange, error)
+	UnhideClient(ctx context.Context, in *ClientStatusRequest, opts ...grpc.CallOption) (*ClientStatusChange, error)
+	GetClientStatusHistory(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*ListClientStatusHistory, error)
+	FindDuplicates(ctx context.Context, in *DuplicateScanRequest, opts ...grpc.CallOption) (*DuplicateScanResponse, error)
+	GetDuplicates(ctx context.Context, in *DuplicateListRequest, opts ...grpc.CallOption) (*ListClientDuplicates, error)
+	DismissDuplicate(ctx context.Context, in *ResolveDuplicateRequest, opts ...grpc.CallOption) (*ResponseStatus, error)
+	MergeClients(ctx context.Context, in *MergeClientsRequest, opts ...grpc.CallOption) (*Client, error)
+	UpsertClientProfile(ctx context.Context, in *ClientProfile, opts ...grpc.CallOption) (*ClientProfile, error)
+	GetClientProfile(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*ClientProfile, error)
+	GetClientProfiles(ctx context.Context, in *ListClientProfilesRequest, opts ...grpc.CallOption) (*ListClientProfilesResponse, error)
+	SendNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error)
+	GetNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, in *NotificationPreferences, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	RequestEmailVerification(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*ResponseStatus, error)
+	RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*ResponseStatus, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResponseStatus, error)
 }
 
 type clientServiceClient struct {
@@ -101,6 +147,15 @@ func (c *clientServiceClient) GetClient(ctx context.Context, in *ClientWithGUID,
 	return out, nil
 }
 
+func (c *clientServiceClient) GetClientsByIDs(ctx context.Context, in *ClientsByIDsRequest, opts ...grpc.CallOption) (*ClientsByIDsResponse, error) {
+	out := new(ClientsByIDsResponse)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetClientsByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientServiceClient) UpdateClient(ctx context.Context, in *Client, opts ...grpc.CallOption) (*Client, error) {
 	out := new(Client)
 	err := c.cc.Invoke(ctx, "/client_service.ClientService/UpdateClient", in, out, opts...)
@@ -173,10 +228,246 @@ func (c *clientServiceClient) UpdatePassword(ctx context.Context, in *UpdatePass
 	return out, nil
 }
 
+func (c *clientServiceClient) BatchCreateClients(ctx context.Context, in *BatchCreateClientsRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error) {
+	out := new(BatchCreateResponse)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/BatchCreateClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) StreamClients(ctx context.Context, in *StreamClientsRequest, opts ...grpc.CallOption) (ClientService_StreamClientsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ClientService_serviceDesc.Streams[0], "/client_service.ClientService/StreamClients", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientServiceStreamClientsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClientService_StreamClientsClient interface {
+	Recv() (*Client, error)
+	grpc.ClientStream
+}
+
+type clientServiceStreamClientsClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientServiceStreamClientsClient) Recv() (*Client, error) {
+	m := new(Client)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clientServiceClient) WatchClients(ctx context.Context, in *WatchClientsRequest, opts ...grpc.CallOption) (ClientService_WatchClientsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ClientService_serviceDesc.Streams[1], "/client_service.ClientService/WatchClients", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &clientServiceWatchClientsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ClientService_WatchClientsClient interface {
+	Recv() (*ClientChange, error)
+	grpc.ClientStream
+}
+
+type clientServiceWatchClientsClient struct {
+	grpc.ClientStream
+}
+
+func (x *clientServiceWatchClientsClient) Recv() (*ClientChange, error) {
+	m := new(ClientChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *clientServiceClient) HideClient(ctx context.Context, in *ClientStatusRequest, opts ...grpc.CallOption) (*ClientStatusChange, error) {
+	out := new(ClientStatusChange)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/HideClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) UnhideClient(ctx context.Context, in *ClientStatusRequest, opts ...grpc.CallOption) (*ClientStatusChange, error) {
+	out := new(ClientStatusChange)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/UnhideClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) GetClientStatusHistory(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*ListClientStatusHistory, error) {
+	out := new(ListClientStatusHistory)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetClientStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) FindDuplicates(ctx context.Context, in *DuplicateScanRequest, opts ...grpc.CallOption) (*DuplicateScanResponse, error) {
+	out := new(DuplicateScanResponse)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/FindDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) GetDuplicates(ctx context.Context, in *DuplicateListRequest, opts ...grpc.CallOption) (*ListClientDuplicates, error) {
+	out := new(ListClientDuplicates)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) DismissDuplicate(ctx context.Context, in *ResolveDuplicateRequest, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/DismissDuplicate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) MergeClients(ctx context.Context, in *MergeClientsRequest, opts ...grpc.CallOption) (*Client, error) {
+	out := new(Client)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/MergeClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) UpsertClientProfile(ctx context.Context, in *ClientProfile, opts ...grpc.CallOption) (*ClientProfile, error) {
+	out := new(ClientProfile)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/UpsertClientProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) GetClientProfile(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*ClientProfile, error) {
+	out := new(ClientProfile)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetClientProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) GetClientProfiles(ctx context.Context, in *ListClientProfilesRequest, opts ...grpc.CallOption) (*ListClientProfilesResponse, error) {
+	out := new(ListClientProfilesResponse)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetClientProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) SendNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error) {
+	out := new(NotificationResponse)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/SendNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) GetNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) GetNotificationPreferences(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/GetNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) UpdateNotificationPreferences(ctx context.Context, in *NotificationPreferences, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/UpdateNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) RequestEmailVerification(ctx context.Context, in *ClientWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/RequestEmailVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) RequestPasswordReset(ctx context.Context, in *PasswordResetRequest, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/client_service.ClientService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientServiceServer is the server API for ClientService service.
 type ClientServiceServer interface {
 	CreateClient(context.Context, *Client) (*ClientWithGUID, error)
 	GetClient(context.Context, *ClientWithGUID) (*Client, error)
+	GetClientsByIDs(context.Context, *ClientsByIDsRequest) (*ClientsByIDsResponse, error)
 	UpdateClient(context.Context, *Client) (*Client, error)
 	DeleteClient(context.Context, *ClientWithGUID) (*DeleteClientResponse, error)
 	GetAllClients(context.Context, *ListRequest) (*ListClientResponse, error)
@@ -185,6 +476,27 @@ type ClientServiceServer interface {
 	UniqueEmail(context.Context, *IsUnique) (*ResponseStatus, error)
 	UpdateRefresh(context.Context, *RefreshRequest) (*ResponseStatus, error)
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*ResponseStatus, error)
+	BatchCreateClients(context.Context, *BatchCreateClientsRequest) (*BatchCreateResponse, error)
+	StreamClients(*StreamClientsRequest, ClientService_StreamClientsServer) error
+	WatchClients(*WatchClientsRequest, ClientService_WatchClientsServer) error
+	HideClient(context.Context, *ClientStatusRequest) (*ClientStatusChange, error)
+	UnhideClient(context.Context, *ClientStatusRequest) (*ClientStatusChange, error)
+	GetClientStatusHistory(context.Context, *ClientWithGUID) (*ListClientStatusHistory, error)
+	FindDuplicates(context.Context, *DuplicateScanRequest) (*DuplicateScanResponse, error)
+	GetDuplicates(context.Context, *DuplicateListRequest) (*ListClientDuplicates, error)
+	DismissDuplicate(context.Context, *ResolveDuplicateRequest) (*ResponseStatus, error)
+	MergeClients(context.Context, *MergeClientsRequest) (*Client, error)
+	UpsertClientProfile(context.Context, *ClientProfile) (*ClientProfile, error)
+	GetClientProfile(context.Context, *ClientWithGUID) (*ClientProfile, error)
+	GetClientProfiles(context.Context, *ListClientProfilesRequest) (*ListClientProfilesResponse, error)
+	SendNotification(context.Context, *NotificationRequest) (*NotificationResponse, error)
+	GetNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	GetNotificationPreferences(context.Context, *ClientWithGUID) (*NotificationPreferences, error)
+	UpdateNotificationPreferences(context.Context, *NotificationPreferences) (*NotificationPreferences, error)
+	RequestEmailVerification(context.Context, *ClientWithGUID) (*ResponseStatus, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*ResponseStatus, error)
+	RequestPasswordReset(context.Context, *PasswordResetRequest) (*ResponseStatus, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResponseStatus, error)
 }
 
 // UnimplementedClientServiceServer can be embedded to have forward compatible implementations.
@@ -197,6 +509,9 @@ func (*UnimplementedClientServiceServer) CreateClient(ctx context.Context, req *
 func (*UnimplementedClientServiceServer) GetClient(ctx context.Context, req *ClientWithGUID) (*Client, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClient not implemented")
 }
+func (*UnimplementedClientServiceServer) GetClientsByIDs(ctx context.Context, req *ClientsByIDsRequest) (*ClientsByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientsByIDs not implemented")
+}
 func (*UnimplementedClientServiceServer) UpdateClient(ctx context.Context, req *Client) (*Client, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClient not implemented")
 }
@@ -221,6 +536,69 @@ func (*UnimplementedClientServiceServer) UpdateRefresh(ctx context.Context, req
 func (*UnimplementedClientServiceServer) UpdatePassword(ctx context.Context, req *UpdatePasswordRequest) (*ResponseStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (*UnimplementedClientServiceServer) BatchCreateClients(ctx context.Context, req *BatchCreateClientsRequest) (*BatchCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateClients not implemented")
+}
+func (*UnimplementedClientServiceServer) StreamClients(req *StreamClientsRequest, srv ClientService_StreamClientsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamClients not implemented")
+}
+func (*UnimplementedClientServiceServer) WatchClients(req *WatchClientsRequest, srv ClientService_WatchClientsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClients not implemented")
+}
+func (*UnimplementedClientServiceServer) HideClient(ctx context.Context, req *ClientStatusRequest) (*ClientStatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideClient not implemented")
+}
+func (*UnimplementedClientServiceServer) UnhideClient(ctx context.Context, req *ClientStatusRequest) (*ClientStatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhideClient not implemented")
+}
+func (*UnimplementedClientServiceServer) GetClientStatusHistory(ctx context.Context, req *ClientWithGUID) (*ListClientStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientStatusHistory not implemented")
+}
+func (*UnimplementedClientServiceServer) FindDuplicates(ctx context.Context, req *DuplicateScanRequest) (*DuplicateScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (*UnimplementedClientServiceServer) GetDuplicates(ctx context.Context, req *DuplicateListRequest) (*ListClientDuplicates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuplicates not implemented")
+}
+func (*UnimplementedClientServiceServer) DismissDuplicate(ctx context.Context, req *ResolveDuplicateRequest) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissDuplicate not implemented")
+}
+func (*UnimplementedClientServiceServer) MergeClients(ctx context.Context, req *MergeClientsRequest) (*Client, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeClients not implemented")
+}
+func (*UnimplementedClientServiceServer) UpsertClientProfile(ctx context.Context, req *ClientProfile) (*ClientProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertClientProfile not implemented")
+}
+func (*UnimplementedClientServiceServer) GetClientProfile(ctx context.Context, req *ClientWithGUID) (*ClientProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientProfile not implemented")
+}
+func (*UnimplementedClientServiceServer) GetClientProfiles(ctx context.Context, req *ListClientProfilesRequest) (*ListClientProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientProfiles not implemented")
+}
+func (*UnimplementedClientServiceServer) SendNotification(ctx context.Context, req *NotificationRequest) (*NotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (*UnimplementedClientServiceServer) GetNotifications(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (*UnimplementedClientServiceServer) GetNotificationPreferences(ctx context.Context, req *ClientWithGUID) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (*UnimplementedClientServiceServer) UpdateNotificationPreferences(ctx context.Context, req *NotificationPreferences) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (*UnimplementedClientServiceServer) RequestEmailVerification(ctx context.Context, req *ClientWithGUID) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailVerification not implemented")
+}
+func (*UnimplementedClientServiceServer) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (*UnimplementedClientServiceServer) RequestPasswordReset(ctx context.Context, req *PasswordResetRequest) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedClientServiceServer) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
 
 func RegisterClientServiceServer(s *grpc.Server, srv ClientServiceServer) {
 	s.RegisterService(&_ClientService_serviceDesc, srv)
@@ -262,6 +640,24 @@ func _ClientService_GetClient_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientService_GetClientsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetClientsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetClientsByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetClientsByIDs(ctx, req.(*ClientsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientService_UpdateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Client)
 	if err := dec(in); err != nil {
@@ -406,6 +802,390 @@ func _ClientService_UpdatePassword_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientService_BatchCreateClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).BatchCreateClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/BatchCreateClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).BatchCreateClients(ctx, req.(*BatchCreateClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_StreamClients_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamClientsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServiceServer).StreamClients(m, &clientServiceStreamClientsServer{stream})
+}
+
+type ClientService_StreamClientsServer interface {
+	Send(*Client) error
+	grpc.ServerStream
+}
+
+type clientServiceStreamClientsServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientServiceStreamClientsServer) Send(m *Client) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ClientService_WatchClients_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClientsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClientServiceServer).WatchClients(m, &clientServiceWatchClientsServer{stream})
+}
+
+type ClientService_WatchClientsServer interface {
+	Send(*ClientChange) error
+	grpc.ServerStream
+}
+
+type clientServiceWatchClientsServer struct {
+	grpc.ServerStream
+}
+
+func (x *clientServiceWatchClientsServer) Send(m *ClientChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ClientService_HideClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).HideClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/HideClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).HideClient(ctx, req.(*ClientStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_UnhideClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).UnhideClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/UnhideClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).UnhideClient(ctx, req.(*ClientStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_GetClientStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetClientStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetClientStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetClientStatusHistory(ctx, req.(*ClientWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/FindDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).FindDuplicates(ctx, req.(*DuplicateScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_GetDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetDuplicates(ctx, req.(*DuplicateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_DismissDuplicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDuplicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).DismissDuplicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/DismissDuplicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).DismissDuplicate(ctx, req.(*ResolveDuplicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_MergeClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).MergeClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/MergeClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).MergeClients(ctx, req.(*MergeClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_UpsertClientProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).UpsertClientProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/UpsertClientProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).UpsertClientProfile(ctx, req.(*ClientProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_GetClientProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetClientProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetClientProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetClientProfile(ctx, req.(*ClientWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_GetClientProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetClientProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetClientProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetClientProfiles(ctx, req.(*ListClientProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).SendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/SendNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).SendNotification(ctx, req.(*NotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/GetNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).GetNotificationPreferences(ctx, req.(*ClientWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationPreferences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/UpdateNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).UpdateNotificationPreferences(ctx, req.(*NotificationPreferences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).RequestEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/RequestEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).RequestEmailVerification(ctx, req.(*ClientWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).RequestPasswordReset(ctx, req.(*PasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/client_service.ClientService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClientService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "client_service.ClientService",
 	HandlerType: (*ClientServiceServer)(nil),
@@ -418,6 +1198,10 @@ var _ClientService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetClient",
 			Handler:    _ClientService_GetClient_Handler,
 		},
+		{
+			MethodName: "GetClientsByIDs",
+			Handler:    _ClientService_GetClientsByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateClient",
 			Handler:    _ClientService_UpdateClient_Handler,
@@ -450,7 +1234,94 @@ var _ClientService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _ClientService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "BatchCreateClients",
+			Handler:    _ClientService_BatchCreateClients_Handler,
+		},
+		{
+			MethodName: "HideClient",
+			Handler:    _ClientService_HideClient_Handler,
+		},
+		{
+			MethodName: "UnhideClient",
+			Handler:    _ClientService_UnhideClient_Handler,
+		},
+		{
+			MethodName: "GetClientStatusHistory",
+			Handler:    _ClientService_GetClientStatusHistory_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _ClientService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "GetDuplicates",
+			Handler:    _ClientService_GetDuplicates_Handler,
+		},
+		{
+			MethodName: "DismissDuplicate",
+			Handler:    _ClientService_DismissDuplicate_Handler,
+		},
+		{
+			MethodName: "MergeClients",
+			Handler:    _ClientService_MergeClients_Handler,
+		},
+		{
+			MethodName: "UpsertClientProfile",
+			Handler:    _ClientService_UpsertClientProfile_Handler,
+		},
+		{
+			MethodName: "GetClientProfile",
+			Handler:    _ClientService_GetClientProfile_Handler,
+		},
+		{
+			MethodName: "GetClientProfiles",
+			Handler:    _ClientService_GetClientProfiles_Handler,
+		},
+		{
+			MethodName: "SendNotification",
+			Handler:    _ClientService_SendNotification_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _ClientService_GetNotifications_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _ClientService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _ClientService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _ClientService_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _ClientService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _ClientService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _ClientService_ResetPassword_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamClients",
+			Handler:       _ClientService_StreamClients_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchClients",
+			Handler:       _ClientService_WatchClients_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "client_service.proto",
 }
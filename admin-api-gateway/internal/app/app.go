@@ -12,16 +12,21 @@ import (
 	grpcService "admin-api-gateway/internal/infrastructure/grpc_service_client"
 
 	"admin-api-gateway/internal/pkg/config"
+	"admin-api-gateway/internal/pkg/idempotency"
 	"admin-api-gateway/internal/pkg/logger"
 
 	"admin-api-gateway/internal/pkg/otlp"
 	"admin-api-gateway/internal/pkg/postgres"
+	"admin-api-gateway/internal/pkg/redis"
+	"admin-api-gateway/internal/usecase/exporter"
+	"admin-api-gateway/internal/usecase/importer"
 )
 
 type App struct {
 	Config       *config.Config
 	Logger       *zap.Logger
 	DB           *postgres.PostgresDB
+	RedisDB      *redis.RedisDB
 	server       *http.Server
 	ShutdownOTLP func() error
 	Clients      grpcService.ServiceClient
@@ -40,6 +45,12 @@ func NewApp(cfg config.Config) (*App, error) {
 		return nil, err
 	}
 
+	// redis init
+	redisDB, err := redis.New(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// otlp collector init
 	shutdownOTLP, err := otlp.InitOTLPProvider(&cfg)
 	if err != nil {
@@ -50,6 +61,7 @@ func NewApp(cfg config.Config) (*App, error) {
 		Config:       &cfg,
 		Logger:       logger,
 		DB:           db,
+		RedisDB:      redisDB,
 		ShutdownOTLP: shutdownOTLP,
 	}, nil
 }
@@ -59,6 +71,10 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error while parsing context timeout: %v", err)
 	}
+	idempotencyTTL, err := time.ParseDuration(a.Config.Idempotency.TTL)
+	if err != nil {
+		return fmt.Errorf("error while parsing idempotency ttl: %v", err)
+	}
 
 	clients, err := grpcService.New(a.Config)
 	if err != nil {
@@ -69,12 +85,22 @@ func (a *App) Run() error {
 	// initialize cache
 	// cache := redisrepo.NewCache(a.RedisDB)
 
+	// bulk import init
+	importService := importer.New(clients, a.Logger, contextTimeout, a.Config.Import.BatchSize, a.Config.Import.TTL)
+
+	// streaming export init
+	exportService := exporter.New(clients)
+
 	// api init
 	handler := api.NewRoute(api.RouteOption{
 		Config:         a.Config,
 		Logger:         a.Logger,
 		ContextTimeout: contextTimeout,
 		Service:        clients,
+		Idempotency:    idempotency.NewRedisStore(a.RedisDB),
+		IdempotencyTTL: idempotencyTTL,
+		Importer:       importService,
+		Exporter:       exportService,
 	})
 	// if err = a.Enforcer.LoadPolicy(); err != nil {
 	// 	return fmt.Errorf("error during enforcer load policy: %w", err)
@@ -94,6 +120,11 @@ func (a *App) Stop() {
 	// close database
 	a.DB.Close()
 
+	// close redis
+	if err := a.RedisDB.Client.Close(); err != nil {
+		a.Logger.Error("close redis", zap.Error(err))
+	}
+
 	// close grpc connections
 	a.Clients.Close()
 
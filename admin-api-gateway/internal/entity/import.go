@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+const (
+	ImportEntityClients = "clients"
+	ImportEntityJobs    = "jobs"
+
+	ImportStatusPending   = "pending"
+	ImportStatusPreview   = "preview"
+	ImportStatusImporting = "importing"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+)
+
+// Import is an asynchronous bulk import of clients or jobs from a CSV/XLSX file.
+type Import struct {
+	ID        string
+	Entity    string
+	FileName  string
+	DryRun    bool
+	Mapping   map[string]string
+	Status    string
+	Error     string
+	TotalRows int
+	ValidRows int
+	Created   int
+	Failed    int
+	Rows      []ImportRow
+	// Principal is who created or committed the import, the rows are created on its behalf
+	Principal string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ImportRow is a data row after column mapping, Number is the line in the file (header is 1).
+type ImportRow struct {
+	Number int
+	Fields map[string]string
+	ID     string
+	Errors []string
+}
+
+type ImportRequest struct {
+	Entity   string
+	FileName string
+	Content  []byte
+	Mapping  map[string]string
+	DryRun   bool
+}
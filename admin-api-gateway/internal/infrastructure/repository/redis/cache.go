@@ -7,8 +7,8 @@ import (
 
 	// "go.opentelemetry.io/otel/attribute"
 
-	// otlp_pkg "api-agteway/internal/pkg/otlp"
-	"api-agteway/internal/pkg/redis"
+	// otlp_pkg "admin-api-gateway/internal/pkg/otlp"
+	"admin-api-gateway/internal/pkg/redis"
 )
 
 type Cache interface {
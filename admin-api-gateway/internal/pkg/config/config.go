@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"time"
 )
 
@@ -47,12 +48,27 @@ type Config struct {
 		Name     string
 	}
 
+	Idempotency struct {
+		TTL string
+	}
+
 	Token struct {
 		Secret     string
 		AccessTTL  time.Duration
 		RefreshTTL time.Duration
 	}
 
+	Import struct {
+		BatchSize   int
+		PreviewRows int
+		// TTL is how long a finished import is kept after its last change
+		TTL time.Duration
+	}
+
+	Export struct {
+		Timeout time.Duration
+	}
+
 	Minio struct {
 		Endpoint              string
 		AccessKey             string
@@ -96,6 +112,9 @@ func NewConfig() (*Config, error) {
 	config.Redis.Password = getEnv("REDIS_PASSWORD", "")
 	config.Redis.Name = getEnv("REDIS_DATABASE", "0")
 
+	// how long the responses of requests sent with an Idempotency-Key are replayed
+	config.Idempotency.TTL = getEnv("IDEMPOTENCY_TTL", "24h")
+
 	// client-job services configuration
 	config.ClientService.Host = getEnv("CLIENT_SERVICE_GRPC_HOST", "client-service")
 	config.ClientService.Port = getEnv("CLIENT_SERVICE_GRPC_PORT", ":1111")
@@ -118,6 +137,30 @@ func NewConfig() (*Config, error) {
 	config.Token.AccessTTL = accessTTl
 	config.Token.RefreshTTL = refreshTTL
 
+	// import configuration
+	batchSize, err := strconv.Atoi(getEnv("IMPORT_BATCH_SIZE", "500"))
+	if err != nil {
+		return nil, err
+	}
+	previewRows, err := strconv.Atoi(getEnv("IMPORT_PREVIEW_ROWS", "20"))
+	if err != nil {
+		return nil, err
+	}
+	importTTL, err := time.ParseDuration(getEnv("IMPORT_TTL", "24h"))
+	if err != nil {
+		return nil, err
+	}
+	config.Import.BatchSize = batchSize
+	config.Import.PreviewRows = previewRows
+	config.Import.TTL = importTTL
+
+	// export configuration, replaces CONTEXT_TIMEOUT for streaming exports
+	exportTimeout, err := time.ParseDuration(getEnv("EXPORT_TIMEOUT", "1h"))
+	if err != nil {
+		return nil, err
+	}
+	config.Export.Timeout = exportTimeout
+
 	// otlp collector configuration
 	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "localhost")
 	config.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
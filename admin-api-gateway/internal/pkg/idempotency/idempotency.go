@@ -0,0 +1,35 @@
+package idempotency
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Header is the HTTP header a client sends the key of a request it may retry in
+	Header = "Idempotency-Key"
+	// MetadataKey is the gRPC metadata the services read the key from, they derive the ids of
+	// the objects a request creates from it
+	MetadataKey = "x-idempotency-key"
+	// MaxKeyLength bounds the keys clients can choose
+	MaxKeyLength = 255
+)
+
+// Record is what is kept for a key: the fingerprint of the request and, once it's done, the
+// response which is replayed to the retries
+type Record struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// NewOutgoingContext makes the services called with the context see the key
+func NewOutgoingContext(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, key)
+}
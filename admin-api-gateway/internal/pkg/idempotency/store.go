@@ -0,0 +1,64 @@
+package idempotency
+
+import (
+	"admin-api-gateway/internal/pkg/redis"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const keyPrefix = "idempotency:"
+
+type Store interface {
+	// Reserve saves the record for the key unless the key has one already, the record it has
+	// is returned then
+	Reserve(ctx context.Context, key string, record *Record, ttl time.Duration) (existing *Record, err error)
+	Save(ctx context.Context, key string, record *Record, ttl time.Duration) error
+	Release(ctx context.Context, key string) error
+}
+
+type redisStore struct {
+	rdb *redis.RedisDB
+}
+
+func NewRedisStore(rdb *redis.RedisDB) Store {
+	return &redisStore{
+		rdb: rdb,
+	}
+}
+
+func (s *redisStore) Reserve(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := s.rdb.Client.SetNX(ctx, keyPrefix+key, data, ttl).Result()
+	if err != nil || reserved {
+		return nil, err
+	}
+
+	data, err = s.rdb.Client.Get(ctx, keyPrefix+key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var existing Record
+	if err = json.Unmarshal(data, &existing); err != nil {
+		return nil, err
+	}
+
+	return &existing, nil
+}
+
+func (s *redisStore) Save(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.rdb.Client.Set(ctx, keyPrefix+key, data, ttl).Err()
+}
+
+func (s *redisStore) Release(ctx context.Context, key string) error {
+	return s.rdb.Client.Del(ctx, keyPrefix+key).Err()
+}
@@ -0,0 +1,28 @@
+package principal
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata the services read the principal of a request from, they
+// record it as the actor of the changes
+const MetadataKey = "x-principal"
+
+// NewOutgoingContext makes the services called with the context see the principal
+func NewOutgoingContext(ctx context.Context, principal string) context.Context {
+	if principal == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, principal)
+}
+
+// FromOutgoingContext returns the principal NewOutgoingContext put into the context
+func FromOutgoingContext(ctx context.Context) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if values := md.Get(MetadataKey); len(values) != 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -1,7 +1,7 @@
 package redis
 
 import (
-	"api-gateway/internal/pkg/config"
+	"admin-api-gateway/internal/pkg/config"
 	"strconv"
 
 	"github.com/go-redis/redis/v8"
@@ -0,0 +1,140 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	clientproto "admin-api-gateway/genproto/client_service"
+	jobproto "admin-api-gateway/genproto/job_service"
+	grpc_service_clients "admin-api-gateway/internal/infrastructure/grpc_service_client"
+)
+
+// rows are pushed to the client every flushEvery rows, so memory use doesn't grow with the table
+const flushEvery = 1000
+
+var (
+	clientColumns = []string{
+		"id", "first_name", "last_name", "age", "gender", "phone_number", "address",
+		"email", "status", "created_at", "updated_at", "deleted_at",
+	}
+	jobColumns = []string{
+		"id", "name", "salary_min", "salary_max", "currency", "pay_period", "level", "location_type", "employment_type",
+		"address", "company_id", "company", "description", "responsibilities", "requirements", "benefits",
+		"skills", "latitude", "longitude", "categories", "tags", "status", "publish_at", "close_at", "created_at", "updated_at",
+	}
+	clientJobColumns = []string{
+		"client_id", "job_id", "start_date", "end_date", "created_at", "updated_at",
+	}
+)
+
+// Exporter streams the rows the services send for the request, its scope and filters pick them
+type Exporter interface {
+	Clients(ctx context.Context, w io.Writer, format string, request *clientproto.StreamClientsRequest) error
+	Jobs(ctx context.Context, w io.Writer, format string, request *jobproto.StreamJobsRequest) error
+	ClientJobs(ctx context.Context, w io.Writer, format, clientID, jobID string) error
+}
+
+type exporter struct {
+	service grpc_service_clients.ServiceClient
+}
+
+func New(service grpc_service_clients.ServiceClient) Exporter {
+	return &exporter{
+		service: service,
+	}
+}
+
+func (e *exporter) Clients(ctx context.Context, w io.Writer, format string, request *clientproto.StreamClientsRequest) error {
+	stream, err := e.service.ClientService().StreamClients(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return e.run(w, format, clientColumns, func() ([]any, error) {
+		client, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return []any{
+			client.Id, client.FirstName, client.LastName, client.Age, client.Gender, client.PhoneNumber, client.Address,
+			client.Email, client.Status, client.CreatedAt, client.UpdatedAt, client.DeletedAt,
+		}, nil
+	})
+}
+
+func (e *exporter) Jobs(ctx context.Context, w io.Writer, format string, request *jobproto.StreamJobsRequest) error {
+	stream, err := e.service.JobService().StreamJobs(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return e.run(w, format, jobColumns, func() ([]any, error) {
+		job, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return []any{
+			job.Id, job.Name, job.SalaryMin, job.SalaryMax, job.Currency, job.PayPeriod, job.Level, job.LocationType, job.EmploymentType,
+			job.Address, job.CompanyId, job.Company, job.Description, job.Responsibilities, job.Requirements, job.Benefits,
+			strings.Join(job.Skills, ","), job.Latitude, job.Longitude, strings.Join(job.CategoryIds, ","), strings.Join(job.Tags, ","), job.Status, job.PublishAt, job.CloseAt, job.CreatedAt, job.UpdatedAt,
+		}, nil
+	})
+}
+
+func (e *exporter) ClientJobs(ctx context.Context, w io.Writer, format, clientID, jobID string) error {
+	stream, err := e.service.JobService().StreamClientJobs(ctx, &jobproto.ClientJobRequest{
+		ClientId: clientID,
+		JobId:    jobID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return e.run(w, format, clientJobColumns, func() ([]any, error) {
+		clientJob, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return []any{
+			clientJob.ClientId, clientJob.JobId, clientJob.StartDate, clientJob.EndDate, clientJob.CreatedAt, clientJob.UpdatedAt,
+		}, nil
+	})
+}
+
+// run drains next until io.EOF. Nothing is written to w before the first row arrives,
+// so an error of the stream itself can still be answered with a regular error response.
+func (e *exporter) run(w io.Writer, format string, columns []string, next func() ([]any, error)) error {
+	enc, err := newEncoder(format, w, columns)
+	if err != nil {
+		return err
+	}
+	defer enc.Discard()
+
+	for count := 1; ; count++ {
+		values, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := enc.Encode(values); err != nil {
+			return err
+		}
+
+		if count%flushEvery == 0 {
+			if err := enc.Flush(); err != nil {
+				return err
+			}
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+		}
+	}
+
+	return enc.Close()
+}
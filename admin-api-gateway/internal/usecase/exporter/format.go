@@ -0,0 +1,186 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+	FormatXLSX   = "xlsx"
+)
+
+var ErrUnknownFormat = errors.New("format should be csv, ndjson or xlsx")
+
+// ContentType returns the mime type of the export format
+func ContentType(format string) string {
+	switch format {
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv"
+	}
+}
+
+// encoder writes rows of typed values, the header goes out together with the first row
+type encoder interface {
+	Encode(values []any) error
+	Flush() error
+	Close() error
+	// Discard releases what the encoder holds, it's safe to call after Close
+	Discard()
+}
+
+func newEncoder(format string, w io.Writer, columns []string) (encoder, error) {
+	switch format {
+	case FormatCSV:
+		return &csvEncoder{writer: csv.NewWriter(w), columns: columns}, nil
+	case FormatNDJSON:
+		return &ndjsonEncoder{writer: bufio.NewWriter(w), columns: columns}, nil
+	case FormatXLSX:
+		return newXLSXEncoder(w, columns)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+type csvEncoder struct {
+	writer  *csv.Writer
+	columns []string
+	started bool
+}
+
+func (e *csvEncoder) Encode(values []any) error {
+	if !e.started {
+		e.started = true
+		if err := e.writer.Write(e.columns); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(values))
+	for index, value := range values {
+		record[index] = fmt.Sprint(value)
+	}
+
+	return e.writer.Write(record)
+}
+
+func (e *csvEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) Discard() {}
+
+func (e *csvEncoder) Close() error {
+	if !e.started {
+		e.started = true
+		if err := e.writer.Write(e.columns); err != nil {
+			return err
+		}
+	}
+	return e.Flush()
+}
+
+// ndjsonEncoder writes one object per line, keys keep the column order
+type ndjsonEncoder struct {
+	writer  *bufio.Writer
+	columns []string
+}
+
+func (e *ndjsonEncoder) Encode(values []any) error {
+	e.writer.WriteByte('{')
+	for index, value := range values {
+		if index != 0 {
+			e.writer.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.columns[index])
+		e.writer.Write(key)
+		e.writer.WriteByte(':')
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		e.writer.Write(raw)
+	}
+	e.writer.WriteByte('}')
+
+	return e.writer.WriteByte('\n')
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return e.writer.Flush()
+}
+
+func (e *ndjsonEncoder) Discard() {}
+
+func (e *ndjsonEncoder) Close() error {
+	return e.writer.Flush()
+}
+
+// xlsxEncoder uses the excelize stream writer, which spills rows to a temp file
+// instead of keeping the whole sheet in memory. The zip is written to w on Close.
+type xlsxEncoder struct {
+	file   *excelize.File
+	sheet  *excelize.StreamWriter
+	writer io.Writer
+	row    int
+}
+
+func newXLSXEncoder(w io.Writer, columns []string) (*xlsxEncoder, error) {
+	file := excelize.NewFile()
+	sheet, err := file.NewStreamWriter("Sheet1")
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	header := make([]any, len(columns))
+	for index, column := range columns {
+		header[index] = column
+	}
+	if err := sheet.SetRow("A1", header); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxEncoder{file: file, sheet: sheet, writer: w, row: 1}, nil
+}
+
+func (e *xlsxEncoder) Encode(values []any) error {
+	e.row++
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return err
+	}
+
+	return e.sheet.SetRow(cell, values)
+}
+
+// Flush is a no-op, nothing can be sent before the archive is complete
+func (e *xlsxEncoder) Flush() error {
+	return nil
+}
+
+func (e *xlsxEncoder) Discard() {
+	e.file.Close()
+}
+
+func (e *xlsxEncoder) Close() error {
+	if err := e.sheet.Flush(); err != nil {
+		return err
+	}
+
+	return e.file.Write(e.writer)
+}
@@ -0,0 +1,178 @@
+package importer
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	clientproto "admin-api-gateway/genproto/client_service"
+	jobproto "admin-api-gateway/genproto/job_service"
+)
+
+const (
+	kindString = iota
+	kindUint
+	kindDecimal
+	kindBool
+	kindEmail
+	kindTime
+	kindDegrees
+)
+
+// same shape as NUMERIC(14, 2) of the jobs table
+var decimalRegexp = regexp.MustCompile(`^\d{1,12}(\.\d{1,2})?$`)
+
+type field struct {
+	name     string
+	kind     int
+	required bool
+	maxLen   int
+}
+
+// column limits follow client-service migrations
+var schemas = map[string][]field{
+	"clients": {
+		{name: "first_name", required: true, maxLen: 32},
+		{name: "last_name", required: true, maxLen: 32},
+		{name: "email", kind: kindEmail, required: true},
+		{name: "password", required: true},
+		{name: "age", kind: kindUint},
+		{name: "gender", maxLen: 6},
+		{name: "phone_number", maxLen: 15},
+		{name: "address"},
+		{name: "status", kind: kindBool},
+		{name: "refresh"},
+	},
+	"jobs": {
+		{name: "name", required: true},
+		{name: "salary_min", kind: kindDecimal},
+		{name: "salary_max", kind: kindDecimal},
+		{name: "currency", maxLen: 3},
+		{name: "pay_period", maxLen: 5},
+		{name: "level", required: true, maxLen: 32},
+		{name: "location_type", required: true, maxLen: 32},
+		{name: "employment_type", required: true, maxLen: 32},
+		{name: "address", required: true},
+		{name: "company_id"},
+		// resolved to a company by job-service, used when company_id is empty
+		{name: "company"},
+		{name: "description"},
+		{name: "responsibilities"},
+		{name: "requirements"},
+		{name: "benefits"},
+		// comma separated
+		{name: "skills"},
+		// decimal degrees, job-service geocodes the address when both are empty
+		{name: "latitude", kind: kindDegrees},
+		{name: "longitude", kind: kindDegrees},
+		// comma separated category ids and tag names
+		{name: "categories"},
+		{name: "tags"},
+		{name: "status", maxLen: 9},
+		{name: "publish_at", kind: kindTime},
+		{name: "close_at", kind: kindTime},
+	},
+}
+
+func validateField(f field, value string) error {
+	if value == "" {
+		if f.required {
+			return fmt.Errorf("%s: is required", f.name)
+		}
+		return nil
+	}
+	if f.maxLen != 0 && len([]rune(value)) > f.maxLen {
+		return fmt.Errorf("%s: must be at most %d characters", f.name, f.maxLen)
+	}
+
+	switch f.kind {
+	case kindUint:
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return fmt.Errorf("%s: must be a positive integer", f.name)
+		}
+	case kindDecimal:
+		if !decimalRegexp.MatchString(value) {
+			return fmt.Errorf("%s: must be a decimal with up to 2 fraction digits", f.name)
+		}
+	case kindBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%s: must be true or false", f.name)
+		}
+	case kindEmail:
+		if _, err := mail.ParseAddress(value); err != nil {
+			return fmt.Errorf("%s: is not a valid email", f.name)
+		}
+	case kindTime:
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Errorf("%s: must be an RFC3339 time", f.name)
+		}
+	case kindDegrees:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s: must be a number of degrees", f.name)
+		}
+	}
+
+	return nil
+}
+
+func toClient(fields map[string]string) *clientproto.Client {
+	age, _ := strconv.ParseUint(fields["age"], 10, 32)
+	status := true
+	if value, ok := fields["status"]; ok && value != "" {
+		status, _ = strconv.ParseBool(value)
+	}
+
+	return &clientproto.Client{
+		FirstName:   fields["first_name"],
+		LastName:    fields["last_name"],
+		Age:         uint32(age),
+		Gender:      fields["gender"],
+		PhoneNumber: fields["phone_number"],
+		Address:     fields["address"],
+		Email:       strings.ToLower(fields["email"]),
+		Password:    fields["password"],
+		Status:      status,
+		Refresh:     fields["refresh"],
+	}
+}
+
+func toJob(fields map[string]string) *jobproto.Job {
+	return &jobproto.Job{
+		Name:             fields["name"],
+		SalaryMin:        fields["salary_min"],
+		SalaryMax:        fields["salary_max"],
+		Currency:         strings.ToUpper(fields["currency"]),
+		PayPeriod:        strings.ToLower(fields["pay_period"]),
+		Level:            fields["level"],
+		LocationType:     fields["location_type"],
+		EmploymentType:   fields["employment_type"],
+		Address:          fields["address"],
+		CompanyId:        fields["company_id"],
+		Company:          fields["company"],
+		Description:      fields["description"],
+		Responsibilities: fields["responsibilities"],
+		Requirements:     fields["requirements"],
+		Benefits:         fields["benefits"],
+		Skills:           splitList(fields["skills"]),
+		Latitude:         fields["latitude"],
+		Longitude:        fields["longitude"],
+		CategoryIds:      splitList(fields["categories"]),
+		Tags:             splitList(fields["tags"]),
+		Status:           strings.ToLower(fields["status"]),
+		PublishAt:        fields["publish_at"],
+		CloseAt:          fields["close_at"],
+	}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,392 @@
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	clientproto "admin-api-gateway/genproto/client_service"
+	jobproto "admin-api-gateway/genproto/job_service"
+	"admin-api-gateway/internal/entity"
+	grpc_service_clients "admin-api-gateway/internal/infrastructure/grpc_service_client"
+	"admin-api-gateway/internal/pkg/principal"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrImportNotFound   = errors.New("import not found")
+	ErrImportNotPreview = errors.New("only a dry-run import in preview status can be committed")
+	ErrUnknownEntity    = errors.New("entity should be clients or jobs")
+)
+
+type Importer interface {
+	Create(ctx context.Context, request *entity.ImportRequest) (*entity.Import, error)
+	Get(ctx context.Context, id string) (*entity.Import, error)
+	Commit(ctx context.Context, id string) (*entity.Import, error)
+	WriteReport(ctx context.Context, id string, w io.Writer) error
+}
+
+type importer struct {
+	mu         sync.RWMutex
+	imports    map[string]*entity.Import
+	service    grpc_service_clients.ServiceClient
+	logger     *zap.Logger
+	ctxTimeout time.Duration
+	batchSize  int
+	ttl        time.Duration
+}
+
+// New keeps a finished import, or a preview nobody committed, for ttl after its last change
+func New(service grpc_service_clients.ServiceClient, logger *zap.Logger, ctxTimeout time.Duration, batchSize int, ttl time.Duration) Importer {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	return &importer{
+		imports:    make(map[string]*entity.Import),
+		service:    service,
+		logger:     logger,
+		ctxTimeout: ctxTimeout,
+		batchSize:  batchSize,
+		ttl:        ttl,
+	}
+}
+
+// Create registers the import and processes the file in background
+func (i *importer) Create(ctx context.Context, request *entity.ImportRequest) (*entity.Import, error) {
+	if _, ok := schemas[request.Entity]; !ok {
+		return nil, ErrUnknownEntity
+	}
+
+	table, err := readTable(request.FileName, request.Content)
+	if err != nil {
+		return nil, err
+	}
+	if len(table) == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	now := time.Now().UTC()
+	imp := &entity.Import{
+		ID:        uuid.New().String(),
+		Entity:    request.Entity,
+		FileName:  request.FileName,
+		DryRun:    request.DryRun,
+		Mapping:   request.Mapping,
+		Status:    entity.ImportStatusPending,
+		Principal: principal.FromOutgoingContext(ctx),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	i.mu.Lock()
+	i.evictExpired(now)
+	i.imports[imp.ID] = imp
+	i.mu.Unlock()
+
+	go i.process(imp, table)
+
+	return i.Get(ctx, imp.ID)
+}
+
+func (i *importer) Get(ctx context.Context, id string) (*entity.Import, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	imp, ok := i.imports[id]
+	if !ok || i.expired(imp, time.Now().UTC()) {
+		return nil, ErrImportNotFound
+	}
+
+	snapshot := *imp
+	snapshot.Rows = append([]entity.ImportRow(nil), imp.Rows...)
+	return &snapshot, nil
+}
+
+// Commit sends the rows of a dry-run import to the services
+func (i *importer) Commit(ctx context.Context, id string) (*entity.Import, error) {
+	i.mu.Lock()
+	imp, ok := i.imports[id]
+	if !ok || i.expired(imp, time.Now().UTC()) {
+		i.mu.Unlock()
+		return nil, ErrImportNotFound
+	}
+	if imp.Status != entity.ImportStatusPreview {
+		i.mu.Unlock()
+		return nil, ErrImportNotPreview
+	}
+	imp.Status = entity.ImportStatusImporting
+	imp.Principal = principal.FromOutgoingContext(ctx)
+	imp.UpdatedAt = time.Now().UTC()
+	i.mu.Unlock()
+
+	go i.commit(imp)
+
+	return i.Get(ctx, id)
+}
+
+// WriteReport writes a per-row csv report: row, status, id, errors
+func (i *importer) WriteReport(ctx context.Context, id string, w io.Writer) error {
+	imp, err := i.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"row", "status", "id", "errors"}); err != nil {
+		return err
+	}
+	for _, row := range imp.Rows {
+		status := "valid"
+		switch {
+		case len(row.Errors) != 0:
+			status = "failed"
+		case row.ID != "":
+			status = "created"
+		}
+		if err := writer.Write([]string{
+			strconv.Itoa(row.Number),
+			status,
+			row.ID,
+			strings.Join(row.Errors, "; "),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func (i *importer) process(imp *entity.Import, table [][]string) {
+	rows, err := mapRows(imp.Entity, imp.Mapping, table)
+	if err != nil {
+		i.fail(imp, err)
+		return
+	}
+
+	i.mu.Lock()
+	imp.Rows = rows
+	imp.TotalRows = len(rows)
+	for _, row := range rows {
+		if len(row.Errors) == 0 {
+			imp.ValidRows++
+		} else {
+			imp.Failed++
+		}
+	}
+	if imp.DryRun {
+		imp.Status = entity.ImportStatusPreview
+	} else {
+		imp.Status = entity.ImportStatusImporting
+	}
+	imp.UpdatedAt = time.Now().UTC()
+	i.mu.Unlock()
+
+	if !imp.DryRun {
+		i.commit(imp)
+	}
+}
+
+func (i *importer) commit(imp *entity.Import) {
+	i.mu.RLock()
+	var pending []int
+	for index, row := range imp.Rows {
+		if len(row.Errors) == 0 && row.ID == "" {
+			pending = append(pending, index)
+		}
+	}
+	i.mu.RUnlock()
+
+	for start := 0; start < len(pending); start += i.batchSize {
+		end := start + i.batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+
+		if err := i.sendBatch(imp, pending[start:end]); err != nil {
+			i.fail(imp, err)
+			return
+		}
+	}
+
+	i.mu.Lock()
+	imp.Status = entity.ImportStatusCompleted
+	imp.UpdatedAt = time.Now().UTC()
+	i.mu.Unlock()
+}
+
+func (i *importer) sendBatch(imp *entity.Import, batch []int) error {
+	ctx, cancel := context.WithTimeout(principal.NewOutgoingContext(context.Background(), imp.Principal), i.ctxTimeout)
+	defer cancel()
+
+	var (
+		results []resultItem
+		err     error
+	)
+	switch imp.Entity {
+	case entity.ImportEntityClients:
+		request := &clientproto.BatchCreateClientsRequest{}
+		for _, index := range batch {
+			request.Clients = append(request.Clients, toClient(imp.Rows[index].Fields))
+		}
+		var response *clientproto.BatchCreateResponse
+		response, err = i.service.ClientService().BatchCreateClients(ctx, request)
+		if err == nil {
+			for _, result := range response.Results {
+				results = append(results, resultItem{result.Index, result.Id, result.Error})
+			}
+		}
+	case entity.ImportEntityJobs:
+		request := &jobproto.BatchCreateJobsRequest{}
+		for _, index := range batch {
+			request.Jobs = append(request.Jobs, toJob(imp.Rows[index].Fields))
+		}
+		var response *jobproto.BatchCreateResponse
+		response, err = i.service.JobService().BatchCreateJobs(ctx, request)
+		if err == nil {
+			for _, result := range response.Results {
+				results = append(results, resultItem{result.Index, result.Id, result.Error})
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error while sending batch: %w", err)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, result := range results {
+		if result.index >= uint64(len(batch)) {
+			continue
+		}
+		row := &imp.Rows[batch[result.index]]
+		if result.error != "" {
+			row.Errors = append(row.Errors, result.error)
+			imp.Failed++
+			continue
+		}
+		row.ID = result.id
+		imp.Created++
+	}
+	imp.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+func (i *importer) fail(imp *entity.Import, err error) {
+	i.logger.Error("import failed", zap.String("id", imp.ID), zap.Error(err))
+
+	i.mu.Lock()
+	imp.Status = entity.ImportStatusFailed
+	imp.Error = err.Error()
+	imp.UpdatedAt = time.Now().UTC()
+	i.mu.Unlock()
+}
+
+// expired tells whether the import is done with and was kept for the ttl, the running ones
+// never expire. The caller holds the lock.
+func (i *importer) expired(imp *entity.Import, now time.Time) bool {
+	switch imp.Status {
+	case entity.ImportStatusPending, entity.ImportStatusImporting:
+		return false
+	}
+	return now.Sub(imp.UpdatedAt) >= i.ttl
+}
+
+// evictExpired drops the expired imports, the caller holds the lock for writing
+func (i *importer) evictExpired(now time.Time) {
+	for id, imp := range i.imports {
+		if i.expired(imp, now) {
+			delete(i.imports, id)
+		}
+	}
+}
+
+type resultItem struct {
+	index uint64
+	id    string
+	error string
+}
+
+// mapRows applies the column mapping (field -> file header) and validates every row
+func mapRows(entityName string, mapping map[string]string, table [][]string) ([]entity.ImportRow, error) {
+	schema := schemas[entityName]
+
+	headers := make(map[string]int, len(table[0]))
+	for index, header := range table[0] {
+		headers[normalizeHeader(header)] = index
+	}
+
+	columns := make(map[string]int, len(schema))
+	for _, f := range schema {
+		source := f.name
+		if mapped, ok := mapping[f.name]; ok {
+			source = mapped
+		}
+		if index, ok := headers[normalizeHeader(source)]; ok {
+			columns[f.name] = index
+			continue
+		}
+		if f.required {
+			return nil, fmt.Errorf("required column %q is not found in file", source)
+		}
+	}
+
+	var (
+		rows   = make([]entity.ImportRow, 0, len(table)-1)
+		emails = make(map[string]int)
+	)
+	for index, record := range table[1:] {
+		row := entity.ImportRow{
+			Number: index + 2,
+			Fields: make(map[string]string, len(columns)),
+		}
+
+		empty := true
+		for name, column := range columns {
+			if column < len(record) {
+				row.Fields[name] = strings.TrimSpace(record[column])
+				if row.Fields[name] != "" {
+					empty = false
+				}
+			}
+		}
+		if empty {
+			continue
+		}
+
+		for _, f := range schema {
+			if err := validateField(f, row.Fields[f.name]); err != nil {
+				row.Errors = append(row.Errors, err.Error())
+			}
+		}
+
+		if entityName == entity.ImportEntityJobs && row.Fields["company_id"] == "" && row.Fields["company"] == "" {
+			row.Errors = append(row.Errors, "company: company_id or company is required")
+		}
+
+		if email := strings.ToLower(row.Fields["email"]); entityName == entity.ImportEntityClients && email != "" {
+			if first, ok := emails[email]; ok {
+				row.Errors = append(row.Errors, fmt.Sprintf("email: duplicates row %d", first))
+			} else {
+				emails[email] = row.Number
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
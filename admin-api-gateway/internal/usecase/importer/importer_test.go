@@ -0,0 +1,80 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"admin-api-gateway/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+func TestImportTTL(t *testing.T) {
+	i := New(nil, zap.NewNop(), time.Second, 0, time.Hour).(*importer)
+	ctx := context.Background()
+
+	create := func() *entity.Import {
+		t.Helper()
+		imp, err := i.Create(ctx, &entity.ImportRequest{
+			Entity:   entity.ImportEntityClients,
+			FileName: "clients.csv",
+			Content:  []byte("first_name,last_name,email,password\nJohn,Doe,john@example.com,secret\n"),
+			DryRun:   true,
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return imp
+	}
+
+	finished := create()
+	running := create()
+	for _, id := range []string{finished.ID, running.ID} {
+		deadline := time.Now().Add(time.Second)
+		for {
+			imp, err := i.Get(ctx, id)
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if imp.Status == entity.ImportStatusPreview {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("import %s is %s, want %s", id, imp.Status, entity.ImportStatusPreview)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	i.mu.Lock()
+	stale := time.Now().UTC().Add(-2 * time.Hour)
+	i.imports[finished.ID].UpdatedAt = stale
+	i.imports[running.ID].Status = entity.ImportStatusImporting
+	i.imports[running.ID].UpdatedAt = stale
+	i.mu.Unlock()
+
+	if _, err := i.Get(ctx, finished.ID); !errors.Is(err, ErrImportNotFound) {
+		t.Errorf("Get of an expired import: err = %v, want %v", err, ErrImportNotFound)
+	}
+	if _, err := i.Commit(ctx, finished.ID); !errors.Is(err, ErrImportNotFound) {
+		t.Errorf("Commit of an expired import: err = %v, want %v", err, ErrImportNotFound)
+	}
+	if _, err := i.Get(ctx, running.ID); err != nil {
+		t.Errorf("Get of a running import: %v", err)
+	}
+
+	fresh := create()
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	if _, ok := i.imports[finished.ID]; ok {
+		t.Error("the expired import is kept")
+	}
+	for _, id := range []string{running.ID, fresh.ID} {
+		if _, ok := i.imports[id]; !ok {
+			t.Errorf("import %s is evicted", id)
+		}
+	}
+}
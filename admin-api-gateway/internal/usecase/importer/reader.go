@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+var ErrUnsupportedFile = errors.New("unsupported file type, expected .csv or .xlsx")
+
+// readTable returns all rows of the file, the first one is the header
+func readTable(fileName string, content []byte) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		reader := csv.NewReader(bytes.NewReader(content))
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		return reader.ReadAll()
+	case ".xlsx":
+		file, err := excelize.OpenReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("error while opening xlsx file: %w", err)
+		}
+		defer file.Close()
+
+		sheets := file.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, errors.New("xlsx file has no sheets")
+		}
+		return file.GetRows(sheets[0])
+	default:
+		return nil, ErrUnsupportedFile
+	}
+}
+
+// normalizeHeader makes "First Name" match the first_name field
+func normalizeHeader(header string) string {
+	header = strings.ToLower(strings.TrimSpace(header))
+	header = strings.TrimPrefix(header, "\ufeff")
+	return strings.Join(strings.FieldsFunc(header, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "_")
+}
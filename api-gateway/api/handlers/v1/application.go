@@ -0,0 +1,162 @@
+package v1
+
+import (
+	_ "api-gateway/api/docs"
+	apierrors "api-gateway/api/errors"
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	jobproto "api-gateway/genproto/job_service"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Apply To Job
+// @Description 	This API for apply the client of the token to a published job, the application starts in the applied stage
+// @Tags 			applications
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Job ID"
+// @Param           Request body models.ApplyToJobRequest false "Application"
+// @Success 		201 {object} models.JobApplication
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Failure 		409 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/job/{id}/apply [POST]
+func (h HandlerV1) ApplyToJob(c *gin.Context) {
+	clientID := middleware.ClientID(c)
+	if clientID == "" {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "applying needs a client token",
+		})
+		return
+	}
+
+	var body models.ApplyToJobRequest
+
+	// the cover letter is optional, so is the body
+	err := c.ShouldBindJSON(&body)
+	if err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	application, err := h.Service.JobService().ApplyToJob(ctx, &jobproto.ApplyToJobRequest{
+		JobId:       c.Param("id"),
+		ClientId:    clientID,
+		CoverLetter: body.CoverLetter,
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case apierrors.IsNotFound(err):
+			status = http.StatusNotFound
+		case apierrors.IsPrecondition(err), apierrors.IsConflict(err):
+			status = http.StatusConflict
+		}
+		c.JSON(status, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, applicationFromProto(application))
+}
+
+// @Summary 		Withdraw Application
+// @Description 	This API for withdraw a job application by the client of the token, hired applications can't be withdrawn
+// @Tags 			applications
+// @Produce 		json
+// @Param           id path string true "Application ID"
+// @Success 		200 {object} models.JobApplication
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/applications/{id}/withdraw [POST]
+func (h HandlerV1) WithdrawApplication(c *gin.Context) {
+	clientID := middleware.ClientID(c)
+	if clientID == "" {
+		c.JSON(http.StatusUnauthorized, models.Error{
+			Message: "withdrawing needs a client token",
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	application, err := h.Service.JobService().GetApplication(ctx, &jobproto.ApplicationWithGUID{
+		ApplicationId: c.Param("id"),
+	})
+	if apierrors.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	if application.ClientId != clientID {
+		c.JSON(http.StatusForbidden, models.Error{
+			Message: "the application belongs to another client",
+		})
+		return
+	}
+
+	application, err = h.Service.JobService().MoveApplication(ctx, &jobproto.MoveApplicationRequest{
+		ApplicationId: application.Id,
+		Status:        "withdrawn",
+		Actor:         clientID,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, applicationFromProto(application))
+}
+
+func applicationFromProto(application *jobproto.JobApplication) models.JobApplication {
+	return models.JobApplication{
+		ID:          application.Id,
+		JobID:       application.JobId,
+		ClientID:    application.ClientId,
+		Status:      application.Status,
+		CoverLetter: application.CoverLetter,
+		CreatedAt:   application.CreatedAt,
+		UpdatedAt:   application.UpdatedAt,
+	}
+}
@@ -2,14 +2,18 @@ package v1
 
 import (
 	_ "api-gateway/api/docs"
+	"api-gateway/api/middleware"
 	"context"
 	"net/http"
 	"time"
 
+	apierrors "api-gateway/api/errors"
 	"api-gateway/api/models"
 	clientproto "api-gateway/genproto/client_service"
+	"api-gateway/internal/usecase/readthrough"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -47,7 +51,7 @@ func (h HandlerV1) CreateClient(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.ClientService().CreateClient(ctx, &clientproto.Client{
@@ -107,7 +111,7 @@ func (h HandlerV1) UpdateClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.ClientService().UpdateClient(ctx, &clientproto.Client{
@@ -140,9 +144,11 @@ func (h HandlerV1) UpdateClient(c *gin.Context) {
 // @Produce 	json
 // @Param 		id path string true "Client ID"
 // @Success 	200 {object} models.Status
+// @Success 	202 {object} models.Error
 // @Failure 	400 {object} models.Error
 // @Failure    	401 {object} models.Error
 // @Failure     403 {object} models.Error
+// @Failure 	409 {object} models.Error
 // @Failure 	500 {object} models.Error
 // @Router 		/v1/client/{id} [DELETE]
 func (h HandlerV1) DeleteClient(c *gin.Context) {
@@ -161,12 +167,26 @@ func (h HandlerV1) DeleteClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	_, err = h.Service.ClientService().DeleteClient(ctx, &clientproto.ClientWithGUID{
 		Guid: clientID,
 	})
+	// the deletion is a saga, a failed step is retried in background and the same
+	// deletion can't run twice at a time
+	if apierrors.IsPending(err) {
+		c.JSON(http.StatusAccepted, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
+	if apierrors.IsConflict(err) {
+		c.JSON(http.StatusConflict, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
@@ -205,13 +225,14 @@ func (h HandlerV1) GetClient(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
-	response, err := h.Service.ClientService().GetClient(ctx, &clientproto.ClientWithGUID{
-		Guid: clientID,
+	response, err := readthrough.Load(ctx, h.Cache, "client", readthrough.ClientKey(clientID), func(ctx context.Context) (*clientproto.Client, error) {
+		return h.Service.ClientService().GetClient(ctx, &clientproto.ClientWithGUID{
+			Guid: clientID,
+		})
 	})
-
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
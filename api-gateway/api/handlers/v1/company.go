@@ -0,0 +1,155 @@
+package v1
+
+import (
+	_ "api-gateway/api/docs"
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	jobproto "api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Get Company
+// @Description 	This API for get a company
+// @Tags 			companies
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Company ID"
+// @Success 		200 {object} models.Company
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/company/{id} [GET]
+func (h HandlerV1) GetCompany(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	company, err := h.Service.JobService().GetCompany(ctx, &jobproto.CompanyWithGUID{
+		CompanyId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, companyFromProto(company))
+}
+
+// @Summary 		List Company Jobs
+// @Description 	This API for get a list of published jobs of a company
+// @Tags 			companies
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Company ID"
+// @Param           page query string true "Page"
+// @Param 			limit query string true "Limit"
+// @Success 		200 {object} []models.Job
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/companies/{id}/jobs [GET]
+func (h HandlerV1) ListCompanyJobs(c *gin.Context) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetCompanyJobs(ctx, &jobproto.CompanyJobsRequest{
+		CompanyId: c.Param("id"),
+		Page:      uint64(page),
+		Limit:     uint64(limit),
+		Status:    "published",
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Job{}
+	for _, job := range list.Jobs {
+		response = append(response, models.Job{
+			ID:                   job.Id,
+			Name:                 job.Name,
+			SalaryMin:            job.SalaryMin,
+			SalaryMax:            job.SalaryMax,
+			Currency:             job.Currency,
+			PayPeriod:            job.PayPeriod,
+			Level:                job.Level,
+			LocationType:         job.LocationType,
+			EmploymentType:       job.EmploymentType,
+			Address:              job.Address,
+			CompanyID:            job.CompanyId,
+			Company:              job.Company,
+			Description:          job.Description,
+			Responsibilities:     job.Responsibilities,
+			Requirements:         job.Requirements,
+			Benefits:             job.Benefits,
+			DescriptionHTML:      job.DescriptionHtml,
+			ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+			RequirementsHTML:     job.RequirementsHtml,
+			BenefitsHTML:         job.BenefitsHtml,
+			Skills:               job.Skills,
+			Latitude:             job.Latitude,
+			Longitude:            job.Longitude,
+			CategoryIDs:          job.CategoryIds,
+			Tags:                 job.Tags,
+			DistanceKm:           job.DistanceKm,
+			Status:               job.Status,
+			PublishAt:            job.PublishAt,
+			CloseAt:              job.CloseAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func companyFromProto(company *jobproto.Company) models.Company {
+	return models.Company{
+		ID:          company.Id,
+		Name:        company.Name,
+		Slug:        company.Slug,
+		Logo:        company.Logo,
+		Website:     company.Website,
+		Description: company.Description,
+		Verified:    company.Verified,
+		CreatedAt:   company.CreatedAt,
+		UpdatedAt:   company.UpdatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	jobproto "api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Get Dictionaries
+// @Description 	This API for get the allowed job levels, location types and employment types
+// @Tags 			dictionaries
+// @Accept 			json
+// @Produce 		json
+// @Param 			lang query string false "Language of the labels: en, ru or uz, Accept-Language when empty"
+// @Success 		200 {object} models.Dictionaries
+// @Failure 		400 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/dictionaries [GET]
+func (h HandlerV1) GetDictionaries(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	dictionaries, err := h.Service.JobService().GetDictionaries(ctx, &jobproto.DictionariesRequest{
+		Lang: requestLanguage(c),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var response models.Dictionaries
+	for _, dictionary := range dictionaries.Dictionaries {
+		entries := make([]models.DictionaryEntry, 0, len(dictionary.Entries))
+		for _, entry := range dictionary.Entries {
+			entries = append(entries, models.DictionaryEntry{
+				Code:   entry.Code,
+				Label:  entry.Label,
+				Labels: entry.Labels,
+			})
+		}
+		switch dictionary.Name {
+		case "level":
+			response.Level = entries
+		case "location_type":
+			response.LocationType = entries
+		case "employment_type":
+			response.EmploymentType = entries
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// requestLanguage takes the lang query parameter or the first language of Accept-Language, "ru-RU" is "ru"
+func requestLanguage(c *gin.Context) string {
+	lang := c.Query("lang")
+	if lang == "" {
+		lang, _, _ = strings.Cut(c.GetHeader("Accept-Language"), ",")
+	}
+	lang, _, _ = strings.Cut(lang, ";")
+	lang, _, _ = strings.Cut(lang, "-")
+	return strings.ToLower(strings.TrimSpace(lang))
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	_ "api-gateway/api/docs"
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	jobproto "api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Get Employment History
+// @Description 	This API for get the own employment timeline of a client: positions oldest first with tenure, gaps between jobs and overlapping assignments
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} models.EmploymentHistory
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/employment-history [GET]
+func (h HandlerV1) GetEmploymentHistory(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	history, err := h.Service.JobService().GetEmploymentHistory(ctx, &jobproto.EmploymentHistoryRequest{
+		ClientId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := models.EmploymentHistory{
+		ClientID:        history.ClientId,
+		Positions:       []models.EmploymentPosition{},
+		Gaps:            []models.EmploymentGap{},
+		Overlaps:        []models.EmploymentOverlap{},
+		TotalTenureDays: history.TotalTenureDays,
+	}
+	for _, position := range history.Positions {
+		response.Positions = append(response.Positions, models.EmploymentPosition{
+			JobID:      position.JobId,
+			JobName:    position.JobName,
+			CompanyID:  position.CompanyId,
+			Company:    position.Company,
+			StartDate:  position.StartDate,
+			EndDate:    position.EndDate,
+			Current:    position.Current,
+			TenureDays: position.TenureDays,
+		})
+	}
+	for _, gap := range history.Gaps {
+		response.Gaps = append(response.Gaps, models.EmploymentGap{
+			From:        gap.From,
+			To:          gap.To,
+			Days:        gap.Days,
+			AfterJobID:  gap.AfterJobId,
+			BeforeJobID: gap.BeforeJobId,
+		})
+	}
+	for _, overlap := range history.Overlaps {
+		response.Overlaps = append(response.Overlaps, models.EmploymentOverlap{
+			FirstJobID:  overlap.FirstJobId,
+			SecondJobID: overlap.SecondJobId,
+			From:        overlap.From,
+			To:          overlap.To,
+			Days:        overlap.Days,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
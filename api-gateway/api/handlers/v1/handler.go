@@ -3,6 +3,7 @@ package v1
 import (
 	grpc_service_clients "api-gateway/internal/infrastructure/grpc_service_client"
 	"api-gateway/internal/pkg/config"
+	"api-gateway/internal/usecase/readthrough"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,6 +14,7 @@ type HandlerV1 struct {
 	Logger         *zap.Logger
 	ContextTimeout time.Duration
 	Service        grpc_service_clients.ServiceClient
+	Cache          *readthrough.Cache
 }
 
 // HandlerV1Config ...
@@ -21,6 +23,7 @@ type HandlerV1Config struct {
 	Logger         *zap.Logger
 	ContextTimeout time.Duration
 	Service        grpc_service_clients.ServiceClient
+	Cache          *readthrough.Cache
 }
 
 // New ...
@@ -30,5 +33,6 @@ func New(c *HandlerV1Config) *HandlerV1 {
 		Logger:         c.Logger,
 		Service:        c.Service,
 		ContextTimeout: c.ContextTimeout,
+		Cache:          c.Cache,
 	}
 }
@@ -2,14 +2,20 @@ package v1
 
 import (
 	_ "api-gateway/api/docs"
+	apierrors "api-gateway/api/errors"
+	"api-gateway/api/middleware"
 	"api-gateway/api/models"
 	clientproto "api-gateway/genproto/client_service"
 	jobproto "api-gateway/genproto/job_service"
+	"api-gateway/internal/usecase/readthrough"
 	"context"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -46,17 +52,33 @@ func (h HandlerV1) CreateJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.JobService().CreateJob(ctx, &jobproto.Job{
-		Name:           body.Name,
-		Salary:         body.Salary,
-		Level:          body.Level,
-		LocationType:   body.LocationType,
-		EmploymentType: body.EmploymentType,
-		Address:        body.Address,
-		Company:        body.Company,
+		Name:             body.Name,
+		SalaryMin:        body.SalaryMin,
+		SalaryMax:        body.SalaryMax,
+		Currency:         body.Currency,
+		PayPeriod:        body.PayPeriod,
+		Level:            body.Level,
+		LocationType:     body.LocationType,
+		EmploymentType:   body.EmploymentType,
+		Address:          body.Address,
+		CompanyId:        body.CompanyID,
+		Company:          body.Company,
+		Description:      body.Description,
+		Responsibilities: body.Responsibilities,
+		Requirements:     body.Requirements,
+		Benefits:         body.Benefits,
+		Skills:           body.Skills,
+		Latitude:         body.Latitude,
+		Longitude:        body.Longitude,
+		CategoryIds:      body.CategoryIDs,
+		Tags:             body.Tags,
+		Status:           body.Status,
+		PublishAt:        body.PublishAt,
+		CloseAt:          body.CloseAt,
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
@@ -100,18 +122,34 @@ func (h HandlerV1) UpdateJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.JobService().UpdateJob(ctx, &jobproto.Job{
-		Id:             body.ID,
-		Name:           body.Name,
-		Salary:         body.Salary,
-		Level:          body.Level,
-		LocationType:   body.LocationType,
-		EmploymentType: body.EmploymentType,
-		Address:        body.Address,
-		Company:        body.Company,
+		Id:               body.ID,
+		Name:             body.Name,
+		SalaryMin:        body.SalaryMin,
+		SalaryMax:        body.SalaryMax,
+		Currency:         body.Currency,
+		PayPeriod:        body.PayPeriod,
+		Level:            body.Level,
+		LocationType:     body.LocationType,
+		EmploymentType:   body.EmploymentType,
+		Address:          body.Address,
+		CompanyId:        body.CompanyID,
+		Company:          body.Company,
+		Description:      body.Description,
+		Responsibilities: body.Responsibilities,
+		Requirements:     body.Requirements,
+		Benefits:         body.Benefits,
+		Skills:           body.Skills,
+		Latitude:         body.Latitude,
+		Longitude:        body.Longitude,
+		CategoryIds:      body.CategoryIDs,
+		Tags:             body.Tags,
+		Status:           body.Status,
+		PublishAt:        body.PublishAt,
+		CloseAt:          body.CloseAt,
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
@@ -129,9 +167,11 @@ func (h HandlerV1) UpdateJob(c *gin.Context) {
 // @Produce 		json
 // @Param           id path string true "Job ID"
 // @Success 		200 {object} models.Job
+// @Success 		202 {object} models.Error
 // @Failure 		400 {object} models.Error
 // @Failure 		401 {object} models.Error
 // @Failure 		403 {object} models.Error
+// @Failure 		409 {object} models.Error
 // @Failure 		500 {object} models.Error
 // @Router 			/v1/job/{id} [DELETE]
 func (h HandlerV1) DeleteJob(c *gin.Context) {
@@ -147,7 +187,7 @@ func (h HandlerV1) DeleteJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	jobID := c.Param("id")
@@ -155,6 +195,20 @@ func (h HandlerV1) DeleteJob(c *gin.Context) {
 	_, err = h.Service.JobService().DeleteJob(ctx, &jobproto.JobWithGUID{
 		JobId: jobID,
 	})
+	// the deletion is a saga, a failed step is retried in background and the same
+	// deletion can't run twice at a time
+	if apierrors.IsPending(err) {
+		c.JSON(http.StatusAccepted, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
+	if apierrors.IsConflict(err) {
+		c.JSON(http.StatusConflict, models.Error{
+			Message: status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
@@ -166,7 +220,7 @@ func (h HandlerV1) DeleteJob(c *gin.Context) {
 }
 
 // @Summary 		Get Job
-// @Description 	This API for get a job
+// @Description 	This API for get a published job, the other ones aren't found
 // @Tags 			jobs
 // @Accept 			json
 // @Produce 		json
@@ -175,6 +229,7 @@ func (h HandlerV1) DeleteJob(c *gin.Context) {
 // @Failure 		400 {object} models.Error
 // @Failure 		401 {object} models.Error
 // @Failure 		403 {object} models.Error
+// @Failure 		404 {object} models.Error
 // @Failure 		500 {object} models.Error
 // @Router 			/v1/job/{id} [GET]
 func (h HandlerV1) GetJob(c *gin.Context) {
@@ -190,14 +245,23 @@ func (h HandlerV1) GetJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	jobID := c.Param("id")
 
-	response, err := h.Service.JobService().GetJob(ctx, &jobproto.JobWithGUID{
-		JobId: jobID,
+	response, err := readthrough.Load(ctx, h.Cache, "job", readthrough.JobKey(jobID), func(ctx context.Context) (*jobproto.Job, error) {
+		return h.Service.JobService().GetJob(ctx, &jobproto.JobWithGUID{
+			JobId: jobID,
+		})
 	})
+	// drafts, scheduled and closed jobs are only seen on the admin gateway
+	if apierrors.IsNotFound(err) || (err == nil && response.Status != "published") {
+		c.JSON(http.StatusNotFound, models.Error{
+			Message: "job not found",
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.Error{
 			Message: err.Error(),
@@ -208,6 +272,131 @@ func (h HandlerV1) GetJob(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// @Summary 		List Jobs
+// @Description 	This API for get a list of published jobs
+// @Tags 			jobs
+// @Accept 			json
+// @Produce 		json
+// @Param           page query string true "Page"
+// @Param 			limit query string true "Limit"
+// @Param 			salary_from query string false "Jobs paying at least this much, e.g. 1000.50"
+// @Param 			salary_to query string false "Jobs paying at most this much"
+// @Param 			currency query string false "ISO 4217 currency, e.g. UZS"
+// @Param 			pay_period query string false "hour, month or year"
+// @Param 			level query string false "Level, e.g. Senior"
+// @Param 			location_type query string false "Location type, e.g. Remote"
+// @Param 			employment_type query string false "Employment type, e.g. Full-Time"
+// @Param 			company_id query string false "Company ID"
+// @Param 			skills query string false "Comma separated skills, jobs asking for at least one of them"
+// @Param 			near query string false "Place name, e.g. Tashkent, searched around with radius_km"
+// @Param 			latitude query string false "Latitude of the search center, e.g. 41.3111"
+// @Param 			longitude query string false "Longitude of the search center, e.g. 69.2797"
+// @Param 			radius_km query string false "Search radius in kilometers, jobs come closest first with distance_km"
+// @Param 			bbox query string false "Bounding box south,west,north,east, e.g. 37.1,55.9,45.6,73.2"
+// @Param 			category_id query string false "Category ID, jobs in it or its subcategories"
+// @Param 			tags query string false "Comma separated tags, jobs with at least one of them"
+// @Success 		200 {object} []models.Job
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/jobs [GET]
+func (h HandlerV1) ListJobs(c *gin.Context) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	// drafts, scheduled and closed jobs are visible only on the admin side
+	listKey := h.Cache.JobsKey(ctx, c.Request.URL.Query().Encode())
+	list, err := readthrough.Load(ctx, h.Cache, "jobs", listKey, func(ctx context.Context) (*jobproto.ListJobResponse, error) {
+		return h.Service.JobService().GetAllJobs(ctx, &jobproto.ListRequest{
+			Page:           uint64(page),
+			Limit:          uint64(limit),
+			SalaryFrom:     c.Query("salary_from"),
+			SalaryTo:       c.Query("salary_to"),
+			Currency:       c.Query("currency"),
+			PayPeriod:      c.Query("pay_period"),
+			Status:         "published",
+			Level:          c.Query("level"),
+			LocationType:   c.Query("location_type"),
+			EmploymentType: c.Query("employment_type"),
+			CompanyId:      c.Query("company_id"),
+			Skills:         splitQuery(c.Query("skills")),
+			Near:           c.Query("near"),
+			Latitude:       c.Query("latitude"),
+			Longitude:      c.Query("longitude"),
+			RadiusKm:       c.Query("radius_km"),
+			Bbox:           c.Query("bbox"),
+			CategoryId:     c.Query("category_id"),
+			Tags:           splitQuery(c.Query("tags")),
+		})
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Job{}
+	for _, job := range list.Jobs {
+		response = append(response, models.Job{
+			ID:                   job.Id,
+			Name:                 job.Name,
+			SalaryMin:            job.SalaryMin,
+			SalaryMax:            job.SalaryMax,
+			Currency:             job.Currency,
+			PayPeriod:            job.PayPeriod,
+			Level:                job.Level,
+			LocationType:         job.LocationType,
+			EmploymentType:       job.EmploymentType,
+			Address:              job.Address,
+			CompanyID:            job.CompanyId,
+			Company:              job.Company,
+			Description:          job.Description,
+			Responsibilities:     job.Responsibilities,
+			Requirements:         job.Requirements,
+			Benefits:             job.Benefits,
+			DescriptionHTML:      job.DescriptionHtml,
+			ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+			RequirementsHTML:     job.RequirementsHtml,
+			BenefitsHTML:         job.BenefitsHtml,
+			Skills:               job.Skills,
+			Latitude:             job.Latitude,
+			Longitude:            job.Longitude,
+			CategoryIDs:          job.CategoryIds,
+			Tags:                 job.Tags,
+			DistanceKm:           job.DistanceKm,
+			Status:               job.Status,
+			PublishAt:            job.PublishAt,
+			CloseAt:              job.CloseAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // @Summary 		Add Client to Job
 // @Description 	This API for add client to job
 // @Tags 			jobs
@@ -241,7 +430,7 @@ func (h HandlerV1) AddClientToJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	response, err := h.Service.JobService().AddClientJob(ctx, &jobproto.ClientJobs{
@@ -292,7 +481,7 @@ func (h HandlerV1) RemoveClientFromJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	_, err = h.Service.JobService().DeleteClientJob(ctx, &jobproto.ClientJobs{
@@ -343,7 +532,7 @@ func (h HandlerV1) GetClientsWithJob(c *gin.Context) {
 		})
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
 	defer cancel()
 
 	clientJobs, err := h.Service.JobService().GetClientJobs(ctx, &jobproto.ClientJobRequest{
@@ -381,12 +570,31 @@ func (h HandlerV1) GetClientsWithJob(c *gin.Context) {
 		Status:      client.Status,
 		Refresh:     client.Refresh,
 	}
+
+	jobIDs := make([]string, 0, len(clientJobs.ClientJobs))
 	for _, clientjob := range clientJobs.ClientJobs {
-		job, err := h.Service.JobService().GetJob(ctx, &jobproto.JobWithGUID{
-			JobId: clientjob.JobId,
+		jobIDs = append(jobIDs, clientjob.JobId)
+	}
+	jobs, err := h.Service.JobService().GetJobsByIDs(ctx, &jobproto.JobsByIDsRequest{
+		Ids: jobIDs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
 		})
-		if err != nil {
-			log.Println("job deleted", clientjob.JobId)
+		return
+	}
+	for _, id := range jobs.MissingIds {
+		log.Println("job deleted", id)
+	}
+	jobsByID := make(map[string]*jobproto.Job, len(jobs.Jobs))
+	for _, job := range jobs.Jobs {
+		jobsByID[job.Id] = job
+	}
+
+	for _, clientjob := range clientJobs.ClientJobs {
+		job, ok := jobsByID[clientjob.JobId]
+		if !ok {
 			continue
 		}
 		startDate, err := time.Parse(time.RFC3339, clientjob.StartDate)
@@ -396,27 +604,62 @@ func (h HandlerV1) GetClientsWithJob(c *gin.Context) {
 			})
 			return
 		}
-		endDate, err := time.Parse(time.RFC3339, clientjob.EndDate)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, models.Error{
-				Message: err.Error(),
-			})
-			return
+		// current jobs have no end date
+		var endDate time.Time
+		if clientjob.EndDate != "" {
+			endDate, err = time.Parse(time.RFC3339, clientjob.EndDate)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, models.Error{
+					Message: err.Error(),
+				})
+				return
+			}
 		}
 
 		response.Jobs = append(response.Jobs, models.ResponseJob{
-			ID:             job.Id,
-			Name:           job.Name,
-			Salary:         job.Salary,
-			Level:          job.Level,
-			LocationType:   job.LocationType,
-			EmploymentType: job.EmploymentType,
-			Address:        job.Address,
-			Company:        job.Company,
-			StartDate:      startDate,
-			EndDate:        endDate,
+			ID:                   job.Id,
+			Name:                 job.Name,
+			SalaryMin:            job.SalaryMin,
+			SalaryMax:            job.SalaryMax,
+			Currency:             job.Currency,
+			PayPeriod:            job.PayPeriod,
+			Level:                job.Level,
+			LocationType:         job.LocationType,
+			EmploymentType:       job.EmploymentType,
+			Address:              job.Address,
+			CompanyID:            job.CompanyId,
+			Company:              job.Company,
+			Description:          job.Description,
+			Responsibilities:     job.Responsibilities,
+			Requirements:         job.Requirements,
+			Benefits:             job.Benefits,
+			DescriptionHTML:      job.DescriptionHtml,
+			ResponsibilitiesHTML: job.ResponsibilitiesHtml,
+			RequirementsHTML:     job.RequirementsHtml,
+			BenefitsHTML:         job.BenefitsHtml,
+			Skills:               job.Skills,
+			Latitude:             job.Latitude,
+			Longitude:            job.Longitude,
+			CategoryIDs:          job.CategoryIds,
+			Tags:                 job.Tags,
+			Status:               job.Status,
+			PublishAt:            job.PublishAt,
+			CloseAt:              job.CloseAt,
+			StartDate:            startDate,
+			EndDate:              endDate,
 		})
 	}
 
 	c.JSON(http.StatusOK, response)
 }
+
+// splitQuery reads a comma separated query parameter
+func splitQuery(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
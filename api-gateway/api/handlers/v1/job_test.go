@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jobproto "api-gateway/genproto/job_service"
+	grpc_service_clients "api-gateway/internal/infrastructure/grpc_service_client"
+	"api-gateway/internal/pkg/config"
+	"api-gateway/internal/usecase/readthrough"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// jobServices answers GetJob with its jobs by id, a missing one isn't found
+type jobServices struct {
+	grpc_service_clients.ServiceClient
+	jobproto.JobServiceClient
+	jobs map[string]*jobproto.Job
+}
+
+func (s jobServices) JobService() jobproto.JobServiceClient {
+	return s
+}
+
+func (s jobServices) GetJob(_ context.Context, in *jobproto.JobWithGUID, _ ...grpc.CallOption) (*jobproto.Job, error) {
+	job, ok := s.jobs[in.JobId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+	return job, nil
+}
+
+func TestGetJobOnlyPublished(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.Context.Timeout = "1s"
+	handler := New(&HandlerV1Config{
+		Config: cfg,
+		Logger: zap.NewNop(),
+		Service: jobServices{jobs: map[string]*jobproto.Job{
+			"published": {Id: "published", Status: "published"},
+			"draft":     {Id: "draft", Status: "draft"},
+			"scheduled": {Id: "scheduled", Status: "scheduled"},
+			"closed":    {Id: "closed", Status: "closed"},
+		}},
+		Cache: readthrough.New(nil, nil, time.Second, zap.NewNop()),
+	})
+	router := gin.New()
+	router.GET("/v1/job/:id", handler.GetJob)
+
+	for id, want := range map[string]int{
+		"published": http.StatusOK,
+		"draft":     http.StatusNotFound,
+		"scheduled": http.StatusNotFound,
+		"closed":    http.StatusNotFound,
+		"missing":   http.StatusNotFound,
+	} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/job/"+id, nil))
+		if recorder.Code != want {
+			t.Errorf("GET /v1/job/%s = %d, want %d", id, recorder.Code, want)
+		}
+	}
+}
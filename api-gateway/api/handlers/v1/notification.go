@@ -0,0 +1,297 @@
+package v1
+
+import (
+	regtool "api-gateway/api/handlers/regtool"
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	clientproto "api-gateway/genproto/client_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		Get Notification Preferences
+// @Description 	This API for get how a client is notified, clients who never changed it get the defaults: emails and job alerts on, SMS off, English
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} models.NotificationPreferences
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/notification-preferences [GET]
+func (h HandlerV1) GetNotificationPreferences(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	preferences, err := h.Service.ClientService().GetNotificationPreferences(ctx, &clientproto.ClientWithGUID{
+		Guid: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, notificationPreferencesFromProto(preferences))
+}
+
+// @Summary 		Update Notification Preferences
+// @Description 	This API for choose the channels, the locale (en, ru or uz) and whether a client gets job alerts, SMS needs the phone number of the client. Verification codes and password resets are sent by email anyway
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           NotificationPreferences body models.NotificationPreferencesRequest true "Notification Preferences Model"
+// @Success 		200 {object} models.NotificationPreferences
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/notification-preferences [PUT]
+func (h HandlerV1) UpdateNotificationPreferences(c *gin.Context) {
+	var body models.NotificationPreferencesRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	preferences, err := h.Service.ClientService().UpdateNotificationPreferences(ctx, &clientproto.NotificationPreferences{
+		ClientId:  c.Param("id"),
+		Locale:    body.Locale,
+		Email:     body.Email,
+		Sms:       body.SMS,
+		JobAlerts: body.JobAlerts,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, notificationPreferencesFromProto(preferences))
+}
+
+// @Summary 		Request Email Verification
+// @Description 	This API for send a verification code to the email of a client, the code expires in 15 minutes and a new one can be asked for after a minute
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/verification [POST]
+func (h HandlerV1) RequestEmailVerification(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.ClientService().RequestEmailVerification(ctx, &clientproto.ClientWithGUID{
+		Guid: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+// @Summary 		Verify Email
+// @Description 	This API for verify the email of a client with the code sent to it
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           VerifyEmail body models.VerifyEmailRequest true "Verification Code"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/verify [POST]
+func (h HandlerV1) VerifyEmail(c *gin.Context) {
+	var body models.VerifyEmailRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.ClientService().VerifyEmail(ctx, &clientproto.VerifyEmailRequest{
+		ClientId: c.Param("id"),
+		Code:     body.Code,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+// @Summary 		Forgot Password
+// @Description 	This API for send a password reset code to the email of a client and by SMS when the client chose it. It answers the same for unknown emails
+// @Tags 			password
+// @Accept 			json
+// @Produce 		json
+// @Param           PasswordForgot body models.PasswordForgotRequest true "Email"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/password/forgot [POST]
+func (h HandlerV1) ForgotPassword(c *gin.Context) {
+	var body models.PasswordForgotRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.ClientService().RequestPasswordReset(ctx, &clientproto.PasswordResetRequest{
+		Email: body.Email,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+// @Summary 		Reset Password
+// @Description 	This API for set a new password with the code sent by Forgot Password, the client is signed out everywhere
+// @Tags 			password
+// @Accept 			json
+// @Produce 		json
+// @Param           PasswordReset body models.PasswordResetRequest true "Email, Code and the New Password"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/password/reset [POST]
+func (h HandlerV1) ResetPassword(c *gin.Context) {
+	var body models.PasswordResetRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	password, err := regtool.HashPassword(body.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.ClientService().ResetPassword(ctx, &clientproto.ResetPasswordRequest{
+		Email:    body.Email,
+		Code:     body.Code,
+		Password: password,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+func notificationPreferencesFromProto(preferences *clientproto.NotificationPreferences) models.NotificationPreferences {
+	return models.NotificationPreferences{
+		Locale:        preferences.Locale,
+		Email:         preferences.Email,
+		SMS:           preferences.Sms,
+		JobAlerts:     preferences.JobAlerts,
+		EmailVerified: preferences.EmailVerified,
+		UpdatedAt:     preferences.UpdatedAt,
+	}
+}
@@ -0,0 +1,249 @@
+package v1
+
+import (
+	_ "api-gateway/api/docs"
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	jobproto "api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Saved Searches
+// @Description 	This API for get the job searches a client saved to be alerted about new matching jobs
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Success 		200 {object} []models.SavedSearch
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/saved-searches [GET]
+func (h HandlerV1) ListSavedSearches(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetSavedSearches(ctx, &jobproto.ListSavedSearchesRequest{
+		ClientId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.SavedSearch{}
+	for _, search := range list.SavedSearches {
+		response = append(response, savedSearchFromProto(search))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		Save Search
+// @Description 	This API for save a job search of a client, new published jobs matching it are sent to the client instantly or in a daily or weekly digest
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           SavedSearch body models.SavedSearchRequest true "Saved Search Model"
+// @Success 		201 {object} models.SavedSearch
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/saved-searches [POST]
+func (h HandlerV1) CreateSavedSearch(c *gin.Context) {
+	var body models.SavedSearchRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	search, err := h.Service.JobService().CreateSavedSearch(ctx, savedSearchToProto(c.Param("id"), "", body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, savedSearchFromProto(search))
+}
+
+// @Summary 		Update Saved Search
+// @Description 	This API for change a saved job search of a client
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           search_id path string true "Saved Search ID"
+// @Param           SavedSearch body models.SavedSearchRequest true "Saved Search Model"
+// @Success 		200 {object} models.SavedSearch
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/saved-searches/{search_id} [PUT]
+func (h HandlerV1) UpdateSavedSearch(c *gin.Context) {
+	var body models.SavedSearchRequest
+
+	err := c.ShouldBindJSON(&body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	search, err := h.Service.JobService().UpdateSavedSearch(ctx, savedSearchToProto(c.Param("id"), c.Param("search_id"), body))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, savedSearchFromProto(search))
+}
+
+// @Summary 		Delete Saved Search
+// @Description 	This API for delete a saved job search of a client, no more alerts are sent for it
+// @Tags 			clients
+// @Accept 			json
+// @Produce 		json
+// @Param           id path string true "Client ID"
+// @Param           search_id path string true "Saved Search ID"
+// @Success 		200 {object} models.Status
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/client/{id}/saved-searches/{search_id} [DELETE]
+func (h HandlerV1) DeleteSavedSearch(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	_, err = h.Service.JobService().DeleteSavedSearch(ctx, &jobproto.SavedSearchWithGUID{
+		Id:       c.Param("search_id"),
+		ClientId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Status: true})
+}
+
+func savedSearchToProto(clientID, guid string, body models.SavedSearchRequest) *jobproto.SavedSearch {
+	return &jobproto.SavedSearch{
+		Id:       guid,
+		ClientId: clientID,
+		Name:     body.Name,
+		Filter: &jobproto.JobSearchFilter{
+			SalaryFrom:     body.Filter.SalaryFrom,
+			SalaryTo:       body.Filter.SalaryTo,
+			Currency:       body.Filter.Currency,
+			PayPeriod:      body.Filter.PayPeriod,
+			Level:          body.Filter.Level,
+			LocationType:   body.Filter.LocationType,
+			EmploymentType: body.Filter.EmploymentType,
+			CompanyId:      body.Filter.CompanyID,
+			Skills:         body.Filter.Skills,
+			Near:           body.Filter.Near,
+			Latitude:       body.Filter.Latitude,
+			Longitude:      body.Filter.Longitude,
+			RadiusKm:       body.Filter.RadiusKm,
+			Bbox:           body.Filter.Bbox,
+			CategoryId:     body.Filter.CategoryID,
+			Tags:           body.Filter.Tags,
+		},
+		Frequency: body.Frequency,
+	}
+}
+
+func savedSearchFromProto(search *jobproto.SavedSearch) models.SavedSearch {
+	response := models.SavedSearch{
+		ID:         search.Id,
+		ClientID:   search.ClientId,
+		Name:       search.Name,
+		Frequency:  search.Frequency,
+		LastSentAt: search.LastSentAt,
+		CreatedAt:  search.CreatedAt,
+		UpdatedAt:  search.UpdatedAt,
+	}
+	if filter := search.Filter; filter != nil {
+		response.Filter = models.JobSearchFilter{
+			SalaryFrom:     filter.SalaryFrom,
+			SalaryTo:       filter.SalaryTo,
+			Currency:       filter.Currency,
+			PayPeriod:      filter.PayPeriod,
+			Level:          filter.Level,
+			LocationType:   filter.LocationType,
+			EmploymentType: filter.EmploymentType,
+			CompanyID:      filter.CompanyId,
+			Skills:         filter.Skills,
+			Near:           filter.Near,
+			Latitude:       filter.Latitude,
+			Longitude:      filter.Longitude,
+			RadiusKm:       filter.RadiusKm,
+			Bbox:           filter.Bbox,
+			CategoryID:     filter.CategoryId,
+			Tags:           filter.Tags,
+		}
+	}
+	if response.Filter.Skills == nil {
+		response.Filter.Skills = []string{}
+	}
+	if response.Filter.Tags == nil {
+		response.Filter.Tags = []string{}
+	}
+	return response
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"api-gateway/api/middleware"
+	"api-gateway/api/models"
+	jobproto "api-gateway/genproto/job_service"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 		List Categories
+// @Description 	This API for get the tree of job categories with their published job counts, every category comes right after its parent
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Success 		200 {object} []models.Category
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/categories [GET]
+func (h HandlerV1) ListCategories(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetCategories(ctx, &jobproto.ListCategoriesRequest{
+		WithCounts: true,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Category{}
+	for _, category := range list.Categories {
+		response = append(response, categoryFromProto(category))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary 		List Tags
+// @Description 	This API for get the job tags with their published job counts
+// @Tags 			taxonomy
+// @Accept 			json
+// @Produce 		json
+// @Success 		200 {object} []models.Tag
+// @Failure 		400 {object} models.Error
+// @Failure 		401 {object} models.Error
+// @Failure 		403 {object} models.Error
+// @Failure 		500 {object} models.Error
+// @Router 			/v1/tags [GET]
+func (h HandlerV1) ListTags(c *gin.Context) {
+	duration, err := time.ParseDuration(h.Config.Context.Timeout)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+	ctx, cancel := context.WithTimeout(middleware.PrincipalContext(c), duration)
+	defer cancel()
+
+	list, err := h.Service.JobService().GetTags(ctx, &jobproto.ListTagsRequest{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response := []models.Tag{}
+	for _, tag := range list.Tags {
+		response = append(response, tagFromProto(tag))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func categoryFromProto(category *jobproto.Category) models.Category {
+	return models.Category{
+		ID:        category.Id,
+		ParentID:  category.ParentId,
+		Name:      category.Name,
+		Position:  int(category.Position),
+		JobCount:  category.JobCount,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+func tagFromProto(tag *jobproto.Tag) models.Tag {
+	return models.Tag{
+		Slug:      tag.Slug,
+		Name:      tag.Name,
+		JobCount:  tag.JobCount,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
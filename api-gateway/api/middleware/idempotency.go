@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"api-gateway/api/models"
+	"api-gateway/internal/pkg/idempotency"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	idempotencyKey = "idempotency_key"
+	// replayedHeader marks a response replayed for a retry
+	replayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotency makes the POST, PUT and DELETE requests sent with an Idempotency-Key safe to
+// retry. The first response is kept for ttl and replayed for retries of the same request, the
+// key can't be reused for a different one. A key is held for at most lockTTL while its request
+// runs. Responses of 5xx aren't kept, so a retry runs the request again. When the store fails
+// the request is served without the guarantee.
+func Idempotency(store idempotency.Store, ttl, lockTTL time.Duration, logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotency.Header)
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodDelete:
+		default:
+			key = ""
+		}
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > idempotency.MaxKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, models.Error{
+				Message: "Idempotency-Key is too long",
+			})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, models.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// keys of different principals never meet. The callers without an id share their
+		// principal, so their keys are scoped by the client IP too, a key reused from the same
+		// address for a different request is still a conflict.
+		requestPrincipal := c.GetString(principalKey)
+		record := idempotency.Record{Fingerprint: fingerprint(c.Request, body)}
+		storeKey := requestPrincipal + ":" + key
+		if !strings.Contains(requestPrincipal, ":") {
+			storeKey = requestPrincipal + ":" + c.ClientIP() + ":" + key
+		}
+
+		ctx := context.Background()
+		existing, err := store.Reserve(ctx, storeKey, &record, lockTTL)
+		if err != nil {
+			logger.Error("middleware idempotency: reserve key", zap.Error(err), zap.String("key", key))
+			c.Next()
+			return
+		}
+		if existing != nil {
+			switch {
+			case existing.Fingerprint != record.Fingerprint:
+				c.AbortWithStatusJSON(http.StatusConflict, models.Error{
+					Message: "Idempotency-Key was already used for a different request",
+				})
+			case !existing.Done:
+				c.AbortWithStatusJSON(http.StatusConflict, models.Error{
+					Message: "a request with the Idempotency-Key is in progress",
+				})
+			default:
+				c.Header(replayedHeader, "true")
+				c.Data(existing.Status, existing.ContentType, existing.Body)
+				c.Abort()
+			}
+			return
+		}
+
+		c.Set(idempotencyKey, key)
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		if writer.Status() >= http.StatusInternalServerError {
+			if err = store.Release(ctx, storeKey); err != nil {
+				logger.Error("middleware idempotency: release key", zap.Error(err), zap.String("key", key))
+			}
+			return
+		}
+		record.Done = true
+		record.Status = writer.Status()
+		record.ContentType = writer.Header().Get("Content-Type")
+		record.Body = writer.body.Bytes()
+		if err = store.Save(ctx, storeKey, &record, ttl); err != nil {
+			logger.Error("middleware idempotency: save response", zap.Error(err), zap.String("key", key))
+		}
+	}
+}
+
+// fingerprint tells the requests sent with a key apart
+func fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// recordingWriter keeps a copy of the response body
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
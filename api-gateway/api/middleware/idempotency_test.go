@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"api-gateway/internal/pkg/idempotency"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// memoryStore keeps the records in a map
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]idempotency.Record
+}
+
+func (s *memoryStore) Reserve(_ context.Context, key string, record *idempotency.Record, _ time.Duration) (*idempotency.Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[key]; ok {
+		return &existing, nil
+	}
+	s.records[key] = *record
+	return nil, nil
+}
+
+func (s *memoryStore) Save(_ context.Context, key string, record *idempotency.Record, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[key] = *record
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func TestIdempotencyPrincipals(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type request struct {
+		principal string
+		ip        string
+		body      string
+		status    int
+		replayed  bool
+	}
+	tests := []struct {
+		name     string
+		requests []request
+	}{
+		{
+			name: "anonymous callers with different requests",
+			requests: []request{
+				{principal: "anonymous", ip: "192.0.2.1", body: `{"name":"a"}`, status: http.StatusCreated},
+				{principal: "anonymous", ip: "192.0.2.2", body: `{"name":"b"}`, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "anonymous caller reuses the key for a different request",
+			requests: []request{
+				{principal: "anonymous", ip: "192.0.2.1", body: `{"name":"a"}`, status: http.StatusCreated},
+				{principal: "anonymous", ip: "192.0.2.1", body: `{"name":"b"}`, status: http.StatusConflict},
+			},
+		},
+		{
+			name: "anonymous retry",
+			requests: []request{
+				{principal: "anonymous", ip: "192.0.2.1", body: `{"name":"a"}`, status: http.StatusCreated},
+				{principal: "anonymous", ip: "192.0.2.1", body: `{"name":"a"}`, status: http.StatusCreated, replayed: true},
+			},
+		},
+		{
+			name: "anonymous callers with the same request",
+			requests: []request{
+				{principal: "anonymous", ip: "192.0.2.1", body: `{"name":"a"}`, status: http.StatusCreated},
+				{principal: "anonymous", ip: "192.0.2.2", body: `{"name":"a"}`, status: http.StatusCreated},
+			},
+		},
+		{
+			name: "client reuses the key for a different request",
+			requests: []request{
+				{principal: "client:42", body: `{"name":"a"}`, status: http.StatusCreated},
+				{principal: "client:42", body: `{"name":"b"}`, status: http.StatusConflict},
+			},
+		},
+		{
+			name: "different clients",
+			requests: []request{
+				{principal: "client:42", body: `{"name":"a"}`, status: http.StatusCreated},
+				{principal: "client:7", body: `{"name":"b"}`, status: http.StatusCreated},
+			},
+		},
+	}
+	for _, tt := range tests {
+		store := &memoryStore{records: map[string]idempotency.Record{}}
+		for i, req := range tt.requests {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set(principalKey, req.principal)
+			})
+			router.Use(Idempotency(store, time.Hour, time.Minute, zap.NewNop()))
+			router.POST("/client", func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				c.Data(http.StatusCreated, "application/json", body)
+			})
+
+			httpRequest := httptest.NewRequest(http.MethodPost, "/client", strings.NewReader(req.body))
+			httpRequest.Header.Set(idempotency.Header, "key-1")
+			if req.ip != "" {
+				httpRequest.RemoteAddr = req.ip + ":41000"
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httpRequest)
+
+			if recorder.Code != req.status {
+				t.Errorf("%s: request %d: status = %d, want %d", tt.name, i, recorder.Code, req.status)
+			}
+			if replayed := recorder.Header().Get(replayedHeader) == "true"; replayed != req.replayed {
+				t.Errorf("%s: request %d: replayed = %t, want %t", tt.name, i, replayed, req.replayed)
+			}
+		}
+	}
+}
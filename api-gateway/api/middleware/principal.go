@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"api-gateway/api/models"
+	"api-gateway/internal/pkg/idempotency"
+	"api-gateway/internal/pkg/principal"
+	tokens "api-gateway/internal/pkg/token"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const principalKey = "principal"
+
+// Principal puts the principal of the request into the gin context: the kind and the subject
+// of the token, e.g. "client:<id>", or fallback when the request has no valid token
+func Principal(jwtsecret, kind, fallback string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Query("token")
+		if token == "" {
+			token = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		}
+
+		requestPrincipal := fallback
+		if claims, err := tokens.ParseJwtToken(token, jwtsecret); err == nil {
+			if subject, ok := claims["sub"].(string); ok && subject != "" {
+				requestPrincipal = kind + ":" + subject
+			}
+		}
+		c.Set(principalKey, requestPrincipal)
+
+		c.Next()
+	}
+}
+
+// PrincipalContext is the context for the gRPC calls of the request, the principal and the
+// Idempotency-Key of the request go along in the metadata
+func PrincipalContext(c *gin.Context) context.Context {
+	ctx := principal.NewOutgoingContext(context.Background(), c.GetString(principalKey))
+	return idempotency.NewOutgoingContext(ctx, c.GetString(idempotencyKey))
+}
+
+// ClientID is the id of the client the request is made by, "" when the principal isn't a client
+func ClientID(c *gin.Context) string {
+	clientID, ok := strings.CutPrefix(c.GetString(principalKey), "client:")
+	if !ok {
+		return ""
+	}
+	return clientID
+}
+
+// ClientOwner lets only the client of the :id path parameter through, e.g. "client:<id>"
+// for /client/:id/..., any other principal gets 403
+func ClientOwner(c *gin.Context) {
+	if c.GetString(principalKey) != "client:"+c.Param("id") {
+		c.AbortWithStatusJSON(http.StatusForbidden, models.Error{
+			Message: "the resource belongs to another client",
+		})
+		return
+	}
+
+	c.Next()
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestClientOwner(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		principal string
+		status    int
+	}{
+		{principal: "client:42", status: http.StatusOK},
+		{principal: "client:7", status: http.StatusForbidden},
+		{principal: "anonymous", status: http.StatusForbidden},
+		{principal: "", status: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(principalKey, tt.principal)
+		})
+		router.GET("/client/:id/employment-history", ClientOwner, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/client/42/employment-history", nil))
+		if recorder.Code != tt.status {
+			t.Errorf("principal %q: status = %d, want %d", tt.principal, recorder.Code, tt.status)
+		}
+	}
+}
+
+func TestClientID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for principal, want := range map[string]string{
+		"client:42":  "42",
+		"client:":    "",
+		"anonymous":  "",
+		"admin:42":   "",
+		"":           "",
+		"client42":   "",
+		"client:4:2": "4:2",
+	} {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Set(principalKey, principal)
+		if got := ClientID(c); got != want {
+			t.Errorf("ClientID(%q) = %q, want %q", principal, got, want)
+		}
+	}
+}
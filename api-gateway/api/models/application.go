@@ -0,0 +1,17 @@
+package models
+
+type (
+	JobApplication struct {
+		ID          string `json:"id"`
+		JobID       string `json:"job_id"`
+		ClientID    string `json:"client_id"`
+		Status      string `json:"status"`
+		CoverLetter string `json:"cover_letter"`
+		CreatedAt   string `json:"created_at"`
+		UpdatedAt   string `json:"updated_at"`
+	}
+
+	ApplyToJobRequest struct {
+		CoverLetter string `json:"cover_letter"`
+	}
+)
@@ -0,0 +1,15 @@
+package models
+
+type (
+	Company struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Slug        string `json:"slug"`
+		Logo        string `json:"logo"`
+		Website     string `json:"website"`
+		Description string `json:"description"`
+		Verified    bool   `json:"verified"`
+		CreatedAt   string `json:"created_at"`
+		UpdatedAt   string `json:"updated_at"`
+	}
+)
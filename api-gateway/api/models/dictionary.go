@@ -0,0 +1,15 @@
+package models
+
+type (
+	DictionaryEntry struct {
+		Code   string            `json:"code"`
+		Label  string            `json:"label"`
+		Labels map[string]string `json:"labels"`
+	}
+
+	Dictionaries struct {
+		Level          []DictionaryEntry `json:"level"`
+		LocationType   []DictionaryEntry `json:"location_type"`
+		EmploymentType []DictionaryEntry `json:"employment_type"`
+	}
+)
@@ -0,0 +1,38 @@
+package models
+
+type (
+	EmploymentPosition struct {
+		JobID      string `json:"job_id"`
+		JobName    string `json:"job_name"`
+		CompanyID  string `json:"company_id"`
+		Company    string `json:"company"`
+		StartDate  string `json:"start_date"`
+		EndDate    string `json:"end_date"`
+		Current    bool   `json:"current"`
+		TenureDays int64  `json:"tenure_days"`
+	}
+
+	EmploymentGap struct {
+		From        string `json:"from"`
+		To          string `json:"to"`
+		Days        int64  `json:"days"`
+		AfterJobID  string `json:"after_job_id"`
+		BeforeJobID string `json:"before_job_id"`
+	}
+
+	EmploymentOverlap struct {
+		FirstJobID  string `json:"first_job_id"`
+		SecondJobID string `json:"second_job_id"`
+		From        string `json:"from"`
+		To          string `json:"to"`
+		Days        int64  `json:"days"`
+	}
+
+	EmploymentHistory struct {
+		ClientID        string               `json:"client_id"`
+		Positions       []EmploymentPosition `json:"positions"`
+		Gaps            []EmploymentGap      `json:"gaps"`
+		Overlaps        []EmploymentOverlap  `json:"overlaps"`
+		TotalTenureDays int64                `json:"total_tenure_days"`
+	}
+)
@@ -0,0 +1,33 @@
+package models
+
+type (
+	NotificationPreferences struct {
+		Locale        string `json:"locale"`
+		Email         bool   `json:"email"`
+		SMS           bool   `json:"sms"`
+		JobAlerts     bool   `json:"job_alerts"`
+		EmailVerified bool   `json:"email_verified"`
+		UpdatedAt     string `json:"updated_at"`
+	}
+
+	NotificationPreferencesRequest struct {
+		Locale    string `json:"locale"`
+		Email     bool   `json:"email"`
+		SMS       bool   `json:"sms"`
+		JobAlerts bool   `json:"job_alerts"`
+	}
+
+	VerifyEmailRequest struct {
+		Code string `json:"code" binding:"required"`
+	}
+
+	PasswordForgotRequest struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+
+	PasswordResetRequest struct {
+		Email    string `json:"email" binding:"required,email"`
+		Code     string `json:"code" binding:"required"`
+		Password string `json:"password" binding:"required,min=8,max=72"`
+	}
+)
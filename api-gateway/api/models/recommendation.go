@@ -0,0 +1,17 @@
+package models
+
+type (
+	RecommendationFactor struct {
+		Name   string  `json:"name"`
+		Score  float64 `json:"score"`
+		Weight float64 `json:"weight"`
+		Detail string  `json:"detail"`
+	}
+
+	JobRecommendation struct {
+		Job         Job                    `json:"job"`
+		Score       float64                `json:"score"`
+		Explanation string                 `json:"explanation"`
+		Factors     []RecommendationFactor `json:"factors"`
+	}
+)
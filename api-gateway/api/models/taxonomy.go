@@ -0,0 +1,25 @@
+package models
+
+type (
+	Category struct {
+		ID        string `json:"id"`
+		ParentID  string `json:"parent_id"`
+		Name      string `json:"name"`
+		Position  int    `json:"position"`
+		JobCount  uint64 `json:"job_count"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}
+
+	Tag struct {
+		Slug      string `json:"slug"`
+		Name      string `json:"name"`
+		JobCount  uint64 `json:"job_count"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}
+
+	TagName struct {
+		Name string `json:"name"`
+	}
+)
@@ -14,6 +14,8 @@ import (
 
 	grpcClients "api-gateway/internal/infrastructure/grpc_service_client"
 	"api-gateway/internal/pkg/config"
+	"api-gateway/internal/pkg/idempotency"
+	"api-gateway/internal/usecase/readthrough"
 )
 
 type RouteOption struct {
@@ -21,6 +23,9 @@ type RouteOption struct {
 	Logger         *zap.Logger
 	ContextTimeout time.Duration
 	Service        grpcClients.ServiceClient
+	Idempotency    idempotency.Store
+	IdempotencyTTL time.Duration
+	Cache          *readthrough.Cache
 }
 
 // NewRoute
@@ -38,6 +43,7 @@ func NewRoute(option RouteOption) *gin.Engine {
 		Logger:         option.Logger,
 		ContextTimeout: option.ContextTimeout,
 		Service:        option.Service,
+		Cache:          option.Cache,
 	})
 
 	corsConfig := cors.DefaultConfig()
@@ -49,6 +55,8 @@ func NewRoute(option RouteOption) *gin.Engine {
 	router.Use(cors.New(corsConfig))
 
 	router.Use(middleware.Tracing)
+	router.Use(middleware.Principal(option.Config.Token.Secret, "client", "anonymous"))
+	router.Use(middleware.Idempotency(option.Idempotency, option.IdempotencyTTL, option.ContextTimeout, option.Logger))
 
 	apiV1 := router.Group("/v1")
 
@@ -57,16 +65,48 @@ func NewRoute(option RouteOption) *gin.Engine {
 	apiV1.PUT("/client", HandlerV1.UpdateClient)
 	apiV1.DELETE("/client/:id", HandlerV1.DeleteClient)
 	apiV1.GET("/client/:id", HandlerV1.GetClient)
+	apiV1.GET("/client/:id/employment-history", middleware.ClientOwner, HandlerV1.GetEmploymentHistory)
+	apiV1.GET("/client/:id/profile", middleware.ClientOwner, HandlerV1.GetClientProfile)
+	apiV1.PUT("/client/:id/profile", middleware.ClientOwner, HandlerV1.UpsertClientProfile)
+	apiV1.GET("/client/:id/recommended-jobs", middleware.ClientOwner, HandlerV1.RecommendJobsForClient)
+	apiV1.GET("/client/:id/saved-searches", middleware.ClientOwner, HandlerV1.ListSavedSearches)
+	apiV1.POST("/client/:id/saved-searches", middleware.ClientOwner, HandlerV1.CreateSavedSearch)
+	apiV1.PUT("/client/:id/saved-searches/:search_id", middleware.ClientOwner, HandlerV1.UpdateSavedSearch)
+	apiV1.DELETE("/client/:id/saved-searches/:search_id", middleware.ClientOwner, HandlerV1.DeleteSavedSearch)
+	apiV1.GET("/client/:id/notification-preferences", middleware.ClientOwner, HandlerV1.GetNotificationPreferences)
+	apiV1.PUT("/client/:id/notification-preferences", middleware.ClientOwner, HandlerV1.UpdateNotificationPreferences)
+	apiV1.POST("/client/:id/verification", middleware.ClientOwner, HandlerV1.RequestEmailVerification)
+	apiV1.POST("/client/:id/verify", HandlerV1.VerifyEmail)
+
+	// password
+	apiV1.POST("/password/forgot", HandlerV1.ForgotPassword)
+	apiV1.POST("/password/reset", HandlerV1.ResetPassword)
+
+	// dictionaries
+	apiV1.GET("/dictionaries", HandlerV1.GetDictionaries)
+
+	// taxonomy
+	apiV1.GET("/categories", HandlerV1.ListCategories)
+	apiV1.GET("/tags", HandlerV1.ListTags)
+
+	// companies
+	apiV1.GET("/company/:id", HandlerV1.GetCompany)
+	apiV1.GET("/companies/:id/jobs", HandlerV1.ListCompanyJobs)
 
 	// jobs
 	apiV1.POST("/job", HandlerV1.CreateJob)
 	apiV1.PUT("/job", HandlerV1.UpdateJob)
 	apiV1.DELETE("/job/:id", HandlerV1.DeleteJob)
 	apiV1.GET("/job/:id", HandlerV1.GetJob)
+	apiV1.GET("/jobs", HandlerV1.ListJobs)
 	apiV1.POST("/job/add-client", HandlerV1.AddClientToJob)
 	apiV1.DELETE("/job/remove-client", HandlerV1.RemoveClientFromJob)
 	apiV1.POST("/jobs/client-jobs", HandlerV1.GetClientsWithJob)
 
+	// applications
+	apiV1.POST("/job/:id/apply", HandlerV1.ApplyToJob)
+	apiV1.POST("/applications/:id/withdraw", HandlerV1.WithdrawApplication)
+
 	url := ginSwagger.URL("swagger/doc.json")
 	apiV1.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 
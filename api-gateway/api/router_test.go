@@ -0,0 +1,75 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"api-gateway/internal/pkg/config"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// the routes of a client's own data answer 403 before reaching the services
+func TestClientOwnerRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := NewRoute(RouteOption{Config: &config.Config{}, Logger: zap.NewNop()})
+
+	for _, route := range []struct{ method, path string }{
+		{http.MethodGet, "/v1/client/42/employment-history"},
+		{http.MethodGet, "/v1/client/42/profile"},
+		{http.MethodPut, "/v1/client/42/profile"},
+		{http.MethodGet, "/v1/client/42/recommended-jobs"},
+		{http.MethodGet, "/v1/client/42/saved-searches"},
+		{http.MethodPost, "/v1/client/42/saved-searches"},
+		{http.MethodPut, "/v1/client/42/saved-searches/7"},
+		{http.MethodDelete, "/v1/client/42/saved-searches/7"},
+		{http.MethodGet, "/v1/client/42/notification-preferences"},
+		{http.MethodPut, "/v1/client/42/notification-preferences"},
+		{http.MethodPost, "/v1/client/42/verification"},
+	} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(route.method, route.path, strings.NewReader("{}")))
+		if recorder.Code != http.StatusForbidden {
+			t.Errorf("anonymous %s %s = %d, want 403", route.method, route.path, recorder.Code)
+		}
+	}
+}
+
+// the application routes act for the client of the token, anonymous callers get 401
+func TestApplicationRoutesNeedClient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := NewRoute(RouteOption{Config: &config.Config{}, Logger: zap.NewNop()})
+
+	for _, path := range []string{"/v1/job/42/apply", "/v1/applications/42/withdraw"} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"client_id":"42"}`)))
+		if recorder.Code != http.StatusUnauthorized {
+			t.Errorf("anonymous POST %s = %d, want 401", path, recorder.Code)
+		}
+	}
+}
+
+func TestDebugVarsOnlyOnDebugServer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{}
+	cfg.Debug.Addr = "localhost:6060"
+
+	recorder := httptest.NewRecorder()
+	NewRoute(RouteOption{Config: cfg, Logger: zap.NewNop()}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("public router: got status %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+
+	server := NewDebugServer(cfg)
+	if server.Addr != cfg.Debug.Addr {
+		t.Errorf("got address %q, want %q", server.Addr, cfg.Debug.Addr)
+	}
+	recorder = httptest.NewRecorder()
+	server.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"memstats"`) {
+		t.Errorf("debug server: got status %d, body %.80q", recorder.Code, recorder.Body.String())
+	}
+}
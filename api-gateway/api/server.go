@@ -2,6 +2,7 @@ package api
 
 import (
 	"api-gateway/internal/pkg/config"
+	"expvar"
 	"fmt"
 	"net/http"
 	"time"
@@ -29,3 +30,16 @@ func NewServer(cfg *config.Config, handler http.Handler) (*http.Server, error) {
 		IdleTimeout:  idleTimeout,
 	}, nil
 }
+
+// NewDebugServer serves the expvars, among them the cache hits and misses, it must
+// listen on an internal address only
+func NewDebugServer(cfg *config.Config) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	return &http.Server{
+		Addr:              cfg.Debug.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
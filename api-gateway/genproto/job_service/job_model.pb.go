@@ -24,16 +24,50 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Job struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Salary               float32  `protobuf:"fixed32,3,opt,name=salary,proto3" json:"salary,omitempty"`
-	Level                string   `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
-	LocationType         string   `protobuf:"bytes,5,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
-	EmploymentType       string   `protobuf:"bytes,6,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
-	Address              string   `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	Company              string   `protobuf:"bytes,8,opt,name=company,proto3" json:"company,omitempty"`
-	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level          string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	LocationType   string `protobuf:"bytes,5,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
+	EmploymentType string `protobuf:"bytes,6,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
+	Address        string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	// name of the company, read-only, jobs reference their company by company_id
+	Company   string `protobuf:"bytes,8,opt,name=company,proto3" json:"company,omitempty"`
+	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Markdown sources, the *_html fields are rendered and sanitized by job-service and ignored on write
+	Description          string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	Responsibilities     string `protobuf:"bytes,12,opt,name=responsibilities,proto3" json:"responsibilities,omitempty"`
+	Requirements         string `protobuf:"bytes,13,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	Benefits             string `protobuf:"bytes,14,opt,name=benefits,proto3" json:"benefits,omitempty"`
+	DescriptionHtml      string `protobuf:"bytes,15,opt,name=description_html,json=descriptionHtml,proto3" json:"description_html,omitempty"`
+	ResponsibilitiesHtml string `protobuf:"bytes,16,opt,name=responsibilities_html,json=responsibilitiesHtml,proto3" json:"responsibilities_html,omitempty"`
+	RequirementsHtml     string `protobuf:"bytes,17,opt,name=requirements_html,json=requirementsHtml,proto3" json:"requirements_html,omitempty"`
+	BenefitsHtml         string `protobuf:"bytes,18,opt,name=benefits_html,json=benefitsHtml,proto3" json:"benefits_html,omitempty"`
+	// decimal strings like "1500000.50", empty when not disclosed
+	SalaryMin string `protobuf:"bytes,19,opt,name=salary_min,json=salaryMin,proto3" json:"salary_min,omitempty"`
+	SalaryMax string `protobuf:"bytes,20,opt,name=salary_max,json=salaryMax,proto3" json:"salary_max,omitempty"`
+	// ISO 4217 code like UZS or USD
+	Currency string `protobuf:"bytes,21,opt,name=currency,proto3" json:"currency,omitempty"`
+	// hour, month or year
+	PayPeriod string `protobuf:"bytes,22,opt,name=pay_period,json=payPeriod,proto3" json:"pay_period,omitempty"`
+	CompanyId string `protobuf:"bytes,23,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// draft, scheduled, published, closed or archived. On write draft, closed and archived are taken
+	// as is, otherwise the job is scheduled or published by publish_at (now when empty) and closed
+	// at close_at. A published job can only be closed, a closed one archived, archived is final.
+	Status string `protobuf:"bytes,24,opt,name=status,proto3" json:"status,omitempty"`
+	// RFC3339, close_at may be empty
+	PublishAt string `protobuf:"bytes,25,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	CloseAt   string `protobuf:"bytes,26,opt,name=close_at,json=closeAt,proto3" json:"close_at,omitempty"`
+	// lowercase, used to match jobs with clients
+	Skills []string `protobuf:"bytes,27,rep,name=skills,proto3" json:"skills,omitempty"`
+	// decimal degrees, geocoded from the address when empty and left empty for unknown places
+	Latitude  string `protobuf:"bytes,28,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude string `protobuf:"bytes,29,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// kilometers from the center of a radius search, read-only
+	DistanceKm  float64  `protobuf:"fixed64,30,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	CategoryIds []string `protobuf:"bytes,31,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	// names, new tags are created with the job
+	Tags                 []string `protobuf:"bytes,32,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -86,13 +120,6 @@ func (m *Job) GetName() string {
 	return ""
 }
 
-func (m *Job) GetSalary() float32 {
-	if m != nil {
-		return m.Salary
-	}
-	return 0
-}
-
 func (m *Job) GetLevel() string {
 	if m != nil {
 		return m.Level
@@ -142,6 +169,160 @@ func (m *Job) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *Job) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Job) GetResponsibilities() string {
+	if m != nil {
+		return m.Responsibilities
+	}
+	return ""
+}
+
+func (m *Job) GetRequirements() string {
+	if m != nil {
+		return m.Requirements
+	}
+	return ""
+}
+
+func (m *Job) GetBenefits() string {
+	if m != nil {
+		return m.Benefits
+	}
+	return ""
+}
+
+func (m *Job) GetDescriptionHtml() string {
+	if m != nil {
+		return m.DescriptionHtml
+	}
+	return ""
+}
+
+func (m *Job) GetResponsibilitiesHtml() string {
+	if m != nil {
+		return m.ResponsibilitiesHtml
+	}
+	return ""
+}
+
+func (m *Job) GetRequirementsHtml() string {
+	if m != nil {
+		return m.RequirementsHtml
+	}
+	return ""
+}
+
+func (m *Job) GetBenefitsHtml() string {
+	if m != nil {
+		return m.BenefitsHtml
+	}
+	return ""
+}
+
+func (m *Job) GetSalaryMin() string {
+	if m != nil {
+		return m.SalaryMin
+	}
+	return ""
+}
+
+func (m *Job) GetSalaryMax() string {
+	if m != nil {
+		return m.SalaryMax
+	}
+	return ""
+}
+
+func (m *Job) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Job) GetPayPeriod() string {
+	if m != nil {
+		return m.PayPeriod
+	}
+	return ""
+}
+
+func (m *Job) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+func (m *Job) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Job) GetPublishAt() string {
+	if m != nil {
+		return m.PublishAt
+	}
+	return ""
+}
+
+func (m *Job) GetCloseAt() string {
+	if m != nil {
+		return m.CloseAt
+	}
+	return ""
+}
+
+func (m *Job) GetSkills() []string {
+	if m != nil {
+		return m.Skills
+	}
+	return nil
+}
+
+func (m *Job) GetLatitude() string {
+	if m != nil {
+		return m.Latitude
+	}
+	return ""
+}
+
+func (m *Job) GetLongitude() string {
+	if m != nil {
+		return m.Longitude
+	}
+	return ""
+}
+
+func (m *Job) GetDistanceKm() float64 {
+	if m != nil {
+		return m.DistanceKm
+	}
+	return 0
+}
+
+func (m *Job) GetCategoryIds() []string {
+	if m != nil {
+		return m.CategoryIds
+	}
+	return nil
+}
+
+func (m *Job) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type ClientJobs struct {
 	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	JobId                string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -276,6 +457,109 @@ func (m *JobWithGUID) GetJobId() string {
 	return ""
 }
 
+// jobs come back in the order of ids, ids without a job are listed in missing_ids
+type JobsByIDsRequest struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobsByIDsRequest) Reset()         { *m = JobsByIDsRequest{} }
+func (m *JobsByIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*JobsByIDsRequest) ProtoMessage()    {}
+func (*JobsByIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{3}
+}
+func (m *JobsByIDsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobsByIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobsByIDsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobsByIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobsByIDsRequest.Merge(m, src)
+}
+func (m *JobsByIDsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobsByIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobsByIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobsByIDsRequest proto.InternalMessageInfo
+
+func (m *JobsByIDsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type JobsByIDsResponse struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	MissingIds           []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobsByIDsResponse) Reset()         { *m = JobsByIDsResponse{} }
+func (m *JobsByIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*JobsByIDsResponse) ProtoMessage()    {}
+func (*JobsByIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{4}
+}
+func (m *JobsByIDsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobsByIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobsByIDsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobsByIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobsByIDsResponse.Merge(m, src)
+}
+func (m *JobsByIDsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobsByIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobsByIDsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobsByIDsResponse proto.InternalMessageInfo
+
+func (m *JobsByIDsResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *JobsByIDsResponse) GetMissingIds() []string {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
+}
+
 type ClientJobRequest struct {
 	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	JobId                string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -290,7 +574,7 @@ func (m *ClientJobRequest) Reset()         { *m = ClientJobRequest{} }
 func (m *ClientJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ClientJobRequest) ProtoMessage()    {}
 func (*ClientJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e8320f5efda8ee1, []int{3}
+	return fileDescriptor_3e8320f5efda8ee1, []int{5}
 }
 func (m *ClientJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +642,7 @@ func (m *ResponseStatus) Reset()         { *m = ResponseStatus{} }
 func (m *ResponseStatus) String() string { return proto.CompactTextString(m) }
 func (*ResponseStatus) ProtoMessage()    {}
 func (*ResponseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e8320f5efda8ee1, []int{4}
+	return fileDescriptor_3e8320f5efda8ee1, []int{6}
 }
 func (m *ResponseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,8 +679,31 @@ func (m *ResponseStatus) GetStatus() bool {
 }
 
 type ListRequest struct {
-	Page                 uint64   `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page  uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// jobs whose salary range overlaps [salary_from, salary_to], either bound may be empty
+	SalaryFrom     string `protobuf:"bytes,3,opt,name=salary_from,json=salaryFrom,proto3" json:"salary_from,omitempty"`
+	SalaryTo       string `protobuf:"bytes,4,opt,name=salary_to,json=salaryTo,proto3" json:"salary_to,omitempty"`
+	Currency       string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	PayPeriod      string `protobuf:"bytes,6,opt,name=pay_period,json=payPeriod,proto3" json:"pay_period,omitempty"`
+	Status         string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Level          string `protobuf:"bytes,8,opt,name=level,proto3" json:"level,omitempty"`
+	LocationType   string `protobuf:"bytes,9,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
+	EmploymentType string `protobuf:"bytes,10,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
+	CompanyId      string `protobuf:"bytes,11,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// jobs asking for at least one of the skills
+	Skills []string `protobuf:"bytes,12,rep,name=skills,proto3" json:"skills,omitempty"`
+	// jobs within radius_km of latitude, longitude or of the place named in near, closest first
+	Latitude  string `protobuf:"bytes,13,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude string `protobuf:"bytes,14,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Near      string `protobuf:"bytes,15,opt,name=near,proto3" json:"near,omitempty"`
+	RadiusKm  string `protobuf:"bytes,16,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// "south,west,north,east" in decimal degrees, west may be east of east across the antimeridian
+	Bbox string `protobuf:"bytes,17,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	// jobs in the category or its subcategories
+	CategoryId string `protobuf:"bytes,18,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// jobs with at least one of the tags, by name or slug
+	Tags                 []string `protobuf:"bytes,19,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -406,7 +713,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e8320f5efda8ee1, []int{5}
+	return fileDescriptor_3e8320f5efda8ee1, []int{7}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,54 +756,173 @@ func (m *ListRequest) GetLimit() uint64 {
 	return 0
 }
 
-type ListJobResponse struct {
-	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListRequest) GetSalaryFrom() string {
+	if m != nil {
+		return m.SalaryFrom
+	}
+	return ""
 }
 
-func (m *ListJobResponse) Reset()         { *m = ListJobResponse{} }
-func (m *ListJobResponse) String() string { return proto.CompactTextString(m) }
-func (*ListJobResponse) ProtoMessage()    {}
-func (*ListJobResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e8320f5efda8ee1, []int{6}
+func (m *ListRequest) GetSalaryTo() string {
+	if m != nil {
+		return m.SalaryTo
+	}
+	return ""
 }
-func (m *ListJobResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *ListRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
-func (m *ListJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ListJobResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *ListRequest) GetPayPeriod() string {
+	if m != nil {
+		return m.PayPeriod
 	}
+	return ""
 }
-func (m *ListJobResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListJobResponse.Merge(m, src)
+
+func (m *ListRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
-func (m *ListJobResponse) XXX_Size() int {
-	return m.Size()
+
+func (m *ListRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
 }
-func (m *ListJobResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListJobResponse.DiscardUnknown(m)
+
+func (m *ListRequest) GetLocationType() string {
+	if m != nil {
+		return m.LocationType
+	}
+	return ""
 }
 
-var xxx_messageInfo_ListJobResponse proto.InternalMessageInfo
+func (m *ListRequest) GetEmploymentType() string {
+	if m != nil {
+		return m.EmploymentType
+	}
+	return ""
+}
 
-func (m *ListJobResponse) GetJobs() []*Job {
+func (m *ListRequest) GetCompanyId() string {
 	if m != nil {
-		return m.Jobs
+		return m.CompanyId
 	}
-	return nil
+	return ""
 }
 
-type ListClientJobs struct {
+func (m *ListRequest) GetSkills() []string {
+	if m != nil {
+		return m.Skills
+	}
+	return nil
+}
+
+func (m *ListRequest) GetLatitude() string {
+	if m != nil {
+		return m.Latitude
+	}
+	return ""
+}
+
+func (m *ListRequest) GetLongitude() string {
+	if m != nil {
+		return m.Longitude
+	}
+	return ""
+}
+
+func (m *ListRequest) GetNear() string {
+	if m != nil {
+		return m.Near
+	}
+	return ""
+}
+
+func (m *ListRequest) GetRadiusKm() string {
+	if m != nil {
+		return m.RadiusKm
+	}
+	return ""
+}
+
+func (m *ListRequest) GetBbox() string {
+	if m != nil {
+		return m.Bbox
+	}
+	return ""
+}
+
+func (m *ListRequest) GetCategoryId() string {
+	if m != nil {
+		return m.CategoryId
+	}
+	return ""
+}
+
+func (m *ListRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ListJobResponse struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListJobResponse) Reset()         { *m = ListJobResponse{} }
+func (m *ListJobResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobResponse) ProtoMessage()    {}
+func (*ListJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{8}
+}
+func (m *ListJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobResponse.Merge(m, src)
+}
+func (m *ListJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobResponse proto.InternalMessageInfo
+
+func (m *ListJobResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type ListClientJobs struct {
 	ClientJobs           []*ClientJobs `protobuf:"bytes,1,rep,name=client_jobs,json=clientJobs,proto3" json:"client_jobs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -507,7 +933,7 @@ func (m *ListClientJobs) Reset()         { *m = ListClientJobs{} }
 func (m *ListClientJobs) String() string { return proto.CompactTextString(m) }
 func (*ListClientJobs) ProtoMessage()    {}
 func (*ListClientJobs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e8320f5efda8ee1, []int{7}
+	return fileDescriptor_3e8320f5efda8ee1, []int{9}
 }
 func (m *ListClientJobs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,707 +969,6493 @@ func (m *ListClientJobs) GetClientJobs() []*ClientJobs {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Job)(nil), "job_service.Job")
-	proto.RegisterType((*ClientJobs)(nil), "job_service.ClientJobs")
-	proto.RegisterType((*JobWithGUID)(nil), "job_service.JobWithGUID")
-	proto.RegisterType((*ClientJobRequest)(nil), "job_service.ClientJobRequest")
-	proto.RegisterType((*ResponseStatus)(nil), "job_service.ResponseStatus")
-	proto.RegisterType((*ListRequest)(nil), "job_service.ListRequest")
-	proto.RegisterType((*ListJobResponse)(nil), "job_service.ListJobResponse")
-	proto.RegisterType((*ListClientJobs)(nil), "job_service.ListClientJobs")
+type BatchCreateJobsRequest struct {
+	Jobs                 []*Job   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("job_model.proto", fileDescriptor_3e8320f5efda8ee1) }
-
-var fileDescriptor_3e8320f5efda8ee1 = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4e,
-	0x10, 0xff, 0xdb, 0x71, 0x3e, 0x3c, 0xf9, 0x93, 0x54, 0xab, 0x02, 0x46, 0x88, 0xa8, 0x32, 0x15,
-	0xe4, 0x14, 0x24, 0x38, 0x94, 0x6b, 0xa1, 0x12, 0x4a, 0xc4, 0xc9, 0x80, 0x90, 0xb8, 0x44, 0x6b,
-	0xef, 0xa8, 0x6c, 0x64, 0x7b, 0x8d, 0x77, 0x53, 0x29, 0x4f, 0x02, 0x8f, 0xc2, 0x23, 0x70, 0xe4,
-	0x11, 0x50, 0x78, 0x11, 0xb4, 0xb3, 0x76, 0xea, 0x22, 0xc4, 0x81, 0xdb, 0xfc, 0x3e, 0x26, 0xbf,
-	0x99, 0xc9, 0x1a, 0xa6, 0x1b, 0x95, 0xae, 0x0b, 0x25, 0x30, 0x5f, 0x54, 0xb5, 0x32, 0x8a, 0x8d,
-	0x2d, 0xa1, 0xb1, 0xbe, 0x92, 0x19, 0xc6, 0x9f, 0x7d, 0xe8, 0xad, 0x54, 0xca, 0x26, 0xe0, 0x4b,
-	0x11, 0x79, 0x27, 0xde, 0x3c, 0x4c, 0x7c, 0x29, 0x18, 0x83, 0xa0, 0xe4, 0x05, 0x46, 0x3e, 0x31,
-	0x54, 0xb3, 0x3b, 0x30, 0xd0, 0x3c, 0xe7, 0xf5, 0x2e, 0xea, 0x9d, 0x78, 0x73, 0x3f, 0x69, 0x10,
-	0x3b, 0x86, 0x7e, 0x8e, 0x57, 0x98, 0x47, 0x01, 0x99, 0x1d, 0x60, 0x0f, 0xe1, 0x56, 0xae, 0x32,
-	0x6e, 0xa4, 0x2a, 0xd7, 0x66, 0x57, 0x61, 0xd4, 0x27, 0xf5, 0xff, 0x96, 0x7c, 0xbb, 0xab, 0x90,
-	0x3d, 0x86, 0x29, 0x16, 0x55, 0xae, 0x76, 0x05, 0x96, 0xc6, 0xd9, 0x06, 0x64, 0x9b, 0x5c, 0xd3,
-	0x64, 0x8c, 0x60, 0xc8, 0x85, 0xa8, 0x51, 0xeb, 0x68, 0x48, 0x86, 0x16, 0x5a, 0x25, 0x53, 0x45,
-	0xc5, 0xcb, 0x5d, 0x34, 0x72, 0x4a, 0x03, 0xd9, 0x03, 0x80, 0xac, 0x46, 0x6e, 0x50, 0xac, 0xb9,
-	0x89, 0x42, 0x12, 0xc3, 0x86, 0x39, 0x37, 0x56, 0xde, 0x56, 0xa2, 0x95, 0xc1, 0xc9, 0x0d, 0x73,
-	0x6e, 0xe2, 0xaf, 0x1e, 0xc0, 0xcb, 0x5c, 0x62, 0x69, 0x56, 0x2a, 0xd5, 0xec, 0x3e, 0x84, 0x19,
-	0xa1, 0xf5, 0xe1, 0x4e, 0x23, 0x47, 0x2c, 0x05, 0xbb, 0x0d, 0x03, 0x7b, 0x54, 0x29, 0x9a, 0x7b,
-	0xf5, 0x37, 0x2a, 0x5d, 0x0a, 0x9b, 0xa0, 0x0d, 0xaf, 0xcd, 0xda, 0xfe, 0x26, 0x1d, 0x2d, 0x4c,
-	0x42, 0x62, 0x2e, 0xb8, 0x41, 0x76, 0x0f, 0x46, 0x58, 0x0a, 0x27, 0xba, 0xd3, 0x0d, 0xb1, 0x14,
-	0x24, 0xdd, 0x1c, 0xbd, 0xff, 0xf7, 0xd1, 0x07, 0xbf, 0x8f, 0x7e, 0x0a, 0xe3, 0x95, 0x4a, 0xdf,
-	0x4b, 0xf3, 0xf1, 0xd5, 0xbb, 0xe5, 0x45, 0x67, 0x3a, 0xaf, 0x33, 0x5d, 0x5c, 0xc1, 0xd1, 0x61,
-	0xbf, 0x04, 0x3f, 0x6d, 0x51, 0x9b, 0x7f, 0xda, 0x92, 0x41, 0x50, 0xf1, 0x4b, 0xb7, 0x5f, 0x90,
-	0x50, 0x4d, 0x4f, 0x42, 0x16, 0xd2, 0xd0, 0x5e, 0x41, 0xe2, 0x40, 0x3c, 0x87, 0x49, 0x82, 0xba,
-	0x52, 0xa5, 0xc6, 0x37, 0x86, 0x9b, 0xad, 0xa6, 0x27, 0x45, 0x15, 0x85, 0x8d, 0x92, 0x06, 0xc5,
-	0x67, 0x30, 0x7e, 0x2d, 0xb5, 0x69, 0xc7, 0x6a, 0x23, 0xbc, 0x3f, 0x45, 0xf8, 0xdd, 0x88, 0x33,
-	0x98, 0xda, 0x46, 0x5a, 0xc9, 0x25, 0xb1, 0x53, 0x08, 0x36, 0x2a, 0xb5, 0x09, 0xbd, 0xf9, 0xf8,
-	0xe9, 0xd1, 0xa2, 0xf3, 0xfc, 0x17, 0xd6, 0x47, 0x6a, 0xbc, 0x82, 0x89, 0x6d, 0xec, 0xfc, 0xe3,
-	0xcf, 0x61, 0xdc, 0xdc, 0xa2, 0xd3, 0x7e, 0xf7, 0x46, 0xfb, 0xb5, 0x3b, 0x81, 0xec, 0x50, 0xbf,
-	0x78, 0xf4, 0x6d, 0x3f, 0xf3, 0xbe, 0xef, 0x67, 0xde, 0x8f, 0xfd, 0xcc, 0xfb, 0xf2, 0x73, 0xf6,
-	0xdf, 0x87, 0xe3, 0x4b, 0x2c, 0xe9, 0xeb, 0x7b, 0xd2, 0x69, 0x4f, 0x07, 0x44, 0x3d, 0xfb, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0x5f, 0xf7, 0x6a, 0xa3, 0x03, 0x00, 0x00,
+func (m *BatchCreateJobsRequest) Reset()         { *m = BatchCreateJobsRequest{} }
+func (m *BatchCreateJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateJobsRequest) ProtoMessage()    {}
+func (*BatchCreateJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{10}
+}
+func (m *BatchCreateJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCreateJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchCreateJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateJobsRequest.Merge(m, src)
+}
+func (m *BatchCreateJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCreateJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateJobsRequest.DiscardUnknown(m)
 }
 
-func (m *Job) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchCreateJobsRequest proto.InternalMessageInfo
+
+func (m *BatchCreateJobsRequest) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Job) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchItemResult struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Company) > 0 {
-		i -= len(m.Company)
-		copy(dAtA[i:], m.Company)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Company)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.EmploymentType) > 0 {
-		i -= len(m.EmploymentType)
-		copy(dAtA[i:], m.EmploymentType)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.EmploymentType)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LocationType) > 0 {
-		i -= len(m.LocationType)
-		copy(dAtA[i:], m.LocationType)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.LocationType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Level)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Salary != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
-		i--
-		dAtA[i] = 0x1d
+func (m *BatchItemResult) Reset()         { *m = BatchItemResult{} }
+func (m *BatchItemResult) String() string { return proto.CompactTextString(m) }
+func (*BatchItemResult) ProtoMessage()    {}
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{11}
+}
+func (m *BatchItemResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchItemResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchItemResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BatchItemResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchItemResult.Merge(m, src)
+}
+func (m *BatchItemResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchItemResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchItemResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchItemResult proto.InternalMessageInfo
+
+func (m *BatchItemResult) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *BatchItemResult) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClientJobs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchItemResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClientJobs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchCreateResponse struct {
+	Results              []*BatchItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *ClientJobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.EndDate) > 0 {
-		i -= len(m.EndDate)
-		copy(dAtA[i:], m.EndDate)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.EndDate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.StartDate) > 0 {
-		i -= len(m.StartDate)
-		copy(dAtA[i:], m.StartDate)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.StartDate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *BatchCreateResponse) Reset()         { *m = BatchCreateResponse{} }
+func (m *BatchCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateResponse) ProtoMessage()    {}
+func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{12}
+}
+func (m *BatchCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateResponse.Merge(m, src)
+}
+func (m *BatchCreateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateResponse.DiscardUnknown(m)
 }
 
-func (m *JobWithGUID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchCreateResponse proto.InternalMessageInfo
+
+func (m *BatchCreateResponse) GetResults() []*BatchItemResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobWithGUID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the filters are those of ListRequest
+type StreamJobsRequest struct {
+	Scope                string   `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	SalaryFrom           string   `protobuf:"bytes,2,opt,name=salary_from,json=salaryFrom,proto3" json:"salary_from,omitempty"`
+	SalaryTo             string   `protobuf:"bytes,3,opt,name=salary_to,json=salaryTo,proto3" json:"salary_to,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	PayPeriod            string   `protobuf:"bytes,5,opt,name=pay_period,json=payPeriod,proto3" json:"pay_period,omitempty"`
+	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Level                string   `protobuf:"bytes,7,opt,name=level,proto3" json:"level,omitempty"`
+	LocationType         string   `protobuf:"bytes,8,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
+	EmploymentType       string   `protobuf:"bytes,9,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
+	CompanyId            string   `protobuf:"bytes,10,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Skills               []string `protobuf:"bytes,11,rep,name=skills,proto3" json:"skills,omitempty"`
+	Latitude             string   `protobuf:"bytes,12,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude            string   `protobuf:"bytes,13,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Near                 string   `protobuf:"bytes,14,opt,name=near,proto3" json:"near,omitempty"`
+	RadiusKm             string   `protobuf:"bytes,15,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	Bbox                 string   `protobuf:"bytes,16,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	CategoryId           string   `protobuf:"bytes,17,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Tags                 []string `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JobWithGUID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *StreamJobsRequest) Reset()         { *m = StreamJobsRequest{} }
+func (m *StreamJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamJobsRequest) ProtoMessage()    {}
+func (*StreamJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{13}
+}
+func (m *StreamJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StreamJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamJobsRequest.Merge(m, src)
+}
+func (m *StreamJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamJobsRequest.DiscardUnknown(m)
 }
 
-func (m *ClientJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamJobsRequest proto.InternalMessageInfo
+
+func (m *StreamJobsRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClientJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamJobsRequest) GetSalaryFrom() string {
+	if m != nil {
+		return m.SalaryFrom
+	}
+	return ""
 }
 
-func (m *ClientJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Limit != 0 {
-		i = encodeVarintJobModel(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Page != 0 {
-		i = encodeVarintJobModel(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *StreamJobsRequest) GetSalaryTo() string {
+	if m != nil {
+		return m.SalaryTo
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ResponseStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamJobsRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ResponseStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamJobsRequest) GetPayPeriod() string {
+	if m != nil {
+		return m.PayPeriod
+	}
+	return ""
 }
 
-func (m *ResponseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StreamJobsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *StreamJobsRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamJobsRequest) GetLocationType() string {
+	if m != nil {
+		return m.LocationType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamJobsRequest) GetEmploymentType() string {
+	if m != nil {
+		return m.EmploymentType
+	}
+	return ""
 }
 
-func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StreamJobsRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
 	}
-	if m.Limit != 0 {
-		i = encodeVarintJobModel(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *StreamJobsRequest) GetSkills() []string {
+	if m != nil {
+		return m.Skills
 	}
-	if m.Page != 0 {
-		i = encodeVarintJobModel(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *StreamJobsRequest) GetLatitude() string {
+	if m != nil {
+		return m.Latitude
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListJobResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamJobsRequest) GetLongitude() string {
+	if m != nil {
+		return m.Longitude
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListJobResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamJobsRequest) GetNear() string {
+	if m != nil {
+		return m.Near
+	}
+	return ""
 }
 
-func (m *ListJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Jobs) > 0 {
-		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintJobModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *StreamJobsRequest) GetRadiusKm() string {
+	if m != nil {
+		return m.RadiusKm
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListClientJobs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamJobsRequest) GetBbox() string {
+	if m != nil {
+		return m.Bbox
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListClientJobs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamJobsRequest) GetCategoryId() string {
+	if m != nil {
+		return m.CategoryId
+	}
+	return ""
 }
 
-func (m *ListClientJobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StreamJobsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	if len(m.ClientJobs) > 0 {
-		for iNdEx := len(m.ClientJobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClientJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintJobModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// cursor 0 starts at the end of the feed, the filters are optional
+type WatchJobsRequest struct {
+	Cursor               uint64   `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Actions              []string `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	CompanyId            string   `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	JobId                string   `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchJobsRequest) Reset()         { *m = WatchJobsRequest{} }
+func (m *WatchJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobsRequest) ProtoMessage()    {}
+func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{14}
+}
+func (m *WatchJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WatchJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobsRequest.Merge(m, src)
+}
+func (m *WatchJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintJobModel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovJobModel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_WatchJobsRequest proto.InternalMessageInfo
+
+func (m *WatchJobsRequest) GetCursor() uint64 {
+	if m != nil {
+		return m.Cursor
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Job) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	if m.Salary != 0 {
-		n += 5
-	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.LocationType)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.EmploymentType)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.Company)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *WatchJobsRequest) GetActions() []string {
+	if m != nil {
+		return m.Actions
 	}
-	return n
+	return nil
 }
 
-func (m *ClientJobs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.StartDate)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.EndDate)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *WatchJobsRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
 	}
-	return n
+	return ""
 }
 
-func (m *JobWithGUID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *WatchJobsRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return n
+	return ""
 }
 
-func (m *ClientJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
+type JobChange struct {
+	Cursor               uint64   `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	JobId                string   `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	CompanyId            string   `protobuf:"bytes,4,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	ChangedAt            string   `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Job                  *Job     `protobuf:"bytes,6,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobChange) Reset()         { *m = JobChange{} }
+func (m *JobChange) String() string { return proto.CompactTextString(m) }
+func (*JobChange) ProtoMessage()    {}
+func (*JobChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{15}
+}
+func (m *JobChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovJobModel(uint64(l))
+}
+func (m *JobChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobChange.Merge(m, src)
+}
+func (m *JobChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobChange proto.InternalMessageInfo
+
+func (m *JobChange) GetCursor() uint64 {
+	if m != nil {
+		return m.Cursor
 	}
-	if m.Page != 0 {
-		n += 1 + sovJobModel(uint64(m.Page))
+	return 0
+}
+
+func (m *JobChange) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	if m.Limit != 0 {
-		n += 1 + sovJobModel(uint64(m.Limit))
+	return ""
+}
+
+func (m *JobChange) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *JobChange) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
 	}
-	return n
+	return ""
 }
 
-func (m *ResponseStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobChange) GetChangedAt() string {
+	if m != nil {
+		return m.ChangedAt
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	return ""
+}
+
+func (m *JobChange) GetJob() *Job {
+	if m != nil {
+		return m.Job
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+type ReassignClientJobsRequest struct {
+	FromClientId         string   `protobuf:"bytes,1,opt,name=from_client_id,json=fromClientId,proto3" json:"from_client_id,omitempty"`
+	ToClientId           string   `protobuf:"bytes,2,opt,name=to_client_id,json=toClientId,proto3" json:"to_client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReassignClientJobsRequest) Reset()         { *m = ReassignClientJobsRequest{} }
+func (m *ReassignClientJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReassignClientJobsRequest) ProtoMessage()    {}
+func (*ReassignClientJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{16}
+}
+func (m *ReassignClientJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignClientJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignClientJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ReassignClientJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignClientJobsRequest.Merge(m, src)
+}
+func (m *ReassignClientJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignClientJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignClientJobsRequest.DiscardUnknown(m)
 }
 
-func (m *ListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ReassignClientJobsRequest proto.InternalMessageInfo
+
+func (m *ReassignClientJobsRequest) GetFromClientId() string {
+	if m != nil {
+		return m.FromClientId
 	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovJobModel(uint64(m.Page))
+	return ""
+}
+
+func (m *ReassignClientJobsRequest) GetToClientId() string {
+	if m != nil {
+		return m.ToClientId
 	}
-	if m.Limit != 0 {
-		n += 1 + sovJobModel(uint64(m.Limit))
+	return ""
+}
+
+// moved_ids and ended_ids are the client_jobs rows the reassignment changed, pass them to
+// UndoReassignClientJobs to revert it
+type ReassignClientJobsResponse struct {
+	Moved                uint64   `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	MovedIds             []string `protobuf:"bytes,2,rep,name=moved_ids,json=movedIds,proto3" json:"moved_ids,omitempty"`
+	EndedIds             []string `protobuf:"bytes,3,rep,name=ended_ids,json=endedIds,proto3" json:"ended_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReassignClientJobsResponse) Reset()         { *m = ReassignClientJobsResponse{} }
+func (m *ReassignClientJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReassignClientJobsResponse) ProtoMessage()    {}
+func (*ReassignClientJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{17}
+}
+func (m *ReassignClientJobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignClientJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignClientJobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *ReassignClientJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignClientJobsResponse.Merge(m, src)
+}
+func (m *ReassignClientJobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignClientJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignClientJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignClientJobsResponse proto.InternalMessageInfo
+
+func (m *ReassignClientJobsResponse) GetMoved() uint64 {
+	if m != nil {
+		return m.Moved
 	}
-	return n
+	return 0
 }
 
-func (m *ListJobResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReassignClientJobsResponse) GetMovedIds() []string {
+	if m != nil {
+		return m.MovedIds
 	}
-	var l int
-	_ = l
-	if len(m.Jobs) > 0 {
-		for _, e := range m.Jobs {
-			l = e.Size()
-			n += 1 + l + sovJobModel(uint64(l))
+	return nil
+}
+
+func (m *ReassignClientJobsResponse) GetEndedIds() []string {
+	if m != nil {
+		return m.EndedIds
+	}
+	return nil
+}
+
+type UndoReassignClientJobsRequest struct {
+	FromClientId         string   `protobuf:"bytes,1,opt,name=from_client_id,json=fromClientId,proto3" json:"from_client_id,omitempty"`
+	ToClientId           string   `protobuf:"bytes,2,opt,name=to_client_id,json=toClientId,proto3" json:"to_client_id,omitempty"`
+	MovedIds             []string `protobuf:"bytes,3,rep,name=moved_ids,json=movedIds,proto3" json:"moved_ids,omitempty"`
+	EndedIds             []string `protobuf:"bytes,4,rep,name=ended_ids,json=endedIds,proto3" json:"ended_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UndoReassignClientJobsRequest) Reset()         { *m = UndoReassignClientJobsRequest{} }
+func (m *UndoReassignClientJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*UndoReassignClientJobsRequest) ProtoMessage()    {}
+func (*UndoReassignClientJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{18}
+}
+func (m *UndoReassignClientJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndoReassignClientJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndoReassignClientJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *UndoReassignClientJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndoReassignClientJobsRequest.Merge(m, src)
+}
+func (m *UndoReassignClientJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndoReassignClientJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndoReassignClientJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UndoReassignClientJobsRequest proto.InternalMessageInfo
+
+func (m *UndoReassignClientJobsRequest) GetFromClientId() string {
+	if m != nil {
+		return m.FromClientId
 	}
-	return n
+	return ""
 }
 
-func (m *ListClientJobs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UndoReassignClientJobsRequest) GetToClientId() string {
+	if m != nil {
+		return m.ToClientId
 	}
-	var l int
-	_ = l
-	if len(m.ClientJobs) > 0 {
-		for _, e := range m.ClientJobs {
-			l = e.Size()
-			n += 1 + l + sovJobModel(uint64(l))
+	return ""
+}
+
+func (m *UndoReassignClientJobsRequest) GetMovedIds() []string {
+	if m != nil {
+		return m.MovedIds
+	}
+	return nil
+}
+
+func (m *UndoReassignClientJobsRequest) GetEndedIds() []string {
+	if m != nil {
+		return m.EndedIds
+	}
+	return nil
+}
+
+// steps of the client deletion saga of client-service, the changes are kept by saga_id so the
+// steps can be repeated and undone
+type EndClientAssignmentsRequest struct {
+	SagaId               string   `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	ClientId             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndClientAssignmentsRequest) Reset()         { *m = EndClientAssignmentsRequest{} }
+func (m *EndClientAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*EndClientAssignmentsRequest) ProtoMessage()    {}
+func (*EndClientAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{19}
+}
+func (m *EndClientAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndClientAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndClientAssignmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *EndClientAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndClientAssignmentsRequest.Merge(m, src)
+}
+func (m *EndClientAssignmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndClientAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndClientAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndClientAssignmentsRequest proto.InternalMessageInfo
+
+func (m *EndClientAssignmentsRequest) GetSagaId() string {
+	if m != nil {
+		return m.SagaId
 	}
-	return n
+	return ""
 }
 
-func sovJobModel(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EndClientAssignmentsRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
-func sozJobModel(x uint64) (n int) {
-	return sovJobModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+type ReopenAssignmentsRequest struct {
+	SagaId               string   `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowJobModel
+
+func (m *ReopenAssignmentsRequest) Reset()         { *m = ReopenAssignmentsRequest{} }
+func (m *ReopenAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReopenAssignmentsRequest) ProtoMessage()    {}
+func (*ReopenAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{20}
+}
+func (m *ReopenAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReopenAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReopenAssignmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReopenAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReopenAssignmentsRequest.Merge(m, src)
+}
+func (m *ReopenAssignmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReopenAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReopenAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReopenAssignmentsRequest proto.InternalMessageInfo
+
+func (m *ReopenAssignmentsRequest) GetSagaId() string {
+	if m != nil {
+		return m.SagaId
+	}
+	return ""
+}
+
+// overlapping are the assignments a reopen left ended, the client holds the job again since
+// in a period overlapping theirs
+type AssignmentsChanged struct {
+	Changed              uint64   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	Overlapping          uint64   `protobuf:"varint,2,opt,name=overlapping,proto3" json:"overlapping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignmentsChanged) Reset()         { *m = AssignmentsChanged{} }
+func (m *AssignmentsChanged) String() string { return proto.CompactTextString(m) }
+func (*AssignmentsChanged) ProtoMessage()    {}
+func (*AssignmentsChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8320f5efda8ee1, []int{21}
+}
+func (m *AssignmentsChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignmentsChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignmentsChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignmentsChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignmentsChanged.Merge(m, src)
+}
+func (m *AssignmentsChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignmentsChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignmentsChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignmentsChanged proto.InternalMessageInfo
+
+func (m *AssignmentsChanged) GetChanged() uint64 {
+	if m != nil {
+		return m.Changed
+	}
+	return 0
+}
+
+func (m *AssignmentsChanged) GetOverlapping() uint64 {
+	if m != nil {
+		return m.Overlapping
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Job)(nil), "job_service.Job")
+	proto.RegisterType((*ClientJobs)(nil), "job_service.ClientJobs")
+	proto.RegisterType((*JobWithGUID)(nil), "job_service.JobWithGUID")
+	proto.RegisterType((*JobsByIDsRequest)(nil), "job_service.JobsByIDsRequest")
+	proto.RegisterType((*JobsByIDsResponse)(nil), "job_service.JobsByIDsResponse")
+	proto.RegisterType((*ClientJobRequest)(nil), "job_service.ClientJobRequest")
+	proto.RegisterType((*ResponseStatus)(nil), "job_service.ResponseStatus")
+	proto.RegisterType((*ListRequest)(nil), "job_service.ListRequest")
+	proto.RegisterType((*ListJobResponse)(nil), "job_service.ListJobResponse")
+	proto.RegisterType((*ListClientJobs)(nil), "job_service.ListClientJobs")
+	proto.RegisterType((*BatchCreateJobsRequest)(nil), "job_service.BatchCreateJobsRequest")
+	proto.RegisterType((*BatchItemResult)(nil), "job_service.BatchItemResult")
+	proto.RegisterType((*BatchCreateResponse)(nil), "job_service.BatchCreateResponse")
+	proto.RegisterType((*StreamJobsRequest)(nil), "job_service.StreamJobsRequest")
+	proto.RegisterType((*WatchJobsRequest)(nil), "job_service.WatchJobsRequest")
+	proto.RegisterType((*JobChange)(nil), "job_service.JobChange")
+	proto.RegisterType((*ReassignClientJobsRequest)(nil), "job_service.ReassignClientJobsRequest")
+	proto.RegisterType((*ReassignClientJobsResponse)(nil), "job_service.ReassignClientJobsResponse")
+	proto.RegisterType((*UndoReassignClientJobsRequest)(nil), "job_service.UndoReassignClientJobsRequest")
+	proto.RegisterType((*EndClientAssignmentsRequest)(nil), "job_service.EndClientAssignmentsRequest")
+	proto.RegisterType((*ReopenAssignmentsRequest)(nil), "job_service.ReopenAssignmentsRequest")
+	proto.RegisterType((*AssignmentsChanged)(nil), "job_service.AssignmentsChanged")
+}
+
+func init() { proto.RegisterFile("job_model.proto", fileDescriptor_3e8320f5efda8ee1) }
+
+var fileDescriptor_3e8320f5efda8ee1 = []byte{
+	// 1400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xae, 0x2c, 0xea, 0x6f, 0x24, 0x4b, 0xf2, 0x46, 0x71, 0x98, 0x38, 0x76, 0x5c, 0x26, 0x68,
+	0xd3, 0x16, 0x48, 0x81, 0x06, 0x68, 0x7b, 0x2a, 0xe0, 0x38, 0xfd, 0x91, 0xd2, 0x00, 0x81, 0x92,
+	0x20, 0x40, 0x2e, 0xc2, 0x92, 0xdc, 0xc8, 0xeb, 0x90, 0x5c, 0x86, 0xbb, 0x32, 0xac, 0xbe, 0x41,
+	0x9f, 0xa0, 0xbd, 0xf6, 0x1d, 0x7a, 0xe8, 0x23, 0xf4, 0xd8, 0x6b, 0x6f, 0x45, 0xfa, 0x22, 0xc5,
+	0xce, 0x2e, 0x25, 0x8a, 0xb5, 0x04, 0xa7, 0x40, 0x6f, 0x3b, 0xdf, 0xcc, 0xee, 0xfc, 0x70, 0xbe,
+	0xd9, 0x25, 0xf4, 0x4e, 0x85, 0x3f, 0x89, 0x45, 0xc8, 0xa2, 0x7b, 0x69, 0x26, 0x94, 0x20, 0x6d,
+	0x0d, 0x48, 0x96, 0x9d, 0xf1, 0x80, 0x79, 0x7f, 0x36, 0xa0, 0x3a, 0x12, 0x3e, 0xe9, 0xc2, 0x16,
+	0x0f, 0xdd, 0xca, 0x61, 0xe5, 0x6e, 0x6b, 0xbc, 0xc5, 0x43, 0x42, 0xc0, 0x49, 0x68, 0xcc, 0xdc,
+	0x2d, 0x44, 0x70, 0x4d, 0x06, 0x50, 0x8b, 0xd8, 0x19, 0x8b, 0x5c, 0x07, 0x41, 0x23, 0x90, 0xdb,
+	0xb0, 0x1d, 0x89, 0x80, 0x2a, 0x2e, 0x92, 0x89, 0x9a, 0xa7, 0xcc, 0xad, 0xa1, 0xb6, 0x93, 0x83,
+	0xcf, 0xe6, 0x29, 0x23, 0x1f, 0x42, 0x8f, 0xc5, 0x69, 0x24, 0xe6, 0x31, 0x4b, 0x94, 0x31, 0xab,
+	0xa3, 0x59, 0x77, 0x09, 0xa3, 0xa1, 0x0b, 0x0d, 0x1a, 0x86, 0x19, 0x93, 0xd2, 0x6d, 0xa0, 0x41,
+	0x2e, 0x6a, 0x4d, 0x20, 0xe2, 0x94, 0x26, 0x73, 0xb7, 0x69, 0x34, 0x56, 0x24, 0xfb, 0x00, 0x41,
+	0xc6, 0xa8, 0x62, 0xe1, 0x84, 0x2a, 0xb7, 0x85, 0xca, 0x96, 0x45, 0x8e, 0x94, 0x56, 0xcf, 0xd2,
+	0x30, 0x57, 0x83, 0x51, 0x5b, 0xe4, 0x48, 0x91, 0x43, 0x68, 0x87, 0x4c, 0x06, 0x19, 0x4f, 0x75,
+	0xb4, 0x6e, 0x1b, 0xf5, 0x45, 0x88, 0x7c, 0x0c, 0xfd, 0x8c, 0xc9, 0x54, 0x24, 0x92, 0xfb, 0x3c,
+	0xe2, 0x8a, 0x33, 0xe9, 0x76, 0xd0, 0xec, 0x5f, 0x38, 0xf1, 0xa0, 0x93, 0xb1, 0x37, 0x33, 0x9e,
+	0x31, 0x9d, 0x92, 0x74, 0xb7, 0x4d, 0x31, 0x8a, 0x18, 0xb9, 0x01, 0x4d, 0x9f, 0x25, 0xec, 0x15,
+	0x57, 0xd2, 0xed, 0xa2, 0x7e, 0x21, 0x93, 0x8f, 0xa0, 0x5f, 0x70, 0x3d, 0x39, 0x51, 0x71, 0xe4,
+	0xf6, 0xd0, 0xa6, 0x57, 0xc0, 0xbf, 0x53, 0x71, 0x44, 0xee, 0xc3, 0xd5, 0xb2, 0x7b, 0x63, 0xdf,
+	0x47, 0xfb, 0x41, 0x59, 0x89, 0x9b, 0x3e, 0x81, 0x9d, 0x62, 0x2c, 0x66, 0xc3, 0x4e, 0x9e, 0xcc,
+	0x52, 0x81, 0xc6, 0xb7, 0x61, 0x3b, 0x0f, 0xcc, 0x18, 0x12, 0x93, 0x4d, 0x0e, 0xa2, 0xd1, 0x3e,
+	0x80, 0xa4, 0x11, 0xcd, 0xe6, 0x93, 0x98, 0x27, 0xee, 0x15, 0x53, 0x5e, 0x83, 0x3c, 0xe6, 0x49,
+	0x51, 0x4d, 0xcf, 0xdd, 0xc1, 0x8a, 0x9a, 0x9e, 0xeb, 0x5a, 0x04, 0xb3, 0x2c, 0x63, 0x49, 0x30,
+	0x77, 0xaf, 0x9a, 0x5a, 0xe4, 0xb2, 0xde, 0x9a, 0xd2, 0xf9, 0x24, 0x65, 0x19, 0x17, 0xa1, 0xbb,
+	0x6b, 0xb6, 0xa6, 0x74, 0xfe, 0x04, 0x01, 0xfc, 0xec, 0xa6, 0x03, 0x26, 0x3c, 0x74, 0xaf, 0xd9,
+	0xcf, 0x6e, 0x90, 0x61, 0x48, 0x76, 0xa1, 0x2e, 0x15, 0x55, 0x33, 0xe9, 0xba, 0xa8, 0xb2, 0x12,
+	0x9e, 0x3a, 0xf3, 0x23, 0x2e, 0x4f, 0x74, 0x3b, 0x5c, 0xb7, 0xa7, 0x1a, 0xe4, 0x48, 0x91, 0xeb,
+	0xd0, 0x0c, 0x22, 0x21, 0x99, 0x56, 0xde, 0xb0, 0x7d, 0xa6, 0xe5, 0x23, 0x85, 0x27, 0xbe, 0xe6,
+	0x51, 0x24, 0xdd, 0xbd, 0xc3, 0x2a, 0x9e, 0x88, 0x92, 0xce, 0x21, 0xa2, 0x8a, 0xab, 0x59, 0xc8,
+	0xdc, 0x9b, 0x26, 0x87, 0x5c, 0x26, 0x37, 0xa1, 0x15, 0x89, 0x64, 0x6a, 0x94, 0xfb, 0xc6, 0xd9,
+	0x02, 0x20, 0xb7, 0xa0, 0x1d, 0x72, 0xa9, 0x68, 0x12, 0xb0, 0xc9, 0xeb, 0xd8, 0x3d, 0x38, 0xac,
+	0xdc, 0xad, 0x8c, 0x21, 0x87, 0x1e, 0xc5, 0xe4, 0x7d, 0xe8, 0x04, 0x54, 0xb1, 0xa9, 0xc8, 0x74,
+	0x92, 0xd2, 0xbd, 0x85, 0x8e, 0xdb, 0x39, 0x36, 0x0c, 0xa5, 0x66, 0xaa, 0xa2, 0x53, 0xe9, 0x1e,
+	0xa2, 0x0a, 0xd7, 0x23, 0xa7, 0x59, 0xed, 0x3b, 0xde, 0x6f, 0x15, 0x80, 0xe3, 0x88, 0xb3, 0x44,
+	0x8d, 0x84, 0x2f, 0xc9, 0x1e, 0xb4, 0x02, 0x94, 0x26, 0x0b, 0xa6, 0x37, 0x0d, 0x30, 0x0c, 0xc9,
+	0x55, 0xa8, 0xeb, 0xb1, 0xc0, 0x43, 0xcb, 0xf8, 0xda, 0xa9, 0xf0, 0x87, 0x58, 0x63, 0xa9, 0x68,
+	0xa6, 0x26, 0x9a, 0x2d, 0x6e, 0xd5, 0x7e, 0x3d, 0x8d, 0x3c, 0xa4, 0x8a, 0xe9, 0x62, 0xb1, 0x24,
+	0x34, 0x4a, 0x33, 0x14, 0x1a, 0x2c, 0x09, 0x51, 0xb5, 0x4a, 0xca, 0xda, 0x66, 0x52, 0xd6, 0x4b,
+	0xa4, 0xf4, 0xee, 0x40, 0x7b, 0x24, 0xfc, 0x17, 0x5c, 0x9d, 0x7c, 0xfb, 0x7c, 0xf8, 0xb0, 0x10,
+	0x5d, 0xa5, 0x10, 0x9d, 0x77, 0x07, 0xfa, 0x3a, 0xb3, 0x07, 0xf3, 0xe1, 0x43, 0x39, 0x66, 0x6f,
+	0x66, 0x4c, 0x2a, 0xd2, 0x87, 0xaa, 0x2e, 0x54, 0x05, 0xab, 0xa1, 0x97, 0xde, 0x4b, 0xd8, 0x29,
+	0x58, 0x21, 0x27, 0x18, 0xb9, 0x03, 0xce, 0xa9, 0xf0, 0x8d, 0x5d, 0xfb, 0xb3, 0xfe, 0xbd, 0xc2,
+	0x4c, 0xbc, 0x37, 0x12, 0xfe, 0x18, 0xb5, 0xfa, 0xfb, 0xc4, 0x5c, 0x4a, 0x9e, 0x4c, 0xb1, 0xfa,
+	0x5b, 0x78, 0x28, 0x58, 0x68, 0x18, 0x4a, 0x2f, 0x85, 0xfe, 0xa2, 0xc2, 0x79, 0x04, 0xff, 0xa5,
+	0xce, 0x04, 0x9c, 0x94, 0x4e, 0x4d, 0x85, 0x9d, 0x31, 0xae, 0x71, 0xdc, 0xf2, 0x98, 0x2b, 0xac,
+	0xac, 0x33, 0x36, 0x82, 0x77, 0x17, 0xba, 0x79, 0x12, 0x4f, 0x4d, 0x43, 0x2f, 0x1b, 0x5d, 0x3b,
+	0x6b, 0xe6, 0x8d, 0xee, 0xfd, 0xe4, 0x40, 0xfb, 0x7b, 0x2e, 0x55, 0x1e, 0x57, 0xee, 0xa3, 0x72,
+	0x91, 0x8f, 0xad, 0x82, 0x0f, 0x9d, 0xb6, 0xe5, 0xec, 0xab, 0x4c, 0xc4, 0xf6, 0xb3, 0x5b, 0x1a,
+	0x7f, 0x93, 0x89, 0x58, 0xa7, 0x68, 0x0d, 0x94, 0xb0, 0x1f, 0xbe, 0x69, 0x80, 0x67, 0x62, 0x85,
+	0xd2, 0xb5, 0x8d, 0x94, 0xae, 0x97, 0x29, 0xbd, 0x4c, 0xa5, 0xb1, 0xc2, 0xd9, 0xc5, 0xcd, 0xd3,
+	0xdc, 0x78, 0xf3, 0xb4, 0x2e, 0x77, 0xf3, 0xc0, 0x85, 0x37, 0xcf, 0xea, 0x38, 0x69, 0x5f, 0x34,
+	0x4e, 0x0c, 0xf9, 0x3b, 0x6b, 0xc9, 0xbf, 0xbd, 0x89, 0xfc, 0xdd, 0x32, 0xf9, 0xf5, 0x15, 0xcb,
+	0x68, 0x66, 0xc7, 0x3b, 0xae, 0x75, 0x61, 0x33, 0x1a, 0xf2, 0x99, 0xd4, 0xe3, 0xc0, 0xcc, 0xf1,
+	0xa6, 0x01, 0x1e, 0xc5, 0x7a, 0x83, 0xef, 0x8b, 0x73, 0x3b, 0xae, 0x71, 0xad, 0x3f, 0x55, 0x61,
+	0x40, 0xd8, 0x01, 0x0d, 0xcb, 0xf9, 0xb0, 0x18, 0x0f, 0x57, 0x96, 0xe3, 0xc1, 0xfb, 0x02, 0x7a,
+	0xba, 0x31, 0xb0, 0x67, 0xdf, 0x85, 0x0f, 0xde, 0x08, 0xba, 0x7a, 0x63, 0x61, 0xa8, 0x7c, 0x09,
+	0x6d, 0xdb, 0xec, 0x85, 0xed, 0xd7, 0x56, 0xb6, 0x2f, 0xad, 0xc7, 0x10, 0x2c, 0xd6, 0xde, 0x57,
+	0xb0, 0xfb, 0x80, 0xaa, 0xe0, 0xe4, 0x18, 0x67, 0x02, 0xaa, 0x6d, 0xa3, 0x5e, 0x2e, 0x96, 0xc7,
+	0xd0, 0xc3, 0xfd, 0x43, 0xc5, 0xe2, 0x31, 0x93, 0xb3, 0x48, 0xe9, 0x36, 0xe1, 0x49, 0xc8, 0xce,
+	0x6d, 0x8b, 0x1b, 0xc1, 0x3e, 0x6d, 0xb6, 0x16, 0x4f, 0x9b, 0x01, 0xd4, 0x58, 0x96, 0x89, 0xcc,
+	0xf6, 0xb5, 0x11, 0xbc, 0xc7, 0x70, 0xa5, 0x10, 0xce, 0xa2, 0x2e, 0x9f, 0x43, 0x23, 0xc3, 0xc3,
+	0xf3, 0x70, 0x6e, 0xae, 0x84, 0x53, 0x8a, 0x60, 0x9c, 0x1b, 0x7b, 0x3f, 0x3a, 0xb0, 0xf3, 0x54,
+	0x65, 0x8c, 0xc6, 0xc5, 0xcc, 0x06, 0x50, 0x93, 0x81, 0x48, 0x59, 0x3e, 0xc6, 0x50, 0x28, 0xd3,
+	0x6d, 0x6b, 0x33, 0xdd, 0xaa, 0x1b, 0xe8, 0xe6, 0x6c, 0xa4, 0x5b, 0x6d, 0x3d, 0xdd, 0xea, 0x17,
+	0xd3, 0xad, 0xb1, 0x91, 0x6e, 0xcd, 0xcb, 0xd1, 0xad, 0x75, 0x09, 0xba, 0xc1, 0x7a, 0xba, 0xb5,
+	0xd7, 0xd2, 0xad, 0xb3, 0x89, 0x6e, 0xdb, 0xeb, 0xe8, 0xd6, 0x5d, 0x47, 0xb7, 0xde, 0x1a, 0xba,
+	0xf5, 0xd7, 0xd3, 0x6d, 0x67, 0x2d, 0xdd, 0x48, 0x81, 0x6e, 0x3f, 0x40, 0xff, 0x85, 0xee, 0x93,
+	0x62, 0x27, 0xec, 0x42, 0x3d, 0x98, 0x65, 0x52, 0x64, 0xb6, 0x57, 0xad, 0x84, 0xef, 0xdf, 0x40,
+	0x57, 0x33, 0xbf, 0x6d, 0x72, 0xb1, 0x54, 0xb0, 0x6a, 0xb9, 0x60, 0xcb, 0x8b, 0xc5, 0x29, 0x5e,
+	0x91, 0xbf, 0x56, 0xa0, 0x35, 0x12, 0xfe, 0xf1, 0x09, 0x4d, 0xa6, 0x6c, 0xad, 0xd7, 0x5d, 0xa8,
+	0x1b, 0x37, 0xb6, 0xf9, 0xac, 0x54, 0x38, 0xb4, 0x5a, 0x7a, 0x15, 0x14, 0x42, 0x71, 0xca, 0xa1,
+	0x68, 0x35, 0xfa, 0x5b, 0xb9, 0xfa, 0x0d, 0x72, 0xa4, 0x88, 0x07, 0xd5, 0x53, 0xe1, 0x63, 0xcb,
+	0x5d, 0xc4, 0x6e, 0xad, 0xf4, 0x02, 0xb8, 0x3e, 0x66, 0x54, 0x4a, 0x3e, 0x4d, 0x0a, 0xe3, 0x63,
+	0x31, 0x1f, 0xba, 0x9a, 0x28, 0x93, 0xf2, 0x2d, 0xdb, 0xd1, 0xe8, 0x71, 0x7e, 0xd3, 0x1e, 0x42,
+	0x47, 0x89, 0x82, 0x8d, 0xa5, 0x95, 0x12, 0xb9, 0x85, 0x17, 0xc1, 0x8d, 0x8b, 0x9c, 0x58, 0xe6,
+	0x0f, 0xa0, 0x16, 0x8b, 0x33, 0x16, 0xe6, 0xc3, 0x04, 0x05, 0xdd, 0x31, 0xb8, 0x28, 0xbc, 0x07,
+	0x9a, 0x08, 0xe8, 0xa7, 0xd8, 0x1e, 0xb4, 0x58, 0x12, 0x5a, 0x65, 0xd5, 0x28, 0x11, 0xd0, 0x4f,
+	0x85, 0x5f, 0x2a, 0xb0, 0xff, 0x3c, 0x09, 0xc5, 0xff, 0x9e, 0xd7, 0x6a, 0x8c, 0xd5, 0x4d, 0x31,
+	0x3a, 0xa5, 0x18, 0x9f, 0xc2, 0xde, 0xd7, 0x49, 0x68, 0x0e, 0x3a, 0xc2, 0x28, 0xf1, 0x67, 0x20,
+	0x0f, 0xf0, 0x1a, 0x34, 0x24, 0x9d, 0xd2, 0x65, 0x64, 0x75, 0x2d, 0x1a, 0x8f, 0xe5, 0x80, 0x16,
+	0x4f, 0x1e, 0xef, 0x3e, 0xb8, 0x63, 0x26, 0x52, 0x96, 0xbc, 0xc3, 0x89, 0xde, 0x13, 0x20, 0x05,
+	0x73, 0xd3, 0xbe, 0x21, 0xfe, 0x03, 0x9a, 0xa5, 0xfd, 0x2a, 0xb9, 0xa8, 0xff, 0xe2, 0xc4, 0x19,
+	0xcb, 0x22, 0x9a, 0xa6, 0x3c, 0x99, 0xda, 0xe7, 0x4c, 0x11, 0x7a, 0xf0, 0xc1, 0xef, 0x6f, 0x0f,
+	0x2a, 0x7f, 0xbc, 0x3d, 0xa8, 0xfc, 0xf5, 0xf6, 0xa0, 0xf2, 0xf3, 0xdf, 0x07, 0xef, 0xbd, 0x1c,
+	0x4c, 0x59, 0x82, 0xbf, 0xc4, 0x9f, 0x16, 0x7a, 0xd0, 0xaf, 0x23, 0x74, 0xff, 0x9f, 0x01, 0x00,
+	0x10, 0x66, 0xb5, 0x36, 0x38, 0x0f, 0x00, 0x00,
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CategoryIds) > 0 {
+		for iNdEx := len(m.CategoryIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CategoryIds[iNdEx])
+			copy(dAtA[i:], m.CategoryIds[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.CategoryIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if m.DistanceKm != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DistanceKm))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf1
+	}
+	if len(m.Longitude) > 0 {
+		i -= len(m.Longitude)
+		copy(dAtA[i:], m.Longitude)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Longitude)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.Latitude) > 0 {
+		i -= len(m.Latitude)
+		copy(dAtA[i:], m.Latitude)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Latitude)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Skills) > 0 {
+		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skills[iNdEx])
+			copy(dAtA[i:], m.Skills[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Skills[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.CloseAt) > 0 {
+		i -= len(m.CloseAt)
+		copy(dAtA[i:], m.CloseAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CloseAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.PublishAt) > 0 {
+		i -= len(m.PublishAt)
+		copy(dAtA[i:], m.PublishAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.PublishAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.PayPeriod) > 0 {
+		i -= len(m.PayPeriod)
+		copy(dAtA[i:], m.PayPeriod)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.PayPeriod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.SalaryMax) > 0 {
+		i -= len(m.SalaryMax)
+		copy(dAtA[i:], m.SalaryMax)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SalaryMax)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.SalaryMin) > 0 {
+		i -= len(m.SalaryMin)
+		copy(dAtA[i:], m.SalaryMin)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SalaryMin)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.BenefitsHtml) > 0 {
+		i -= len(m.BenefitsHtml)
+		copy(dAtA[i:], m.BenefitsHtml)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.BenefitsHtml)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RequirementsHtml) > 0 {
+		i -= len(m.RequirementsHtml)
+		copy(dAtA[i:], m.RequirementsHtml)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.RequirementsHtml)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ResponsibilitiesHtml) > 0 {
+		i -= len(m.ResponsibilitiesHtml)
+		copy(dAtA[i:], m.ResponsibilitiesHtml)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ResponsibilitiesHtml)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DescriptionHtml) > 0 {
+		i -= len(m.DescriptionHtml)
+		copy(dAtA[i:], m.DescriptionHtml)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.DescriptionHtml)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Benefits) > 0 {
+		i -= len(m.Benefits)
+		copy(dAtA[i:], m.Benefits)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Benefits)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Requirements) > 0 {
+		i -= len(m.Requirements)
+		copy(dAtA[i:], m.Requirements)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Requirements)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Responsibilities) > 0 {
+		i -= len(m.Responsibilities)
+		copy(dAtA[i:], m.Responsibilities)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Responsibilities)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Company) > 0 {
+		i -= len(m.Company)
+		copy(dAtA[i:], m.Company)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Company)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EmploymentType) > 0 {
+		i -= len(m.EmploymentType)
+		copy(dAtA[i:], m.EmploymentType)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.EmploymentType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LocationType) > 0 {
+		i -= len(m.LocationType)
+		copy(dAtA[i:], m.LocationType)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.LocationType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientJobs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientJobs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientJobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobWithGUID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobWithGUID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobWithGUID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobsByIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsByIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobsByIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobsByIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsByIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobsByIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingIds) > 0 {
+		for iNdEx := len(m.MissingIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingIds[iNdEx])
+			copy(dAtA[i:], m.MissingIds[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.MissingIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJobModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Page != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.CategoryId) > 0 {
+		i -= len(m.CategoryId)
+		copy(dAtA[i:], m.CategoryId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CategoryId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Bbox) > 0 {
+		i -= len(m.Bbox)
+		copy(dAtA[i:], m.Bbox)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Bbox)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RadiusKm) > 0 {
+		i -= len(m.RadiusKm)
+		copy(dAtA[i:], m.RadiusKm)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.RadiusKm)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Near) > 0 {
+		i -= len(m.Near)
+		copy(dAtA[i:], m.Near)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Near)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Longitude) > 0 {
+		i -= len(m.Longitude)
+		copy(dAtA[i:], m.Longitude)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Longitude)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Latitude) > 0 {
+		i -= len(m.Latitude)
+		copy(dAtA[i:], m.Latitude)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Latitude)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Skills) > 0 {
+		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skills[iNdEx])
+			copy(dAtA[i:], m.Skills[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Skills[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.EmploymentType) > 0 {
+		i -= len(m.EmploymentType)
+		copy(dAtA[i:], m.EmploymentType)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.EmploymentType)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.LocationType) > 0 {
+		i -= len(m.LocationType)
+		copy(dAtA[i:], m.LocationType)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.LocationType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PayPeriod) > 0 {
+		i -= len(m.PayPeriod)
+		copy(dAtA[i:], m.PayPeriod)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.PayPeriod)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SalaryTo) > 0 {
+		i -= len(m.SalaryTo)
+		copy(dAtA[i:], m.SalaryTo)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SalaryTo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SalaryFrom) > 0 {
+		i -= len(m.SalaryFrom)
+		copy(dAtA[i:], m.SalaryFrom)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SalaryFrom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJobModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClientJobs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClientJobs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClientJobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientJobs) > 0 {
+		for iNdEx := len(m.ClientJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJobModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJobModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchItemResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchItemResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchItemResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJobModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.CategoryId) > 0 {
+		i -= len(m.CategoryId)
+		copy(dAtA[i:], m.CategoryId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CategoryId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Bbox) > 0 {
+		i -= len(m.Bbox)
+		copy(dAtA[i:], m.Bbox)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Bbox)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.RadiusKm) > 0 {
+		i -= len(m.RadiusKm)
+		copy(dAtA[i:], m.RadiusKm)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.RadiusKm)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Near) > 0 {
+		i -= len(m.Near)
+		copy(dAtA[i:], m.Near)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Near)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Longitude) > 0 {
+		i -= len(m.Longitude)
+		copy(dAtA[i:], m.Longitude)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Longitude)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Latitude) > 0 {
+		i -= len(m.Latitude)
+		copy(dAtA[i:], m.Latitude)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Latitude)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Skills) > 0 {
+		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skills[iNdEx])
+			copy(dAtA[i:], m.Skills[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Skills[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.EmploymentType) > 0 {
+		i -= len(m.EmploymentType)
+		copy(dAtA[i:], m.EmploymentType)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.EmploymentType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.LocationType) > 0 {
+		i -= len(m.LocationType)
+		copy(dAtA[i:], m.LocationType)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.LocationType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PayPeriod) > 0 {
+		i -= len(m.PayPeriod)
+		copy(dAtA[i:], m.PayPeriod)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.PayPeriod)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SalaryTo) > 0 {
+		i -= len(m.SalaryTo)
+		copy(dAtA[i:], m.SalaryTo)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SalaryTo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SalaryFrom) > 0 {
+		i -= len(m.SalaryFrom)
+		copy(dAtA[i:], m.SalaryFrom)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SalaryFrom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actions[iNdEx])
+			copy(dAtA[i:], m.Actions[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.Actions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Cursor != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Cursor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJobModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChangedAt) > 0 {
+		i -= len(m.ChangedAt)
+		copy(dAtA[i:], m.ChangedAt)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ChangedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Cursor != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Cursor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignClientJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignClientJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignClientJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ToClientId) > 0 {
+		i -= len(m.ToClientId)
+		copy(dAtA[i:], m.ToClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ToClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromClientId) > 0 {
+		i -= len(m.FromClientId)
+		copy(dAtA[i:], m.FromClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.FromClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignClientJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignClientJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignClientJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndedIds) > 0 {
+		for iNdEx := len(m.EndedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EndedIds[iNdEx])
+			copy(dAtA[i:], m.EndedIds[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.EndedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MovedIds) > 0 {
+		for iNdEx := len(m.MovedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MovedIds[iNdEx])
+			copy(dAtA[i:], m.MovedIds[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.MovedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Moved != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Moved))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndoReassignClientJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndoReassignClientJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndoReassignClientJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndedIds) > 0 {
+		for iNdEx := len(m.EndedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EndedIds[iNdEx])
+			copy(dAtA[i:], m.EndedIds[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.EndedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MovedIds) > 0 {
+		for iNdEx := len(m.MovedIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MovedIds[iNdEx])
+			copy(dAtA[i:], m.MovedIds[iNdEx])
+			i = encodeVarintJobModel(dAtA, i, uint64(len(m.MovedIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToClientId) > 0 {
+		i -= len(m.ToClientId)
+		copy(dAtA[i:], m.ToClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ToClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromClientId) > 0 {
+		i -= len(m.FromClientId)
+		copy(dAtA[i:], m.FromClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.FromClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndClientAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndClientAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndClientAssignmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SagaId) > 0 {
+		i -= len(m.SagaId)
+		copy(dAtA[i:], m.SagaId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SagaId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReopenAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReopenAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReopenAssignmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SagaId) > 0 {
+		i -= len(m.SagaId)
+		copy(dAtA[i:], m.SagaId)
+		i = encodeVarintJobModel(dAtA, i, uint64(len(m.SagaId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignmentsChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentsChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignmentsChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Overlapping != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Overlapping))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Changed != 0 {
+		i = encodeVarintJobModel(dAtA, i, uint64(m.Changed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJobModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJobModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.LocationType)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.EmploymentType)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Company)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Responsibilities)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Requirements)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Benefits)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.DescriptionHtml)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.ResponsibilitiesHtml)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.RequirementsHtml)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.BenefitsHtml)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.SalaryMin)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.SalaryMax)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.PayPeriod)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.PublishAt)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CloseAt)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	if len(m.Skills) > 0 {
+		for _, s := range m.Skills {
+			l = len(s)
+			n += 2 + l + sovJobModel(uint64(l))
+		}
+	}
+	l = len(m.Latitude)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Longitude)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	if m.DistanceKm != 0 {
+		n += 10
+	}
+	if len(m.CategoryIds) > 0 {
+		for _, s := range m.CategoryIds {
+			l = len(s)
+			n += 2 + l + sovJobModel(uint64(l))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClientJobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobWithGUID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobsByIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobsByIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if len(m.MissingIds) > 0 {
+		for _, s := range m.MissingIds {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClientJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovJobModel(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovJobModel(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovJobModel(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovJobModel(uint64(m.Limit))
+	}
+	l = len(m.SalaryFrom)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.SalaryTo)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.PayPeriod)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.LocationType)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.EmploymentType)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if len(m.Skills) > 0 {
+		for _, s := range m.Skills {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	l = len(m.Latitude)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Longitude)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Near)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.RadiusKm)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Bbox)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CategoryId)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClientJobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientJobs) > 0 {
+		for _, e := range m.ClientJobs {
+			l = e.Size()
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCreateJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchItemResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovJobModel(uint64(m.Index))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.SalaryFrom)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.SalaryTo)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.PayPeriod)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.LocationType)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.EmploymentType)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if len(m.Skills) > 0 {
+		for _, s := range m.Skills {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	l = len(m.Latitude)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Longitude)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Near)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.RadiusKm)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.Bbox)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CategoryId)
+	if l > 0 {
+		n += 2 + l + sovJobModel(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cursor != 0 {
+		n += 1 + sovJobModel(uint64(m.Cursor))
+	}
+	if len(m.Actions) > 0 {
+		for _, s := range m.Actions {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cursor != 0 {
+		n += 1 + sovJobModel(uint64(m.Cursor))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.ChangedAt)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReassignClientJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.ToClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReassignClientJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Moved != 0 {
+		n += 1 + sovJobModel(uint64(m.Moved))
+	}
+	if len(m.MovedIds) > 0 {
+		for _, s := range m.MovedIds {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if len(m.EndedIds) > 0 {
+		for _, s := range m.EndedIds {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UndoReassignClientJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.ToClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if len(m.MovedIds) > 0 {
+		for _, s := range m.MovedIds {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if len(m.EndedIds) > 0 {
+		for _, s := range m.EndedIds {
+			l = len(s)
+			n += 1 + l + sovJobModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EndClientAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SagaId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReopenAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SagaId)
+	if l > 0 {
+		n += 1 + l + sovJobModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentsChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Changed != 0 {
+		n += 1 + sovJobModel(uint64(m.Changed))
+	}
+	if m.Overlapping != 0 {
+		n += 1 + sovJobModel(uint64(m.Overlapping))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovJobModel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJobModel(x uint64) (n int) {
+	return sovJobModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmploymentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmploymentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Company", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Company = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responsibilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responsibilities = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirements = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Benefits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Benefits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHtml", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionHtml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsibilitiesHtml", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsibilitiesHtml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirementsHtml", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequirementsHtml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BenefitsHtml", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BenefitsHtml = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloseAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Latitude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longitude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceKm", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DistanceKm = float64(math.Float64frombits(v))
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryIds = append(m.CategoryIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientJobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientJobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientJobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobWithGUID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobWithGUID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobWithGUID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsByIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobsByIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobsByIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsByIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobsByIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobsByIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingIds = append(m.MissingIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmploymentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmploymentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Latitude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longitude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Near", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Near = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RadiusKm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RadiusKm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClientJobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClientJobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClientJobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientJobs = append(m.ClientJobs, &ClientJobs{})
+			if err := m.ClientJobs[len(m.ClientJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCreateJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchItemResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchItemResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchItemResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BatchItemResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmploymentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmploymentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1271,11 +7483,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Latitude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1303,22 +7515,43 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Longitude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Near", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Salary = float32(math.Float32frombits(v))
-		case 4:
+			m.Near = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RadiusKm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1346,11 +7579,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.RadiusKm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bbox", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1378,11 +7611,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocationType = string(dAtA[iNdEx:postIndex])
+			m.Bbox = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmploymentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1410,11 +7643,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmploymentType = string(dAtA[iNdEx:postIndex])
+			m.CategoryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1442,11 +7675,81 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			m.Cursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Company", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,11 +7777,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Company = string(dAtA[iNdEx:postIndex])
+			m.Actions = append(m.Actions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1506,11 +7809,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1538,7 +7841,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1562,7 +7865,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientJobs) Unmarshal(dAtA []byte) error {
+func (m *JobChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,15 +7888,34 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientJobs: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientJobs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			m.Cursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1621,9 +7943,9 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
@@ -1655,9 +7977,9 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 			}
 			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1685,11 +8007,11 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartDate = string(dAtA[iNdEx:postIndex])
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,11 +8039,98 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndDate = string(dAtA[iNdEx:postIndex])
+			m.ChangedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReassignClientJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReassignClientJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReassignClientJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1749,11 +8158,11 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.FromClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1781,7 +8190,7 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.ToClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1805,7 +8214,7 @@ func (m *ClientJobs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobWithGUID) Unmarshal(dAtA []byte) error {
+func (m *ReassignClientJobsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,15 +8237,34 @@ func (m *JobWithGUID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobWithGUID: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReassignClientJobsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobWithGUID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReassignClientJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moved", wireType)
+			}
+			m.Moved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Moved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1864,7 +8292,39 @@ func (m *JobWithGUID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.MovedIds = append(m.MovedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndedIds = append(m.EndedIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1888,7 +8348,7 @@ func (m *JobWithGUID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
+func (m *UndoReassignClientJobsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1911,15 +8371,15 @@ func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndoReassignClientJobsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndoReassignClientJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1947,11 +8407,11 @@ func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.FromClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1979,13 +8439,13 @@ func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.ToClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedIds", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJobModel
@@ -1995,16 +8455,29 @@ func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MovedIds = append(m.MovedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedIds", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJobModel
@@ -2014,11 +8487,24 @@ func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndedIds = append(m.EndedIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJobModel(dAtA[iNdEx:])
@@ -2041,7 +8527,7 @@ func (m *ClientJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseStatus) Unmarshal(dAtA []byte) error {
+func (m *EndClientAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2064,17 +8550,17 @@ func (m *ResponseStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndClientAssignmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndClientAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SagaId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJobModel
@@ -2084,68 +8570,29 @@ func (m *ResponseStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipJobModel(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJobModel
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowJobModel
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			m.SagaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			m.Page = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJobModel
@@ -2155,30 +8602,24 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobModel
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJobModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJobModel(dAtA[iNdEx:])
@@ -2201,7 +8642,7 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobResponse) Unmarshal(dAtA []byte) error {
+func (m *ReopenAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2224,17 +8665,17 @@ func (m *ListJobResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReopenAssignmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReopenAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SagaId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJobModel
@@ -2244,25 +8685,23 @@ func (m *ListJobResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJobModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJobModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jobs = append(m.Jobs, &Job{})
-			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SagaId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2286,7 +8725,7 @@ func (m *ListJobResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClientJobs) Unmarshal(dAtA []byte) error {
+func (m *AssignmentsChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2309,17 +8748,17 @@ func (m *ListClientJobs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClientJobs: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentsChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClientJobs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentsChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientJobs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
 			}
-			var msglen int
+			m.Changed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJobModel
@@ -2329,26 +8768,30 @@ func (m *ListClientJobs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Changed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthJobModel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthJobModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlapping", wireType)
 			}
-			m.ClientJobs = append(m.ClientJobs, &ClientJobs{})
-			if err := m.ClientJobs[len(m.ClientJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Overlapping = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Overlapping |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJobModel(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -10,21 +11,28 @@ import (
 
 	"api-gateway/api"
 	grpcService "api-gateway/internal/infrastructure/grpc_service_client"
+	redisrepo "api-gateway/internal/infrastructure/repository/redis"
+	"api-gateway/internal/usecase/readthrough"
 
 	"api-gateway/internal/pkg/config"
+	"api-gateway/internal/pkg/idempotency"
 	"api-gateway/internal/pkg/logger"
 
 	"api-gateway/internal/pkg/otlp"
 	"api-gateway/internal/pkg/postgres"
+	"api-gateway/internal/pkg/redis"
 )
 
 type App struct {
 	Config       *config.Config
 	Logger       *zap.Logger
 	DB           *postgres.PostgresDB
+	RedisDB      *redis.RedisDB
 	server       *http.Server
+	debugServer  *http.Server
 	ShutdownOTLP func() error
 	Clients      grpcService.ServiceClient
+	stopCache    context.CancelFunc
 }
 
 func NewApp(cfg config.Config) (*App, error) {
@@ -40,6 +48,12 @@ func NewApp(cfg config.Config) (*App, error) {
 		return nil, err
 	}
 
+	// redis init
+	redisDB, err := redis.New(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// otlp collector init
 	shutdownOTLP, err := otlp.InitOTLPProvider(&cfg)
 	if err != nil {
@@ -50,6 +64,7 @@ func NewApp(cfg config.Config) (*App, error) {
 		Config:       &cfg,
 		Logger:       logger,
 		DB:           db,
+		RedisDB:      redisDB,
 		ShutdownOTLP: shutdownOTLP,
 	}, nil
 }
@@ -59,6 +74,10 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error while parsing context timeout: %v", err)
 	}
+	idempotencyTTL, err := time.ParseDuration(a.Config.Idempotency.TTL)
+	if err != nil {
+		return fmt.Errorf("error while parsing idempotency ttl: %v", err)
+	}
 
 	clients, err := grpcService.New(a.Config)
 	if err != nil {
@@ -66,8 +85,26 @@ func (a *App) Run() error {
 	}
 	a.Clients = clients
 
-	// initialize cache
-	// cache := redisrepo.NewCache(a.RedisDB)
+	cacheTTLs := make(map[string]time.Duration)
+	for name, value := range map[string]string{
+		"job":    a.Config.Cache.JobTTL,
+		"jobs":   a.Config.Cache.JobsTTL,
+		"client": a.Config.Cache.ClientTTL,
+	} {
+		if cacheTTLs[name], err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("error while parsing cache ttl of %s: %v", name, err)
+		}
+	}
+	cacheWatchRetry, err := time.ParseDuration(a.Config.Cache.WatchRetry)
+	if err != nil {
+		return fmt.Errorf("error while parsing cache watch retry: %v", err)
+	}
+
+	// initialize cache, the changes reported by the services invalidate it
+	cache := readthrough.New(redisrepo.NewCache(a.RedisDB), cacheTTLs, contextTimeout, a.Logger)
+	cacheCtx, stopCache := context.WithCancel(context.Background())
+	a.stopCache = stopCache
+	go readthrough.NewInvalidator(cache, clients, a.Logger, cacheWatchRetry).Run(cacheCtx)
 
 	// api init
 	handler := api.NewRoute(api.RouteOption{
@@ -75,6 +112,9 @@ func (a *App) Run() error {
 		Logger:         a.Logger,
 		ContextTimeout: contextTimeout,
 		Service:        clients,
+		Idempotency:    idempotency.NewRedisStore(a.RedisDB),
+		IdempotencyTTL: idempotencyTTL,
+		Cache:          cache,
 	})
 	// if err = a.Enforcer.LoadPolicy(); err != nil {
 	// 	return fmt.Errorf("error during enforcer load policy: %w", err)
@@ -86,6 +126,16 @@ func (a *App) Run() error {
 		return fmt.Errorf("error while initializing server: %v", err)
 	}
 
+	// debug server init, kept off the public router
+	if a.Config.Debug.Addr != "" {
+		a.debugServer = api.NewDebugServer(a.Config)
+		go func() {
+			if err := a.debugServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				a.Logger.Error("debug server http", zap.Error(err))
+			}
+		}()
+	}
+
 	return a.server.ListenAndServe()
 }
 
@@ -94,6 +144,16 @@ func (a *App) Stop() {
 	// close database
 	a.DB.Close()
 
+	// stop cache invalidation
+	if a.stopCache != nil {
+		a.stopCache()
+	}
+
+	// close redis
+	if err := a.RedisDB.Client.Close(); err != nil {
+		a.Logger.Error("close redis", zap.Error(err))
+	}
+
 	// close grpc connections
 	a.Clients.Close()
 
@@ -102,6 +162,13 @@ func (a *App) Stop() {
 		a.Logger.Error("shutdown server http ", zap.Error(err))
 	}
 
+	// shutdown debug server http
+	if a.debugServer != nil {
+		if err := a.debugServer.Shutdown(context.Background()); err != nil {
+			a.Logger.Error("shutdown debug server http", zap.Error(err))
+		}
+	}
+
 	// shutdown otlp collector
 	if err := a.ShutdownOTLP(); err != nil {
 		a.Logger.Error("shutdown otlp collector", zap.Error(err))
@@ -7,14 +7,17 @@ import (
 
 	// "go.opentelemetry.io/otel/attribute"
 
-	// otlp_pkg "api-agteway/internal/pkg/otlp"
-	"agteway/internal/pkg/redis"
+	// otlp_pkg "api-gateway/internal/pkg/otlp"
+	"api-gateway/internal/pkg/redis"
 )
 
 type Cache interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string) ([]byte, error)
 	Del(ctx context.Context, key string) error
+	// DelMatch deletes the keys matching the glob pattern
+	DelMatch(ctx context.Context, pattern string) error
+	Incr(ctx context.Context, key string) (int64, error)
 }
 
 func NewCache(rdb *redis.RedisDB) *cache {
@@ -71,3 +74,18 @@ func (c *cache) Del(ctx context.Context, key string) error {
 
 	return nil
 }
+
+func (c *cache) DelMatch(ctx context.Context, pattern string) error {
+	iter := c.rdb.Client.Scan(ctx, 0, pattern, 100).Iterator()
+	for iter.Next(ctx) {
+		if err := c.rdb.Client.Del(ctx, iter.Val()).Err(); err != nil {
+			return err
+		}
+	}
+
+	return iter.Err()
+}
+
+func (c *cache) Incr(ctx context.Context, key string) (int64, error) {
+	return c.rdb.Client.Incr(ctx, key).Result()
+}
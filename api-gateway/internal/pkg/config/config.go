@@ -27,6 +27,10 @@ type Config struct {
 		IdleTimeout  string
 	}
 
+	Debug struct {
+		Addr string
+	}
+
 	DB struct {
 		Host     string
 		Port     string
@@ -47,6 +51,17 @@ type Config struct {
 		Name     string
 	}
 
+	Idempotency struct {
+		TTL string
+	}
+
+	Cache struct {
+		JobTTL     string
+		JobsTTL    string
+		ClientTTL  string
+		WatchRetry string
+	}
+
 	Token struct {
 		Secret     string
 		AccessTTL  time.Duration
@@ -82,6 +97,9 @@ func NewConfig() (*Config, error) {
 	config.Server.WriteTimeout = getEnv("SERVER_WRITE_TIMEOUT", "10s")
 	config.Server.IdleTimeout = getEnv("SERVER_IDLE_TIMEOUT", "120s")
 
+	// debug configuration, internal only, empty turns the listener off
+	config.Debug.Addr = getEnv("DEBUG_ADDR", "localhost:6060")
+
 	// db configuration
 	config.DB.Host = getEnv("POSTGRES_HOST", "postgres")
 	config.DB.Port = getEnv("POSTGRES_PORT", "5432")
@@ -96,6 +114,17 @@ func NewConfig() (*Config, error) {
 	config.Redis.Password = getEnv("REDIS_PASSWORD", "")
 	config.Redis.Name = getEnv("REDIS_DATABASE", "0")
 
+	// how long the responses of requests sent with an Idempotency-Key are replayed
+	config.Idempotency.TTL = getEnv("IDEMPOTENCY_TTL", "24h")
+
+	// read-through cache, a zero TTL turns the cache of the values off. The changes reported by
+	// the services drop what they make stale, after the feeds fail they are watched again in
+	// CACHE_WATCH_RETRY.
+	config.Cache.JobTTL = getEnv("CACHE_JOB_TTL", "5m")
+	config.Cache.JobsTTL = getEnv("CACHE_JOBS_TTL", "1m")
+	config.Cache.ClientTTL = getEnv("CACHE_CLIENT_TTL", "5m")
+	config.Cache.WatchRetry = getEnv("CACHE_WATCH_RETRY", "5s")
+
 	// client-job services configuration
 	config.ClientService.Host = getEnv("CLIENT_SERVICE_GRPC_HOST", "client-service")
 	config.ClientService.Port = getEnv("CLIENT_SERVICE_GRPC_PORT", ":1111")
@@ -0,0 +1,101 @@
+package readthrough
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientproto "api-gateway/genproto/client_service"
+	jobproto "api-gateway/genproto/job_service"
+	grpc_service_clients "api-gateway/internal/infrastructure/grpc_service_client"
+)
+
+// Invalidator follows the change feeds of job-service and client-service and drops what the
+// changes make stale. A feed watched from its end may have missed changes, so whatever is
+// cached of it is flushed then.
+type Invalidator struct {
+	cache   *Cache
+	service grpc_service_clients.ServiceClient
+	logger  *zap.Logger
+	retry   time.Duration
+}
+
+func NewInvalidator(cache *Cache, service grpc_service_clients.ServiceClient, logger *zap.Logger, retry time.Duration) *Invalidator {
+	return &Invalidator{
+		cache:   cache,
+		service: service,
+		logger:  logger,
+		retry:   retry,
+	}
+}
+
+// Run blocks until ctx is done
+func (i *Invalidator) Run(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		i.follow(ctx, "jobs", i.watchJobs, i.cache.FlushJobs)
+	}()
+	i.follow(ctx, "clients", i.watchClients, i.cache.FlushClients)
+	<-done
+}
+
+// follow watches the feed again after every failure, from the last change seen
+func (i *Invalidator) follow(ctx context.Context, feed string, watch func(ctx context.Context, cursor *uint64) error, flush func(ctx context.Context)) {
+	var cursor uint64
+	for {
+		if cursor == 0 {
+			flush(ctx)
+		}
+		err := watch(ctx, &cursor)
+		if ctx.Err() != nil {
+			return
+		}
+		i.logger.Error("cache invalidator: watch "+feed, zap.Error(err), zap.Uint64("cursor", cursor))
+		// the changes after the cursor were pruned
+		if status.Code(err) == codes.FailedPrecondition {
+			cursor = 0
+		}
+
+		timer := time.NewTimer(i.retry)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (i *Invalidator) watchJobs(ctx context.Context, cursor *uint64) error {
+	stream, err := i.service.JobService().WatchJobs(ctx, &jobproto.WatchJobsRequest{Cursor: *cursor})
+	if err != nil {
+		return err
+	}
+	for {
+		change, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		i.cache.JobChanged(ctx, change.JobId)
+		*cursor = change.Cursor
+	}
+}
+
+func (i *Invalidator) watchClients(ctx context.Context, cursor *uint64) error {
+	stream, err := i.service.ClientService().WatchClients(ctx, &clientproto.WatchClientsRequest{Cursor: *cursor})
+	if err != nil {
+		return err
+	}
+	for {
+		change, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		i.cache.ClientChanged(ctx, change.ClientId)
+		*cursor = change.Cursor
+	}
+}
@@ -0,0 +1,154 @@
+package readthrough
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"strconv"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	redisrepo "api-gateway/internal/infrastructure/repository/redis"
+)
+
+const (
+	keyPrefix = "cache:"
+	// jobsGenerationKey is bumped on every job change, the cached listings of an older
+	// generation are never read again and expire
+	jobsGenerationKey = keyPrefix + "jobs:generation"
+)
+
+// metrics are served with the other expvars on the internal debug listener
+var (
+	hits   = expvar.NewMap("cache_hits")
+	misses = expvar.NewMap("cache_misses")
+	errs   = expvar.NewMap("cache_errors")
+)
+
+// Cache reads through Redis, a miss is loaded once for all the concurrent requests of the key.
+// Redis failing never fails a request, it's read from the services then.
+type Cache struct {
+	cache       redisrepo.Cache
+	ttls        map[string]time.Duration
+	loadTimeout time.Duration
+	group       singleflight.Group
+	logger      *zap.Logger
+}
+
+// New takes the TTLs by the name of the cached values, e.g. "job", a value without a TTL isn't
+// cached. A miss is loaded for at most loadTimeout.
+func New(cache redisrepo.Cache, ttls map[string]time.Duration, loadTimeout time.Duration, logger *zap.Logger) *Cache {
+	return &Cache{
+		cache:       cache,
+		ttls:        ttls,
+		loadTimeout: loadTimeout,
+		logger:      logger,
+	}
+}
+
+// Load returns the value of the name cached under key, on a miss the value load returns is
+// cached for the TTL of the name. The lookups are counted by the name, errors aren't cached.
+// The load is shared by the requests waiting for it, so it isn't canceled with the one that
+// started it.
+func Load[T any](ctx context.Context, c *Cache, name, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	ttl := c.ttls[name]
+	if ttl <= 0 {
+		return load(ctx)
+	}
+
+	var value T
+	data, err := c.cache.Get(ctx, key)
+	if err == nil {
+		if err = json.Unmarshal(data, &value); err == nil {
+			hits.Add(name, 1)
+			return value, nil
+		}
+	}
+	if !errors.Is(err, goredis.Nil) {
+		c.fail(name, "read", key, err)
+	}
+	misses.Add(name, 1)
+
+	loaded, err, _ := c.group.Do(key, func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.loadTimeout)
+		defer cancel()
+
+		value, err := load(loadCtx)
+		if err != nil {
+			return value, err
+		}
+		if err := c.cache.Set(loadCtx, key, value, ttl); err != nil {
+			c.fail(name, "write", key, err)
+		}
+		return value, nil
+	})
+	if err != nil {
+		return value, err
+	}
+
+	return loaded.(T), nil
+}
+
+func JobKey(id string) string {
+	return keyPrefix + "job:" + id
+}
+
+func ClientKey(id string) string {
+	return keyPrefix + "client:" + id
+}
+
+// JobsKey is the key of a job listing for the query, e.g. the encoded query parameters
+func (c *Cache) JobsKey(ctx context.Context, query string) string {
+	var generation int64
+	if data, err := c.cache.Get(ctx, jobsGenerationKey); err == nil {
+		generation, _ = strconv.ParseInt(string(data), 10, 64)
+	} else if !errors.Is(err, goredis.Nil) {
+		c.fail("jobs", "read", jobsGenerationKey, err)
+	}
+
+	sum := sha256.Sum256([]byte(query))
+	return keyPrefix + "jobs:" + strconv.FormatInt(generation, 10) + ":" + hex.EncodeToString(sum[:])
+}
+
+// JobChanged drops the cached job and every cached job listing
+func (c *Cache) JobChanged(ctx context.Context, id string) {
+	if err := c.cache.Del(ctx, JobKey(id)); err != nil {
+		c.fail("job", "invalidate", JobKey(id), err)
+	}
+	if _, err := c.cache.Incr(ctx, jobsGenerationKey); err != nil {
+		c.fail("jobs", "invalidate", jobsGenerationKey, err)
+	}
+}
+
+func (c *Cache) ClientChanged(ctx context.Context, id string) {
+	if err := c.cache.Del(ctx, ClientKey(id)); err != nil {
+		c.fail("client", "invalidate", ClientKey(id), err)
+	}
+}
+
+// FlushJobs drops every cached job and job listing, for when changes may have been missed
+func (c *Cache) FlushJobs(ctx context.Context) {
+	if err := c.cache.DelMatch(ctx, JobKey("*")); err != nil {
+		c.fail("job", "flush", JobKey("*"), err)
+	}
+	if _, err := c.cache.Incr(ctx, jobsGenerationKey); err != nil {
+		c.fail("jobs", "flush", jobsGenerationKey, err)
+	}
+}
+
+func (c *Cache) FlushClients(ctx context.Context) {
+	if err := c.cache.DelMatch(ctx, ClientKey("*")); err != nil {
+		c.fail("client", "flush", ClientKey("*"), err)
+	}
+}
+
+func (c *Cache) fail(name, op, key string, err error) {
+	errs.Add(name, 1)
+	c.logger.Error("cache: "+op, zap.Error(err), zap.String("key", key))
+}
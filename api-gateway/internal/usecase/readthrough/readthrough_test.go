@@ -0,0 +1,121 @@
+package readthrough
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// memoryCache keeps the values in a map
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value interface{}, _ time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = data
+	return nil
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.values[key]
+	if !ok {
+		return nil, goredis.Nil
+	}
+	return data, nil
+}
+
+func (c *memoryCache) Del(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func (c *memoryCache) DelMatch(context.Context, string) error {
+	return nil
+}
+
+func (c *memoryCache) Incr(context.Context, string) (int64, error) {
+	return 0, nil
+}
+
+func TestLoadOutlivesFirstCaller(t *testing.T) {
+	store := &memoryCache{values: map[string][]byte{}}
+	cache := New(store, map[string]time.Duration{"job": time.Minute}, time.Second, zap.NewNop())
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	load := func(ctx context.Context) (string, error) {
+		close(started)
+		<-release
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		return "loaded", nil
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstDone := make(chan error, 1)
+	go func() {
+		_, err := Load(firstCtx, cache, "job", JobKey("1"), load)
+		firstDone <- err
+	}()
+	<-started
+
+	secondDone := make(chan string, 1)
+	go func() {
+		value, err := Load(context.Background(), cache, "job", JobKey("1"), func(context.Context) (string, error) {
+			t.Error("second caller loaded the value again")
+			return "", nil
+		})
+		if err != nil {
+			t.Errorf("second caller: %v", err)
+		}
+		secondDone <- value
+	}()
+
+	// the second caller waits on the load of the first one, it's canceled then
+	time.Sleep(20 * time.Millisecond)
+	cancelFirst()
+	close(release)
+
+	if err := <-firstDone; err != nil {
+		t.Errorf("first caller: %v", err)
+	}
+	if value := <-secondDone; value != "loaded" {
+		t.Errorf("second caller got %q, want %q", value, "loaded")
+	}
+	if data, err := store.Get(context.Background(), JobKey("1")); err != nil || string(data) != `"loaded"` {
+		t.Errorf("cached %q, %v, want %q", data, err, `"loaded"`)
+	}
+}
+
+func TestLoadTimeout(t *testing.T) {
+	store := &memoryCache{values: map[string][]byte{}}
+	cache := New(store, map[string]time.Duration{"job": time.Minute}, 10*time.Millisecond, zap.NewNop())
+
+	_, err := Load(context.Background(), cache, "job", JobKey("1"), func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if _, err = store.Get(context.Background(), JobKey("1")); err != goredis.Nil {
+		t.Errorf("a failed load was cached")
+	}
+}
@@ -0,0 +1,2004 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: employment_model.proto
+
+package job_service
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type EmploymentHistoryRequest struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmploymentHistoryRequest) Reset()         { *m = EmploymentHistoryRequest{} }
+func (m *EmploymentHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*EmploymentHistoryRequest) ProtoMessage()    {}
+func (*EmploymentHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa91ae4f13c00ee0, []int{0}
+}
+func (m *EmploymentHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmploymentHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmploymentHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmploymentHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmploymentHistoryRequest.Merge(m, src)
+}
+func (m *EmploymentHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmploymentHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmploymentHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmploymentHistoryRequest proto.InternalMessageInfo
+
+func (m *EmploymentHistoryRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// dates are RFC3339, an empty end_date is a current position
+type EmploymentPosition struct {
+	JobId     string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	JobName   string `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	CompanyId string `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Company   string `protobuf:"bytes,4,opt,name=company,proto3" json:"company,omitempty"`
+	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Current   bool   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	// up to now for current positions
+	TenureDays           int64    `protobuf:"varint,8,opt,name=tenure_days,json=tenureDays,proto3" json:"tenure_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmploymentPosition) Reset()         { *m = EmploymentPosition{} }
+func (m *EmploymentPosition) String() string { return proto.CompactTextString(m) }
+func (*EmploymentPosition) ProtoMessage()    {}
+func (*EmploymentPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa91ae4f13c00ee0, []int{1}
+}
+func (m *EmploymentPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmploymentPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmploymentPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmploymentPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmploymentPosition.Merge(m, src)
+}
+func (m *EmploymentPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmploymentPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmploymentPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmploymentPosition proto.InternalMessageInfo
+
+func (m *EmploymentPosition) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *EmploymentPosition) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *EmploymentPosition) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+func (m *EmploymentPosition) GetCompany() string {
+	if m != nil {
+		return m.Company
+	}
+	return ""
+}
+
+func (m *EmploymentPosition) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *EmploymentPosition) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *EmploymentPosition) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+func (m *EmploymentPosition) GetTenureDays() int64 {
+	if m != nil {
+		return m.TenureDays
+	}
+	return 0
+}
+
+type EmploymentGap struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Days                 int64    `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	AfterJobId           string   `protobuf:"bytes,4,opt,name=after_job_id,json=afterJobId,proto3" json:"after_job_id,omitempty"`
+	BeforeJobId          string   `protobuf:"bytes,5,opt,name=before_job_id,json=beforeJobId,proto3" json:"before_job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmploymentGap) Reset()         { *m = EmploymentGap{} }
+func (m *EmploymentGap) String() string { return proto.CompactTextString(m) }
+func (*EmploymentGap) ProtoMessage()    {}
+func (*EmploymentGap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa91ae4f13c00ee0, []int{2}
+}
+func (m *EmploymentGap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmploymentGap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmploymentGap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmploymentGap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmploymentGap.Merge(m, src)
+}
+func (m *EmploymentGap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmploymentGap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmploymentGap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmploymentGap proto.InternalMessageInfo
+
+func (m *EmploymentGap) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EmploymentGap) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EmploymentGap) GetDays() int64 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *EmploymentGap) GetAfterJobId() string {
+	if m != nil {
+		return m.AfterJobId
+	}
+	return ""
+}
+
+func (m *EmploymentGap) GetBeforeJobId() string {
+	if m != nil {
+		return m.BeforeJobId
+	}
+	return ""
+}
+
+// an empty to is an overlap still going on
+type EmploymentOverlap struct {
+	FirstJobId           string   `protobuf:"bytes,1,opt,name=first_job_id,json=firstJobId,proto3" json:"first_job_id,omitempty"`
+	SecondJobId          string   `protobuf:"bytes,2,opt,name=second_job_id,json=secondJobId,proto3" json:"second_job_id,omitempty"`
+	From                 string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Days                 int64    `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmploymentOverlap) Reset()         { *m = EmploymentOverlap{} }
+func (m *EmploymentOverlap) String() string { return proto.CompactTextString(m) }
+func (*EmploymentOverlap) ProtoMessage()    {}
+func (*EmploymentOverlap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa91ae4f13c00ee0, []int{3}
+}
+func (m *EmploymentOverlap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmploymentOverlap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmploymentOverlap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmploymentOverlap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmploymentOverlap.Merge(m, src)
+}
+func (m *EmploymentOverlap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmploymentOverlap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmploymentOverlap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmploymentOverlap proto.InternalMessageInfo
+
+func (m *EmploymentOverlap) GetFirstJobId() string {
+	if m != nil {
+		return m.FirstJobId
+	}
+	return ""
+}
+
+func (m *EmploymentOverlap) GetSecondJobId() string {
+	if m != nil {
+		return m.SecondJobId
+	}
+	return ""
+}
+
+func (m *EmploymentOverlap) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EmploymentOverlap) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EmploymentOverlap) GetDays() int64 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+// positions are ordered by start_date, oldest first
+type EmploymentHistory struct {
+	ClientId  string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Positions []*EmploymentPosition `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	Gaps      []*EmploymentGap      `protobuf:"bytes,3,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	Overlaps  []*EmploymentOverlap  `protobuf:"bytes,4,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	// days covered by at least one position, overlaps are counted once
+	TotalTenureDays      int64    `protobuf:"varint,5,opt,name=total_tenure_days,json=totalTenureDays,proto3" json:"total_tenure_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmploymentHistory) Reset()         { *m = EmploymentHistory{} }
+func (m *EmploymentHistory) String() string { return proto.CompactTextString(m) }
+func (*EmploymentHistory) ProtoMessage()    {}
+func (*EmploymentHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa91ae4f13c00ee0, []int{4}
+}
+func (m *EmploymentHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmploymentHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmploymentHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmploymentHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmploymentHistory.Merge(m, src)
+}
+func (m *EmploymentHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmploymentHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmploymentHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmploymentHistory proto.InternalMessageInfo
+
+func (m *EmploymentHistory) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *EmploymentHistory) GetPositions() []*EmploymentPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *EmploymentHistory) GetGaps() []*EmploymentGap {
+	if m != nil {
+		return m.Gaps
+	}
+	return nil
+}
+
+func (m *EmploymentHistory) GetOverlaps() []*EmploymentOverlap {
+	if m != nil {
+		return m.Overlaps
+	}
+	return nil
+}
+
+func (m *EmploymentHistory) GetTotalTenureDays() int64 {
+	if m != nil {
+		return m.TotalTenureDays
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EmploymentHistoryRequest)(nil), "job_service.EmploymentHistoryRequest")
+	proto.RegisterType((*EmploymentPosition)(nil), "job_service.EmploymentPosition")
+	proto.RegisterType((*EmploymentGap)(nil), "job_service.EmploymentGap")
+	proto.RegisterType((*EmploymentOverlap)(nil), "job_service.EmploymentOverlap")
+	proto.RegisterType((*EmploymentHistory)(nil), "job_service.EmploymentHistory")
+}
+
+func init() { proto.RegisterFile("employment_model.proto", fileDescriptor_fa91ae4f13c00ee0) }
+
+var fileDescriptor_fa91ae4f13c00ee0 = []byte{
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0xfd, 0xef, 0x94, 0x05, 0xd5, 0x02, 0x64, 0x40, 0x74, 0xab, 0x1c, 0x50, 0xc5, 0xa1,
+	0x48, 0x70, 0x40, 0x42, 0xe2, 0x82, 0x16, 0x2d, 0xe5, 0x00, 0x28, 0xe2, 0xc4, 0x25, 0x72, 0xeb,
+	0xe9, 0x2a, 0x55, 0x62, 0x07, 0xdb, 0x5d, 0x29, 0x4f, 0xc0, 0x89, 0x03, 0x37, 0x1e, 0x89, 0x23,
+	0x8f, 0x80, 0xca, 0x2b, 0xf0, 0x00, 0xc8, 0x76, 0x9a, 0x14, 0x55, 0xbb, 0x37, 0xcf, 0x37, 0xdf,
+	0x37, 0xf6, 0x37, 0xf9, 0x02, 0xf7, 0x30, 0x2f, 0x32, 0x55, 0xe6, 0x28, 0x6d, 0x92, 0x2b, 0x81,
+	0xd9, 0xbc, 0xd0, 0xca, 0x2a, 0x3a, 0xda, 0xa8, 0x65, 0x62, 0x50, 0x5f, 0xa6, 0x2b, 0x8c, 0x5e,
+	0x00, 0x7b, 0x53, 0xd3, 0xde, 0xa6, 0xc6, 0x2a, 0x5d, 0xc6, 0xf8, 0x65, 0x8b, 0xc6, 0xd2, 0x87,
+	0x30, 0x5c, 0x65, 0xa9, 0x93, 0xa7, 0x82, 0x91, 0x29, 0x99, 0x0d, 0xe3, 0x41, 0x00, 0x16, 0x22,
+	0xfa, 0x4b, 0x80, 0x36, 0xca, 0x8f, 0xca, 0xa4, 0x36, 0x55, 0x92, 0xde, 0x85, 0x9e, 0x1b, 0x5f,
+	0x0b, 0xba, 0x1b, 0xb5, 0x5c, 0x08, 0x7a, 0x1f, 0x06, 0x0e, 0x96, 0x3c, 0x47, 0xd6, 0xf2, 0x8d,
+	0xfe, 0x46, 0x2d, 0xdf, 0xf3, 0x1c, 0xe9, 0x23, 0x80, 0x95, 0xca, 0x0b, 0x2e, 0x4b, 0xa7, 0x6a,
+	0xfb, 0xe6, 0xb0, 0x42, 0x16, 0x82, 0x32, 0xe8, 0x57, 0x05, 0xeb, 0x04, 0x61, 0x55, 0x3a, 0xa1,
+	0xb1, 0x5c, 0xdb, 0x44, 0x70, 0x8b, 0xac, 0x1b, 0x84, 0x1e, 0x39, 0xe3, 0x16, 0xdd, 0x95, 0x28,
+	0x45, 0x68, 0xf6, 0x82, 0x12, 0xa5, 0xf0, 0x2d, 0x37, 0x73, 0xab, 0x35, 0x4a, 0xcb, 0xfa, 0x53,
+	0x32, 0x1b, 0xc4, 0xfb, 0x92, 0x9e, 0xc2, 0xc8, 0xa2, 0xdc, 0x6a, 0x4c, 0x04, 0x2f, 0x0d, 0x1b,
+	0x4c, 0xc9, 0xac, 0x1d, 0x43, 0x80, 0xce, 0x78, 0x69, 0xa2, 0x6f, 0x04, 0x4e, 0x1a, 0xdb, 0xe7,
+	0xbc, 0xa0, 0x14, 0x3a, 0x6b, 0xad, 0xf2, 0xca, 0xaf, 0x3f, 0xd3, 0x5b, 0xd0, 0xb2, 0xaa, 0x32,
+	0xda, 0xb2, 0xca, 0x71, 0xfc, 0xbc, 0xb6, 0x9f, 0xe7, 0xcf, 0x74, 0x0a, 0x37, 0xf9, 0xda, 0xa2,
+	0x4e, 0xaa, 0x7d, 0x05, 0x77, 0xe0, 0xb1, 0x77, 0x7e, 0x69, 0x11, 0x9c, 0x2c, 0x71, 0xad, 0x34,
+	0xee, 0x29, 0xc1, 0xe3, 0x28, 0x80, 0x9e, 0x13, 0x7d, 0x27, 0x30, 0x6e, 0xde, 0xf3, 0xe1, 0x12,
+	0x75, 0xc6, 0x0b, 0x37, 0x7b, 0x9d, 0x6a, 0x63, 0x93, 0xff, 0xbe, 0x05, 0x78, 0xac, 0x9e, 0x6d,
+	0x70, 0xa5, 0xa4, 0xd8, 0x53, 0xc2, 0x63, 0x47, 0x01, 0x0c, 0x9c, 0xbd, 0xb3, 0xf6, 0x91, 0xb3,
+	0xce, 0x91, 0xb3, 0x6e, 0xe3, 0x2c, 0xfa, 0xda, 0x82, 0xf1, 0x51, 0xa8, 0xae, 0x4d, 0x13, 0x7d,
+	0x05, 0xc3, 0xa2, 0x8a, 0x90, 0x61, 0xad, 0x69, 0x7b, 0x36, 0x7a, 0x76, 0x3a, 0x3f, 0xc8, 0xe9,
+	0xfc, 0x38, 0x6a, 0x71, 0xa3, 0xa0, 0x73, 0xe8, 0x5c, 0xf0, 0xc2, 0xed, 0xd7, 0x29, 0x1f, 0x5c,
+	0xa1, 0x3c, 0xe7, 0x45, 0xec, 0x79, 0xf4, 0x25, 0x0c, 0x54, 0x58, 0x95, 0x61, 0x1d, 0xaf, 0x99,
+	0x5c, 0xa1, 0xa9, 0x36, 0x1a, 0xd7, 0x7c, 0xfa, 0x04, 0xc6, 0x56, 0x59, 0x9e, 0x25, 0x87, 0x41,
+	0x09, 0xf6, 0x6f, 0xfb, 0xc6, 0xa7, 0x3a, 0x2d, 0xaf, 0x1f, 0xff, 0xdc, 0x4d, 0xc8, 0xaf, 0xdd,
+	0x84, 0xfc, 0xde, 0x4d, 0xc8, 0x8f, 0x3f, 0x93, 0x1b, 0x9f, 0xef, 0x5c, 0xa0, 0xf4, 0xbf, 0xe1,
+	0xd3, 0x83, 0xfb, 0x96, 0x3d, 0x0f, 0x3d, 0xff, 0x37, 0x00, 0x0a, 0xba, 0x67, 0x54, 0xb3, 0x03,
+	0x00, 0x00,
+}
+
+func (m *EmploymentHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmploymentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmploymentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmploymentPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmploymentPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmploymentPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TenureDays != 0 {
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(m.TenureDays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Current {
+		i--
+		if m.Current {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Company) > 0 {
+		i -= len(m.Company)
+		copy(dAtA[i:], m.Company)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.Company)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobName) > 0 {
+		i -= len(m.JobName)
+		copy(dAtA[i:], m.JobName)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.JobName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmploymentGap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmploymentGap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmploymentGap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BeforeJobId) > 0 {
+		i -= len(m.BeforeJobId)
+		copy(dAtA[i:], m.BeforeJobId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.BeforeJobId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AfterJobId) > 0 {
+		i -= len(m.AfterJobId)
+		copy(dAtA[i:], m.AfterJobId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.AfterJobId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Days != 0 {
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmploymentOverlap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmploymentOverlap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmploymentOverlap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Days != 0 {
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SecondJobId) > 0 {
+		i -= len(m.SecondJobId)
+		copy(dAtA[i:], m.SecondJobId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.SecondJobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstJobId) > 0 {
+		i -= len(m.FirstJobId)
+		copy(dAtA[i:], m.FirstJobId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.FirstJobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmploymentHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmploymentHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmploymentHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalTenureDays != 0 {
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(m.TotalTenureDays))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Overlaps) > 0 {
+		for iNdEx := len(m.Overlaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overlaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEmploymentModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Gaps) > 0 {
+		for iNdEx := len(m.Gaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEmploymentModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEmploymentModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintEmploymentModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEmploymentModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEmploymentModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmploymentHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmploymentPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.JobName)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.Company)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	if m.Current {
+		n += 2
+	}
+	if m.TenureDays != 0 {
+		n += 1 + sovEmploymentModel(uint64(m.TenureDays))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmploymentGap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	if m.Days != 0 {
+		n += 1 + sovEmploymentModel(uint64(m.Days))
+	}
+	l = len(m.AfterJobId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.BeforeJobId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmploymentOverlap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstJobId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.SecondJobId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	if m.Days != 0 {
+		n += 1 + sovEmploymentModel(uint64(m.Days))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EmploymentHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEmploymentModel(uint64(l))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovEmploymentModel(uint64(l))
+		}
+	}
+	if len(m.Gaps) > 0 {
+		for _, e := range m.Gaps {
+			l = e.Size()
+			n += 1 + l + sovEmploymentModel(uint64(l))
+		}
+	}
+	if len(m.Overlaps) > 0 {
+		for _, e := range m.Overlaps {
+			l = e.Size()
+			n += 1 + l + sovEmploymentModel(uint64(l))
+		}
+	}
+	if m.TotalTenureDays != 0 {
+		n += 1 + sovEmploymentModel(uint64(m.TotalTenureDays))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEmploymentModel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEmploymentModel(x uint64) (n int) {
+	return sovEmploymentModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmploymentHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmploymentModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmploymentHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmploymentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmploymentModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmploymentPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmploymentModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmploymentPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmploymentPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Company", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Company = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Current = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenureDays", wireType)
+			}
+			m.TenureDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TenureDays |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmploymentModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmploymentGap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmploymentModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmploymentGap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmploymentGap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmploymentModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmploymentOverlap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmploymentModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmploymentOverlap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmploymentOverlap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmploymentModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmploymentHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmploymentModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmploymentHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmploymentHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, &EmploymentPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gaps = append(m.Gaps, &EmploymentGap{})
+			if err := m.Gaps[len(m.Gaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overlaps = append(m.Overlaps, &EmploymentOverlap{})
+			if err := m.Overlaps[len(m.Overlaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTenureDays", wireType)
+			}
+			m.TotalTenureDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTenureDays |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmploymentModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmploymentModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEmploymentModel(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEmploymentModel
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmploymentModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEmploymentModel
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEmploymentModel
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEmploymentModel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEmploymentModel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEmploymentModel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEmploymentModel = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2285 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: saved_search_model.proto
+
+package job_service
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// the filters of the job listing, empty ones match any job
+type JobSearchFilter struct {
+	SalaryFrom     string   `protobuf:"bytes,1,opt,name=salary_from,json=salaryFrom,proto3" json:"salary_from,omitempty"`
+	SalaryTo       string   `protobuf:"bytes,2,opt,name=salary_to,json=salaryTo,proto3" json:"salary_to,omitempty"`
+	Currency       string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	PayPeriod      string   `protobuf:"bytes,4,opt,name=pay_period,json=payPeriod,proto3" json:"pay_period,omitempty"`
+	Level          string   `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	LocationType   string   `protobuf:"bytes,6,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
+	EmploymentType string   `protobuf:"bytes,7,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
+	CompanyId      string   `protobuf:"bytes,8,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Skills         []string `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
+	// a place geocoded into latitude and longitude, a center needs radius_km
+	Near      string `protobuf:"bytes,10,opt,name=near,proto3" json:"near,omitempty"`
+	Latitude  string `protobuf:"bytes,11,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude string `protobuf:"bytes,12,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm  string `protobuf:"bytes,13,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// south,west,north,east
+	Bbox string `protobuf:"bytes,14,opt,name=bbox,proto3" json:"bbox,omitempty"`
+	// jobs in the category or its subcategories
+	CategoryId string `protobuf:"bytes,15,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// jobs with at least one of the tags
+	Tags                 []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobSearchFilter) Reset()         { *m = JobSearchFilter{} }
+func (m *JobSearchFilter) String() string { return proto.CompactTextString(m) }
+func (*JobSearchFilter) ProtoMessage()    {}
+func (*JobSearchFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f036643a9b421cf1, []int{0}
+}
+func (m *JobSearchFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSearchFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSearchFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSearchFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSearchFilter.Merge(m, src)
+}
+func (m *JobSearchFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSearchFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSearchFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSearchFilter proto.InternalMessageInfo
+
+func (m *JobSearchFilter) GetSalaryFrom() string {
+	if m != nil {
+		return m.SalaryFrom
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetSalaryTo() string {
+	if m != nil {
+		return m.SalaryTo
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetPayPeriod() string {
+	if m != nil {
+		return m.PayPeriod
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetLocationType() string {
+	if m != nil {
+		return m.LocationType
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetEmploymentType() string {
+	if m != nil {
+		return m.EmploymentType
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetSkills() []string {
+	if m != nil {
+		return m.Skills
+	}
+	return nil
+}
+
+func (m *JobSearchFilter) GetNear() string {
+	if m != nil {
+		return m.Near
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetLatitude() string {
+	if m != nil {
+		return m.Latitude
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetLongitude() string {
+	if m != nil {
+		return m.Longitude
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetRadiusKm() string {
+	if m != nil {
+		return m.RadiusKm
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetBbox() string {
+	if m != nil {
+		return m.Bbox
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetCategoryId() string {
+	if m != nil {
+		return m.CategoryId
+	}
+	return ""
+}
+
+func (m *JobSearchFilter) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// frequency is instant, daily or weekly, instant by default.
+// last_sent_at is empty until the first alert
+type SavedSearch struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId             string           `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name                 string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Filter               *JobSearchFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Frequency            string           `protobuf:"bytes,5,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	LastSentAt           string           `protobuf:"bytes,6,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	CreatedAt            string           `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string           `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SavedSearch) Reset()         { *m = SavedSearch{} }
+func (m *SavedSearch) String() string { return proto.CompactTextString(m) }
+func (*SavedSearch) ProtoMessage()    {}
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f036643a9b421cf1, []int{1}
+}
+func (m *SavedSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SavedSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SavedSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SavedSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SavedSearch.Merge(m, src)
+}
+func (m *SavedSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SavedSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SavedSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SavedSearch proto.InternalMessageInfo
+
+func (m *SavedSearch) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetFilter() *JobSearchFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *SavedSearch) GetFrequency() string {
+	if m != nil {
+		return m.Frequency
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetLastSentAt() string {
+	if m != nil {
+		return m.LastSentAt
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type SavedSearchWithGUID struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SavedSearchWithGUID) Reset()         { *m = SavedSearchWithGUID{} }
+func (m *SavedSearchWithGUID) String() string { return proto.CompactTextString(m) }
+func (*SavedSearchWithGUID) ProtoMessage()    {}
+func (*SavedSearchWithGUID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f036643a9b421cf1, []int{2}
+}
+func (m *SavedSearchWithGUID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SavedSearchWithGUID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SavedSearchWithGUID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SavedSearchWithGUID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SavedSearchWithGUID.Merge(m, src)
+}
+func (m *SavedSearchWithGUID) XXX_Size() int {
+	return m.Size()
+}
+func (m *SavedSearchWithGUID) XXX_DiscardUnknown() {
+	xxx_messageInfo_SavedSearchWithGUID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SavedSearchWithGUID proto.InternalMessageInfo
+
+func (m *SavedSearchWithGUID) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SavedSearchWithGUID) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type ListSavedSearchesRequest struct {
+	ClientId             string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSavedSearchesRequest) Reset()         { *m = ListSavedSearchesRequest{} }
+func (m *ListSavedSearchesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSavedSearchesRequest) ProtoMessage()    {}
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f036643a9b421cf1, []int{3}
+}
+func (m *ListSavedSearchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSavedSearchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSavedSearchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSavedSearchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSavedSearchesRequest.Merge(m, src)
+}
+func (m *ListSavedSearchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSavedSearchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSavedSearchesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSavedSearchesRequest proto.InternalMessageInfo
+
+func (m *ListSavedSearchesRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+type ListSavedSearchesResponse struct {
+	SavedSearches        []*SavedSearch `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListSavedSearchesResponse) Reset()         { *m = ListSavedSearchesResponse{} }
+func (m *ListSavedSearchesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSavedSearchesResponse) ProtoMessage()    {}
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f036643a9b421cf1, []int{4}
+}
+func (m *ListSavedSearchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSavedSearchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSavedSearchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSavedSearchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSavedSearchesResponse.Merge(m, src)
+}
+func (m *ListSavedSearchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSavedSearchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSavedSearchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSavedSearchesResponse proto.InternalMessageInfo
+
+func (m *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if m != nil {
+		return m.SavedSearches
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*JobSearchFilter)(nil), "job_service.JobSearchFilter")
+	proto.RegisterType((*SavedSearch)(nil), "job_service.SavedSearch")
+	proto.RegisterType((*SavedSearchWithGUID)(nil), "job_service.SavedSearchWithGUID")
+	proto.RegisterType((*ListSavedSearchesRequest)(nil), "job_service.ListSavedSearchesRequest")
+	proto.RegisterType((*ListSavedSearchesResponse)(nil), "job_service.ListSavedSearchesResponse")
+}
+
+func init() { proto.RegisterFile("saved_search_model.proto", fileDescriptor_f036643a9b421cf1) }
+
+var fileDescriptor_f036643a9b421cf1 = []byte{
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xd9, 0xa4, 0x4d, 0xbb, 0xb3, 0x6d, 0x8a, 0x4c, 0x85, 0x0c, 0xb4, 0x21, 0x0a, 0x12,
+	0xf4, 0x54, 0xa4, 0x82, 0xc4, 0x11, 0xb5, 0x42, 0x45, 0x05, 0x0e, 0x28, 0x2d, 0x42, 0x42, 0x48,
+	0x2b, 0x67, 0x3d, 0x49, 0x4d, 0xbd, 0xeb, 0xc5, 0x76, 0x22, 0xf6, 0xca, 0x53, 0xf0, 0x48, 0x1c,
+	0xb9, 0x72, 0x43, 0xe1, 0x45, 0x90, 0xed, 0x5d, 0xba, 0xad, 0xb8, 0x70, 0xb3, 0xbf, 0x7f, 0xc6,
+	0x1e, 0xcf, 0x3f, 0x06, 0x6a, 0xd8, 0x02, 0x79, 0x6a, 0x90, 0xe9, 0xec, 0x3c, 0xcd, 0x15, 0x47,
+	0xb9, 0x5f, 0x6a, 0x65, 0x15, 0x49, 0x3e, 0xa9, 0x49, 0x6a, 0x50, 0x2f, 0x44, 0x86, 0xa3, 0x9f,
+	0x5d, 0xd8, 0x7a, 0xa5, 0x26, 0xa7, 0x3e, 0xec, 0x58, 0x48, 0x8b, 0x9a, 0xdc, 0x87, 0xc4, 0x30,
+	0xc9, 0x74, 0x95, 0x4e, 0xb5, 0xca, 0x69, 0x34, 0x8c, 0xf6, 0xe2, 0x31, 0x04, 0x74, 0xac, 0x55,
+	0x4e, 0xee, 0x41, 0x5c, 0x07, 0x58, 0x45, 0x3b, 0x5e, 0x5e, 0x0f, 0xe0, 0x4c, 0x91, 0xbb, 0xb0,
+	0x9e, 0xcd, 0xb5, 0xc6, 0x22, 0xab, 0x68, 0x37, 0x68, 0xcd, 0x9e, 0xec, 0x02, 0x94, 0xac, 0x4a,
+	0x4b, 0xd4, 0x42, 0x71, 0xba, 0xe2, 0xd5, 0xb8, 0x64, 0xd5, 0x5b, 0x0f, 0xc8, 0x36, 0xac, 0x4a,
+	0x5c, 0xa0, 0xa4, 0xab, 0x5e, 0x09, 0x1b, 0xf2, 0x00, 0x36, 0xa5, 0xca, 0x98, 0x15, 0xaa, 0x48,
+	0x6d, 0x55, 0x22, 0xed, 0x79, 0x75, 0xa3, 0x81, 0x67, 0x55, 0x89, 0xe4, 0x11, 0x6c, 0x61, 0x5e,
+	0x4a, 0x55, 0xe5, 0x58, 0xd8, 0x10, 0xb6, 0xe6, 0xc3, 0xfa, 0x97, 0xd8, 0x07, 0xee, 0x02, 0x64,
+	0x2a, 0x2f, 0x59, 0x51, 0xa5, 0x82, 0xd3, 0xf5, 0x50, 0x42, 0x4d, 0x4e, 0x38, 0xb9, 0x0d, 0x3d,
+	0x73, 0x21, 0xa4, 0x34, 0x34, 0x1e, 0x76, 0xf7, 0xe2, 0x71, 0xbd, 0x23, 0x04, 0x56, 0x0a, 0x64,
+	0x9a, 0x82, 0x4f, 0xf0, 0x6b, 0xf7, 0x52, 0xc9, 0xac, 0xb0, 0x73, 0x8e, 0x34, 0x09, 0x2f, 0x6d,
+	0xf6, 0x64, 0x07, 0x62, 0xa9, 0x8a, 0x59, 0x10, 0x37, 0xc2, 0x2d, 0x7f, 0x81, 0x6b, 0xa0, 0x66,
+	0x5c, 0xcc, 0x4d, 0x7a, 0x91, 0xd3, 0xcd, 0x90, 0x1a, 0xc0, 0xeb, 0xdc, 0x5d, 0x35, 0x99, 0xa8,
+	0x2f, 0xb4, 0x1f, 0xae, 0x72, 0x6b, 0x67, 0x49, 0xc6, 0x2c, 0xce, 0x94, 0xf6, 0x65, 0x6f, 0x05,
+	0x4b, 0x1a, 0x74, 0xc2, 0x5d, 0x92, 0x65, 0x33, 0x43, 0x6f, 0xfa, 0xaa, 0xfd, 0x7a, 0xf4, 0xb5,
+	0x03, 0xc9, 0xa9, 0x9b, 0x82, 0xe0, 0x2e, 0xe9, 0x43, 0x47, 0xf0, 0xda, 0xce, 0x8e, 0xe0, 0xae,
+	0x8a, 0x4c, 0x0a, 0xd7, 0x2f, 0xc1, 0x1b, 0x1b, 0x03, 0x08, 0x07, 0x16, 0x2c, 0xc7, 0xda, 0x42,
+	0xbf, 0x26, 0x4f, 0xa1, 0x37, 0xf5, 0x23, 0xe2, 0xad, 0x4b, 0x0e, 0x76, 0xf6, 0x5b, 0xa3, 0xb4,
+	0x7f, 0x6d, 0x8c, 0xc6, 0x75, 0xac, 0x6b, 0xc5, 0x54, 0xe3, 0xe7, 0xb9, 0x9f, 0x88, 0xe0, 0xec,
+	0x25, 0x20, 0x43, 0xd8, 0x90, 0xcc, 0xd8, 0xd4, 0xb8, 0x3a, 0x98, 0xad, 0xcd, 0x05, 0xc7, 0x4e,
+	0xb1, 0xb0, 0x87, 0xd6, 0x3b, 0xa6, 0x91, 0x59, 0xe4, 0x4e, 0x5f, 0xab, 0x1d, 0x0b, 0x24, 0xc8,
+	0xf3, 0x92, 0x37, 0x72, 0x6d, 0x68, 0x4d, 0x0e, 0xed, 0xe8, 0x08, 0x6e, 0xb5, 0x7a, 0xf0, 0x5e,
+	0xd8, 0xf3, 0x97, 0xef, 0x4e, 0x5e, 0xfc, 0x57, 0x2f, 0x46, 0xcf, 0x80, 0xbe, 0x11, 0xc6, 0xb6,
+	0xce, 0x41, 0x33, 0x76, 0x0f, 0x30, 0xf6, 0x6a, 0x62, 0x74, 0x2d, 0xf1, 0x23, 0xdc, 0xf9, 0x47,
+	0xa2, 0x29, 0x55, 0x61, 0x90, 0x3c, 0x87, 0x7e, 0xfb, 0x8f, 0xa2, 0xa1, 0xd1, 0xb0, 0xbb, 0x97,
+	0x1c, 0xd0, 0x2b, 0x5d, 0x6d, 0xe5, 0x8e, 0x37, 0x4d, 0xfb, 0xa0, 0xa3, 0x87, 0xdf, 0x97, 0x83,
+	0xe8, 0xc7, 0x72, 0x10, 0xfd, 0x5a, 0x0e, 0xa2, 0x6f, 0xbf, 0x07, 0x37, 0x3e, 0x6c, 0xcf, 0xb0,
+	0xf0, 0x9f, 0xfc, 0x71, 0xeb, 0x88, 0x49, 0xcf, 0xa3, 0x27, 0x7f, 0x06, 0x00, 0x49, 0xd9, 0x75,
+	0x8f, 0x13, 0x04, 0x00, 0x00,
+}
+
+func (m *JobSearchFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSearchFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSearchFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CategoryId) > 0 {
+		i -= len(m.CategoryId)
+		copy(dAtA[i:], m.CategoryId)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.CategoryId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Bbox) > 0 {
+		i -= len(m.Bbox)
+		copy(dAtA[i:], m.Bbox)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Bbox)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RadiusKm) > 0 {
+		i -= len(m.RadiusKm)
+		copy(dAtA[i:], m.RadiusKm)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.RadiusKm)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Longitude) > 0 {
+		i -= len(m.Longitude)
+		copy(dAtA[i:], m.Longitude)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Longitude)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Latitude) > 0 {
+		i -= len(m.Latitude)
+		copy(dAtA[i:], m.Latitude)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Latitude)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Near) > 0 {
+		i -= len(m.Near)
+		copy(dAtA[i:], m.Near)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Near)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Skills) > 0 {
+		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skills[iNdEx])
+			copy(dAtA[i:], m.Skills[iNdEx])
+			i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Skills[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CompanyId) > 0 {
+		i -= len(m.CompanyId)
+		copy(dAtA[i:], m.CompanyId)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.CompanyId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.EmploymentType) > 0 {
+		i -= len(m.EmploymentType)
+		copy(dAtA[i:], m.EmploymentType)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.EmploymentType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LocationType) > 0 {
+		i -= len(m.LocationType)
+		copy(dAtA[i:], m.LocationType)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.LocationType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayPeriod) > 0 {
+		i -= len(m.PayPeriod)
+		copy(dAtA[i:], m.PayPeriod)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.PayPeriod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SalaryTo) > 0 {
+		i -= len(m.SalaryTo)
+		copy(dAtA[i:], m.SalaryTo)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.SalaryTo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SalaryFrom) > 0 {
+		i -= len(m.SalaryFrom)
+		copy(dAtA[i:], m.SalaryFrom)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.SalaryFrom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SavedSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SavedSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SavedSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LastSentAt) > 0 {
+		i -= len(m.LastSentAt)
+		copy(dAtA[i:], m.LastSentAt)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.LastSentAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Frequency) > 0 {
+		i -= len(m.Frequency)
+		copy(dAtA[i:], m.Frequency)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Frequency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSavedSearchModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SavedSearchWithGUID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SavedSearchWithGUID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SavedSearchWithGUID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSavedSearchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSavedSearchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSavedSearchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSavedSearchModel(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSavedSearchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSavedSearchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSavedSearchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SavedSearches) > 0 {
+		for iNdEx := len(m.SavedSearches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavedSearches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSavedSearchModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSavedSearchModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSavedSearchModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSearchFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SalaryFrom)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.SalaryTo)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.PayPeriod)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.LocationType)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.EmploymentType)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.CompanyId)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	if len(m.Skills) > 0 {
+		for _, s := range m.Skills {
+			l = len(s)
+			n += 1 + l + sovSavedSearchModel(uint64(l))
+		}
+	}
+	l = len(m.Near)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Latitude)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Longitude)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.RadiusKm)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Bbox)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.CategoryId)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovSavedSearchModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SavedSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.Frequency)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.LastSentAt)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SavedSearchWithGUID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSavedSearchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSavedSearchModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSavedSearchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SavedSearches) > 0 {
+		for _, e := range m.SavedSearches {
+			l = e.Size()
+			n += 1 + l + sovSavedSearchModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSavedSearchModel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSavedSearchModel(x uint64) (n int) {
+	return sovSavedSearchModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *JobSearchFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSavedSearchModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSearchFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSearchFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalaryTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalaryTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmploymentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmploymentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompanyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Near", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Near = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Latitude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Longitude = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RadiusKm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RadiusKm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bbox", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bbox = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSavedSearchModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SavedSearch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSavedSearchModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SavedSearch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SavedSearch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &JobSearchFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frequency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSentAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastSentAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSavedSearchModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SavedSearchWithGUID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSavedSearchModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SavedSearchWithGUID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SavedSearchWithGUID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSavedSearchModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSavedSearchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSavedSearchModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSavedSearchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSavedSearchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSavedSearchModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSavedSearchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSavedSearchModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSavedSearchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSavedSearchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavedSearches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavedSearches = append(m.SavedSearches, &SavedSearch{})
+			if err := m.SavedSearches[len(m.SavedSearches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSavedSearchModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSavedSearchModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSavedSearchModel(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSavedSearchModel
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSavedSearchModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSavedSearchModel
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSavedSearchModel
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSavedSearchModel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSavedSearchModel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSavedSearchModel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSavedSearchModel = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,15 +2,25 @@ package app
 
 import (
 	clientproto "client-service/genproto/client_service"
+	"client-service/internal/delivery/dispatcher"
 	grpc_server "client-service/internal/delivery/grpc/server"
 	client_service_services "client-service/internal/delivery/grpc/services"
+	"client-service/internal/delivery/relay"
+	"client-service/internal/delivery/resumer"
+	"client-service/internal/delivery/scheduler"
+	"client-service/internal/infrastructure/assignments"
+	"client-service/internal/infrastructure/broker"
+	"client-service/internal/infrastructure/dictionaries"
 	"client-service/internal/infrastructure/grpc_service_clients"
+	"client-service/internal/infrastructure/notification"
 	repo "client-service/internal/infrastructure/repository/postgresql"
 	"client-service/internal/pkg/config"
 	"client-service/internal/pkg/logger"
 	"client-service/internal/pkg/postgres"
 	"client-service/internal/usecase"
+	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -28,6 +38,12 @@ type App struct {
 	GrpcServer     *grpc.Server
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
+	stopScheduler  context.CancelFunc
+	stopListener   context.CancelFunc
+	stopRelay      context.CancelFunc
+	stopDispatcher context.CancelFunc
+	stopResumer    context.CancelFunc
+	Broker         broker.Broker
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -49,6 +65,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 			grpc_ctxtags.StreamServerInterceptor(),
 			grpc_zap.StreamServerInterceptor(logger),
 			grpc_recovery.StreamServerInterceptor(),
+			grpc_server.StreamInterceptorErrors(),
 		)),
 		grpc.UnaryInterceptor(grpc_server.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
@@ -57,6 +74,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 				grpc_recovery.UnaryServerInterceptor(),
 			),
 			grpc_server.UnaryInterceptorData(logger),
+			grpc_server.UnaryInterceptorErrors(),
 		)),
 	)
 
@@ -79,6 +97,34 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error during parse duration for context timeout : %w", err)
 	}
+	schedulerInterval, err := time.ParseDuration(a.Config.Scheduler.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for scheduler interval : %w", err)
+	}
+	changeRetention, err := time.ParseDuration(a.Config.ChangeFeed.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for change feed retention : %w", err)
+	}
+	outboxInterval, err := time.ParseDuration(a.Config.Outbox.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox interval : %w", err)
+	}
+	outboxRetention, err := time.ParseDuration(a.Config.Outbox.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox retention : %w", err)
+	}
+	outboxBatchSize, err := strconv.ParseUint(a.Config.Outbox.BatchSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse outbox batch size : %w", err)
+	}
+	notificationInterval, err := time.ParseDuration(a.Config.Notification.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for notification interval : %w", err)
+	}
+	sagaInterval, err := time.ParseDuration(a.Config.Saga.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for saga interval : %w", err)
+	}
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -88,11 +134,62 @@ func (a *App) Run() error {
 
 	// repositories initialization
 	articleRepo := repo.NewClientsRepo(a.DB)
+	outboxRepo := repo.NewOutboxRepo(a.DB)
+	notificationRepo := repo.NewClientNotificationsRepo(a.DB)
+	sagaRepo := repo.NewSagasRepo(a.DB)
 
-	// usecase initialization
-	articleUsecase := usecase.NewUserService(contextTimeout, articleRepo)
+	// broker of the domain events
+	eventBroker, err := broker.New(a.Config, a.Logger)
+	if err != nil {
+		return fmt.Errorf("error during initialize broker: %w", err)
+	}
+	a.Broker = eventBroker
+
+	// templates and senders of the notifications
+	notificationTemplates, err := notification.LoadTemplates()
+	if err != nil {
+		return fmt.Errorf("error during load notification templates: %w", err)
+	}
+	notificationProviders, err := notification.New(a.Config, a.Logger)
+	if err != nil {
+		return fmt.Errorf("error during initialize notification providers: %w", err)
+	}
+
+	// one connection listens for client changes on behalf of every watcher
+	clientChanges := postgres.NewListener(a.DB, "client_changes")
+	listenerCtx, stopListener := context.WithCancel(context.Background())
+	a.stopListener = stopListener
+	go clientChanges.Run(listenerCtx, func(err error) {
+		a.Logger.Error("listen for client changes", zap.Error(err))
+	})
 
-	clientproto.RegisterClientServiceServer(a.GrpcServer, client_service_services.NewRPC(a.Logger, articleUsecase, &a.ServiceClients))
+	// usecase initialization
+	articleUsecase := usecase.NewUserService(contextTimeout, articleRepo, clientChanges, dictionaries.New(serviceClients.JobService()))
+	outboxUsecase := usecase.NewOutboxService(contextTimeout, outboxRepo, eventBroker, outboxBatchSize)
+	notificationUsecase := usecase.NewNotificationService(contextTimeout, notificationRepo, articleRepo, notificationTemplates, notificationProviders)
+	sagaUsecase := usecase.NewSagaService(contextTimeout, sagaRepo, articleRepo, assignments.New(serviceClients.JobService()))
+
+	// change feed scheduler
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	a.stopScheduler = stopScheduler
+	go scheduler.New(a.Logger, articleUsecase, schedulerInterval, changeRetention).Run(schedulerCtx)
+
+	// outbox relay
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	a.stopRelay = stopRelay
+	go relay.New(a.Logger, outboxUsecase, outboxInterval, outboxRetention).Run(relayCtx)
+
+	// notification queue dispatcher
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	a.stopDispatcher = stopDispatcher
+	go dispatcher.New(a.Logger, notificationUsecase, notificationInterval).Run(dispatcherCtx)
+
+	// deletion saga resumer
+	resumerCtx, stopResumer := context.WithCancel(context.Background())
+	a.stopResumer = stopResumer
+	go resumer.New(a.Logger, sagaUsecase, sagaInterval).Run(resumerCtx)
+
+	clientproto.RegisterClientServiceServer(a.GrpcServer, client_service_services.NewRPC(a.Logger, articleUsecase, notificationUsecase, sagaUsecase, &a.ServiceClients))
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -102,6 +199,31 @@ func (a *App) Run() error {
 }
 
 func (a *App) Stop() {
+	// stop change feed scheduler
+	if a.stopScheduler != nil {
+		a.stopScheduler()
+	}
+	// stop listening for client changes
+	if a.stopListener != nil {
+		a.stopListener()
+	}
+	// stop outbox relay, the events it was publishing stay pending
+	if a.stopRelay != nil {
+		a.stopRelay()
+	}
+	// stop notification dispatcher, the notifications it was sending are taken again after their lease
+	if a.stopDispatcher != nil {
+		a.stopDispatcher()
+	}
+	// stop saga resumer, the sagas it was running are taken again after their lease
+	if a.stopResumer != nil {
+		a.stopResumer()
+	}
+	if a.Broker != nil {
+		if err := a.Broker.Close(); err != nil {
+			a.Logger.Error("close broker", zap.Error(err))
+		}
+	}
 	// closing client service connections
 	a.ServiceClients.Close()
 	// stop gRPC server
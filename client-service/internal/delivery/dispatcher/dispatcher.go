@@ -0,0 +1,48 @@
+package dispatcher
+
+import (
+	"client-service/internal/usecase"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Dispatcher sends the due notifications of the queue, again right away while there are any and
+// every interval otherwise
+type Dispatcher struct {
+	logger              *zap.Logger
+	notificationUsecase usecase.Notification
+	interval            time.Duration
+}
+
+func New(logger *zap.Logger, notificationUsecase usecase.Notification, interval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		logger:              logger,
+		notificationUsecase: notificationUsecase,
+		interval:            interval,
+	}
+}
+
+// Run blocks until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		wait := d.interval
+		sent, failed, err := d.notificationUsecase.DeliverDueNotifications(ctx)
+		if err != nil {
+			d.logger.Error("dispatcher: send notifications", zap.Error(err))
+		}
+		if sent != 0 || failed != 0 {
+			d.logger.Info("dispatcher: notifications sent", zap.Uint64("sent", sent), zap.Uint64("failed", failed))
+			wait = 0
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
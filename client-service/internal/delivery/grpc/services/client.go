@@ -2,12 +2,14 @@ package services
 
 import (
 	clientproto "client-service/genproto/client_service"
+	jobproto "client-service/genproto/job_service"
 	"client-service/internal/entity"
 	"client-service/internal/infrastructure/grpc_service_clients"
 	"client-service/internal/pkg/otlp"
 	"client-service/internal/usecase"
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -15,16 +17,20 @@ import (
 )
 
 type clientRPC struct {
-	logger        *zap.Logger
-	clientUsecase usecase.Client
-	clients       grpc_service_clients.ServiceClients
+	logger              *zap.Logger
+	clientUsecase       usecase.Client
+	notificationUsecase usecase.Notification
+	sagaUsecase         usecase.Saga
+	clients             grpc_service_clients.ServiceClients
 }
 
-func NewRPC(logger *zap.Logger, clientUsecase usecase.Client, services *grpc_service_clients.ServiceClients) clientproto.ClientServiceServer {
+func NewRPC(logger *zap.Logger, clientUsecase usecase.Client, notificationUsecase usecase.Notification, sagaUsecase usecase.Saga, services *grpc_service_clients.ServiceClients) clientproto.ClientServiceServer {
 	return &clientRPC{
-		logger:        logger,
-		clientUsecase: clientUsecase,
-		clients:       *services,
+		logger:              logger,
+		clientUsecase:       clientUsecase,
+		notificationUsecase: notificationUsecase,
+		sagaUsecase:         sagaUsecase,
+		clients:             *services,
 	}
 }
 
@@ -110,7 +116,8 @@ func (s clientRPC) DeleteClient(ctx context.Context, in *clientproto.ClientWithG
 	)
 	defer span.End()
 
-	err := s.clientUsecase.DeleteClient(ctx, in.Guid)
+	// the assignments of the client in job-service are ended first, see usecase.Saga
+	err := s.sagaUsecase.DeleteClient(ctx, in.Guid)
 	if err != nil {
 		s.logger.Error(err.Error())
 		return &clientproto.DeleteClientResponse{Status: false}, err
@@ -151,6 +158,43 @@ func (s clientRPC) GetClient(ctx context.Context, in *clientproto.ClientWithGUID
 	}, nil
 }
 
+func (s clientRPC) GetClientsByIDs(ctx context.Context, in *clientproto.ClientsByIDsRequest) (*clientproto.ClientsByIDsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetClientsByIDs")
+	span.SetAttributes(
+		attribute.Key("ids").Int(len(in.Ids)),
+	)
+	defer span.End()
+
+	clients, missing, err := s.clientUsecase.GetClientsByIDs(ctx, in.Ids)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	response := clientproto.ClientsByIDsResponse{
+		MissingIds: missing,
+	}
+	for _, client := range clients {
+		response.Clients = append(response.Clients, &clientproto.Client{
+			Id:          client.GUID,
+			FirstName:   client.FirstName,
+			LastName:    client.LastName,
+			Age:         uint32(client.Age),
+			Gender:      client.Gender,
+			PhoneNumber: client.PhoneNumber,
+			Address:     client.Address,
+			Email:       client.Email,
+			Password:    client.Password,
+			Status:      client.Status,
+			Refresh:     client.Refresh,
+			CreatedAt:   client.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   client.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &response, nil
+}
+
 func (s clientRPC) GetAllClients(ctx context.Context, in *clientproto.ListRequest) (*clientproto.ListClientResponse, error) {
 	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ListClients")
 	span.SetAttributes(
@@ -333,3 +377,344 @@ func (s clientRPC) UpdatePassword(ctx context.Context, in *clientproto.UpdatePas
 
 	return &clientproto.ResponseStatus{Status: true}, nil
 }
+
+func (s clientRPC) BatchCreateClients(ctx context.Context, in *clientproto.BatchCreateClientsRequest) (*clientproto.BatchCreateResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "BatchCreateClients")
+	span.SetAttributes(
+		attribute.Key("count").Int(len(in.Clients)),
+	)
+	defer span.End()
+
+	clients := make([]*entity.Client, 0, len(in.Clients))
+	for _, client := range in.Clients {
+		clients = append(clients, &entity.Client{
+			FirstName:   client.FirstName,
+			LastName:    client.LastName,
+			Age:         uint64(client.Age),
+			Gender:      client.Gender,
+			PhoneNumber: client.PhoneNumber,
+			Address:     client.Address,
+			Email:       client.Email,
+			Password:    client.Password,
+			Status:      client.Status,
+			Refresh:     client.Refresh,
+		})
+	}
+
+	results, err := s.clientUsecase.BatchCreateClients(ctx, clients)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response clientproto.BatchCreateResponse
+	for _, result := range results {
+		response.Results = append(response.Results, &clientproto.BatchItemResult{
+			Index: result.Index,
+			Id:    result.GUID,
+			Error: result.Error,
+		})
+	}
+
+	return &response, nil
+}
+
+func (s clientRPC) StreamClients(in *clientproto.StreamClientsRequest, stream clientproto.ClientService_StreamClientsServer) error {
+	ctx, span := otlp.Start(stream.Context(), "user_grpc-delivery", "StreamClients")
+	span.SetAttributes(
+		attribute.Key("scope").String(in.Scope),
+	)
+	defer span.End()
+
+	err := s.clientUsecase.StreamClients(ctx, in.Scope, map[string]string{
+		"gender":          in.Gender,
+		"age_from":        in.AgeFrom,
+		"age_to":          in.AgeTo,
+		"skills":          strings.Join(in.Skills, ","),
+		"level":           in.Level,
+		"location_type":   in.LocationType,
+		"employment_type": in.EmploymentType,
+		"salary_from":     in.SalaryFrom,
+		"salary_to":       in.SalaryTo,
+		"currency":        in.Currency,
+		"pay_period":      in.PayPeriod,
+	}, func(client *entity.Client) error {
+		response := &clientproto.Client{
+			Id:          client.GUID,
+			FirstName:   client.FirstName,
+			LastName:    client.LastName,
+			Age:         uint32(client.Age),
+			Gender:      client.Gender,
+			PhoneNumber: client.PhoneNumber,
+			Address:     client.Address,
+			Email:       client.Email,
+			Status:      client.Status,
+			CreatedAt:   client.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   client.UpdatedAt.Format(time.RFC3339),
+		}
+		if !client.DeletedAt.IsZero() {
+			response.DeletedAt = client.DeletedAt.Format(time.RFC3339)
+		}
+
+		return stream.Send(response)
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// WatchClients streams the client changes until the client goes away
+func (s clientRPC) WatchClients(in *clientproto.WatchClientsRequest, stream clientproto.ClientService_WatchClientsServer) error {
+	ctx, span := otlp.Start(stream.Context(), "user_grpc-delivery", "WatchClients")
+	span.SetAttributes(
+		attribute.Key("cursor").Int64(int64(in.Cursor)),
+	)
+	defer span.End()
+
+	err := s.clientUsecase.WatchClients(ctx, in.Cursor, map[string]string{
+		"actions":   strings.Join(in.Actions, ","),
+		"client_id": in.ClientId,
+	}, func(change *entity.ClientChange) error {
+		response := &clientproto.ClientChange{
+			Cursor:    change.Cursor,
+			Action:    change.Action,
+			ClientId:  change.ClientID,
+			ChangedAt: change.ChangedAt.Format(time.RFC3339),
+		}
+		if client := change.Client; client != nil {
+			response.Client = &clientproto.Client{
+				Id:          client.GUID,
+				FirstName:   client.FirstName,
+				LastName:    client.LastName,
+				Age:         uint32(client.Age),
+				Gender:      client.Gender,
+				PhoneNumber: client.PhoneNumber,
+				Address:     client.Address,
+				Email:       client.Email,
+				Status:      client.Status,
+				CreatedAt:   client.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:   client.UpdatedAt.Format(time.RFC3339),
+			}
+		}
+		return stream.Send(response)
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s clientRPC) HideClient(ctx context.Context, in *clientproto.ClientStatusRequest) (*clientproto.ClientStatusChange, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "HideClient")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+		attribute.Key("actor").String(in.Actor),
+	)
+	defer span.End()
+
+	change, err := s.clientUsecase.HideClient(ctx, &entity.ClientStatusChange{
+		ClientID: in.ClientId,
+		Reason:   in.Reason,
+		Actor:    in.Actor,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return clientStatusChangeToProto(change), nil
+}
+
+func (s clientRPC) UnhideClient(ctx context.Context, in *clientproto.ClientStatusRequest) (*clientproto.ClientStatusChange, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UnhideClient")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+		attribute.Key("actor").String(in.Actor),
+	)
+	defer span.End()
+
+	change, err := s.clientUsecase.UnhideClient(ctx, &entity.ClientStatusChange{
+		ClientID: in.ClientId,
+		Reason:   in.Reason,
+		Actor:    in.Actor,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return clientStatusChangeToProto(change), nil
+}
+
+func (s clientRPC) GetClientStatusHistory(ctx context.Context, in *clientproto.ClientWithGUID) (*clientproto.ListClientStatusHistory, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetClientStatusHistory")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Guid),
+	)
+	defer span.End()
+
+	changes, err := s.clientUsecase.GetClientStatusHistory(ctx, in.Guid)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response clientproto.ListClientStatusHistory
+	for _, change := range changes {
+		response.Changes = append(response.Changes, clientStatusChangeToProto(change))
+	}
+
+	return &response, nil
+}
+
+func clientStatusChangeToProto(change *entity.ClientStatusChange) *clientproto.ClientStatusChange {
+	return &clientproto.ClientStatusChange{
+		Id:        change.GUID,
+		ClientId:  change.ClientID,
+		Status:    change.Status,
+		Reason:    change.Reason,
+		Actor:     change.Actor,
+		CreatedAt: change.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (s clientRPC) FindDuplicates(ctx context.Context, in *clientproto.DuplicateScanRequest) (*clientproto.DuplicateScanResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "FindDuplicates")
+	defer span.End()
+
+	found, err := s.clientUsecase.FindDuplicates(ctx)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &clientproto.DuplicateScanResponse{Found: found}, nil
+}
+
+func (s clientRPC) GetDuplicates(ctx context.Context, in *clientproto.DuplicateListRequest) (*clientproto.ListClientDuplicates, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetDuplicates")
+	span.SetAttributes(
+		attribute.Key("status").String(in.Status),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+	if offset < 0 {
+		offset = 0
+	}
+
+	duplicates, err := s.clientUsecase.GetDuplicates(ctx, uint64(in.Limit), uint64(offset), in.Status)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response clientproto.ListClientDuplicates
+	for _, duplicate := range duplicates {
+		response.Duplicates = append(response.Duplicates, &clientproto.ClientDuplicate{
+			Id: duplicate.GUID,
+			Client: &clientproto.Client{
+				Id:          duplicate.Client.GUID,
+				FirstName:   duplicate.Client.FirstName,
+				LastName:    duplicate.Client.LastName,
+				Email:       duplicate.Client.Email,
+				PhoneNumber: duplicate.Client.PhoneNumber,
+			},
+			Duplicate: &clientproto.Client{
+				Id:          duplicate.Duplicate.GUID,
+				FirstName:   duplicate.Duplicate.FirstName,
+				LastName:    duplicate.Duplicate.LastName,
+				Email:       duplicate.Duplicate.Email,
+				PhoneNumber: duplicate.Duplicate.PhoneNumber,
+			},
+			Score:      duplicate.Score,
+			Reasons:    duplicate.Reasons,
+			Status:     duplicate.Status,
+			ResolvedBy: duplicate.ResolvedBy,
+			CreatedAt:  duplicate.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:  duplicate.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &response, nil
+}
+
+func (s clientRPC) DismissDuplicate(ctx context.Context, in *clientproto.ResolveDuplicateRequest) (*clientproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "DismissDuplicate")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	if err := s.clientUsecase.DismissDuplicate(ctx, in.Id, in.Actor); err != nil {
+		s.logger.Error(err.Error())
+		return &clientproto.ResponseStatus{Status: false}, err
+	}
+
+	return &clientproto.ResponseStatus{Status: true}, nil
+}
+
+// MergeClients checks both clients, moves client_jobs of the merged client in job-service, then
+// soft-deletes it.
+// Moving is idempotent and undone when the soft delete fails, so a failed merge can be retried.
+func (s clientRPC) MergeClients(ctx context.Context, in *clientproto.MergeClientsRequest) (*clientproto.Client, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "MergeClients")
+	span.SetAttributes(
+		attribute.Key("keep_id").String(in.KeepId),
+		attribute.Key("merge_id").String(in.MergeId),
+	)
+	defer span.End()
+
+	err := s.clientUsecase.MergeClients(ctx, &entity.MergeClients{
+		KeepID:  in.KeepId,
+		MergeID: in.MergeId,
+		Actor:   in.Actor,
+	}, func(ctx context.Context) (func(ctx context.Context) error, error) {
+		moved, err := s.clients.JobService().ReassignClientJobs(ctx, &jobproto.ReassignClientJobsRequest{
+			FromClientId: in.MergeId,
+			ToClientId:   in.KeepId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return func(ctx context.Context) error {
+			_, err := s.clients.JobService().UndoReassignClientJobs(ctx, &jobproto.UndoReassignClientJobsRequest{
+				FromClientId: in.MergeId,
+				ToClientId:   in.KeepId,
+				MovedIds:     moved.MovedIds,
+				EndedIds:     moved.EndedIds,
+			})
+			return err
+		}, nil
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	keep, err := s.clientUsecase.GetClient(ctx, map[string]string{"id": in.KeepId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &clientproto.Client{
+		Id:          keep.GUID,
+		FirstName:   keep.FirstName,
+		LastName:    keep.LastName,
+		Age:         uint32(keep.Age),
+		Gender:      keep.Gender,
+		PhoneNumber: keep.PhoneNumber,
+		Address:     keep.Address,
+		Email:       keep.Email,
+		Status:      keep.Status,
+		CreatedAt:   keep.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   keep.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
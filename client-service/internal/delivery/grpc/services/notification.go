@@ -0,0 +1,205 @@
+package services
+
+import (
+	clientproto "client-service/genproto/client_service"
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s clientRPC) SendNotification(ctx context.Context, in *clientproto.NotificationRequest) (*clientproto.NotificationResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "SendNotification")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+		attribute.Key("template").String(in.Template),
+	)
+	defer span.End()
+
+	var data map[string]any
+	if in.Data != "" {
+		if err := json.Unmarshal([]byte(in.Data), &data); err != nil {
+			return nil, fmt.Errorf("data should be a JSON object: %w", err)
+		}
+	}
+
+	notifications, err := s.notificationUsecase.Notify(ctx, &entity.NotificationRequest{
+		ClientID: in.ClientId,
+		Template: in.Template,
+		Data:     data,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response clientproto.NotificationResponse
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, notificationToProto(notification))
+	}
+
+	return &response, nil
+}
+
+func (s clientRPC) GetNotifications(ctx context.Context, in *clientproto.ListNotificationsRequest) (*clientproto.ListNotificationsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetNotifications")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+	if offset < 0 {
+		offset = 0
+	}
+
+	notifications, err := s.notificationUsecase.GetNotifications(ctx, uint64(in.Limit), uint64(offset), map[string]string{
+		"client_id": in.ClientId,
+		"channel":   in.Channel,
+		"template":  in.Template,
+		"status":    in.Status,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response clientproto.ListNotificationsResponse
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, notificationToProto(notification))
+	}
+
+	return &response, nil
+}
+
+func (s clientRPC) GetNotificationPreferences(ctx context.Context, in *clientproto.ClientWithGUID) (*clientproto.NotificationPreferences, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetNotificationPreferences")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Guid),
+	)
+	defer span.End()
+
+	preferences, err := s.notificationUsecase.GetNotificationPreferences(ctx, in.Guid)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return preferencesToProto(preferences), nil
+}
+
+func (s clientRPC) UpdateNotificationPreferences(ctx context.Context, in *clientproto.NotificationPreferences) (*clientproto.NotificationPreferences, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpdateNotificationPreferences")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+	)
+	defer span.End()
+
+	preferences, err := s.notificationUsecase.UpdateNotificationPreferences(ctx, &entity.NotificationPreferences{
+		ClientID:  in.ClientId,
+		Locale:    in.Locale,
+		Email:     in.Email,
+		SMS:       in.Sms,
+		JobAlerts: in.JobAlerts,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return preferencesToProto(preferences), nil
+}
+
+func (s clientRPC) RequestEmailVerification(ctx context.Context, in *clientproto.ClientWithGUID) (*clientproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "RequestEmailVerification")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Guid),
+	)
+	defer span.End()
+
+	if err := s.notificationUsecase.RequestEmailVerification(ctx, in.Guid); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &clientproto.ResponseStatus{Status: true}, nil
+}
+
+func (s clientRPC) VerifyEmail(ctx context.Context, in *clientproto.VerifyEmailRequest) (*clientproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "VerifyEmail")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+	)
+	defer span.End()
+
+	if err := s.notificationUsecase.VerifyEmail(ctx, in.ClientId, in.Code); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &clientproto.ResponseStatus{Status: true}, nil
+}
+
+func (s clientRPC) RequestPasswordReset(ctx context.Context, in *clientproto.PasswordResetRequest) (*clientproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "RequestPasswordReset")
+	defer span.End()
+
+	if err := s.notificationUsecase.RequestPasswordReset(ctx, in.Email); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &clientproto.ResponseStatus{Status: true}, nil
+}
+
+func (s clientRPC) ResetPassword(ctx context.Context, in *clientproto.ResetPasswordRequest) (*clientproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ResetPassword")
+	defer span.End()
+
+	if err := s.notificationUsecase.ResetPassword(ctx, in.Email, in.Code, in.Password); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &clientproto.ResponseStatus{Status: true}, nil
+}
+
+func notificationToProto(notification *entity.Notification) *clientproto.Notification {
+	response := &clientproto.Notification{
+		Id:            notification.GUID,
+		ClientId:      notification.ClientID,
+		Channel:       notification.Channel,
+		Template:      notification.Template,
+		Locale:        notification.Locale,
+		To:            notification.To,
+		Subject:       notification.Subject,
+		Body:          notification.Body,
+		Html:          notification.HTML,
+		Status:        notification.Status,
+		Attempts:      int64(notification.Attempts),
+		NextAttemptAt: notification.NextAttemptAt.Format(time.RFC3339),
+		LastError:     notification.LastError,
+		CreatedAt:     notification.CreatedAt.Format(time.RFC3339),
+	}
+	if !notification.SentAt.IsZero() {
+		response.SentAt = notification.SentAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+func preferencesToProto(preferences *entity.NotificationPreferences) *clientproto.NotificationPreferences {
+	return &clientproto.NotificationPreferences{
+		ClientId:      preferences.ClientID,
+		Locale:        preferences.Locale,
+		Email:         preferences.Email,
+		Sms:           preferences.SMS,
+		JobAlerts:     preferences.JobAlerts,
+		EmailVerified: preferences.EmailVerified,
+		UpdatedAt:     preferences.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	clientproto "client-service/genproto/client_service"
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s clientRPC) UpsertClientProfile(ctx context.Context, in *clientproto.ClientProfile) (*clientproto.ClientProfile, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpsertClientProfile")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+	)
+	defer span.End()
+
+	profile, err := s.clientUsecase.UpsertClientProfile(ctx, &entity.ClientProfile{
+		ClientID:              in.ClientId,
+		Skills:                in.Skills,
+		DesiredLevel:          in.DesiredLevel,
+		DesiredLocationType:   in.DesiredLocationType,
+		DesiredEmploymentType: in.DesiredEmploymentType,
+		ExpectedSalary:        in.ExpectedSalary,
+		ExpectedCurrency:      in.ExpectedCurrency,
+		ExpectedPayPeriod:     in.ExpectedPayPeriod,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return profileToProto(profile), nil
+}
+
+func (s clientRPC) GetClientProfile(ctx context.Context, in *clientproto.ClientWithGUID) (*clientproto.ClientProfile, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetClientProfile")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Guid),
+	)
+	defer span.End()
+
+	profile, err := s.clientUsecase.GetClientProfile(ctx, in.Guid)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return profileToProto(profile), nil
+}
+
+func (s clientRPC) GetClientProfiles(ctx context.Context, in *clientproto.ListClientProfilesRequest) (*clientproto.ListClientProfilesResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetClientProfiles")
+	span.SetAttributes(
+		attribute.Key("skills").StringSlice(in.Skills),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+	if offset < 0 {
+		offset = 0
+	}
+
+	profiles, err := s.clientUsecase.GetClientProfiles(ctx, uint64(in.Limit), uint64(offset), in.Skills)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response clientproto.ListClientProfilesResponse
+	for _, profile := range profiles {
+		response.Profiles = append(response.Profiles, profileToProto(profile))
+	}
+
+	return &response, nil
+}
+
+func profileToProto(profile *entity.ClientProfile) *clientproto.ClientProfile {
+	response := &clientproto.ClientProfile{
+		ClientId:              profile.ClientID,
+		FirstName:             profile.FirstName,
+		LastName:              profile.LastName,
+		Skills:                profile.Skills,
+		DesiredLevel:          profile.DesiredLevel,
+		DesiredLocationType:   profile.DesiredLocationType,
+		DesiredEmploymentType: profile.DesiredEmploymentType,
+		ExpectedSalary:        profile.ExpectedSalary,
+		ExpectedCurrency:      profile.ExpectedCurrency,
+		ExpectedPayPeriod:     profile.ExpectedPayPeriod,
+	}
+	if !profile.UpdatedAt.IsZero() {
+		response.UpdatedAt = profile.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
@@ -0,0 +1,178 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/broker"
+	"client-service/internal/infrastructure/repository/postgresql"
+	"client-service/internal/pkg/postgres"
+	"client-service/internal/usecase"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.uber.org/zap"
+)
+
+type relayResult struct {
+	published uint64
+	err       error
+}
+
+// scriptedOutbox answers the passes of the relay with results, then stops the relay
+type scriptedOutbox struct {
+	results []relayResult
+	calls   []time.Time
+	stop    context.CancelFunc
+}
+
+func (o *scriptedOutbox) RelayEvents(context.Context) (uint64, error) {
+	o.calls = append(o.calls, time.Now())
+	if len(o.calls) > len(o.results) {
+		o.stop()
+		return 0, nil
+	}
+	result := o.results[len(o.calls)-1]
+	return result.published, result.err
+}
+
+func (o *scriptedOutbox) PruneEvents(context.Context, time.Duration) (uint64, error) {
+	return 0, nil
+}
+
+func TestRunBacksOff(t *testing.T) {
+	const interval = 100 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errBroker := errors.New("broker is down")
+	outbox := &scriptedOutbox{
+		results: []relayResult{
+			{published: 2},
+			{err: errBroker},
+			{err: errBroker},
+			{published: 1},
+			{},
+		},
+		stop: cancel,
+	}
+	New(zap.NewNop(), outbox, interval, time.Hour).Run(ctx)
+
+	if len(outbox.calls) != len(outbox.results)+1 {
+		t.Fatalf("%d passes, want %d", len(outbox.calls), len(outbox.results)+1)
+	}
+	gap := func(i int) time.Duration {
+		return outbox.calls[i+1].Sub(outbox.calls[i])
+	}
+	// published events are followed right away, failures by twice the wait before
+	if gap(0) >= interval/2 {
+		t.Errorf("pass after published events came after %v, want right away", gap(0))
+	}
+	if gap(1) < interval {
+		t.Errorf("pass after the first failure came after %v, want %v", gap(1), interval)
+	}
+	if gap(2) < 2*interval {
+		t.Errorf("pass after the second failure came after %v, want %v", gap(2), 2*interval)
+	}
+	if gap(3) >= interval/2 {
+		t.Errorf("pass after a recovery came after %v, want right away", gap(3))
+	}
+	if gap(4) < interval {
+		t.Errorf("pass after an empty outbox came after %v, want %v", gap(4), interval)
+	}
+}
+
+// flakyBroker fails the first publish of an event type, the other events go to the memory broker
+type flakyBroker struct {
+	*broker.Memory
+	mu     sync.Mutex
+	failOn string
+}
+
+func (b *flakyBroker) Publish(ctx context.Context, event *entity.Event) error {
+	b.mu.Lock()
+	fail := event.Type == b.failOn
+	if fail {
+		b.failOn = ""
+	}
+	b.mu.Unlock()
+
+	if fail {
+		return errors.New("broker is down")
+	}
+	return b.Memory.Publish(ctx, event)
+}
+
+// TestRelayKeepsKeyOrder runs the relay over the outbox of TEST_DATABASE_URL, a database with
+// the migrations applied, it's skipped without one
+func TestRelayKeepsKeyOrder(t *testing.T) {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	pool, err := pgxpool.Connect(context.Background(), url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	db := &postgres.PostgresDB{Pool: pool, Sq: *postgres.NewSquirrel()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	keys := []string{uuid.NewString(), uuid.NewString()}
+	t.Cleanup(func() {
+		if _, err := db.Exec(context.Background(), `DELETE FROM outbox WHERE key = ANY($1)`, keys); err != nil {
+			t.Error(err)
+		}
+	})
+	types := []string{"test.0", "test.1", "test.2", "test.3"}
+	for i, eventType := range types {
+		if _, err = db.Exec(ctx,
+			`INSERT INTO outbox (event_id, source, topic, type, key, payload) VALUES ($1, 'client-service', 'test', $2, $3, '{}')`,
+			uuid.NewString(), eventType, keys[i%2],
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	events := &flakyBroker{Memory: broker.NewMemory(), failOn: "test.1"}
+	outbox := usecase.NewOutboxService(time.Second, postgresql.NewOutboxRepo(db), events, 100)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		New(zap.NewNop(), outbox, 10*time.Millisecond, time.Hour).Run(ctx)
+	}()
+
+	var published []string
+	for ctx.Err() == nil {
+		published = published[:0]
+		for _, event := range events.Published() {
+			if event.Key == keys[0] || event.Key == keys[1] {
+				published = append(published, event.Type)
+			}
+		}
+		if len(published) >= len(types) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	// the failed test.1 held back everything after it, so each key kept its order
+	if len(published) != len(types) {
+		t.Fatalf("published %v, want %v", published, types)
+	}
+	for i, eventType := range types {
+		if published[i] != eventType {
+			t.Errorf("published %v, want %v", published, types)
+			break
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package resumer
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/usecase"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Resumer goes on with the sagas which wait for a retry or whose instance stopped while running
+// them, every interval
+type Resumer struct {
+	logger      *zap.Logger
+	sagaUsecase usecase.Saga
+	interval    time.Duration
+}
+
+func New(logger *zap.Logger, sagaUsecase usecase.Saga, interval time.Duration) *Resumer {
+	return &Resumer{
+		logger:      logger,
+		sagaUsecase: sagaUsecase,
+		interval:    interval,
+	}
+}
+
+// Run blocks until ctx is done, the first pass runs right away
+func (r *Resumer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.resume(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Resumer) resume(ctx context.Context) {
+	sagas, err := r.sagaUsecase.ResumeSagas(ctx)
+	if err != nil {
+		r.logger.Error("resumer: resume sagas", zap.Error(err))
+		return
+	}
+
+	for _, saga := range sagas {
+		fields := []zap.Field{
+			zap.String("saga", saga.GUID),
+			zap.String("kind", saga.Kind),
+			zap.String("entity_id", saga.EntityID),
+			zap.String("status", saga.Status),
+		}
+		switch saga.Status {
+		case entity.SagaFailed:
+			r.logger.Error("resumer: saga failed", append(fields, zap.String("error", saga.LastError))...)
+		case entity.SagaCompensated:
+			r.logger.Warn("resumer: saga compensated", append(fields, zap.String("error", saga.LastError))...)
+		default:
+			r.logger.Info("resumer: saga resumed", fields...)
+		}
+	}
+}
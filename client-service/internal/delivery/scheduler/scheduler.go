@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"client-service/internal/usecase"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Scheduler prunes the client change feed every interval
+type Scheduler struct {
+	logger          *zap.Logger
+	clientUsecase   usecase.Client
+	interval        time.Duration
+	changeRetention time.Duration
+}
+
+func New(logger *zap.Logger, clientUsecase usecase.Client, interval, changeRetention time.Duration) *Scheduler {
+	return &Scheduler{
+		logger:          logger,
+		clientUsecase:   clientUsecase,
+		interval:        interval,
+		changeRetention: changeRetention,
+	}
+}
+
+// Run blocks until ctx is done, the first pass runs right away
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	pruned, err := s.clientUsecase.PruneClientChanges(ctx, s.changeRetention)
+	if err != nil {
+		s.logger.Error("scheduler: prune client changes", zap.Error(err))
+	} else if pruned != 0 {
+		s.logger.Info("scheduler: client changes pruned", zap.Uint64("pruned", pruned))
+	}
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// actions of the change feed
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// ClientChange is an entry of the client change feed, Cursor orders the entries
+type ClientChange struct {
+	Cursor    uint64
+	ClientID  string
+	Action    string
+	ChangedAt time.Time
+	// the client as it is now, nil for deletions and clients deleted since
+	Client *Client
+}
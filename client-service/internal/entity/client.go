@@ -36,3 +36,67 @@ type UpdatePassword struct {
 type Response struct {
 	Status bool
 }
+
+// ClientStatusChange is a row of client_status_history, Status false means the client is hidden
+type ClientStatusChange struct {
+	GUID      string
+	ClientID  string
+	Status    bool
+	Reason    string
+	Actor     string
+	CreatedAt time.Time
+}
+
+const (
+	DuplicateStatusPending   = "pending"
+	DuplicateStatusMerged    = "merged"
+	DuplicateStatusDismissed = "dismissed"
+)
+
+// ClientDuplicate is a pair from the review queue, Score is between 0 and 1
+type ClientDuplicate struct {
+	GUID       string
+	Client     Client
+	Duplicate  Client
+	Score      float64
+	Reasons    []string
+	Status     string
+	ResolvedBy string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type MergeClients struct {
+	KeepID  string
+	MergeID string
+	Actor   string
+}
+
+type BatchResult struct {
+	Index uint64
+	GUID  string
+	Error string
+}
+
+// scopes of a client export, they match the list endpoints
+const (
+	ClientScopeActive  = "active"
+	ClientScopeDeleted = "deleted"
+	ClientScopeHidden  = "hidden"
+)
+
+// ClientProfile is what a client looks for in a job, an empty field is no preference
+type ClientProfile struct {
+	ClientID              string
+	FirstName             string
+	LastName              string
+	Skills                []string
+	DesiredLevel          string
+	DesiredLocationType   string
+	DesiredEmploymentType string
+	ExpectedSalary        string
+	ExpectedCurrency      string
+	ExpectedPayPeriod     string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
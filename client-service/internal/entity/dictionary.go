@@ -0,0 +1,18 @@
+package entity
+
+// names of the job dictionaries of job-service, the same as the job fields they hold the values of
+const (
+	DictionaryLevel          = "level"
+	DictionaryLocationType   = "location_type"
+	DictionaryEmploymentType = "employment_type"
+)
+
+// DictionaryEntry is an allowed value of a job field, Code is what jobs and profiles store
+type DictionaryEntry struct {
+	Code string
+	// by language, e.g. "en", "ru", "uz"
+	Labels map[string]string
+}
+
+// Dictionaries are the entries of every dictionary by its name
+type Dictionaries map[string][]*DictionaryEntry
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -8,6 +9,8 @@ import (
 var (
 	ErrorConflict = NewErrConflict("object")
 	ErrorNotFound = NewErrNotFound("object")
+
+	ErrorStatusUnchanged = errors.New("client already has this visibility status")
 )
 
 // error not found
@@ -36,6 +39,19 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error of a rule the current state of an object breaks, e.g. a pruned change feed
+type ErrPrecondition struct {
+	reason string
+}
+
+func (e *ErrPrecondition) Error() string {
+	return e.reason
+}
+
+func NewErrPrecondition(reason string) *ErrPrecondition {
+	return &ErrPrecondition{reason}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
@@ -69,3 +85,16 @@ func (e ErrNoRequiredParameter) Error() string {
 
 	return fmt.Sprintf("could not find required parameter(s): %s", str.String())
 }
+
+// error of a saga waiting to retry a step, it's finished in background
+type ErrPending struct {
+	reason string
+}
+
+func (e *ErrPending) Error() string {
+	return e.reason
+}
+
+func NewErrPending(reason string) *ErrPending {
+	return &ErrPending{reason: reason}
+}
@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// topic of the client events, the events of a client are delivered in order
+const TopicClients = "clients"
+
+// types of the domain events
+const (
+	EventClientCreated  = "ClientCreated"
+	EventClientUpdated  = "ClientUpdated"
+	EventClientDeleted  = "ClientDeleted"
+	EventClientHidden   = "ClientHidden"
+	EventClientUnhidden = "ClientUnhidden"
+	EventClientsMerged  = "ClientsMerged"
+)
+
+// Event is a domain event of the outbox, Data is marshalled to the JSON Payload when it's written
+type Event struct {
+	ID        uint64
+	GUID      string
+	Topic     string
+	Type      string
+	Key       string
+	Data      any
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// ClientEvent is the payload of the client events
+type ClientEvent struct {
+	ClientID   string    `json:"client_id"`
+	FirstName  string    `json:"first_name,omitempty"`
+	LastName   string    `json:"last_name,omitempty"`
+	Email      string    `json:"email,omitempty"`
+	Status     bool      `json:"status"`
+	Reason     string    `json:"reason,omitempty"`
+	MergedInto string    `json:"merged_into,omitempty"`
+	At         time.Time `json:"at"`
+}
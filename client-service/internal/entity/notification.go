@@ -0,0 +1,86 @@
+package entity
+
+import "time"
+
+const (
+	NotificationChannelEmail = "email"
+	NotificationChannelSMS   = "sms"
+
+	NotificationPending = "pending"
+	NotificationSent    = "sent"
+	NotificationDead    = "dead"
+
+	TemplateVerification  = "verification"
+	TemplatePasswordReset = "password_reset"
+	TemplateJobAlert      = "job_alert"
+
+	CodePurposeVerifyEmail   = "verify_email"
+	CodePurposeResetPassword = "reset_password"
+
+	DefaultLocale = "en"
+)
+
+// NotificationLocales are the locales with templates, other locales get the default one
+var NotificationLocales = []string{"en", "ru", "uz"}
+
+// NotificationTemplates are the templates other services can send through SendNotification,
+// the codes are sent by client-service only
+var NotificationTemplates = []string{TemplateJobAlert}
+
+// Notification is a rendered message of the queue, To is an email or a phone number
+type Notification struct {
+	GUID          string
+	ClientID      string
+	Channel       string
+	Template      string
+	Locale        string
+	To            string
+	Subject       string
+	Body          string
+	HTML          string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	SentAt        time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NotificationRequest asks for the template to be rendered with Data and queued on the
+// channels the client chose
+type NotificationRequest struct {
+	ClientID string
+	Template string
+	Data     map[string]any
+}
+
+// Message is a template rendered for a channel, an SMS has only a Body
+type Message struct {
+	Subject string
+	Body    string
+	HTML    string
+}
+
+type NotificationPreferences struct {
+	ClientID      string
+	Locale        string
+	Email         bool
+	SMS           bool
+	JobAlerts     bool
+	EmailVerified bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// VerificationCode is a one time code sent to Destination, the code itself isn't kept
+type VerificationCode struct {
+	GUID        string
+	ClientID    string
+	Purpose     string
+	Destination string
+	CodeHash    string
+	Attempts    int
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
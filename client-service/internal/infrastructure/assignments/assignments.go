@@ -0,0 +1,67 @@
+package assignments
+
+import (
+	jobproto "client-service/genproto/job_service"
+	"client-service/internal/entity"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Assignments ends and reopens the assignments of a client in job-service on behalf of a saga,
+// job-service keeps what it changed under the id of the saga. Reopen leaves ended the
+// assignments overlapping ones made since and counts them in overlapping.
+type Assignments interface {
+	End(ctx context.Context, sagaID, clientID string) (uint64, error)
+	Reopen(ctx context.Context, sagaID string) (reopened, overlapping uint64, err error)
+}
+
+type assignments struct {
+	jobService jobproto.JobServiceClient
+}
+
+func New(jobService jobproto.JobServiceClient) Assignments {
+	return &assignments{
+		jobService: jobService,
+	}
+}
+
+func (a *assignments) End(ctx context.Context, sagaID, clientID string) (uint64, error) {
+	response, err := a.jobService.EndClientAssignments(ctx, &jobproto.EndClientAssignmentsRequest{
+		SagaId:   sagaID,
+		ClientId: clientID,
+	})
+	if err != nil {
+		return 0, entityError(err)
+	}
+	return response.Changed, nil
+}
+
+func (a *assignments) Reopen(ctx context.Context, sagaID string) (reopened, overlapping uint64, err error) {
+	response, err := a.jobService.ReopenAssignments(ctx, &jobproto.ReopenAssignmentsRequest{
+		SagaId: sagaID,
+	})
+	if err != nil {
+		return 0, 0, entityError(err)
+	}
+	return response.Changed, response.Overlapping, nil
+}
+
+// entityError turns the errors retrying can't fix into the entity ones, the rest such as an
+// unavailable job-service are returned as they are
+func entityError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return entity.NewErrNotFound(st.Message())
+	case codes.AlreadyExists:
+		return entity.NewErrConflict(st.Message())
+	case codes.FailedPrecondition, codes.InvalidArgument:
+		return entity.NewErrPrecondition(st.Message())
+	}
+	return err
+}
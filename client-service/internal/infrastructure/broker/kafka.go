@@ -0,0 +1,50 @@
+package broker
+
+import (
+	"client-service/internal/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type kafkaBroker struct {
+	writer *kafka.Writer
+	prefix string
+}
+
+// NewKafka writes the events to the topic prefix+topic, the events of a key land on one
+// partition and are acknowledged by all in sync replicas
+func NewKafka(brokers []string, prefix string) Broker {
+	return &kafkaBroker{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+		prefix: prefix,
+	}
+}
+
+func (k *kafkaBroker) Publish(ctx context.Context, event *entity.Event) error {
+	err := k.writer.WriteMessages(ctx, kafka.Message{
+		Topic: k.prefix + event.Topic,
+		Key:   []byte(event.Key),
+		Value: event.Payload,
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(event.GUID)},
+			{Key: "event_type", Value: []byte(event.Type)},
+		},
+		Time: event.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("write event %s to kafka: %w", event.GUID, err)
+	}
+	return nil
+}
+
+func (k *kafkaBroker) Close() error {
+	return k.writer.Close()
+}
@@ -0,0 +1,52 @@
+package broker
+
+import (
+	"client-service/internal/entity"
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestMemoryKeepsOrder(t *testing.T) {
+	memory := NewMemory()
+	for i := 0; i < 3; i++ {
+		if err := memory.Publish(context.Background(), &entity.Event{GUID: strconv.Itoa(i), Key: "k"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	published := memory.Published()
+	for i, event := range published {
+		if event.GUID != strconv.Itoa(i) {
+			t.Errorf("event %d = %s, want the events in publish order", i, event.GUID)
+		}
+	}
+
+	// the returned slice is a copy, changing it doesn't change the broker
+	published[0] = nil
+	if memory.Published()[0] == nil {
+		t.Error("Published returned the events of the broker, not a copy")
+	}
+	if err := memory.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMemoryConcurrentPublish(t *testing.T) {
+	memory := NewMemory()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = memory.Publish(context.Background(), &entity.Event{GUID: strconv.Itoa(i)})
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(memory.Published()); got != 50 {
+		t.Errorf("published %d events, want 50", got)
+	}
+}
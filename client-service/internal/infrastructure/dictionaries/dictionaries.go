@@ -0,0 +1,43 @@
+package dictionaries
+
+import (
+	jobproto "client-service/genproto/job_service"
+	"client-service/internal/entity"
+	"context"
+)
+
+// Dictionaries reads the allowed values of the job fields from job-service, which owns them
+type Dictionaries interface {
+	Get(ctx context.Context) (entity.Dictionaries, error)
+}
+
+type dictionaries struct {
+	jobService jobproto.JobServiceClient
+}
+
+func New(jobService jobproto.JobServiceClient) Dictionaries {
+	return &dictionaries{
+		jobService: jobService,
+	}
+}
+
+func (d *dictionaries) Get(ctx context.Context) (entity.Dictionaries, error) {
+	response, err := d.jobService.GetDictionaries(ctx, &jobproto.DictionariesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(entity.Dictionaries, len(response.Dictionaries))
+	for _, dictionary := range response.Dictionaries {
+		entries := make([]*entity.DictionaryEntry, 0, len(dictionary.Entries))
+		for _, entry := range dictionary.Entries {
+			entries = append(entries, &entity.DictionaryEntry{
+				Code:   entry.Code,
+				Labels: entry.Labels,
+			})
+		}
+		result[dictionary.Name] = entries
+	}
+
+	return result, nil
+}
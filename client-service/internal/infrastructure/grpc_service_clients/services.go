@@ -3,6 +3,7 @@ package grpc_service_clients
 import (
 	jobproto "client-service/genproto/job_service"
 	"client-service/internal/pkg/config"
+	"client-service/internal/pkg/principal"
 	"fmt"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -24,7 +25,7 @@ func New(config *config.Config) (ServiceClients, error) {
 	jobServiceConnection, err := grpc.Dial(
 		fmt.Sprintf("%s%s", config.JobService.Host, config.JobService.Port),
 		grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), principal.UnaryClientInterceptor()),
 		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	)
 	if err != nil {
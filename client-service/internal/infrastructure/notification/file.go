@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"client-service/internal/entity"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+type fileProvider struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFile appends every notification to the file at path as a JSON line, for development
+func NewFile(path string) Provider {
+	return &fileProvider{
+		path: path,
+	}
+}
+
+func (f *fileProvider) Send(_ context.Context, notification *entity.Notification) error {
+	line, err := json.Marshal(map[string]any{
+		"id":       notification.GUID,
+		"channel":  notification.Channel,
+		"template": notification.Template,
+		"to":       notification.To,
+		"subject":  notification.Subject,
+		"body":     notification.Body,
+		"html":     notification.HTML,
+		"sent_at":  time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"client-service/internal/entity"
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type logProvider struct {
+	logger *zap.Logger
+}
+
+// NewLog only logs the notifications, for development
+func NewLog(logger *zap.Logger) Provider {
+	return &logProvider{
+		logger: logger,
+	}
+}
+
+func (l *logProvider) Send(_ context.Context, notification *entity.Notification) error {
+	l.logger.Info("notification",
+		zap.String("channel", notification.Channel),
+		zap.String("template", notification.Template),
+		zap.String("to", notification.To),
+		zap.String("subject", notification.Subject),
+		zap.String("body", notification.Body),
+	)
+	return nil
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/pkg/config"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Provider sends the notifications of a channel, an error means the notification should be retried
+type Provider interface {
+	Send(ctx context.Context, notification *entity.Notification) error
+}
+
+// Providers picks the provider of a notification by its channel
+type Providers map[string]Provider
+
+func (p Providers) Send(ctx context.Context, notification *entity.Notification) error {
+	provider, ok := p[notification.Channel]
+	if !ok {
+		return fmt.Errorf("no provider for the %s channel", notification.Channel)
+	}
+	return provider.Send(ctx, notification)
+}
+
+// New picks the email provider by EMAIL_PROVIDER and the SMS one by SMS_PROVIDER
+func New(cfg *config.Config, logger *zap.Logger) (Providers, error) {
+	var (
+		providers = make(Providers, 2)
+		err       error
+	)
+
+	switch cfg.Notification.Email {
+	case "smtp":
+		smtp := cfg.Notification.SMTP
+		providers[entity.NotificationChannelEmail] = NewSMTP(smtp.Host, smtp.Port, smtp.User, smtp.Password, smtp.From)
+	default:
+		if providers[entity.NotificationChannelEmail], err = newDevProvider(cfg, logger, cfg.Notification.Email); err != nil {
+			return nil, fmt.Errorf("unknown email provider %q, should be smtp, file, log or memory", cfg.Notification.Email)
+		}
+	}
+
+	switch cfg.Notification.SMS {
+	case "http":
+		sms := cfg.Notification.SMSHTTP
+		timeout, err := time.ParseDuration(sms.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("error during parse duration for sms timeout : %w", err)
+		}
+		providers[entity.NotificationChannelSMS] = NewSMSHTTP(sms.URL, sms.Token, sms.From, timeout)
+	default:
+		if providers[entity.NotificationChannelSMS], err = newDevProvider(cfg, logger, cfg.Notification.SMS); err != nil {
+			return nil, fmt.Errorf("unknown sms provider %q, should be http, file, log or memory", cfg.Notification.SMS)
+		}
+	}
+
+	return providers, nil
+}
+
+// newDevProvider returns the providers that don't send anything, for development and tests
+func newDevProvider(cfg *config.Config, logger *zap.Logger, kind string) (Provider, error) {
+	switch kind {
+	case "file":
+		return NewFile(cfg.Notification.File), nil
+	case "log":
+		return NewLog(logger), nil
+	case "memory":
+		return NewMemory(), nil
+	}
+	return nil, fmt.Errorf("unknown provider %q", kind)
+}
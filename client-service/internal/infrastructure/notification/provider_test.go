@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"bufio"
+	"client-service/internal/entity"
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	provider := NewFile(path)
+
+	for _, guid := range []string{"n1", "n2"} {
+		if err := provider.Send(context.Background(), &entity.Notification{
+			GUID:    guid,
+			Channel: entity.NotificationChannelEmail,
+			To:      "ali@example.com",
+			Subject: "Confirm your email",
+			Body:    "code 123456",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var guids []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line map[string]any
+		if err = json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		if line["to"] != "ali@example.com" || line["body"] != "code 123456" {
+			t.Errorf("line = %v", line)
+		}
+		guids = append(guids, line["id"].(string))
+	}
+	if strings.Join(guids, ",") != "n1,n2" {
+		t.Errorf("lines of %v, want one line per notification in order", guids)
+	}
+}
+
+func TestMemoryProvider(t *testing.T) {
+	memory := NewMemory()
+	for _, guid := range []string{"n1", "n2"} {
+		if err := memory.Send(context.Background(), &entity.Notification{GUID: guid}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sent := memory.Sent()
+	if len(sent) != 2 || sent[0].GUID != "n1" || sent[1].GUID != "n2" {
+		t.Fatalf("sent = %v, want n1 and n2", sent)
+	}
+	sent[0] = nil
+	if memory.Sent()[0] == nil {
+		t.Error("Sent returned the notifications of the provider, not a copy")
+	}
+}
+
+func TestSMSHTTPProvider(t *testing.T) {
+	var (
+		request map[string]string
+		auth    string
+		status  = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("quota exceeded"))
+	}))
+	defer server.Close()
+
+	provider := NewSMSHTTP(server.URL, "secret", "Jobs", time.Second)
+	notification := &entity.Notification{Channel: entity.NotificationChannelSMS, To: "+998901234567", Body: "code 123456"}
+	if err := provider.Send(context.Background(), notification); err != nil {
+		t.Fatal(err)
+	}
+	if request["from"] != "Jobs" || request["to"] != "+998901234567" || request["text"] != "code 123456" {
+		t.Errorf("request = %v", request)
+	}
+	if auth != "Bearer secret" {
+		t.Errorf("Authorization = %q, want the bearer token", auth)
+	}
+
+	status = http.StatusTooManyRequests
+	err := provider.Send(context.Background(), notification)
+	if err == nil || !strings.Contains(err.Error(), "429") || !strings.Contains(err.Error(), "quota exceeded") {
+		t.Errorf("Send = %v, want the status and the reason of the gateway", err)
+	}
+}
+
+func TestMailMessage(t *testing.T) {
+	plain, err := mailMessage("jobs@example.com", &entity.Notification{
+		To:      "ali@example.com",
+		Subject: "Подтвердите адрес",
+		Body:    "code 123456",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers, body, _ := strings.Cut(string(plain), "\r\n\r\n")
+	subject := headerValue(headers, "Subject")
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(subject); err != nil || decoded != "Подтвердите адрес" {
+		t.Errorf("subject %q decodes to %q, %v", subject, decoded, err)
+	}
+	if !strings.Contains(headers, "Content-Type: text/plain; charset=UTF-8") || body != "code 123456" {
+		t.Errorf("plain message = %q", plain)
+	}
+
+	multipart, err := mailMessage("jobs@example.com", &entity.Notification{
+		To:      "ali@example.com",
+		Subject: "Confirm",
+		Body:    "code 123456",
+		HTML:    "<p>code 123456</p>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := string(multipart)
+	for _, want := range []string{
+		"Content-Type: multipart/alternative; boundary=",
+		"Content-Type: text/plain; charset=UTF-8",
+		"Content-Type: text/html; charset=UTF-8",
+		"<p>code 123456</p>",
+	} {
+		if !strings.Contains(message, want) {
+			t.Errorf("multipart message = %q, want %q in it", message, want)
+		}
+	}
+}
+
+func headerValue(headers, name string) string {
+	for _, line := range strings.Split(headers, "\r\n") {
+		if value, ok := strings.CutPrefix(line, name+": "); ok {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package notification
+
+import (
+	"bytes"
+	"client-service/internal/entity"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type smsHTTPProvider struct {
+	client *http.Client
+	url    string
+	token  string
+	from   string
+}
+
+// NewSMSHTTP posts every SMS to the gateway at url as JSON {"from", "to", "text"}, with the
+// token as a bearer token when there is one. A status other than 2xx is an error.
+func NewSMSHTTP(url, token, from string, timeout time.Duration) Provider {
+	return &smsHTTPProvider{
+		client: &http.Client{Timeout: timeout},
+		url:    url,
+		token:  token,
+		from:   from,
+	}
+}
+
+func (s *smsHTTPProvider) Send(ctx context.Context, notification *entity.Notification) error {
+	body, err := json.Marshal(map[string]string{
+		"from": s.from,
+		"to":   notification.To,
+		"text": notification.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("send sms to %s: %w", notification.To, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		reason, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("send sms to %s: gateway answered %d %s", notification.To, response.StatusCode, bytes.TrimSpace(reason))
+	}
+	return nil
+}
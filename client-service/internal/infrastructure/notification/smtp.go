@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"bytes"
+	"client-service/internal/entity"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+type smtpProvider struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTP sends the emails over SMTP, as multipart/alternative when there is an HTML body.
+// Without a user no authentication is done.
+func NewSMTP(host, port, user, password, from string) Provider {
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, password, host)
+	}
+	return &smtpProvider{
+		addr: net.JoinHostPort(host, strings.TrimPrefix(port, ":")),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (s *smtpProvider) Send(ctx context.Context, notification *entity.Notification) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	message, err := mailMessage(s.from, notification)
+	if err != nil {
+		return err
+	}
+
+	if err = smtp.SendMail(s.addr, s.auth, s.from, []string{notification.To}, message); err != nil {
+		return fmt.Errorf("send mail to %s: %w", notification.To, err)
+	}
+	return nil
+}
+
+func mailMessage(from string, notification *entity.Notification) ([]byte, error) {
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", notification.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", notification.Subject))
+	message.WriteString("MIME-Version: 1.0\r\n")
+
+	if notification.HTML == "" {
+		message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+		message.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		if err := writeQuotedPrintable(&message, notification.Body); err != nil {
+			return nil, err
+		}
+		return message.Bytes(), nil
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+	boundary := hex.EncodeToString(random)
+	fmt.Fprintf(&message, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", boundary)
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", notification.Body},
+		{"text/html", notification.HTML},
+	} {
+		fmt.Fprintf(&message, "--%s\r\n", boundary)
+		fmt.Fprintf(&message, "Content-Type: %s; charset=UTF-8\r\n", part.contentType)
+		message.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		if err := writeQuotedPrintable(&message, part.body); err != nil {
+			return nil, err
+		}
+		message.WriteString("\r\n")
+	}
+	fmt.Fprintf(&message, "--%s--\r\n", boundary)
+
+	return message.Bytes(), nil
+}
+
+func writeQuotedPrintable(buf *bytes.Buffer, body string) error {
+	writer := quotedprintable.NewWriter(buf)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		return err
+	}
+	return writer.Close()
+}
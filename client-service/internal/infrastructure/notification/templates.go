@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"bytes"
+	"client-service/internal/entity"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFiles embed.FS
+
+// Templates renders the notifications. templates/<locale>/<name>.txt defines the "subject" and the
+// "body" of the email and the "sms" text when the notification can go by SMS, the optional
+// templates/<locale>/<name>.html is the HTML body of the email.
+type Templates struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+func LoadTemplates() (*Templates, error) {
+	templates := &Templates{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+
+	err := fs.WalkDir(templateFiles, "templates", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := templateFiles.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		key := strings.TrimSuffix(strings.TrimPrefix(name, "templates/"), path.Ext(name))
+		switch path.Ext(name) {
+		case ".txt":
+			templates.text[key], err = texttemplate.New(key).Option("missingkey=error").Parse(string(content))
+		case ".html":
+			templates.html[key], err = htmltemplate.New(key).Option("missingkey=error").Parse(string(content))
+		}
+		if err != nil {
+			return fmt.Errorf("parse template %s: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for key, text := range templates.text {
+		if text.Lookup("subject") == nil || text.Lookup("body") == nil {
+			return nil, fmt.Errorf("template %s should define subject and body", key)
+		}
+	}
+
+	return templates, nil
+}
+
+// Supports reports whether the template can be sent on the channel
+func (t *Templates) Supports(name, channel string) bool {
+	text, ok := t.text[entity.DefaultLocale+"/"+name]
+	if !ok {
+		return false
+	}
+	return channel == entity.NotificationChannelEmail || text.Lookup("sms") != nil
+}
+
+// Render renders the template in the locale for the channel, a locale without the template gets
+// the default one. It returns the locale used.
+func (t *Templates) Render(name, locale, channel string, data any) (*entity.Message, string, error) {
+	if _, ok := t.text[locale+"/"+name]; !ok {
+		locale = entity.DefaultLocale
+	}
+	key := locale + "/" + name
+	text, ok := t.text[key]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown template %q", name)
+	}
+
+	var (
+		message entity.Message
+		err     error
+	)
+	if channel == entity.NotificationChannelSMS {
+		if text.Lookup("sms") == nil {
+			return nil, "", fmt.Errorf("template %q can't be sent by sms", name)
+		}
+		if message.Body, err = executeText(text, "sms", data); err != nil {
+			return nil, "", err
+		}
+		return &message, locale, nil
+	}
+
+	if message.Subject, err = executeText(text, "subject", data); err != nil {
+		return nil, "", err
+	}
+	if message.Body, err = executeText(text, "body", data); err != nil {
+		return nil, "", err
+	}
+	if html, ok := t.html[key]; ok {
+		var buf bytes.Buffer
+		if err = html.Execute(&buf, data); err != nil {
+			return nil, "", fmt.Errorf("render template %s: %w", key+".html", err)
+		}
+		message.HTML = strings.TrimSpace(buf.String())
+	}
+
+	return &message, locale, nil
+}
+
+func executeText(text *texttemplate.Template, name string, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := text.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("render template %s: %w", text.Name(), err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,92 @@
+package notification
+
+import (
+	"client-service/internal/entity"
+	"strings"
+	"testing"
+)
+
+func TestRenderLocales(t *testing.T) {
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := map[string]any{"FirstName": "Ali", "LastName": "Valiev", "Code": "123456", "ExpiresIn": 15}
+
+	tests := []struct {
+		locale, wantLocale, subject string
+	}{
+		{"en", "en", "Confirm your email"},
+		{"ru", "ru", "Подтвердите адрес электронной почты"},
+		// locales without templates get the default one
+		{"de", entity.DefaultLocale, "Confirm your email"},
+		{"", entity.DefaultLocale, "Confirm your email"},
+	}
+	for _, tt := range tests {
+		message, locale, err := templates.Render(entity.TemplateVerification, tt.locale, entity.NotificationChannelEmail, data)
+		if err != nil {
+			t.Fatalf("locale %q: %v", tt.locale, err)
+		}
+		if locale != tt.wantLocale {
+			t.Errorf("locale %q: rendered in %q, want %q", tt.locale, locale, tt.wantLocale)
+		}
+		if message.Subject != tt.subject {
+			t.Errorf("locale %q: subject = %q, want %q", tt.locale, message.Subject, tt.subject)
+		}
+		if !strings.Contains(message.Body, "123456") || !strings.Contains(message.HTML, "123456") {
+			t.Errorf("locale %q: the code is missing from %q or %q", tt.locale, message.Body, message.HTML)
+		}
+	}
+}
+
+func TestRenderChannels(t *testing.T) {
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message, locale, err := templates.Render(entity.TemplatePasswordReset, "uz", entity.NotificationChannelSMS,
+		map[string]any{"FirstName": "", "Code": "654321", "ExpiresIn": 15})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if locale != "uz" || message.Subject != "" || message.HTML != "" || !strings.HasPrefix(message.Body, "Parolni tiklash kodi: 654321") {
+		t.Errorf("sms = %+v in %q", message, locale)
+	}
+
+	if !templates.Supports(entity.TemplatePasswordReset, entity.NotificationChannelSMS) {
+		t.Error("password reset codes should go by sms")
+	}
+	if templates.Supports(entity.TemplateVerification, entity.NotificationChannelSMS) {
+		t.Error("email verification codes shouldn't go by sms")
+	}
+	if _, _, err = templates.Render(entity.TemplateVerification, "en", entity.NotificationChannelSMS, map[string]any{}); err == nil {
+		t.Error("rendered the verification for sms")
+	}
+	if _, _, err = templates.Render("unknown", "en", entity.NotificationChannelEmail, map[string]any{}); err == nil {
+		t.Error("rendered an unknown template")
+	}
+	// a value the template needs can't be left out
+	if _, _, err = templates.Render(entity.TemplateVerification, "en", entity.NotificationChannelEmail, map[string]any{"FirstName": ""}); err == nil {
+		t.Error("rendered without the code")
+	}
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	templates, err := LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message, _, err := templates.Render(entity.TemplateVerification, "en", entity.NotificationChannelEmail,
+		map[string]any{"FirstName": "<script>x</script>", "Code": "123456", "ExpiresIn": 15})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(message.HTML, "<script>") {
+		t.Errorf("html = %q, want the name escaped", message.HTML)
+	}
+	if !strings.Contains(message.Body, "<script>x</script>") {
+		t.Errorf("body = %q, want the name as it is", message.Body)
+	}
+}
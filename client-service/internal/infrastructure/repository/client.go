@@ -3,6 +3,7 @@ package repository
 import (
 	"client-service/internal/entity"
 	"context"
+	"time"
 )
 
 type Clients interface {
@@ -10,10 +11,25 @@ type Clients interface {
 	UpdateClient(ctx context.Context, kyc *entity.Client) (*entity.Client, error)
 	DeleteClient(ctx context.Context, guid string) error
 	GetClient(ctx context.Context, params map[string]string) (*entity.Client, error)
+	GetClientsByIDs(ctx context.Context, ids []string) ([]*entity.Client, error)
 	GetAllClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Client, error)
 	GetAllDeletedClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Client, error)
 	GetAllHiddenClients(ctx context.Context, limit, offset uint64, filter map[string]bool) ([]*entity.Client, error)
 	UniqueEmail(ctx context.Context, request *entity.IsUnique) (*entity.Response, error)
 	UpdateRefresh(ctx context.Context, request *entity.UpdateRefresh) (*entity.Response, error)
 	UpdatePassword(ctx context.Context, request *entity.UpdatePassword) (*entity.Response, error)
+	BatchCreateClients(ctx context.Context, clients []*entity.Client) ([]*entity.BatchResult, error)
+	StreamClients(ctx context.Context, scope string, filter map[string]string, fn func(client *entity.Client) error) error
+	GetClientChanges(ctx context.Context, after, limit uint64) ([]*entity.ClientChange, error)
+	GetClientChangesRange(ctx context.Context) (first, last uint64, err error)
+	PruneClientChanges(ctx context.Context, before time.Time) (uint64, error)
+	ChangeClientStatus(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error)
+	GetClientStatusHistory(ctx context.Context, clientID string) ([]*entity.ClientStatusChange, error)
+	SaveDuplicates(ctx context.Context, duplicates []*entity.ClientDuplicate) (uint64, error)
+	GetDuplicates(ctx context.Context, limit, offset uint64, status string) ([]*entity.ClientDuplicate, error)
+	DismissDuplicate(ctx context.Context, guid, actor string) error
+	MergeClients(ctx context.Context, request *entity.MergeClients) error
+	UpsertClientProfile(ctx context.Context, profile *entity.ClientProfile) (*entity.ClientProfile, error)
+	GetClientProfile(ctx context.Context, clientID string) (*entity.ClientProfile, error)
+	GetClientProfiles(ctx context.Context, limit, offset uint64, skills []string) ([]*entity.ClientProfile, error)
 }
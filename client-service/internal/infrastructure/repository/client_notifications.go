@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"client-service/internal/entity"
+	"context"
+	"time"
+)
+
+// ClientNotifications is the notification queue, the preferences and the one time codes of the clients
+type ClientNotifications interface {
+	GetNotificationPreferences(ctx context.Context, clientID string) (*entity.NotificationPreferences, error)
+	UpsertNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) (*entity.NotificationPreferences, error)
+	EnqueueNotifications(ctx context.Context, notifications ...*entity.Notification) error
+	ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.Notification, error)
+	SaveNotification(ctx context.Context, notification *entity.Notification) error
+	GetNotifications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Notification, error)
+	CreateVerificationCode(ctx context.Context, code *entity.VerificationCode, notifications ...*entity.Notification) error
+	GetVerificationCode(ctx context.Context, clientID, purpose string) (*entity.VerificationCode, error)
+	FailVerificationCode(ctx context.Context, guid string) error
+	ConfirmEmail(ctx context.Context, code *entity.VerificationCode, now time.Time) error
+	ResetPassword(ctx context.Context, code *entity.VerificationCode, password string, now time.Time) error
+}
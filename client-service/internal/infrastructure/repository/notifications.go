@@ -0,0 +1,6 @@
+package repository
+
+// Notifications wakes subscribers up when the database reports a change
+type Notifications interface {
+	Subscribe() (wake <-chan struct{}, unsubscribe func())
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"client-service/internal/entity"
+	"context"
+	"time"
+)
+
+type Outbox interface {
+	RelayEvents(ctx context.Context, limit uint64, publish func(ctx context.Context, event *entity.Event) error) (uint64, error)
+	PruneEvents(ctx context.Context, before time.Time) (uint64, error)
+}
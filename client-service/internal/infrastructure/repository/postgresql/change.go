@@ -0,0 +1,99 @@
+package postgresql
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"time"
+)
+
+const clientChangeTableName = "client_changes"
+
+// GetClientChanges returns up to limit changes after the cursor in the order they were committed
+func (p clientRepo) GetClientChanges(ctx context.Context, after, limit uint64) ([]*entity.ClientChange, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetClientChanges")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"client_id",
+			"action",
+			"changed_at",
+		).
+		From(clientChangeTableName).
+		Where(p.db.Sq.Expr("id > ?", after)).
+		OrderBy("id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientChangeTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	changes := make([]*entity.ClientChange, 0, limit)
+	for rows.Next() {
+		var change entity.ClientChange
+		if err = rows.Scan(
+			&change.Cursor,
+			&change.ClientID,
+			&change.Action,
+			&change.ChangedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		changes = append(changes, &change)
+	}
+
+	return changes, p.db.Error(rows.Err())
+}
+
+// GetClientChangesRange returns the oldest cursor the feed can resume from and the cursor of its last change
+func (p clientRepo) GetClientChangesRange(ctx context.Context) (uint64, uint64, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetClientChangesRange")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("COALESCE(MIN(id) - 1, 0)", "COALESCE(MAX(id), 0)").
+		From(clientChangeTableName).
+		ToSql()
+	if err != nil {
+		return 0, 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientChangeTableName, "range"))
+	}
+
+	var first, last uint64
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&first, &last); err != nil {
+		return 0, 0, p.db.Error(err)
+	}
+
+	return first, last, nil
+}
+
+// PruneClientChanges deletes the changes made before the time, the last change is kept so the
+// cursors of watchers stay valid
+func (p clientRepo) PruneClientChanges(ctx context.Context, before time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "PruneClientChanges")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(clientChangeTableName).
+		Where(p.db.Sq.Expr("changed_at < ?", before)).
+		Where("id < (SELECT MAX(id) FROM " + clientChangeTableName + ")").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, clientChangeTableName+" prune")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(commandTag.RowsAffected()), nil
+}
@@ -7,15 +7,19 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"client-service/internal/infrastructure/repository"
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
-	clientTableName       = "clients"
-	clientsSpanRepoPrefix = "clientRepo"
+	clientTableName              = "clients"
+	clientStatusHistoryTableName = "client_status_history"
+	clientDuplicatesTableName    = "client_duplicates"
+	clientsSpanRepoPrefix        = "clientRepo"
 )
 
 type clientRepo struct {
@@ -76,10 +80,23 @@ func (p clientRepo) CreateClient(ctx context.Context, client *entity.Client) (*e
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = writeEvents(ctx, p.db, tx, clientEvent(entity.EventClientCreated, client)); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 
 	return client, nil
 }
@@ -109,7 +126,13 @@ func (p clientRepo) UpdateClient(ctx context.Context, client *entity.Client) (*e
 		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, sqlStr, args...)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -117,6 +140,13 @@ func (p clientRepo) UpdateClient(ctx context.Context, client *entity.Client) (*e
 	if commandTag.RowsAffected() == 0 {
 		return nil, p.db.Error(fmt.Errorf("no sql rows"))
 	}
+	if err = writeEvents(ctx, p.db, tx, clientEvent(entity.EventClientUpdated, client)); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 
 	return client, nil
 }
@@ -135,19 +165,33 @@ func (p clientRepo) DeleteClient(ctx context.Context, guid string) error {
 		SetMap(clauses).
 		Where(p.db.Sq.Equal("id", guid)).
 		Where("deleted_at IS NULL").
+		Suffix("RETURNING first_name, last_name, email, status").
 		ToSql()
 	if err != nil {
 		return p.db.ErrSQLBuild(err, p.tableName+" delete")
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return p.db.Error(err)
 	}
+	defer tx.Rollback(ctx)
 
-	if commandTag.RowsAffected() == 0 {
+	client := entity.Client{GUID: guid, UpdatedAt: time.Now().UTC()}
+	err = tx.QueryRow(ctx, sqlStr, args...).Scan(&client.FirstName, &client.LastName, &client.Email, &client.Status)
+	if err == pgx.ErrNoRows {
 		return p.db.Error(fmt.Errorf("no sql rows"))
 	}
+	if err != nil {
+		return p.db.Error(err)
+	}
+	if err = writeEvents(ctx, p.db, tx, clientEvent(entity.EventClientDeleted, &client)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
 
 	return nil
 }
@@ -164,7 +208,7 @@ func (p clientRepo) GetClient(ctx context.Context, params map[string]string) (*e
 	queryBuilder := p.clientsSelectQueryPrefix()
 
 	for key, value := range params {
-		if key == "id" {
+		if key == "id" || key == "email" {
 			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
 		}
 	}
@@ -218,6 +262,73 @@ func (p clientRepo) GetClient(ctx context.Context, params map[string]string) (*e
 	return &client, nil
 }
 
+// GetClientsByIDs returns the clients found among ids in no particular order
+func (p clientRepo) GetClientsByIDs(ctx context.Context, ids []string) ([]*entity.Client, error) {
+
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetClientsByIDs")
+	defer span.End()
+
+	query, args, err := p.clientsSelectQueryPrefix().
+		Where("id = ANY(?::UUID[])", ids).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get by ids"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	clients := make([]*entity.Client, 0, len(ids))
+	for rows.Next() {
+		var (
+			client          entity.Client
+			nullAge         sql.NullInt32
+			nullGender      sql.NullString
+			nullPhoneNumber sql.NullString
+			nullAddress     sql.NullString
+			nullDeletedAt   sql.NullTime
+		)
+		if err = rows.Scan(
+			&client.GUID,
+			&client.FirstName,
+			&client.LastName,
+			&nullAge,
+			&nullGender,
+			&nullPhoneNumber,
+			&nullAddress,
+			&client.Email,
+			&client.Password,
+			&client.Status,
+			&client.Refresh,
+			&client.CreatedAt,
+			&client.UpdatedAt,
+			&nullDeletedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if nullAge.Valid {
+			client.Age = uint64(nullAge.Int32)
+		}
+		if nullGender.Valid {
+			client.Gender = nullGender.String
+		}
+		if nullPhoneNumber.Valid {
+			client.PhoneNumber = nullPhoneNumber.String
+		}
+		if nullAddress.Valid {
+			client.Address = nullAddress.String
+		}
+
+		clients = append(clients, &client)
+	}
+
+	return clients, p.db.Error(rows.Err())
+}
+
 func (p clientRepo) GetAllClients(ctx context.Context, limit uint64, offset uint64, filter map[string]string) ([]*entity.Client, error) {
 
 	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "ListClients")
@@ -530,3 +641,591 @@ func (p clientRepo) UpdatePassword(ctx context.Context, request *entity.UpdatePa
 
 	return &entity.Response{Status: true}, nil
 }
+
+// batchTxSize bounds the rows created in one transaction. The change feed and the audit log
+// triggers take global advisory locks held until commit, so every other write of clients and
+// audited rows waits for the transaction; a big batch in one would stall them all.
+const batchTxSize = 100
+
+// every row is inserted under its own savepoint, so one bad row doesn't abort the whole batch.
+// The batch is committed every batchTxSize rows, when a commit fails the rows before stay created.
+func (p clientRepo) BatchCreateClients(ctx context.Context, clients []*entity.Client) ([]*entity.BatchResult, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "BatchCreateClients")
+	defer span.End()
+
+	results := make([]*entity.BatchResult, 0, len(clients))
+	for start := 0; start < len(clients); start += batchTxSize {
+		created, err := p.batchCreateClients(ctx, clients[start:min(start+batchTxSize, len(clients))], start)
+		if err != nil && start == 0 {
+			return nil, err
+		}
+		if err != nil {
+			// the transactions before are committed, the rest of the batch is reported as failed
+			for index := start; index < len(clients); index++ {
+				results = append(results, &entity.BatchResult{Index: uint64(index), Error: err.Error()})
+			}
+			return results, nil
+		}
+		results = append(results, created...)
+	}
+
+	return results, nil
+}
+
+// batchCreateClients creates the clients in one transaction, offset is the index of the first one in the batch
+func (p clientRepo) batchCreateClients(ctx context.Context, clients []*entity.Client, offset int) ([]*entity.BatchResult, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	results := make([]*entity.BatchResult, 0, len(clients))
+	for index, client := range clients {
+		data := map[string]any{
+			"id":           client.GUID,
+			"first_name":   client.FirstName,
+			"last_name":    client.LastName,
+			"age":          client.Age,
+			"gender":       client.Gender,
+			"phone_number": client.PhoneNumber,
+			"address":      client.Address,
+			"email":        client.Email,
+			"password":     client.Password,
+			"status":       client.Status,
+			"refresh":      client.Refresh,
+			"created_at":   client.CreatedAt,
+			"updated_at":   client.UpdatedAt,
+		}
+		query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+		if err != nil {
+			return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "batch create"))
+		}
+
+		if _, err = tx.Exec(ctx, "SAVEPOINT batch_item"); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		result := &entity.BatchResult{Index: uint64(offset + index), GUID: client.GUID}
+		if _, err = tx.Exec(ctx, query, args...); err == nil {
+			err = writeEvents(ctx, p.db, tx, clientEvent(entity.EventClientCreated, client))
+		}
+		if err != nil {
+			if _, rollbackErr := tx.Exec(ctx, "ROLLBACK TO SAVEPOINT batch_item"); rollbackErr != nil {
+				return nil, p.db.Error(rollbackErr)
+			}
+			result.GUID = ""
+			result.Error = p.db.Error(err).Error()
+		}
+		results = append(results, result)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return results, nil
+}
+
+// filterClients applies the filters of a client export, the preferences are matched against
+// the client profiles
+func (p clientRepo) filterClients(queryBuilder squirrel.SelectBuilder, filter map[string]string) squirrel.SelectBuilder {
+	for key, value := range filter {
+		switch key {
+		case "gender":
+			queryBuilder = queryBuilder.Where("LOWER(gender) = ?", value)
+		case "age_from":
+			queryBuilder = queryBuilder.Where("age >= ?::INT", value)
+		case "age_to":
+			queryBuilder = queryBuilder.Where("age <= ?::INT", value)
+		case "skills":
+			// comma separated, clients having at least one of them
+			queryBuilder = queryBuilder.Where("id IN (SELECT client_id FROM client_profiles WHERE skills && ?)", strings.Split(value, ","))
+		case "level", "location_type", "employment_type":
+			queryBuilder = queryBuilder.Where("id IN (SELECT client_id FROM client_profiles WHERE desired_"+key+" = ?)", value)
+		case "salary_from":
+			queryBuilder = queryBuilder.Where("id IN (SELECT client_id FROM client_profiles WHERE expected_salary >= ?::NUMERIC)", value)
+		case "salary_to":
+			queryBuilder = queryBuilder.Where("id IN (SELECT client_id FROM client_profiles WHERE expected_salary <= ?::NUMERIC)", value)
+		case "currency":
+			queryBuilder = queryBuilder.Where("id IN (SELECT client_id FROM client_profiles WHERE expected_currency = ?)", value)
+		case "pay_period":
+			queryBuilder = queryBuilder.Where("id IN (SELECT client_id FROM client_profiles WHERE expected_pay_period = ?)", value)
+		}
+	}
+
+	return queryBuilder
+}
+
+// StreamClients scans rows one by one and hands them to fn, the result set is never held in memory
+func (p clientRepo) StreamClients(ctx context.Context, scope string, filter map[string]string, fn func(client *entity.Client) error) error {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "StreamClients")
+	defer span.End()
+
+	queryBuilder := p.filterClients(p.clientsSelectQueryPrefix(), filter)
+
+	switch scope {
+	case entity.ClientScopeDeleted:
+		queryBuilder = queryBuilder.Where("deleted_at IS NOT NULL")
+	case entity.ClientScopeHidden:
+		queryBuilder = queryBuilder.Where(p.db.Sq.Equal("status", false))
+	default:
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.OrderBy("created_at", "id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "stream"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			client          entity.Client
+			nullAge         sql.NullInt32
+			nullGender      sql.NullString
+			nullPhoneNumber sql.NullString
+			nullAddress     sql.NullString
+			nullDeletedAt   sql.NullTime
+		)
+		if err = rows.Scan(
+			&client.GUID,
+			&client.FirstName,
+			&client.LastName,
+			&nullAge,
+			&nullGender,
+			&nullPhoneNumber,
+			&nullAddress,
+			&client.Email,
+			&client.Password,
+			&client.Status,
+			&client.Refresh,
+			&client.CreatedAt,
+			&client.UpdatedAt,
+			&nullDeletedAt,
+		); err != nil {
+			return p.db.Error(err)
+		}
+		if nullAge.Valid {
+			client.Age = uint64(nullAge.Int32)
+		}
+		if nullGender.Valid {
+			client.Gender = nullGender.String
+		}
+		if nullPhoneNumber.Valid {
+			client.PhoneNumber = nullPhoneNumber.String
+		}
+		if nullAddress.Valid {
+			client.Address = nullAddress.String
+		}
+		if nullDeletedAt.Valid {
+			client.DeletedAt = nullDeletedAt.Time
+		}
+
+		if err = fn(&client); err != nil {
+			return err
+		}
+	}
+
+	return p.db.Error(rows.Err())
+}
+
+// ChangeClientStatus updates clients.status and writes the history row in one transaction
+func (p clientRepo) ChangeClientStatus(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "ChangeClientStatus")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.db.Sq.Builder.
+		Select("status").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("id", change.ClientID)).
+		Where("deleted_at IS NULL").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get status"))
+	}
+
+	var status bool
+	if err = tx.QueryRow(ctx, query, args...).Scan(&status); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if status == change.Status {
+		return nil, entity.ErrorStatusUnchanged
+	}
+
+	query, args, err = p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"status":     change.Status,
+			"updated_at": change.CreatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", change.ClientID)).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update status")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	query, args, err = p.db.Sq.Builder.
+		Insert(clientStatusHistoryTableName).
+		SetMap(map[string]any{
+			"id":         change.GUID,
+			"client_id":  change.ClientID,
+			"status":     change.Status,
+			"reason":     change.Reason,
+			"actor":      change.Actor,
+			"created_at": change.CreatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, clientStatusHistoryTableName+" create")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	eventType := entity.EventClientHidden
+	if change.Status {
+		eventType = entity.EventClientUnhidden
+	}
+	event := &entity.Event{
+		Topic: entity.TopicClients,
+		Type:  eventType,
+		Key:   change.ClientID,
+		Data: entity.ClientEvent{
+			ClientID: change.ClientID,
+			Status:   change.Status,
+			Reason:   change.Reason,
+			At:       change.CreatedAt,
+		},
+	}
+	if err = writeEvents(ctx, p.db, tx, event); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return change, nil
+}
+
+func (p clientRepo) GetClientStatusHistory(ctx context.Context, clientID string) ([]*entity.ClientStatusChange, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetClientStatusHistory")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"client_id",
+			"status",
+			"reason",
+			"actor",
+			"created_at",
+		).
+		From(clientStatusHistoryTableName).
+		Where(p.db.Sq.Equal("client_id", clientID)).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientStatusHistoryTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	changes := make([]*entity.ClientStatusChange, 0)
+	for rows.Next() {
+		var change entity.ClientStatusChange
+		if err = rows.Scan(
+			&change.GUID,
+			&change.ClientID,
+			&change.Status,
+			&change.Reason,
+			&change.Actor,
+			&change.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, p.db.Error(rows.Err())
+}
+
+// SaveDuplicates upserts the pairs, a pair that was already merged or dismissed is left as it is
+func (p clientRepo) SaveDuplicates(ctx context.Context, duplicates []*entity.ClientDuplicate) (uint64, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "SaveDuplicates")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var saved uint64
+	for _, duplicate := range duplicates {
+		query, args, err := p.db.Sq.Builder.
+			Insert(clientDuplicatesTableName).
+			SetMap(map[string]any{
+				"id":           duplicate.GUID,
+				"client_id":    duplicate.Client.GUID,
+				"duplicate_id": duplicate.Duplicate.GUID,
+				"score":        duplicate.Score,
+				"reasons":      strings.Join(duplicate.Reasons, ","),
+				"status":       duplicate.Status,
+				"created_at":   duplicate.CreatedAt,
+				"updated_at":   duplicate.UpdatedAt,
+			}).
+			Suffix("ON CONFLICT (client_id, duplicate_id) DO UPDATE SET " +
+				"score = EXCLUDED.score, reasons = EXCLUDED.reasons, updated_at = EXCLUDED.updated_at " +
+				"WHERE " + clientDuplicatesTableName + ".status = 'pending'").
+			ToSql()
+		if err != nil {
+			return 0, p.db.ErrSQLBuild(err, clientDuplicatesTableName+" upsert")
+		}
+
+		commandTag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return 0, p.db.Error(err)
+		}
+		saved += uint64(commandTag.RowsAffected())
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return saved, nil
+}
+
+func (p clientRepo) GetDuplicates(ctx context.Context, limit, offset uint64, status string) ([]*entity.ClientDuplicate, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetDuplicates")
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.
+		Select(
+			"d.id",
+			"d.score",
+			"d.reasons",
+			"d.status",
+			"d.resolved_by",
+			"d.created_at",
+			"d.updated_at",
+			"c.id",
+			"c.first_name",
+			"c.last_name",
+			"c.email",
+			"c.phone_number",
+			"u.id",
+			"u.first_name",
+			"u.last_name",
+			"u.email",
+			"u.phone_number",
+		).
+		From(clientDuplicatesTableName+" d").
+		Join(p.tableName+" c ON c.id = d.client_id").
+		Join(p.tableName+" u ON u.id = d.duplicate_id").
+		Where(p.db.Sq.Equal("d.status", status)).
+		OrderBy("d.score DESC", "d.created_at")
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientDuplicatesTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	duplicates := make([]*entity.ClientDuplicate, 0)
+	for rows.Next() {
+		var (
+			duplicate      entity.ClientDuplicate
+			reasons        string
+			nullResolvedBy sql.NullString
+			nullPhone      sql.NullString
+			nullDupPhone   sql.NullString
+		)
+		if err = rows.Scan(
+			&duplicate.GUID,
+			&duplicate.Score,
+			&reasons,
+			&duplicate.Status,
+			&nullResolvedBy,
+			&duplicate.CreatedAt,
+			&duplicate.UpdatedAt,
+			&duplicate.Client.GUID,
+			&duplicate.Client.FirstName,
+			&duplicate.Client.LastName,
+			&duplicate.Client.Email,
+			&nullPhone,
+			&duplicate.Duplicate.GUID,
+			&duplicate.Duplicate.FirstName,
+			&duplicate.Duplicate.LastName,
+			&duplicate.Duplicate.Email,
+			&nullDupPhone,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if reasons != "" {
+			duplicate.Reasons = strings.Split(reasons, ",")
+		}
+		if nullResolvedBy.Valid {
+			duplicate.ResolvedBy = nullResolvedBy.String
+		}
+		if nullPhone.Valid {
+			duplicate.Client.PhoneNumber = nullPhone.String
+		}
+		if nullDupPhone.Valid {
+			duplicate.Duplicate.PhoneNumber = nullDupPhone.String
+		}
+
+		duplicates = append(duplicates, &duplicate)
+	}
+
+	return duplicates, p.db.Error(rows.Err())
+}
+
+func (p clientRepo) DismissDuplicate(ctx context.Context, guid, actor string) error {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "DismissDuplicate")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(clientDuplicatesTableName).
+		SetMap(map[string]any{
+			"status":      entity.DuplicateStatusDismissed,
+			"resolved_by": actor,
+			"updated_at":  time.Now().UTC(),
+		}).
+		Where(p.db.Sq.Equal("id", guid)).
+		Where(p.db.Sq.Equal("status", entity.DuplicateStatusPending)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, clientDuplicatesTableName+" dismiss")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return p.db.Error(fmt.Errorf("no sql rows"))
+	}
+
+	return nil
+}
+
+// MergeClients soft-deletes the merged client and resolves every pending pair it's part of
+func (p clientRepo) MergeClients(ctx context.Context, request *entity.MergeClients) error {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "MergeClients")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.db.Sq.Builder.
+		Select("COUNT(*)").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("id", []string{request.KeepID, request.MergeID})).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" merge")
+	}
+
+	var count int
+	if err = tx.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return p.db.Error(err)
+	}
+	if count != 2 {
+		return entity.ErrorNotFound
+	}
+
+	now := time.Now().UTC()
+	query, args, err = p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"merged_into": request.KeepID,
+			"deleted_at":  now,
+			"updated_at":  now,
+		}).
+		Where(p.db.Sq.Equal("id", request.MergeID)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" merge")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	query, args, err = p.db.Sq.Builder.
+		Update(clientDuplicatesTableName).
+		SetMap(map[string]any{
+			"status":      entity.DuplicateStatusMerged,
+			"resolved_by": request.Actor,
+			"updated_at":  now,
+		}).
+		Where(p.db.Sq.Equal("status", entity.DuplicateStatusPending)).
+		Where(squirrel.Or{
+			p.db.Sq.Equal("client_id", request.MergeID),
+			p.db.Sq.Equal("duplicate_id", request.MergeID),
+		}).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, clientDuplicatesTableName+" merge")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	event := &entity.Event{
+		Topic: entity.TopicClients,
+		Type:  entity.EventClientsMerged,
+		Key:   request.MergeID,
+		Data: entity.ClientEvent{
+			ClientID:   request.MergeID,
+			MergedInto: request.KeepID,
+			At:         now,
+		},
+	}
+	if err = writeEvents(ctx, p.db, tx, event); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
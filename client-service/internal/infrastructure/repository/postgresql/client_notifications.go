@@ -0,0 +1,523 @@
+package postgresql
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/otlp"
+	"client-service/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	notificationTableName           = "notifications"
+	notificationPreferenceTableName = "notification_preferences"
+	verificationCodeTableName       = "verification_codes"
+	notificationsSpanRepoPrefix     = "notificationsRepo"
+)
+
+var notificationColumns = []string{
+	"id",
+	"COALESCE(client_id::TEXT, '')",
+	"channel",
+	"template",
+	"locale",
+	"recipient",
+	"subject",
+	"body",
+	"html",
+	"status",
+	"attempts",
+	"next_attempt_at",
+	"last_error",
+	"sent_at",
+	"created_at",
+	"updated_at",
+}
+
+type clientNotificationsRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewClientNotificationsRepo(db *postgres.PostgresDB) repository.ClientNotifications {
+	return &clientNotificationsRepo{
+		db: db,
+	}
+}
+
+// GetNotificationPreferences answers the defaults for a client who hasn't changed them
+func (p clientNotificationsRepo) GetNotificationPreferences(ctx context.Context, clientID string) (*entity.NotificationPreferences, error) {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "GetNotificationPreferences")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"clients.id",
+			"COALESCE(notification_preferences.locale, '"+entity.DefaultLocale+"')",
+			"COALESCE(notification_preferences.email, TRUE)",
+			"COALESCE(notification_preferences.sms, FALSE)",
+			"COALESCE(notification_preferences.job_alerts, TRUE)",
+			"COALESCE(notification_preferences.verified_email = clients.email, FALSE)",
+			"COALESCE(notification_preferences.created_at, clients.created_at)",
+			"COALESCE(notification_preferences.updated_at, clients.created_at)",
+		).
+		From(clientTableName).
+		LeftJoin("notification_preferences ON notification_preferences.client_id = clients.id").
+		Where(p.db.Sq.Equal("clients.id", clientID)).
+		Where("clients.deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", notificationPreferenceTableName, "get"))
+	}
+
+	var preferences entity.NotificationPreferences
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&preferences.ClientID,
+		&preferences.Locale,
+		&preferences.Email,
+		&preferences.SMS,
+		&preferences.JobAlerts,
+		&preferences.EmailVerified,
+		&preferences.CreatedAt,
+		&preferences.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &preferences, nil
+}
+
+func (p clientNotificationsRepo) UpsertNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) (*entity.NotificationPreferences, error) {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "UpsertNotificationPreferences")
+	defer span.End()
+
+	data := map[string]any{
+		"client_id":  preferences.ClientID,
+		"locale":     preferences.Locale,
+		"email":      preferences.Email,
+		"sms":        preferences.SMS,
+		"job_alerts": preferences.JobAlerts,
+		"created_at": preferences.CreatedAt,
+		"updated_at": preferences.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.
+		Insert(notificationPreferenceTableName).
+		SetMap(data).
+		Suffix(`ON CONFLICT (client_id) DO UPDATE SET
+			locale = EXCLUDED.locale,
+			email = EXCLUDED.email,
+			sms = EXCLUDED.sms,
+			job_alerts = EXCLUDED.job_alerts,
+			updated_at = EXCLUDED.updated_at
+		RETURNING created_at,
+			COALESCE(verified_email = (SELECT email FROM clients WHERE clients.id = notification_preferences.client_id), FALSE)`).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", notificationPreferenceTableName, "upsert"))
+	}
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&preferences.CreatedAt, &preferences.EmailVerified); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return preferences, nil
+}
+
+func (p clientNotificationsRepo) EnqueueNotifications(ctx context.Context, notifications ...*entity.Notification) error {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "EnqueueNotifications")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = p.insertNotifications(ctx, tx, notifications...); err != nil {
+		return err
+	}
+
+	return p.db.Error(tx.Commit(ctx))
+}
+
+// ClaimDueNotifications takes the pending notifications that are due and moves their next attempt
+// a lease ahead, so no other instance sends them meanwhile
+func (p clientNotificationsRepo) ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.Notification, error) {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "ClaimDueNotifications")
+	defer span.End()
+
+	due := p.db.Sq.Builder.
+		Select("id").
+		From(notificationTableName).
+		Where(p.db.Sq.Equal("status", entity.NotificationPending)).
+		Where(p.db.Sq.Expr("next_attempt_at <= ?", now)).
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(notificationTableName).
+		Set("next_attempt_at", now.Add(lease)).
+		Where(due.Prefix("id IN (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(notificationColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, notificationTableName+" claim")
+	}
+
+	return p.queryNotifications(ctx, sqlStr, args...)
+}
+
+func (p clientNotificationsRepo) SaveNotification(ctx context.Context, notification *entity.Notification) error {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "SaveNotification")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(notificationTableName).
+		SetMap(map[string]any{
+			"status":          notification.Status,
+			"attempts":        notification.Attempts,
+			"next_attempt_at": notification.NextAttemptAt,
+			"last_error":      notification.LastError,
+			"sent_at":         nullIfZero(notification.SentAt),
+			"updated_at":      notification.UpdatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", notification.GUID)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, notificationTableName+" update")
+	}
+
+	if _, err = p.db.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p clientNotificationsRepo) GetNotifications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Notification, error) {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "GetNotifications")
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(notificationColumns...).From(notificationTableName)
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		if value == "" {
+			continue
+		}
+		switch key {
+		case "client_id", "channel", "template", "status":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+	queryBuilder = queryBuilder.OrderBy("created_at DESC", "id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", notificationTableName, "list"))
+	}
+
+	return p.queryNotifications(ctx, query, args...)
+}
+
+// CreateVerificationCode replaces the unused codes of the client for the purpose and queues the
+// notifications that carry the code, all or nothing
+func (p clientNotificationsRepo) CreateVerificationCode(ctx context.Context, code *entity.VerificationCode, notifications ...*entity.Notification) error {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "CreateVerificationCode")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(verificationCodeTableName).
+		Where(p.db.Sq.Equal("client_id", code.ClientID)).
+		Where(p.db.Sq.Equal("purpose", code.Purpose)).
+		Where("used_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, verificationCodeTableName+" delete")
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	sqlStr, args, err = p.db.Sq.Builder.
+		Insert(verificationCodeTableName).
+		SetMap(map[string]any{
+			"id":          code.GUID,
+			"client_id":   code.ClientID,
+			"purpose":     code.Purpose,
+			"destination": code.Destination,
+			"code_hash":   code.CodeHash,
+			"expires_at":  code.ExpiresAt,
+			"created_at":  code.CreatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, verificationCodeTableName+" create")
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	if err = p.insertNotifications(ctx, tx, notifications...); err != nil {
+		return err
+	}
+
+	return p.db.Error(tx.Commit(ctx))
+}
+
+// GetVerificationCode returns the unused code of the client for the purpose, expired or not
+func (p clientNotificationsRepo) GetVerificationCode(ctx context.Context, clientID, purpose string) (*entity.VerificationCode, error) {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "GetVerificationCode")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("id", "client_id", "purpose", "destination", "code_hash", "attempts", "expires_at", "created_at").
+		From(verificationCodeTableName).
+		Where(p.db.Sq.Equal("client_id", clientID)).
+		Where(p.db.Sq.Equal("purpose", purpose)).
+		Where("used_at IS NULL").
+		OrderBy("created_at DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", verificationCodeTableName, "get"))
+	}
+
+	var code entity.VerificationCode
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&code.GUID,
+		&code.ClientID,
+		&code.Purpose,
+		&code.Destination,
+		&code.CodeHash,
+		&code.Attempts,
+		&code.ExpiresAt,
+		&code.CreatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &code, nil
+}
+
+func (p clientNotificationsRepo) FailVerificationCode(ctx context.Context, guid string) error {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "FailVerificationCode")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(verificationCodeTableName).
+		Set("attempts", p.db.Sq.Expr("attempts + 1")).
+		Where(p.db.Sq.Equal("id", guid)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, verificationCodeTableName+" update")
+	}
+
+	if _, err = p.db.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// ConfirmEmail uses the code and verifies the email it was sent to
+func (p clientNotificationsRepo) ConfirmEmail(ctx context.Context, code *entity.VerificationCode, now time.Time) error {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "ConfirmEmail")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = p.useVerificationCode(ctx, tx, code.GUID, now); err != nil {
+		return err
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Insert(notificationPreferenceTableName).
+		SetMap(map[string]any{
+			"client_id":         code.ClientID,
+			"verified_email":    code.Destination,
+			"email_verified_at": now,
+			"created_at":        now,
+			"updated_at":        now,
+		}).
+		Suffix(`ON CONFLICT (client_id) DO UPDATE SET
+			verified_email = EXCLUDED.verified_email,
+			email_verified_at = EXCLUDED.email_verified_at,
+			updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, notificationPreferenceTableName+" upsert")
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return p.db.Error(tx.Commit(ctx))
+}
+
+// ResetPassword uses the code and sets the password, the refresh token is dropped so the
+// sessions started with the old password end
+func (p clientNotificationsRepo) ResetPassword(ctx context.Context, code *entity.VerificationCode, password string, now time.Time) error {
+	ctx, span := otlp.Start(ctx, notificationsSpanRepoPrefix+"_grpc-repository", "ResetPassword")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = p.useVerificationCode(ctx, tx, code.GUID, now); err != nil {
+		return err
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(clientTableName).
+		SetMap(map[string]any{
+			"password":   password,
+			"refresh":    "",
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("id", code.ClientID)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, clientTableName+" update")
+	}
+	commandTag, err := tx.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	return p.db.Error(tx.Commit(ctx))
+}
+
+// useVerificationCode marks the code used, a code is used once even by concurrent requests
+func (p clientNotificationsRepo) useVerificationCode(ctx context.Context, tx pgx.Tx, guid string, now time.Time) error {
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(verificationCodeTableName).
+		Set("used_at", now).
+		Where(p.db.Sq.Equal("id", guid)).
+		Where("used_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, verificationCodeTableName+" update")
+	}
+
+	commandTag, err := tx.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (p clientNotificationsRepo) insertNotifications(ctx context.Context, tx pgx.Tx, notifications ...*entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	insert := p.db.Sq.Builder.
+		Insert(notificationTableName).
+		Columns("id", "client_id", "channel", "template", "locale", "recipient", "subject", "body", "html", "status", "next_attempt_at", "created_at", "updated_at")
+	for _, notification := range notifications {
+		insert = insert.Values(
+			notification.GUID,
+			nullIfEmpty(notification.ClientID),
+			notification.Channel,
+			notification.Template,
+			notification.Locale,
+			notification.To,
+			notification.Subject,
+			notification.Body,
+			notification.HTML,
+			notification.Status,
+			notification.NextAttemptAt,
+			notification.CreatedAt,
+			notification.UpdatedAt,
+		)
+	}
+	sqlStr, args, err := insert.ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, notificationTableName+" create")
+	}
+
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p clientNotificationsRepo) queryNotifications(ctx context.Context, query string, args ...any) ([]*entity.Notification, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	notifications := make([]*entity.Notification, 0)
+	for rows.Next() {
+		var (
+			notification entity.Notification
+			sentAt       sql.NullTime
+		)
+		if err = rows.Scan(
+			&notification.GUID,
+			&notification.ClientID,
+			&notification.Channel,
+			&notification.Template,
+			&notification.Locale,
+			&notification.To,
+			&notification.Subject,
+			&notification.Body,
+			&notification.HTML,
+			&notification.Status,
+			&notification.Attempts,
+			&notification.NextAttemptAt,
+			&notification.LastError,
+			&sentAt,
+			&notification.CreatedAt,
+			&notification.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		notification.SentAt = sentAt.Time
+
+		notifications = append(notifications, &notification)
+	}
+
+	return notifications, p.db.Error(rows.Err())
+}
+
+func nullIfZero(value time.Time) any {
+	if value.IsZero() {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,200 @@
+package postgresql
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/otlp"
+	"client-service/internal/pkg/postgres"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	outboxTableName      = "outbox"
+	outboxSource         = "client-service"
+	outboxSpanRepoPrefix = "outboxRepo"
+)
+
+type outboxRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewOutboxRepo(db *postgres.PostgresDB) repository.Outbox {
+	return &outboxRepo{
+		db: db,
+	}
+}
+
+// RelayEvents hands publish the pending events in outbox order and marks the published ones.
+// It stops at the first failure, so no later event of the same key gets ahead of the failed one.
+// While another instance relays, the lock isn't taken and nothing is published.
+func (p outboxRepo) RelayEvents(ctx context.Context, limit uint64, publish func(ctx context.Context, event *entity.Event) error) (uint64, error) {
+	ctx, span := otlp.Start(ctx, outboxSpanRepoPrefix+"_grpc-repository", "RelayEvents")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err = tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", "outbox:"+outboxSource).Scan(&locked); err != nil {
+		return 0, p.db.Error(err)
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"event_id",
+			"topic",
+			"type",
+			"key",
+			"payload",
+			"created_at",
+		).
+		From(outboxTableName).
+		Where(p.db.Sq.Equal("source", outboxSource)).
+		Where("published_at IS NULL").
+		OrderBy("id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", outboxTableName, "pending"))
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	events := make([]*entity.Event, 0)
+	for rows.Next() {
+		var event entity.Event
+		if err = rows.Scan(
+			&event.ID,
+			&event.GUID,
+			&event.Topic,
+			&event.Type,
+			&event.Key,
+			&event.Payload,
+			&event.CreatedAt,
+		); err != nil {
+			rows.Close()
+			return 0, p.db.Error(err)
+		}
+		events = append(events, &event)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	var (
+		published  = make([]uint64, 0, len(events))
+		publishErr error
+	)
+	for _, event := range events {
+		if publishErr = publish(ctx, event); publishErr != nil {
+			if _, err = tx.Exec(ctx,
+				"UPDATE outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1",
+				event.ID, publishErr.Error(),
+			); err != nil {
+				return 0, p.db.Error(err)
+			}
+			break
+		}
+		published = append(published, event.ID)
+	}
+
+	if len(published) != 0 {
+		if _, err = tx.Exec(ctx,
+			"UPDATE outbox SET published_at = $2, attempts = attempts + 1, last_error = '' WHERE id = ANY($1)",
+			published, time.Now().UTC(),
+		); err != nil {
+			return 0, p.db.Error(err)
+		}
+	}
+
+	// a failed commit leaves the events pending, they are published again
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(len(published)), publishErr
+}
+
+// PruneEvents deletes the events published before the time
+func (p outboxRepo) PruneEvents(ctx context.Context, before time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, outboxSpanRepoPrefix+"_grpc-repository", "PruneEvents")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(outboxTableName).
+		Where(p.db.Sq.Equal("source", outboxSource)).
+		Where(p.db.Sq.Expr("published_at < ?", before)).
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, outboxTableName+" prune")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(commandTag.RowsAffected()), nil
+}
+
+// writeEvents adds the events to the outbox in the transaction of the change they describe
+func writeEvents(ctx context.Context, db *postgres.PostgresDB, tx pgx.Tx, events ...*entity.Event) error {
+	for _, event := range events {
+		payload, err := json.Marshal(event.Data)
+		if err != nil {
+			return fmt.Errorf("marshal %s event: %w", event.Type, err)
+		}
+
+		data := map[string]any{
+			"event_id":   uuid.New().String(),
+			"source":     outboxSource,
+			"topic":      event.Topic,
+			"type":       event.Type,
+			"key":        event.Key,
+			"payload":    string(payload),
+			"created_at": time.Now().UTC(),
+		}
+		query, args, err := db.Sq.Builder.Insert(outboxTableName).SetMap(data).ToSql()
+		if err != nil {
+			return db.ErrSQLBuild(err, fmt.Sprintf("%s %s", outboxTableName, "create"))
+		}
+
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return db.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func clientEvent(eventType string, client *entity.Client) *entity.Event {
+	return &entity.Event{
+		Topic: entity.TopicClients,
+		Type:  eventType,
+		Key:   client.GUID,
+		Data: entity.ClientEvent{
+			ClientID:  client.GUID,
+			FirstName: client.FirstName,
+			LastName:  client.LastName,
+			Email:     client.Email,
+			Status:    client.Status,
+			At:        client.UpdatedAt,
+		},
+	}
+}
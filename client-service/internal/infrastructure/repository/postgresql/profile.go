@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const clientProfilesTableName = "client_profiles"
+
+func (p *clientRepo) profilesSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(
+			"cp.client_id",
+			"c.first_name",
+			"c.last_name",
+			"cp.skills",
+			"cp.desired_level",
+			"cp.desired_location_type",
+			"cp.desired_employment_type",
+			"COALESCE(cp.expected_salary::TEXT, '')",
+			"COALESCE(cp.expected_currency, '')",
+			"COALESCE(cp.expected_pay_period, '')",
+			"cp.created_at",
+			"cp.updated_at",
+		).
+		From(clientProfilesTableName + " cp").
+		Join(p.tableName + " c ON c.id = cp.client_id")
+}
+
+func (p clientRepo) UpsertClientProfile(ctx context.Context, profile *entity.ClientProfile) (*entity.ClientProfile, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "UpsertClientProfile")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Insert(clientProfilesTableName).
+		SetMap(map[string]any{
+			"client_id":               profile.ClientID,
+			"skills":                  profile.Skills,
+			"desired_level":           profile.DesiredLevel,
+			"desired_location_type":   profile.DesiredLocationType,
+			"desired_employment_type": profile.DesiredEmploymentType,
+			"expected_salary":         nullIfEmpty(profile.ExpectedSalary),
+			"expected_currency":       nullIfEmpty(profile.ExpectedCurrency),
+			"expected_pay_period":     nullIfEmpty(profile.ExpectedPayPeriod),
+			"created_at":              profile.CreatedAt,
+			"updated_at":              profile.UpdatedAt,
+		}).
+		Suffix("ON CONFLICT (client_id) DO UPDATE SET " +
+			"skills = EXCLUDED.skills, desired_level = EXCLUDED.desired_level, " +
+			"desired_location_type = EXCLUDED.desired_location_type, desired_employment_type = EXCLUDED.desired_employment_type, " +
+			"expected_salary = EXCLUDED.expected_salary, expected_currency = EXCLUDED.expected_currency, " +
+			"expected_pay_period = EXCLUDED.expected_pay_period, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, clientProfilesTableName+" upsert")
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return p.GetClientProfile(ctx, profile.ClientID)
+}
+
+func (p clientRepo) GetClientProfile(ctx context.Context, clientID string) (*entity.ClientProfile, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetClientProfile")
+	defer span.End()
+
+	query, args, err := p.profilesSelectQueryPrefix().
+		Where(p.db.Sq.Equal("cp.client_id", clientID)).
+		Where("c.deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientProfilesTableName, "get"))
+	}
+
+	var profile entity.ClientProfile
+	if err = p.db.QueryRow(ctx, query, args...).Scan(profileScanArgs(&profile)...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &profile, nil
+}
+
+// GetClientProfiles lists the profiles of active clients, skills narrow it down to profiles sharing one of them
+func (p clientRepo) GetClientProfiles(ctx context.Context, limit, offset uint64, skills []string) ([]*entity.ClientProfile, error) {
+	ctx, span := otlp.Start(ctx, clientsSpanRepoPrefix+"_grpc-repository", "GetClientProfiles")
+	defer span.End()
+
+	queryBuilder := p.profilesSelectQueryPrefix().
+		Where("c.deleted_at IS NULL").
+		Where("c.status = TRUE")
+	if len(skills) != 0 {
+		queryBuilder = queryBuilder.Where("cp.skills && ?", skills)
+	}
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+	queryBuilder = queryBuilder.OrderBy("cp.updated_at DESC", "cp.client_id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientProfilesTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	profiles := make([]*entity.ClientProfile, 0)
+	for rows.Next() {
+		var profile entity.ClientProfile
+		if err = rows.Scan(profileScanArgs(&profile)...); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		profiles = append(profiles, &profile)
+	}
+
+	return profiles, p.db.Error(rows.Err())
+}
+
+func profileScanArgs(profile *entity.ClientProfile) []any {
+	return []any{
+		&profile.ClientID,
+		&profile.FirstName,
+		&profile.LastName,
+		&profile.Skills,
+		&profile.DesiredLevel,
+		&profile.DesiredLocationType,
+		&profile.DesiredEmploymentType,
+		&profile.ExpectedSalary,
+		&profile.ExpectedCurrency,
+		&profile.ExpectedPayPeriod,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	}
+}
+
+func nullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,161 @@
+package postgresql
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/otlp"
+	"client-service/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	sagaTableName       = "sagas"
+	sagaChangeTableName = "saga_changes"
+	sagasSpanRepoPrefix = "sagasRepo"
+)
+
+var sagaColumns = []string{
+	"id",
+	"kind",
+	"entity_id",
+	"status",
+	"step",
+	"attempts",
+	"next_attempt_at",
+	"last_error",
+	"actor",
+	"created_at",
+	"updated_at",
+}
+
+type sagaRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewSagasRepo(db *postgres.PostgresDB) repository.Sagas {
+	return &sagaRepo{
+		db: db,
+	}
+}
+
+func (p sagaRepo) CreateSaga(ctx context.Context, saga *entity.Saga) error {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "CreateSaga")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Insert(sagaTableName).
+		SetMap(map[string]any{
+			"id":              saga.GUID,
+			"kind":            saga.Kind,
+			"entity_id":       saga.EntityID,
+			"status":          saga.Status,
+			"step":            saga.Step,
+			"attempts":        saga.Attempts,
+			"next_attempt_at": saga.NextAttemptAt,
+			"last_error":      saga.LastError,
+			"actor":           saga.Actor,
+			"created_at":      saga.CreatedAt,
+			"updated_at":      saga.UpdatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaTableName, "create"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p sagaRepo) SaveSaga(ctx context.Context, saga *entity.Saga) error {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "SaveSaga")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Update(sagaTableName).
+		SetMap(map[string]any{
+			"status":          saga.Status,
+			"step":            saga.Step,
+			"attempts":        saga.Attempts,
+			"next_attempt_at": saga.NextAttemptAt,
+			"last_error":      saga.LastError,
+			"updated_at":      saga.UpdatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", saga.GUID)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaTableName, "update"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// ClaimDueSagas takes the unfinished sagas of the kind that are due and moves their next attempt
+// a lease ahead, so no other instance runs them meanwhile. A saga whose instance died while it
+// ran is taken again once the lease is over.
+func (p sagaRepo) ClaimDueSagas(ctx context.Context, kind string, now time.Time, lease time.Duration, limit uint64) ([]*entity.Saga, error) {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "ClaimDueSagas")
+	defer span.End()
+
+	due := p.db.Sq.Builder.
+		Select("id").
+		From(sagaTableName).
+		Where(p.db.Sq.Equal("kind", kind)).
+		Where(p.db.Sq.Expr("status IN (?, ?)", entity.SagaRunning, entity.SagaCompensating)).
+		Where(p.db.Sq.Expr("next_attempt_at <= ?", now)).
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := p.db.Sq.Builder.
+		Update(sagaTableName).
+		Set("next_attempt_at", now.Add(lease)).
+		Where(due.Prefix("id IN (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(sagaColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaTableName, "claim"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	sagas := make([]*entity.Saga, 0)
+	for rows.Next() {
+		var saga entity.Saga
+		if err = rows.Scan(sagaScanArgs(&saga)...); err != nil {
+			return nil, p.db.Error(err)
+		}
+		sagas = append(sagas, &saga)
+	}
+
+	return sagas, p.db.Error(rows.Err())
+}
+
+func sagaScanArgs(saga *entity.Saga) []any {
+	return []any{
+		&saga.GUID,
+		&saga.Kind,
+		&saga.EntityID,
+		&saga.Status,
+		&saga.Step,
+		&saga.Attempts,
+		&saga.NextAttemptAt,
+		&saga.LastError,
+		&saga.Actor,
+		&saga.CreatedAt,
+		&saga.UpdatedAt,
+	}
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"client-service/internal/entity"
+	"context"
+	"time"
+)
+
+type Sagas interface {
+	// CreateSaga is a conflict while a saga of the kind runs for the entity
+	CreateSaga(ctx context.Context, saga *entity.Saga) error
+	SaveSaga(ctx context.Context, saga *entity.Saga) error
+	ClaimDueSagas(ctx context.Context, kind string, now time.Time, lease time.Duration, limit uint64) ([]*entity.Saga, error)
+}
@@ -14,6 +14,14 @@ type Config struct {
 		Timeout string
 	}
 
+	Scheduler struct {
+		Interval string
+	}
+
+	ChangeFeed struct {
+		Retention string
+	}
+
 	DB struct {
 		Host     string
 		Port     string
@@ -28,6 +36,47 @@ type Config struct {
 		Port string
 	}
 
+	Broker struct {
+		Kind        string
+		TopicPrefix string
+		Kafka       struct {
+			Brokers string
+		}
+		NATS struct {
+			URL string
+		}
+	}
+
+	Outbox struct {
+		Interval  string
+		BatchSize string
+		Retention string
+	}
+
+	Saga struct {
+		Interval string
+	}
+
+	Notification struct {
+		Interval string
+		Email    string
+		SMS      string
+		File     string
+		SMTP     struct {
+			Host     string
+			Port     string
+			User     string
+			Password string
+			From     string
+		}
+		SMSHTTP struct {
+			URL     string
+			Token   string
+			From    string
+			Timeout string
+		}
+	}
+
 	OTLPCollector struct {
 		Host string
 		Port string
@@ -44,6 +93,12 @@ func New() *Config {
 	config.RPCPort = getEnv("RPC_PORT", ":1111")
 	config.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
 
+	// how often the client change feed is pruned
+	config.Scheduler.Interval = getEnv("SCHEDULER_INTERVAL", "1m")
+
+	// how long client changes are kept for watchers resuming from a cursor
+	config.ChangeFeed.Retention = getEnv("CHANGE_FEED_RETENTION", "168h")
+
 	// db configuration
 	config.DB.Host = getEnv("POSTGRES_HOST", "postgres")
 	config.DB.Port = getEnv("POSTGRES_PORT", "5432")
@@ -56,6 +111,36 @@ func New() *Config {
 	config.JobService.Host = getEnv("JOB_SERVICE_RPC_HOST", "job-service")
 	config.JobService.Port = getEnv("JOB_SERVICE_RPC_PORT", ":2222")
 
+	// publishing of the domain events: kafka, nats, log or memory
+	config.Broker.Kind = getEnv("BROKER", "log")
+	config.Broker.TopicPrefix = getEnv("BROKER_TOPIC_PREFIX", "")
+	config.Broker.Kafka.Brokers = getEnv("KAFKA_BROKERS", "kafka:9092")
+	config.Broker.NATS.URL = getEnv("NATS_URL", "nats://nats:4222")
+
+	// how often the outbox is relayed, how many events one pass publishes and how long published ones are kept
+	config.Outbox.Interval = getEnv("OUTBOX_INTERVAL", "1s")
+	config.Outbox.BatchSize = getEnv("OUTBOX_BATCH_SIZE", "100")
+	config.Outbox.Retention = getEnv("OUTBOX_RETENTION", "168h")
+
+	// how often the due deletion sagas are resumed
+	config.Saga.Interval = getEnv("SAGA_INTERVAL", "30s")
+
+	// notifications: email by smtp, file, log or memory, SMS by http, file, log or memory,
+	// the file provider appends to NOTIFICATION_FILE
+	config.Notification.Interval = getEnv("NOTIFICATION_INTERVAL", "5s")
+	config.Notification.Email = getEnv("EMAIL_PROVIDER", "log")
+	config.Notification.SMS = getEnv("SMS_PROVIDER", "log")
+	config.Notification.File = getEnv("NOTIFICATION_FILE", "notifications.log")
+	config.Notification.SMTP.Host = getEnv("SMTP_HOST", "localhost")
+	config.Notification.SMTP.Port = getEnv("SMTP_PORT", ":25")
+	config.Notification.SMTP.User = getEnv("SMTP_USER", "")
+	config.Notification.SMTP.Password = getEnv("SMTP_PASSWORD", "")
+	config.Notification.SMTP.From = getEnv("SMTP_FROM", "jobs@localhost")
+	config.Notification.SMSHTTP.URL = getEnv("SMS_URL", "")
+	config.Notification.SMSHTTP.Token = getEnv("SMS_TOKEN", "")
+	config.Notification.SMSHTTP.From = getEnv("SMS_FROM", "")
+	config.Notification.SMSHTTP.Timeout = getEnv("SMS_TIMEOUT", "10s")
+
 	// otlp collector configuration
 	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "localhost")
 	config.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
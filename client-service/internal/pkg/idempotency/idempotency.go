@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"client-service/internal/pkg/principal"
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata the gateways send the Idempotency-Key of a request in
+const MetadataKey = "x-idempotency-key"
+
+// namespace of the ids derived from the keys
+var namespace = uuid.MustParse("6f3a1c52-8a4e-4c1b-9d57-2e0b7a9c4d11")
+
+type ctxKeyIdempotency struct{}
+
+func NewContext(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, ctxKeyIdempotency{}, key)
+}
+
+// FromContext returns the Idempotency-Key of the request, it's empty when the client sent none
+func FromContext(ctx context.Context) string {
+	key, _ := ctx.Value(ctxKeyIdempotency{}).(string)
+	return key
+}
+
+// FromIncomingContext reads the key of the incoming metadata
+func FromIncomingContext(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, MetadataKey); len(values) != 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ID derives the id of the object of the kind a request creates from its key and principal, a
+// retried request then conflicts with the object the first one created instead of creating
+// another. It's false when the request has no key or its principal has no id: the anonymous
+// callers share theirs and one of them would get the object of another.
+func ID(ctx context.Context, kind string) (string, bool) {
+	key := FromContext(ctx)
+	requestPrincipal := principal.FromContext(ctx)
+	if key == "" || !strings.Contains(requestPrincipal, ":") {
+		return "", false
+	}
+	return uuid.NewSHA1(namespace, []byte(requestPrincipal+"\n"+kind+"\n"+key)).String(), true
+}
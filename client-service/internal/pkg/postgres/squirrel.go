@@ -67,7 +67,7 @@ func (s *Squirrel) Lt(key string, value interface{}) sq.Lt {
 }
 
 func (s *Squirrel) Expr(sql string, args ...interface{}) sq.Sqlizer {
-	return sq.Expr(sql, args)
+	return sq.Expr(sql, args...)
 }
 
 func (s *Squirrel) JSONPathWhere(fieldName, jsonbOp, searchField, value string) (string, error) {
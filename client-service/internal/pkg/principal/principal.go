@@ -0,0 +1,43 @@
+package principal
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata the gateways send the principal of a request in,
+// e.g. "client:<id>" or "admin:<id>"
+const MetadataKey = "x-principal"
+
+type ctxKeyPrincipal struct{}
+
+func NewContext(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, ctxKeyPrincipal{}, principal)
+}
+
+// FromContext returns the principal of the request, it's empty for the work the service
+// does on its own
+func FromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(ctxKeyPrincipal{}).(string)
+	return principal
+}
+
+// FromIncomingContext reads the principal of the incoming metadata
+func FromIncomingContext(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, MetadataKey); len(values) != 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// UnaryClientInterceptor passes the principal on to the services called on behalf of the request
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if principal := FromContext(ctx); principal != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, principal)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	watchBatchSize = 100
+	// wakes watchers up when a notification got lost, e.g. while the listener reconnected
+	watchPollInterval = 30 * time.Second
+)
+
+// WatchClients hands fn the client changes after the cursor until ctx is done, a zero cursor starts
+// at the end of the feed. The filter holds comma separated actions and client_id.
+func (u clientService) WatchClients(ctx context.Context, cursor uint64, filter map[string]string, fn func(change *entity.ClientChange) error) error {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "WatchClients")
+	defer span.End()
+
+	actions, err := watchActions(filter["actions"])
+	if err != nil {
+		return err
+	}
+	clientID, err := watchID("client_id", filter["client_id"])
+	if err != nil {
+		return err
+	}
+
+	// subscribed before the first read, so no change between the read and the wait is missed
+	wake, unsubscribe := u.changes.Subscribe()
+	defer unsubscribe()
+
+	first, last, err := u.repo.GetClientChangesRange(ctx)
+	if err != nil {
+		return err
+	}
+	if cursor, err = watchCursor(cursor, first, last); err != nil {
+		return err
+	}
+
+	poll := time.NewTicker(watchPollInterval)
+	defer poll.Stop()
+
+	for {
+		changes, err := u.nextClientChanges(ctx, cursor, func(change *entity.ClientChange) bool {
+			return (len(actions) == 0 || actions[change.Action]) &&
+				(clientID == "" || change.ClientID == clientID)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, change := range changes.matched {
+			if err = fn(change); err != nil {
+				return err
+			}
+		}
+		cursor = changes.cursor
+		if changes.more {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		case <-poll.C:
+		}
+	}
+}
+
+type clientChangeBatch struct {
+	matched []*entity.ClientChange
+	// the last change read, matched or not
+	cursor uint64
+	more   bool
+}
+
+// nextClientChanges reads a batch of changes after the cursor and loads the clients of the matching ones
+func (u clientService) nextClientChanges(ctx context.Context, cursor uint64, match func(change *entity.ClientChange) bool) (*clientChangeBatch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	changes, err := u.repo.GetClientChanges(ctx, cursor, watchBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := clientChangeBatch{
+		cursor: cursor,
+		more:   len(changes) == watchBatchSize,
+	}
+	var ids []string
+	for _, change := range changes {
+		batch.cursor = change.Cursor
+		if !match(change) {
+			continue
+		}
+		batch.matched = append(batch.matched, change)
+		if change.Action != entity.ChangeDeleted {
+			ids = append(ids, change.ClientID)
+		}
+	}
+	if len(ids) == 0 {
+		return &batch, nil
+	}
+
+	clients, err := u.repo.GetClientsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.Client, len(clients))
+	for _, client := range clients {
+		byID[client.GUID] = client
+	}
+	for _, change := range batch.matched {
+		if change.Action != entity.ChangeDeleted {
+			change.Client = byID[change.ClientID]
+		}
+	}
+
+	return &batch, nil
+}
+
+// PruneClientChanges drops the changes older than the retention from the feed
+func (u clientService) PruneClientChanges(ctx context.Context, retention time.Duration) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "PruneClientChanges")
+	defer span.End()
+
+	return u.repo.PruneClientChanges(ctx, time.Now().UTC().Add(-retention))
+}
+
+// watchCursor checks a cursor against the range of the feed, zero is its end
+func watchCursor(cursor, first, last uint64) (uint64, error) {
+	switch {
+	case cursor == 0:
+		return last, nil
+	case cursor < first:
+		return 0, entity.NewErrPrecondition("the changes after the cursor were pruned, resync and watch without a cursor")
+	case cursor > last:
+		return 0, entity.NewErrPrecondition("the cursor is ahead of the change feed")
+	}
+	return cursor, nil
+}
+
+func watchActions(value string) (map[string]bool, error) {
+	actions := make(map[string]bool)
+	for _, action := range strings.Split(value, ",") {
+		switch action = strings.ToLower(strings.TrimSpace(action)); action {
+		case "":
+		case entity.ChangeCreated, entity.ChangeUpdated, entity.ChangeDeleted:
+			actions[action] = true
+		default:
+			return nil, fmt.Errorf("unknown action %q, expected created, updated or deleted", action)
+		}
+	}
+	return actions, nil
+}
+
+func watchID(name, value string) (string, error) {
+	if value = strings.TrimSpace(value); value == "" {
+		return "", nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("%s should be a uuid", name)
+	}
+	return id.String(), nil
+}
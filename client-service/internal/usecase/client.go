@@ -2,35 +2,66 @@ package usecase
 
 import (
 	"client-service/internal/entity"
+	"client-service/internal/infrastructure/dictionaries"
 	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/idempotency"
 	"client-service/internal/pkg/otlp"
+	"client-service/internal/pkg/principal"
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/currency"
 )
 
+// maxLookupIDs bounds a batch lookup, bigger sets are looked up in several calls
+const maxLookupIDs = 500
+
 type Client interface {
 	CreateClient(ctx context.Context, article *entity.Client) (*entity.Client, error)
 	UpdateClient(ctx context.Context, article *entity.Client) (*entity.Client, error)
 	DeleteClient(ctx context.Context, guid string) error
 	GetClient(ctx context.Context, params map[string]string) (*entity.Client, error)
+	GetClientsByIDs(ctx context.Context, ids []string) (clients []*entity.Client, missing []string, err error)
 	GetAllClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Client, error)
 	GetAllDeletedClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Client, error)
 	GetAllHiddenClients(ctx context.Context, limit, offset uint64, filter map[string]bool) ([]*entity.Client, error)
 	UniqueEmail(ctx context.Context, request *entity.IsUnique) (*entity.Response, error)
 	UpdateRefresh(ctx context.Context, request *entity.UpdateRefresh) (*entity.Response, error)
 	UpdatePassword(ctx context.Context, request *entity.UpdatePassword) (*entity.Response, error)
+	BatchCreateClients(ctx context.Context, clients []*entity.Client) ([]*entity.BatchResult, error)
+	StreamClients(ctx context.Context, scope string, filter map[string]string, fn func(client *entity.Client) error) error
+	WatchClients(ctx context.Context, cursor uint64, filter map[string]string, fn func(change *entity.ClientChange) error) error
+	PruneClientChanges(ctx context.Context, retention time.Duration) (uint64, error)
+	HideClient(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error)
+	UnhideClient(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error)
+	GetClientStatusHistory(ctx context.Context, clientID string) ([]*entity.ClientStatusChange, error)
+	FindDuplicates(ctx context.Context) (uint64, error)
+	GetDuplicates(ctx context.Context, limit, offset uint64, status string) ([]*entity.ClientDuplicate, error)
+	DismissDuplicate(ctx context.Context, guid, actor string) error
+	MergeClients(ctx context.Context, request *entity.MergeClients, moveJobs MoveJobs) error
+	UpsertClientProfile(ctx context.Context, profile *entity.ClientProfile) (*entity.ClientProfile, error)
+	GetClientProfile(ctx context.Context, clientID string) (*entity.ClientProfile, error)
+	GetClientProfiles(ctx context.Context, limit, offset uint64, skills []string) ([]*entity.ClientProfile, error)
 }
 
 type clientService struct {
 	BaseUseCase
-	repo       repository.Clients
-	ctxTimeout time.Duration
+	repo         repository.Clients
+	changes      repository.Notifications
+	dictionaries dictionaries.Dictionaries
+	ctxTimeout   time.Duration
 }
 
-func NewUserService(ctxTimeout time.Duration, repo repository.Clients) Client {
+func NewUserService(ctxTimeout time.Duration, repo repository.Clients, changes repository.Notifications, dictionaries dictionaries.Dictionaries) Client {
 	return clientService{
-		ctxTimeout: ctxTimeout,
-		repo:       repo,
+		ctxTimeout:   ctxTimeout,
+		repo:         repo,
+		changes:      changes,
+		dictionaries: dictionaries,
 	}
 }
 
@@ -42,6 +73,9 @@ func (u clientService) CreateClient(ctx context.Context, user *entity.Client) (*
 	defer span.End()
 
 	u.beforeRequest(&user.GUID, &user.CreatedAt, &user.UpdatedAt)
+	if id, ok := idempotency.ID(ctx, "client"); ok {
+		user.GUID = id
+	}
 
 	return u.repo.CreateClient(ctx, user)
 }
@@ -78,6 +112,44 @@ func (u clientService) GetClient(ctx context.Context, params map[string]string)
 	return u.repo.GetClient(ctx, params)
 }
 
+// GetClientsByIDs returns the clients in the order of ids, repeated ids are looked up once
+// and ids without a client, malformed ones included, come back as missing
+func (u clientService) GetClientsByIDs(ctx context.Context, ids []string) ([]*entity.Client, []string, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetClientsByIDs")
+	defer span.End()
+
+	ids, valid, err := lookupIDs(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]*entity.Client, len(valid))
+	if len(valid) != 0 {
+		found, err := u.repo.GetClientsByIDs(ctx, valid)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, client := range found {
+			byID[client.GUID] = client
+		}
+	}
+
+	clients := make([]*entity.Client, 0, len(byID))
+	missing := make([]string, 0)
+	for _, id := range ids {
+		if client, ok := byID[id]; ok {
+			clients = append(clients, client)
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	return clients, missing, nil
+}
+
 func (u clientService) GetAllClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
@@ -138,3 +210,208 @@ func (u clientService) UpdatePassword(ctx context.Context, request *entity.Updat
 
 	return u.repo.UpdatePassword(ctx, request)
 }
+
+func (u clientService) BatchCreateClients(ctx context.Context, clients []*entity.Client) ([]*entity.BatchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "BatchCreateClients")
+	defer span.End()
+
+	var (
+		results = make([]*entity.BatchResult, len(clients))
+		valid   = make([]*entity.Client, 0, len(clients))
+		indexes = make([]int, 0, len(clients))
+	)
+	for index, client := range clients {
+		var missing []string
+		if strings.TrimSpace(client.FirstName) == "" {
+			missing = append(missing, "first_name")
+		}
+		if strings.TrimSpace(client.LastName) == "" {
+			missing = append(missing, "last_name")
+		}
+		if strings.TrimSpace(client.Email) == "" {
+			missing = append(missing, "email")
+		}
+		if client.Password == "" {
+			missing = append(missing, "password")
+		}
+		if len(missing) != 0 {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: entity.NewErrNoRequiredParameter(missing...).Error(),
+			}
+			continue
+		}
+
+		u.beforeRequest(&client.GUID, &client.CreatedAt, &client.UpdatedAt)
+		valid = append(valid, client)
+		indexes = append(indexes, index)
+	}
+
+	if len(valid) != 0 {
+		created, err := u.repo.BatchCreateClients(ctx, valid)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range created {
+			result.Index = uint64(indexes[result.Index])
+			results[result.Index] = result
+		}
+	}
+
+	return results, nil
+}
+
+// StreamClients has no ctxTimeout, an export lives as long as the caller's stream
+func (u clientService) StreamClients(ctx context.Context, scope string, filter map[string]string, fn func(client *entity.Client) error) error {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "StreamClients")
+	defer span.End()
+
+	switch scope {
+	case "":
+		scope = entity.ClientScopeActive
+	case entity.ClientScopeActive, entity.ClientScopeDeleted, entity.ClientScopeHidden:
+	default:
+		return fmt.Errorf("unknown scope %q, expected active, deleted or hidden", scope)
+	}
+	if err := normalizeClientFilter(filter); err != nil {
+		return err
+	}
+	if err := u.normalizeDesiredFilter(ctx, filter); err != nil {
+		return err
+	}
+
+	return u.repo.StreamClients(ctx, scope, filter, fn)
+}
+
+// normalizeClientFilter checks the filters of a client export and brings them to the form of the
+// stored clients and profiles, the empty filters are dropped
+func normalizeClientFilter(filter map[string]string) error {
+	validation := entity.NewErrValidation()
+	for key, value := range filter {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			delete(filter, key)
+			continue
+		}
+		filter[key] = value
+
+		switch key {
+		case "gender":
+			filter[key] = strings.ToLower(value)
+		case "age_from", "age_to":
+			if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+				validation.Errors[key] = fmt.Sprintf("should be a whole number, got %q", value)
+			}
+		case "skills":
+			filter[key] = strings.Join(normalizeSkills(strings.Split(value, ",")), ",")
+		case "salary_from", "salary_to":
+			if !salaryRegexp.MatchString(value) {
+				validation.Errors[key] = fmt.Sprintf("should be a decimal with up to 2 fraction digits, got %q", value)
+			}
+		case "currency":
+			unit, err := currency.ParseISO(value)
+			if err != nil {
+				validation.Errors[key] = fmt.Sprintf("%q is not an ISO 4217 code", value)
+				continue
+			}
+			filter[key] = unit.String()
+		case "pay_period":
+			filter[key] = strings.ToLower(value)
+			switch filter[key] {
+			case "hour", "month", "year":
+			default:
+				validation.Errors[key] = fmt.Sprintf("should be hour, month or year, got %q", value)
+			}
+		}
+	}
+
+	return validationError(validation)
+}
+
+func (u clientService) HideClient(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "HideClient")
+	defer span.End()
+
+	change.Status = false
+	return u.changeClientStatus(ctx, change)
+}
+
+func (u clientService) UnhideClient(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UnhideClient")
+	defer span.End()
+
+	change.Status = true
+	return u.changeClientStatus(ctx, change)
+}
+
+// changeClientStatus records the principal of the request as the actor, the actor of the
+// change is only taken for the work the service does on its own
+func (u clientService) changeClientStatus(ctx context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error) {
+	if actor := principal.FromContext(ctx); actor != "" {
+		change.Actor = actor
+	}
+
+	var missing []string
+	if strings.TrimSpace(change.ClientID) == "" {
+		missing = append(missing, "client_id")
+	}
+	if strings.TrimSpace(change.Reason) == "" {
+		missing = append(missing, "reason")
+	}
+	if strings.TrimSpace(change.Actor) == "" {
+		missing = append(missing, "actor")
+	}
+	if len(missing) != 0 {
+		return nil, entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	u.beforeRequest(&change.GUID, &change.CreatedAt, nil)
+
+	return u.repo.ChangeClientStatus(ctx, change)
+}
+
+func (u clientService) GetClientStatusHistory(ctx context.Context, clientID string) ([]*entity.ClientStatusChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetClientStatusHistory")
+	defer span.End()
+
+	return u.repo.GetClientStatusHistory(ctx, clientID)
+}
+
+// lookupIDs drops blank and repeated ids, uuids are put in their canonical form.
+// valid holds the ids the database can be asked for.
+func lookupIDs(in []string) (ids, valid []string, err error) {
+	if len(in) > maxLookupIDs {
+		return nil, nil, fmt.Errorf("at most %d ids can be looked up at once", maxLookupIDs)
+	}
+
+	seen := make(map[string]bool, len(in))
+	for _, id := range in {
+		id = strings.TrimSpace(id)
+		parsed, err := uuid.Parse(id)
+		if err == nil {
+			id = parsed.String()
+		}
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+		if err == nil {
+			valid = append(valid, id)
+		}
+	}
+
+	return ids, valid, nil
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/principal"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalizeClientFilter(t *testing.T) {
+	filter := map[string]string{
+		"gender":     "Male",
+		"age_from":   "18",
+		"age_to":     " ",
+		"skills":     "Go,  SQL ,go",
+		"level":      " Senior ",
+		"salary_to":  "1500.50",
+		"currency":   "uzs",
+		"pay_period": "Month",
+	}
+	if err := normalizeClientFilter(filter); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"gender":     "male",
+		"age_from":   "18",
+		"skills":     "go,sql",
+		"level":      "Senior",
+		"salary_to":  "1500.50",
+		"currency":   "UZS",
+		"pay_period": "month",
+	}
+	if !reflect.DeepEqual(filter, want) {
+		t.Errorf("filter = %v, want %v", filter, want)
+	}
+
+	err := normalizeClientFilter(map[string]string{
+		"age_to":      "-1",
+		"salary_from": "a lot",
+		"currency":    "XX",
+		"pay_period":  "week",
+	})
+	var validation *entity.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+	for _, key := range []string{"age_to", "salary_from", "currency", "pay_period"} {
+		if _, ok := validation.Errors[key]; !ok {
+			t.Errorf("errors = %v, want %s", validation.Errors, key)
+		}
+	}
+}
+
+// statusRepo keeps the status changes, the other methods of the repository aren't called
+type statusRepo struct {
+	repository.Clients
+	changes []*entity.ClientStatusChange
+}
+
+func (r *statusRepo) ChangeClientStatus(_ context.Context, change *entity.ClientStatusChange) (*entity.ClientStatusChange, error) {
+	r.changes = append(r.changes, change)
+	return change, nil
+}
+
+func TestClientStatusActor(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal string
+		actor     string
+		want      string
+		err       bool
+	}{
+		{name: "principal wins over the claimed actor", principal: "admin:7", actor: "someone else", want: "admin:7"},
+		{name: "principal without an actor", principal: "admin:7", want: "admin:7"},
+		{name: "work of the service", actor: "merge", want: "merge"},
+		{name: "nobody", err: true},
+	}
+	for _, tt := range tests {
+		repo := &statusRepo{}
+		u := clientService{repo: repo, ctxTimeout: time.Second}
+
+		ctx := context.Background()
+		if tt.principal != "" {
+			ctx = principal.NewContext(ctx, tt.principal)
+		}
+		_, err := u.HideClient(ctx, &entity.ClientStatusChange{ClientID: "42", Reason: "spam", Actor: tt.actor})
+		if (err != nil) != tt.err {
+			t.Errorf("%s: err = %v, want error %t", tt.name, err, tt.err)
+			continue
+		}
+		if !tt.err && repo.changes[0].Actor != tt.want {
+			t.Errorf("%s: actor = %q, want %q", tt.name, repo.changes[0].Actor, tt.want)
+		}
+	}
+}
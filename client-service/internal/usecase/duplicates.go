@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// a pair is queued for review when its score reaches duplicateThreshold,
+// an equal normalized email alone is enough, a name match alone never is
+const (
+	duplicateThreshold = 0.45
+
+	weightEmail      = 0.5
+	weightEmailLocal = 0.25
+	weightPhone      = 0.3
+	weightName       = 0.2
+
+	// names below this Jaro-Winkler similarity don't count at all
+	minNameSimilarity = 0.85
+	// phones are compared by their last digits, so country codes and trunk prefixes don't matter
+	phoneSuffixLen = 9
+	// blocks bigger than this are skipped
+	maxBlockSize = 50
+)
+
+type duplicateKey struct {
+	guid       string
+	email      string
+	emailLocal string
+	phone      string
+	name       string
+}
+
+func newDuplicateKey(client *entity.Client) duplicateKey {
+	email, local := normalizeEmail(client.Email)
+	return duplicateKey{
+		guid:       client.GUID,
+		email:      email,
+		emailLocal: local,
+		phone:      normalizePhone(client.PhoneNumber),
+		name:       normalizeName(client.FirstName + " " + client.LastName),
+	}
+}
+
+// blocks returns the values a candidate must share with this client to be compared at all
+func (k duplicateKey) blocks() []string {
+	var blocks []string
+	if k.email != "" {
+		blocks = append(blocks, "e:"+k.email)
+	}
+	if len(k.emailLocal) >= 4 {
+		blocks = append(blocks, "l:"+k.emailLocal)
+	}
+	if k.phone != "" {
+		blocks = append(blocks, "p:"+k.phone)
+	}
+	return blocks
+}
+
+func scoreDuplicate(a, b duplicateKey) (float64, []string) {
+	var (
+		score   float64
+		reasons []string
+	)
+
+	switch {
+	case a.email != "" && a.email == b.email:
+		score += weightEmail
+		reasons = append(reasons, "email")
+	case len(a.emailLocal) >= 4 && a.emailLocal == b.emailLocal:
+		score += weightEmailLocal
+		reasons = append(reasons, "email_local")
+	}
+
+	if a.phone != "" && a.phone == b.phone {
+		score += weightPhone
+		reasons = append(reasons, "phone")
+	}
+
+	if similarity := jaroWinkler(a.name, b.name); similarity >= minNameSimilarity {
+		score += weightName * similarity
+		reasons = append(reasons, "name")
+	}
+
+	return score, reasons
+}
+
+// normalizeEmail lowercases the address and drops "+tag" and dots of the local part,
+// so John.Doe+jobs@Mail.com and johndoe@mail.com are the same
+func normalizeEmail(email string) (string, string) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "", ""
+	}
+
+	local, domain := email[:at], email[at+1:]
+	if plus := strings.Index(local, "+"); plus >= 0 {
+		local = local[:plus]
+	}
+	local = strings.ReplaceAll(local, ".", "")
+	if local == "" || domain == "" {
+		return "", ""
+	}
+
+	return local + "@" + domain, local
+}
+
+func normalizePhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) < 7 {
+		return ""
+	}
+	if len(digits) > phoneSuffixLen {
+		digits = digits[len(digits)-phoneSuffixLen:]
+	}
+	return digits
+}
+
+func normalizeName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}), " ")
+}
+
+func jaroWinkler(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	s1, s2 := []rune(a), []rune(b)
+	window := max(len(s1), len(s2))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+	matches := 0
+	for i := range s1 {
+		from, to := max(0, i-window), min(len(s2), i+window+1)
+		for j := from; j < to; j++ {
+			if matched2[j] || s1[i] != s2[j] {
+				continue
+			}
+			matched1[i], matched2[j] = true, true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if s1[i] != s2[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s1), len(s2)) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// FindDuplicates compares active clients that share an email, email local part or phone
+// and queues every pair scoring at least duplicateThreshold. Dismissed and merged pairs stay resolved.
+func (u clientService) FindDuplicates(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "FindDuplicates")
+	defer span.End()
+
+	var (
+		keys   []duplicateKey
+		blocks = make(map[string][]int)
+	)
+	err := u.repo.StreamClients(ctx, entity.ClientScopeActive, nil, func(client *entity.Client) error {
+		key := newDuplicateKey(client)
+		for _, block := range key.blocks() {
+			blocks[block] = append(blocks[block], len(keys))
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		duplicates []*entity.ClientDuplicate
+		compared   = make(map[[2]int]bool)
+	)
+	for _, members := range blocks {
+		// placeholder values like 000000000 would otherwise pair everyone with everyone
+		if len(members) > maxBlockSize {
+			continue
+		}
+		for i := 0; i < len(members); i++ {
+			for j := i + 1; j < len(members); j++ {
+				pair := [2]int{members[i], members[j]}
+				if compared[pair] {
+					continue
+				}
+				compared[pair] = true
+
+				a, b := keys[pair[0]], keys[pair[1]]
+				score, reasons := scoreDuplicate(a, b)
+				if score < duplicateThreshold {
+					continue
+				}
+				if a.guid > b.guid {
+					a, b = b, a
+				}
+
+				duplicate := &entity.ClientDuplicate{
+					Client:    entity.Client{GUID: a.guid},
+					Duplicate: entity.Client{GUID: b.guid},
+					Score:     score,
+					Reasons:   reasons,
+					Status:    entity.DuplicateStatusPending,
+				}
+				u.beforeRequest(&duplicate.GUID, &duplicate.CreatedAt, &duplicate.UpdatedAt)
+				duplicates = append(duplicates, duplicate)
+			}
+		}
+	}
+
+	if len(duplicates) == 0 {
+		return 0, nil
+	}
+
+	return u.repo.SaveDuplicates(ctx, duplicates)
+}
+
+func (u clientService) GetDuplicates(ctx context.Context, limit, offset uint64, status string) ([]*entity.ClientDuplicate, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetDuplicates")
+	defer span.End()
+
+	switch status {
+	case "":
+		status = entity.DuplicateStatusPending
+	case entity.DuplicateStatusPending, entity.DuplicateStatusMerged, entity.DuplicateStatusDismissed:
+	default:
+		return nil, fmt.Errorf("unknown status %q, expected pending, merged or dismissed", status)
+	}
+
+	return u.repo.GetDuplicates(ctx, limit, offset, status)
+}
+
+func (u clientService) DismissDuplicate(ctx context.Context, guid, actor string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DismissDuplicate")
+	defer span.End()
+
+	if strings.TrimSpace(guid) == "" || strings.TrimSpace(actor) == "" {
+		return entity.NewErrNoRequiredParameter("id", "actor")
+	}
+
+	return u.repo.DismissDuplicate(ctx, guid, actor)
+}
+
+// MoveJobs moves the client_jobs rows of a merge to the kept client, the returned moveBack undoes it
+type MoveJobs func(ctx context.Context) (moveBack func(ctx context.Context) error, err error)
+
+// MergeClients soft-deletes the merged client with a merged_into reference. moveJobs runs once
+// both clients are known to exist and aren't deleted, before the soft delete, it should move the
+// client_jobs rows to the kept client. They're moved back when the soft delete fails.
+func (u clientService) MergeClients(ctx context.Context, request *entity.MergeClients, moveJobs MoveJobs) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "MergeClients")
+	defer span.End()
+
+	var missing []string
+	if strings.TrimSpace(request.KeepID) == "" {
+		missing = append(missing, "keep_id")
+	}
+	if strings.TrimSpace(request.MergeID) == "" {
+		missing = append(missing, "merge_id")
+	}
+	if strings.TrimSpace(request.Actor) == "" {
+		missing = append(missing, "actor")
+	}
+	if len(missing) != 0 {
+		return entity.NewErrNoRequiredParameter(missing...)
+	}
+	if request.KeepID == request.MergeID {
+		validation := entity.NewErrValidation()
+		validation.Errors["merge_id"] = "can't merge a client into itself"
+		return validationError(validation)
+	}
+
+	// both clients are checked before the assignments are moved, so a missing one moves nothing
+	for _, guid := range []string{request.KeepID, request.MergeID} {
+		if _, err := u.repo.GetClient(ctx, map[string]string{"id": guid}); err != nil {
+			return err
+		}
+	}
+
+	moveBack, err := moveJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = u.repo.MergeClients(ctx, request); err != nil {
+		// the merged client stays, so it gets its assignments back, even if the request is gone
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), u.ctxTimeout)
+		defer cancel()
+
+		if backErr := moveBack(ctx); backErr != nil {
+			return errors.Join(err, fmt.Errorf("moving the assignments back: %w", backErr))
+		}
+		return err
+	}
+
+	return nil
+}
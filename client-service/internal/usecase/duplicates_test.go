@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		email, normalized, local string
+	}{
+		{"John.Doe+jobs@Mail.com", "johndoe@mail.com", "johndoe"},
+		{"  johndoe@mail.com ", "johndoe@mail.com", "johndoe"},
+		{"not-an-email", "", ""},
+		{"@mail.com", "", ""},
+		{"+tag@mail.com", "", ""},
+		{"john@", "", ""},
+	}
+	for _, tt := range tests {
+		normalized, local := normalizeEmail(tt.email)
+		if normalized != tt.normalized || local != tt.local {
+			t.Errorf("normalizeEmail(%q) = %q, %q, want %q, %q", tt.email, normalized, local, tt.normalized, tt.local)
+		}
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone, want string
+	}{
+		{"+998 (90) 123-45-67", "901234567"},
+		{"8 90 123 45 67", "901234567"},
+		{"123456", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizePhone(tt.phone); got != tt.want {
+			t.Errorf("normalizePhone(%q) = %q, want %q", tt.phone, got, tt.want)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"martha", "marhta", 0.9611},
+		{"dwayne", "duane", 0.84},
+		{"john doe", "john doe", 1},
+		{"", "john", 0},
+		{"abc", "xyz", 0},
+	}
+	for _, tt := range tests {
+		if got := jaroWinkler(tt.a, tt.b); math.Abs(got-tt.want) > 0.0001 {
+			t.Errorf("jaroWinkler(%q, %q) = %.4f, want %.4f", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestScoreDuplicate(t *testing.T) {
+	client := func(first, last, email, phone string) duplicateKey {
+		return newDuplicateKey(&entity.Client{FirstName: first, LastName: last, Email: email, PhoneNumber: phone})
+	}
+
+	tests := []struct {
+		name      string
+		a, b      duplicateKey
+		duplicate bool
+		reasons   []string
+	}{
+		{
+			name:      "same normalized email",
+			a:         client("John", "Doe", "John.Doe+jobs@mail.com", ""),
+			b:         client("Jack", "Smith", "johndoe@mail.com", ""),
+			duplicate: true,
+			reasons:   []string{"email"},
+		},
+		{
+			name:      "same phone and name",
+			a:         client("John", "Doe", "", "+998901234567"),
+			b:         client("Jon", "Doe", "", "90 123 45 67"),
+			duplicate: true,
+			reasons:   []string{"phone", "name"},
+		},
+		{
+			name:      "same name only",
+			a:         client("John", "Doe", "john@a.com", ""),
+			b:         client("John", "Doe", "doe@b.com", ""),
+			duplicate: false,
+			reasons:   []string{"name"},
+		},
+		{
+			name:      "same email local part and name",
+			a:         client("John", "Doe", "johndoe@a.com", ""),
+			b:         client("John", "Doe", "johndoe@b.com", ""),
+			duplicate: true,
+			reasons:   []string{"email_local", "name"},
+		},
+		{
+			name:      "nothing shared",
+			a:         client("John", "Doe", "john@a.com", "901234567"),
+			b:         client("Mary", "Major", "mary@b.com", "907654321"),
+			duplicate: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, reasons := scoreDuplicate(tt.a, tt.b)
+			if duplicate := score >= duplicateThreshold; duplicate != tt.duplicate {
+				t.Errorf("score %.3f, duplicate = %v, want %v", score, duplicate, tt.duplicate)
+			}
+			if !slices.Equal(reasons, tt.reasons) {
+				t.Errorf("reasons = %v, want %v", reasons, tt.reasons)
+			}
+		})
+	}
+}
+
+func TestDuplicateKeyBlocks(t *testing.T) {
+	key := newDuplicateKey(&entity.Client{Email: "jo@mail.com", PhoneNumber: "901234567"})
+	if want := []string{"e:jo@mail.com", "p:901234567"}; !slices.Equal(key.blocks(), want) {
+		t.Errorf("blocks = %v, want %v", key.blocks(), want)
+	}
+}
+
+// mergeRepo finds every client and fails the soft delete of a merge with err
+type mergeRepo struct {
+	repository.Clients
+	err error
+}
+
+func (r mergeRepo) GetClient(_ context.Context, params map[string]string) (*entity.Client, error) {
+	return &entity.Client{GUID: params["id"]}, nil
+}
+
+func (r mergeRepo) MergeClients(context.Context, *entity.MergeClients) error {
+	return r.err
+}
+
+func TestMergeClientsMovesJobsBack(t *testing.T) {
+	errMerge := errors.New("merge failed")
+	errBack := errors.New("move back failed")
+	tests := []struct {
+		name     string
+		mergeErr error
+		backErr  error
+		wantBack bool
+		wantErrs []error
+	}{
+		{"merged", nil, nil, false, nil},
+		{"merge failed", errMerge, nil, true, []error{errMerge}},
+		{"move back failed", errMerge, errBack, true, []error{errMerge, errBack}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := clientService{repo: mergeRepo{err: tt.mergeErr}, ctxTimeout: time.Second}
+
+			var moved, movedBack bool
+			err := u.MergeClients(context.Background(), &entity.MergeClients{
+				KeepID:  "keep",
+				MergeID: "merge",
+				Actor:   "admin",
+			}, func(context.Context) (func(context.Context) error, error) {
+				moved = true
+				return func(ctx context.Context) error {
+					if ctx.Err() != nil {
+						t.Errorf("moveBack got a done context: %v", ctx.Err())
+					}
+					movedBack = true
+					return tt.backErr
+				}, nil
+			})
+
+			if !moved {
+				t.Error("the assignments weren't moved")
+			}
+			if movedBack != tt.wantBack {
+				t.Errorf("moved back = %t, want %t", movedBack, tt.wantBack)
+			}
+			if tt.wantErrs == nil && err != nil {
+				t.Errorf("err = %v, want nil", err)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("err = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeClientsMoveFailed(t *testing.T) {
+	errMove := errors.New("move failed")
+	u := clientService{repo: mergeRepo{err: errors.New("must not be merged")}, ctxTimeout: time.Second}
+
+	err := u.MergeClients(context.Background(), &entity.MergeClients{
+		KeepID:  "keep",
+		MergeID: "merge",
+		Actor:   "admin",
+	}, func(context.Context) (func(context.Context) error, error) {
+		return nil, errMove
+	})
+	if !errors.Is(err, errMove) {
+		t.Errorf("err = %v, want %v", err, errMove)
+	}
+}
@@ -0,0 +1,578 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/notification"
+	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	notificationBatchSize   = 50
+	notificationParallelism = 8
+	// a notification is dead after its attempts, the delay between them doubles from 1m up to 2h
+	notificationMaxAttempts = 6
+	notificationFirstRetry  = time.Minute
+	notificationMaxRetry    = 2 * time.Hour
+	// a claimed notification is taken again after the lease, when its instance died while sending
+	notificationLease = 5 * time.Minute
+
+	// one time codes are 6 digits, a new one can be asked for a minute after the last
+	codeDigits      = 6
+	codeTTL         = 15 * time.Minute
+	codeMaxAttempts = 5
+	codeResendAfter = time.Minute
+)
+
+type Notification interface {
+	Notify(ctx context.Context, request *entity.NotificationRequest) ([]*entity.Notification, error)
+	DeliverDueNotifications(ctx context.Context) (sent, failed uint64, err error)
+	GetNotifications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Notification, error)
+	GetNotificationPreferences(ctx context.Context, clientID string) (*entity.NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) (*entity.NotificationPreferences, error)
+	RequestEmailVerification(ctx context.Context, clientID string) error
+	VerifyEmail(ctx context.Context, clientID, code string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, email, code, password string) error
+}
+
+type notificationService struct {
+	BaseUseCase
+	repo       repository.ClientNotifications
+	clients    repository.Clients
+	templates  *notification.Templates
+	provider   notification.Provider
+	ctxTimeout time.Duration
+}
+
+func NewNotificationService(ctxTimeout time.Duration, repo repository.ClientNotifications, clients repository.Clients, templates *notification.Templates, provider notification.Provider) Notification {
+	return notificationService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		clients:    clients,
+		templates:  templates,
+		provider:   provider,
+	}
+}
+
+// Notify queues the template for the channels the client chose, a hidden client or one who turned
+// the notifications off gets none
+func (u notificationService) Notify(ctx context.Context, request *entity.NotificationRequest) ([]*entity.Notification, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "Notify")
+	defer span.End()
+
+	var missing []string
+	if request.ClientID == "" {
+		missing = append(missing, "client_id")
+	}
+	if request.Template == "" {
+		missing = append(missing, "template")
+	}
+	if len(missing) != 0 {
+		return nil, entity.NewErrNoRequiredParameter(missing...)
+	}
+	if !slices.Contains(entity.NotificationTemplates, request.Template) {
+		return nil, fmt.Errorf("template should be one of %s, got %q", strings.Join(entity.NotificationTemplates, ", "), request.Template)
+	}
+
+	client, err := u.clients.GetClient(ctx, map[string]string{"id": request.ClientID})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("client")
+	}
+	if err != nil {
+		return nil, err
+	}
+	preferences, err := u.repo.GetNotificationPreferences(ctx, client.GUID)
+	if err != nil {
+		return nil, err
+	}
+	if !client.Status || (request.Template == entity.TemplateJobAlert && !preferences.JobAlerts) {
+		return []*entity.Notification{}, nil
+	}
+
+	channels := make([]string, 0, 2)
+	if preferences.Email && client.Email != "" {
+		channels = append(channels, entity.NotificationChannelEmail)
+	}
+	if preferences.SMS && client.PhoneNumber != "" && u.templates.Supports(request.Template, entity.NotificationChannelSMS) {
+		channels = append(channels, entity.NotificationChannelSMS)
+	}
+
+	notifications, err := u.render(client, preferences.Locale, request.Template, request.Data, channels...)
+	if err != nil {
+		return nil, err
+	}
+	if len(notifications) == 0 {
+		return notifications, nil
+	}
+
+	return notifications, u.repo.EnqueueNotifications(ctx, notifications...)
+}
+
+func (u notificationService) GetNotifications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Notification, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetNotifications")
+	defer span.End()
+
+	return u.repo.GetNotifications(ctx, limit, offset, filter)
+}
+
+func (u notificationService) GetNotificationPreferences(ctx context.Context, clientID string) (*entity.NotificationPreferences, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetNotificationPreferences")
+	defer span.End()
+
+	if clientID == "" {
+		return nil, entity.NewErrNoRequiredParameter("client_id")
+	}
+
+	preferences, err := u.repo.GetNotificationPreferences(ctx, clientID)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("client")
+	}
+	return preferences, err
+}
+
+// UpdateNotificationPreferences turns SMS on only for clients with a phone number
+func (u notificationService) UpdateNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) (*entity.NotificationPreferences, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateNotificationPreferences")
+	defer span.End()
+
+	if preferences.ClientID == "" {
+		return nil, entity.NewErrNoRequiredParameter("client_id")
+	}
+
+	client, err := u.clients.GetClient(ctx, map[string]string{"id": preferences.ClientID})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("client")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	validation := entity.NewErrValidation()
+	preferences.Locale = strings.ToLower(strings.TrimSpace(preferences.Locale))
+	if preferences.Locale == "" {
+		preferences.Locale = entity.DefaultLocale
+	}
+	if !slices.Contains(entity.NotificationLocales, preferences.Locale) {
+		validation.Errors["locale"] = "should be one of " + strings.Join(entity.NotificationLocales, ", ")
+	}
+	if preferences.SMS && client.PhoneNumber == "" {
+		validation.Errors["sms"] = "needs the phone number of the client"
+	}
+	if err = validationError(validation); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(nil, &preferences.CreatedAt, &preferences.UpdatedAt)
+
+	return u.repo.UpsertNotificationPreferences(ctx, preferences)
+}
+
+// RequestEmailVerification sends a code to the email of the client, VerifyEmail checks it
+func (u notificationService) RequestEmailVerification(ctx context.Context, clientID string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "RequestEmailVerification")
+	defer span.End()
+
+	if clientID == "" {
+		return entity.NewErrNoRequiredParameter("client_id")
+	}
+
+	client, err := u.clients.GetClient(ctx, map[string]string{"id": clientID})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrNotFound("client")
+	}
+	if err != nil {
+		return err
+	}
+	preferences, err := u.repo.GetNotificationPreferences(ctx, client.GUID)
+	if err != nil {
+		return err
+	}
+	if preferences.EmailVerified {
+		return entity.NewErrPrecondition("the email is verified already")
+	}
+
+	return u.sendCode(ctx, client, preferences, entity.CodePurposeVerifyEmail, entity.TemplateVerification, entity.NotificationChannelEmail)
+}
+
+func (u notificationService) VerifyEmail(ctx context.Context, clientID, code string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "VerifyEmail")
+	defer span.End()
+
+	if clientID == "" || code == "" {
+		return entity.NewErrNoRequiredParameter("client_id", "code")
+	}
+
+	client, err := u.clients.GetClient(ctx, map[string]string{"id": clientID})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrNotFound("client")
+	}
+	if err != nil {
+		return err
+	}
+
+	stored, err := u.checkCode(ctx, client.GUID, entity.CodePurposeVerifyEmail, code)
+	if err != nil {
+		return err
+	}
+	if stored.Destination != client.Email {
+		return entity.NewErrPrecondition("the email changed after the code was sent, request a new one")
+	}
+
+	err = u.repo.ConfirmEmail(ctx, stored, time.Now().UTC())
+	if errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrPrecondition("the code is used already, request a new one")
+	}
+	return err
+}
+
+// RequestPasswordReset sends a code to the email of the client and by SMS when the client chose
+// it. An unknown email gets no error, so the answer doesn't tell which emails have an account.
+func (u notificationService) RequestPasswordReset(ctx context.Context, email string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "RequestPasswordReset")
+	defer span.End()
+
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return entity.NewErrNoRequiredParameter("email")
+	}
+
+	client, err := u.clients.GetClient(ctx, map[string]string{"email": email})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	preferences, err := u.repo.GetNotificationPreferences(ctx, client.GUID)
+	if err != nil {
+		return err
+	}
+
+	channels := []string{entity.NotificationChannelEmail}
+	if preferences.SMS && client.PhoneNumber != "" {
+		channels = append(channels, entity.NotificationChannelSMS)
+	}
+	err = u.sendCode(ctx, client, preferences, entity.CodePurposeResetPassword, entity.TemplatePasswordReset, channels...)
+
+	var errPrecondition *entity.ErrPrecondition
+	if errors.As(err, &errPrecondition) {
+		return nil
+	}
+	return err
+}
+
+// ResetPassword sets the password when the code is right, the password comes hashed
+func (u notificationService) ResetPassword(ctx context.Context, email, code, password string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ResetPassword")
+	defer span.End()
+
+	email = strings.TrimSpace(email)
+	var missing []string
+	if email == "" {
+		missing = append(missing, "email")
+	}
+	if code == "" {
+		missing = append(missing, "code")
+	}
+	if password == "" {
+		missing = append(missing, "password")
+	}
+	if len(missing) != 0 {
+		return entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	client, err := u.clients.GetClient(ctx, map[string]string{"email": email})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return wrongCode()
+	}
+	if err != nil {
+		return err
+	}
+
+	stored, err := u.checkCode(ctx, client.GUID, entity.CodePurposeResetPassword, code)
+	if err != nil {
+		return err
+	}
+
+	err = u.repo.ResetPassword(ctx, stored, password, time.Now().UTC())
+	if errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrPrecondition("the code is used already, request a new one")
+	}
+	return err
+}
+
+// DeliverDueNotifications sends the due notifications of the queue, those that fail are retried
+// later and given up after their attempts
+func (u notificationService) DeliverDueNotifications(ctx context.Context) (sent, failed uint64, err error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeliverDueNotifications")
+	defer span.End()
+
+	claimCtx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	notifications, err := u.repo.ClaimDueNotifications(claimCtx, time.Now().UTC(), notificationLease, notificationBatchSize)
+	cancel()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		saveErr  error
+		parallel = make(chan struct{}, notificationParallelism)
+	)
+	for _, notification := range notifications {
+		wg.Add(1)
+		parallel <- struct{}{}
+		go func(notification *entity.Notification) {
+			defer func() {
+				<-parallel
+				wg.Done()
+			}()
+
+			ok, err := u.deliver(ctx, notification)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				saveErr = err
+			}
+			if ok {
+				sent++
+			} else {
+				failed++
+			}
+		}(notification)
+	}
+	wg.Wait()
+
+	return sent, failed, saveErr
+}
+
+// deliver sends the notification once and saves the outcome, the error is that of saving it
+func (u notificationService) deliver(ctx context.Context, notification *entity.Notification) (bool, error) {
+	sendErr := u.provider.Send(ctx, notification)
+	if sendErr != nil && ctx.Err() != nil {
+		// shutting down, the notification is taken again once the lease is over
+		return false, nil
+	}
+
+	now := time.Now().UTC()
+	notification.Attempts++
+	notification.UpdatedAt = now
+	if sendErr == nil {
+		notification.Status = entity.NotificationSent
+		notification.LastError = ""
+		notification.SentAt = now
+	} else {
+		notification.LastError = sendErr.Error()
+		notification.NextAttemptAt = now.Add(notificationRetryDelay(notification.Attempts))
+		if notification.Attempts >= notificationMaxAttempts {
+			notification.Status = entity.NotificationDead
+		}
+	}
+
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), u.ctxTimeout)
+	defer cancel()
+	if err := u.repo.SaveNotification(saveCtx, notification); err != nil {
+		return false, u.Error("save notification", err)
+	}
+	return sendErr == nil, nil
+}
+
+// sendCode replaces the code of the client for the purpose and queues it on the channels
+func (u notificationService) sendCode(ctx context.Context, client *entity.Client, preferences *entity.NotificationPreferences, purpose, template string, channels ...string) error {
+	now := time.Now().UTC()
+	last, err := u.repo.GetVerificationCode(ctx, client.GUID, purpose)
+	if err != nil && !errors.Is(err, entity.ErrorNotFound) {
+		return err
+	}
+	if err == nil && now.Sub(last.CreatedAt) < codeResendAfter {
+		return entity.NewErrPrecondition("a code was sent less than a minute ago, try again later")
+	}
+
+	code, err := newCode()
+	if err != nil {
+		return err
+	}
+	verification := &entity.VerificationCode{
+		GUID:        uuid.New().String(),
+		ClientID:    client.GUID,
+		Purpose:     purpose,
+		Destination: client.Email,
+		CodeHash:    codeHash(client.GUID, purpose, code),
+		ExpiresAt:   now.Add(codeTTL),
+		CreatedAt:   now,
+	}
+
+	data := map[string]any{
+		"Code":      code,
+		"ExpiresIn": int(codeTTL.Minutes()),
+	}
+	notifications, err := u.render(client, preferences.Locale, template, data, channels...)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.CreateVerificationCode(ctx, verification, notifications...)
+}
+
+// checkCode returns the unused code of the client when it matches, a wrong code counts
+// against its attempts
+func (u notificationService) checkCode(ctx context.Context, clientID, purpose, code string) (*entity.VerificationCode, error) {
+	stored, err := u.repo.GetVerificationCode(ctx, clientID, purpose)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, wrongCode()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case time.Now().UTC().After(stored.ExpiresAt):
+		return nil, entity.NewErrPrecondition("the code has expired, request a new one")
+	case stored.Attempts >= codeMaxAttempts:
+		return nil, entity.NewErrPrecondition("too many wrong codes, request a new one")
+	}
+
+	hash := codeHash(clientID, purpose, strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(stored.CodeHash)) != 1 {
+		if err = u.repo.FailVerificationCode(ctx, stored.GUID); err != nil {
+			return nil, err
+		}
+		return nil, wrongCode()
+	}
+
+	return stored, nil
+}
+
+// render renders the template for every channel, the data gets the name of the client
+func (u notificationService) render(client *entity.Client, locale, template string, data map[string]any, channels ...string) ([]*entity.Notification, error) {
+	values := make(map[string]any, len(data)+2)
+	for key, value := range data {
+		values[key] = value
+	}
+	values["FirstName"] = client.FirstName
+	values["LastName"] = client.LastName
+
+	now := time.Now().UTC()
+	notifications := make([]*entity.Notification, 0, len(channels))
+	for _, channel := range channels {
+		message, usedLocale, err := u.templates.Render(template, locale, channel, values)
+		if err != nil {
+			return nil, err
+		}
+
+		to := client.Email
+		if channel == entity.NotificationChannelSMS {
+			to = client.PhoneNumber
+		}
+		notifications = append(notifications, &entity.Notification{
+			GUID:          uuid.New().String(),
+			ClientID:      client.GUID,
+			Channel:       channel,
+			Template:      template,
+			Locale:        usedLocale,
+			To:            to,
+			Subject:       message.Subject,
+			Body:          message.Body,
+			HTML:          message.HTML,
+			Status:        entity.NotificationPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	return notifications, nil
+}
+
+// notificationRetryDelay is the delay after the attempts, doubled with every attempt
+func notificationRetryDelay(attempts int) time.Duration {
+	delay := notificationFirstRetry
+	for i := 1; i < attempts && delay < notificationMaxRetry; i++ {
+		delay *= 2
+	}
+	return min(delay, notificationMaxRetry)
+}
+
+func newCode() (string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(codeDigits), nil)
+	number, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", codeDigits, number), nil
+}
+
+// codeHash binds the code to the client and the purpose, so a code is no good for anything else
+func codeHash(clientID, purpose, code string) string {
+	sum := sha256.Sum256([]byte(purpose + ":" + clientID + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func wrongCode() error {
+	validation := entity.NewErrValidation()
+	validation.Errors["code"] = "is wrong"
+	return validationError(validation)
+}
+
+// validationError returns nil when there are no errors, otherwise the errors sorted by field
+func validationError(validation *entity.ErrValidation) error {
+	if len(validation.Errors) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(validation.Errors))
+	for field := range validation.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, field+": "+validation.Errors[field])
+	}
+	validation.Err = errors.New(strings.Join(messages, "; "))
+
+	return validation
+}
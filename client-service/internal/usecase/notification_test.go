@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/notification"
+	"client-service/internal/infrastructure/repository"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// codeRepo has one code of the client, a wrong code counts against its attempts
+type codeRepo struct {
+	repository.ClientNotifications
+	code *entity.VerificationCode
+}
+
+func (r *codeRepo) GetVerificationCode(_ context.Context, clientID, purpose string) (*entity.VerificationCode, error) {
+	if r.code == nil || r.code.ClientID != clientID || r.code.Purpose != purpose {
+		return nil, entity.ErrorNotFound
+	}
+	code := *r.code
+	return &code, nil
+}
+
+func (r *codeRepo) FailVerificationCode(context.Context, string) error {
+	r.code.Attempts++
+	return nil
+}
+
+func TestCheckCode(t *testing.T) {
+	newRepo := func(expiresAt time.Time) *codeRepo {
+		return &codeRepo{code: &entity.VerificationCode{
+			GUID:      "code",
+			ClientID:  "c1",
+			Purpose:   entity.CodePurposeVerifyEmail,
+			CodeHash:  codeHash("c1", entity.CodePurposeVerifyEmail, "123456"),
+			ExpiresAt: expiresAt,
+		}}
+	}
+	isWrong := func(err error) bool {
+		var validation *entity.ErrValidation
+		return errors.As(err, &validation) && validation.Errors["code"] != ""
+	}
+	isPrecondition := func(err error) bool {
+		var precondition *entity.ErrPrecondition
+		return errors.As(err, &precondition)
+	}
+	ctx := context.Background()
+
+	repo := newRepo(time.Now().Add(codeTTL))
+	u := notificationService{repo: repo}
+	if stored, err := u.checkCode(ctx, "c1", entity.CodePurposeVerifyEmail, " 123456 "); err != nil || stored.GUID != "code" {
+		t.Errorf("right code = %v, %v", stored, err)
+	}
+	// the code is bound to the client and the purpose
+	if _, err := u.checkCode(ctx, "c1", entity.CodePurposeResetPassword, "123456"); !isWrong(err) {
+		t.Errorf("code of another purpose = %v, want a wrong code", err)
+	}
+	if _, err := u.checkCode(ctx, "c2", entity.CodePurposeVerifyEmail, "123456"); !isWrong(err) {
+		t.Errorf("code of another client = %v, want a wrong code", err)
+	}
+	if repo.code.Attempts != 0 {
+		t.Errorf("attempts = %d, want 0", repo.code.Attempts)
+	}
+
+	// every wrong code is an attempt, after the last one even the right code is refused
+	for i := 1; i <= codeMaxAttempts; i++ {
+		if _, err := u.checkCode(ctx, "c1", entity.CodePurposeVerifyEmail, "000000"); !isWrong(err) {
+			t.Fatalf("wrong code %d = %v, want a wrong code", i, err)
+		}
+		if repo.code.Attempts != i {
+			t.Fatalf("attempts after %d wrong codes = %d", i, repo.code.Attempts)
+		}
+	}
+	if _, err := u.checkCode(ctx, "c1", entity.CodePurposeVerifyEmail, "123456"); !isPrecondition(err) {
+		t.Errorf("right code after %d wrong ones = %v, want a precondition error", codeMaxAttempts, err)
+	}
+	if repo.code.Attempts != codeMaxAttempts {
+		t.Errorf("attempts = %d, want no more than %d", repo.code.Attempts, codeMaxAttempts)
+	}
+
+	u = notificationService{repo: newRepo(time.Now().Add(-time.Second))}
+	if _, err := u.checkCode(ctx, "c1", entity.CodePurposeVerifyEmail, "123456"); !isPrecondition(err) {
+		t.Errorf("expired code = %v, want a precondition error", err)
+	}
+}
+
+func TestNewCode(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		code, err := newCode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) != codeDigits || len(codeHash("c1", entity.CodePurposeVerifyEmail, code)) != 64 {
+			t.Fatalf("code = %q, want %d digits", code, codeDigits)
+		}
+		for _, digit := range code {
+			if digit < '0' || digit > '9' {
+				t.Fatalf("code = %q, want digits only", code)
+			}
+		}
+	}
+}
+
+func TestRenderFallsBackToDefaultLocale(t *testing.T) {
+	templates, err := notification.LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := notificationService{templates: templates}
+	client := &entity.Client{GUID: "c1", FirstName: "Ali", Email: "ali@example.com", PhoneNumber: "+998901234567"}
+	data := map[string]any{"Code": "123456", "ExpiresIn": 15}
+
+	for locale, want := range map[string]string{"ru": "ru", "uz": "uz", "de": entity.DefaultLocale} {
+		notifications, err := u.render(client, locale, entity.TemplatePasswordReset, data,
+			entity.NotificationChannelEmail, entity.NotificationChannelSMS)
+		if err != nil {
+			t.Fatalf("locale %q: %v", locale, err)
+		}
+		if len(notifications) != 2 {
+			t.Fatalf("locale %q: %d notifications, want one per channel", locale, len(notifications))
+		}
+		email, sms := notifications[0], notifications[1]
+		if email.Locale != want || sms.Locale != want {
+			t.Errorf("locale %q: rendered in %q and %q, want %q", locale, email.Locale, sms.Locale, want)
+		}
+		if email.To != client.Email || sms.To != client.PhoneNumber {
+			t.Errorf("locale %q: sent to %q and %q", locale, email.To, sms.To)
+		}
+		if email.Status != entity.NotificationPending || email.Subject == "" || sms.Subject != "" {
+			t.Errorf("locale %q: email = %+v, sms = %+v", locale, email, sms)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+const maxProfileSkills = 50
+
+// same shape as NUMERIC(14, 2) of client_profiles
+var salaryRegexp = regexp.MustCompile(`^\d{1,12}(\.\d{1,2})?$`)
+
+func (u clientService) UpsertClientProfile(ctx context.Context, profile *entity.ClientProfile) (*entity.ClientProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpsertClientProfile")
+	defer span.End()
+
+	if strings.TrimSpace(profile.ClientID) == "" {
+		return nil, entity.NewErrNoRequiredParameter("client_id")
+	}
+	if err := normalizeProfile(profile); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeDesired(ctx, profile); err != nil {
+		return nil, err
+	}
+	if _, err := u.repo.GetClient(ctx, map[string]string{"id": profile.ClientID}); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(nil, &profile.CreatedAt, &profile.UpdatedAt)
+
+	return u.repo.UpsertClientProfile(ctx, profile)
+}
+
+// GetClientProfile answers an empty profile for a client who hasn't filled it in yet
+func (u clientService) GetClientProfile(ctx context.Context, clientID string) (*entity.ClientProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetClientProfile")
+	defer span.End()
+
+	profile, err := u.repo.GetClientProfile(ctx, clientID)
+	if !errors.Is(err, entity.ErrorNotFound) {
+		return profile, err
+	}
+
+	client, err := u.repo.GetClient(ctx, map[string]string{"id": clientID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ClientProfile{
+		ClientID:  client.GUID,
+		FirstName: client.FirstName,
+		LastName:  client.LastName,
+		Skills:    []string{},
+	}, nil
+}
+
+func (u clientService) GetClientProfiles(ctx context.Context, limit, offset uint64, skills []string) ([]*entity.ClientProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetClientProfiles")
+	defer span.End()
+
+	return u.repo.GetClientProfiles(ctx, limit, offset, normalizeSkills(skills))
+}
+
+// normalizeProfile brings the preferences to the form the jobs use: lowercase skills,
+// an ISO 4217 currency and a pay period, both are required with an expected salary
+func normalizeProfile(profile *entity.ClientProfile) error {
+	profile.Skills = normalizeSkills(profile.Skills)
+	if len(profile.Skills) > maxProfileSkills {
+		return fmt.Errorf("a profile can have at most %d skills", maxProfileSkills)
+	}
+
+	profile.DesiredLevel = strings.TrimSpace(profile.DesiredLevel)
+	profile.DesiredLocationType = strings.TrimSpace(profile.DesiredLocationType)
+	profile.DesiredEmploymentType = strings.TrimSpace(profile.DesiredEmploymentType)
+	profile.ExpectedSalary = strings.TrimSpace(profile.ExpectedSalary)
+	profile.ExpectedCurrency = strings.ToUpper(strings.TrimSpace(profile.ExpectedCurrency))
+	profile.ExpectedPayPeriod = strings.ToLower(strings.TrimSpace(profile.ExpectedPayPeriod))
+
+	if profile.ExpectedCurrency != "" {
+		unit, err := currency.ParseISO(profile.ExpectedCurrency)
+		if err != nil {
+			return fmt.Errorf("expected_currency %q is not an ISO 4217 code", profile.ExpectedCurrency)
+		}
+		profile.ExpectedCurrency = unit.String()
+	}
+	switch profile.ExpectedPayPeriod {
+	case "", "hour", "month", "year":
+	default:
+		return fmt.Errorf("expected_pay_period should be hour, month or year, got %q", profile.ExpectedPayPeriod)
+	}
+
+	if profile.ExpectedSalary == "" {
+		return nil
+	}
+	if !salaryRegexp.MatchString(profile.ExpectedSalary) {
+		return fmt.Errorf("expected_salary should be a decimal with up to 2 fraction digits, got %q", profile.ExpectedSalary)
+	}
+
+	var missing []string
+	if profile.ExpectedCurrency == "" {
+		missing = append(missing, "expected_currency")
+	}
+	if profile.ExpectedPayPeriod == "" {
+		missing = append(missing, "expected_pay_period")
+	}
+	if len(missing) != 0 {
+		return entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	return nil
+}
+
+// normalizeDesired replaces the desired level, location type and employment type with the codes
+// of the job dictionaries they stand for, the recommendations compare them with the jobs as they are
+func (u clientService) normalizeDesired(ctx context.Context, profile *entity.ClientProfile) error {
+	if profile.DesiredLevel == "" && profile.DesiredLocationType == "" && profile.DesiredEmploymentType == "" {
+		return nil
+	}
+
+	dictionaries, err := u.dictionaries.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return normalizeDesired(dictionaries, profile)
+}
+
+func normalizeDesired(dictionaries entity.Dictionaries, profile *entity.ClientProfile) error {
+	validation := entity.NewErrValidation()
+	for _, field := range []struct {
+		name       string
+		dictionary string
+		value      *string
+	}{
+		{name: "desired_level", dictionary: entity.DictionaryLevel, value: &profile.DesiredLevel},
+		{name: "desired_location_type", dictionary: entity.DictionaryLocationType, value: &profile.DesiredLocationType},
+		{name: "desired_employment_type", dictionary: entity.DictionaryEmploymentType, value: &profile.DesiredEmploymentType},
+	} {
+		if *field.value == "" {
+			continue
+		}
+		code, ok := lookupCode(dictionaries[field.dictionary], *field.value)
+		if !ok {
+			codes := make([]string, 0, len(dictionaries[field.dictionary]))
+			for _, entry := range dictionaries[field.dictionary] {
+				codes = append(codes, entry.Code)
+			}
+			validation.Errors[field.name] = fmt.Sprintf("unknown value %q, should be one of %s", *field.value, strings.Join(codes, ", "))
+			continue
+		}
+		*field.value = code
+	}
+
+	return validationError(validation)
+}
+
+// normalizeDesiredFilter replaces the level, location type and employment type a client export
+// is filtered by with the dictionary codes the profiles store
+func (u clientService) normalizeDesiredFilter(ctx context.Context, filter map[string]string) error {
+	if filter[entity.DictionaryLevel] == "" && filter[entity.DictionaryLocationType] == "" && filter[entity.DictionaryEmploymentType] == "" {
+		return nil
+	}
+
+	dictionaries, err := u.dictionaries.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return normalizeDesiredFilter(dictionaries, filter)
+}
+
+func normalizeDesiredFilter(dictionaries entity.Dictionaries, filter map[string]string) error {
+	validation := entity.NewErrValidation()
+	// the filters are named after the dictionaries
+	for _, name := range []string{entity.DictionaryLevel, entity.DictionaryLocationType, entity.DictionaryEmploymentType} {
+		value, ok := filter[name]
+		if !ok || value == "" {
+			continue
+		}
+		code, ok := lookupCode(dictionaries[name], value)
+		if !ok {
+			codes := make([]string, 0, len(dictionaries[name]))
+			for _, entry := range dictionaries[name] {
+				codes = append(codes, entry.Code)
+			}
+			validation.Errors[name] = fmt.Sprintf("unknown value %q, should be one of %s", value, strings.Join(codes, ", "))
+			continue
+		}
+		filter[name] = code
+	}
+
+	return validationError(validation)
+}
+
+// lookupCode matches the value against the codes and the labels in every language,
+// ignoring the case, dashes and underscores the way job-service does
+func lookupCode(entries []*entity.DictionaryEntry, value string) (string, bool) {
+	value = normalizeValue(value)
+	for _, entry := range entries {
+		if normalizeValue(entry.Code) == value {
+			return entry.Code, true
+		}
+	}
+	for _, entry := range entries {
+		for _, label := range entry.Labels {
+			if normalizeValue(label) == value {
+				return entry.Code, true
+			}
+		}
+	}
+	return "", false
+}
+
+func normalizeValue(value string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), " ")
+}
+
+// normalizeSkills lowercases and sorts the skills, empty ones and repeats are dropped
+func normalizeSkills(skills []string) []string {
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.Join(strings.Fields(skill), " "))
+		if skill == "" || seen[skill] {
+			continue
+		}
+		seen[skill] = true
+		normalized = append(normalized, skill)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
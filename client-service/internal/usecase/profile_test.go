@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"errors"
+	"testing"
+)
+
+func TestNormalizeDesired(t *testing.T) {
+	dictionaries := entity.Dictionaries{
+		entity.DictionaryLevel: {
+			{Code: "Senior", Labels: map[string]string{"en": "Senior", "ru": "Старший"}},
+		},
+		entity.DictionaryLocationType: {
+			{Code: "On-site", Labels: map[string]string{"en": "On-site", "ru": "В офисе"}},
+		},
+		entity.DictionaryEmploymentType: {
+			{Code: "Full-Time", Labels: map[string]string{"en": "Full-time"}},
+			{Code: "Internship-Program", Labels: map[string]string{"en": "Internship programme"}},
+		},
+	}
+
+	profile := &entity.ClientProfile{
+		DesiredLevel:          "старший",
+		DesiredLocationType:   "on site",
+		DesiredEmploymentType: "internship_program",
+	}
+	if err := normalizeDesired(dictionaries, profile); err != nil {
+		t.Fatal(err)
+	}
+	// codes longer than the old VARCHAR(10) are kept as they are
+	if profile.DesiredLevel != "Senior" || profile.DesiredLocationType != "On-site" || profile.DesiredEmploymentType != "Internship-Program" {
+		t.Errorf("profile = %+v, want the codes", profile)
+	}
+
+	err := normalizeDesired(dictionaries, &entity.ClientProfile{DesiredLevel: "Guru", DesiredEmploymentType: "full time"})
+	var validation *entity.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+	if _, ok := validation.Errors["desired_level"]; !ok || len(validation.Errors) != 1 {
+		t.Errorf("errors = %v, want desired_level only", validation.Errors)
+	}
+
+	if err = normalizeDesired(nil, &entity.ClientProfile{}); err != nil {
+		t.Errorf("an empty profile fails: %v", err)
+	}
+}
+
+func TestNormalizeDesiredFilter(t *testing.T) {
+	dictionaries := entity.Dictionaries{
+		entity.DictionaryLevel: {
+			{Code: "Senior", Labels: map[string]string{"en": "Senior", "ru": "Старший"}},
+		},
+		entity.DictionaryEmploymentType: {
+			{Code: "Full-Time", Labels: map[string]string{"en": "Full-time"}},
+		},
+	}
+
+	filter := map[string]string{"level": "старший", "employment_type": "full_time", "gender": "male"}
+	if err := normalizeDesiredFilter(dictionaries, filter); err != nil {
+		t.Fatal(err)
+	}
+	if filter["level"] != "Senior" || filter["employment_type"] != "Full-Time" || filter["gender"] != "male" {
+		t.Errorf("filter = %v, want the codes", filter)
+	}
+
+	err := normalizeDesiredFilter(dictionaries, map[string]string{"level": "Guru", "employment_type": "Full-Time"})
+	var validation *entity.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+	if _, ok := validation.Errors["level"]; !ok || len(validation.Errors) != 1 {
+		t.Errorf("errors = %v, want level only", validation.Errors)
+	}
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"client-service/internal/entity"
+	"client-service/internal/infrastructure/assignments"
+	"client-service/internal/infrastructure/repository"
+	"client-service/internal/pkg/otlp"
+	"client-service/internal/pkg/principal"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// sagaLease is how long a saga is left to the instance running it before the resumer takes it
+	sagaLease = 2 * time.Minute
+	// a step failing for a transient reason is retried after sagaRetryDelay doubled with every
+	// attempt up to sagaMaxRetryDelay, after sagaMaxAttempts the saga is compensated
+	sagaRetryDelay    = 30 * time.Second
+	sagaMaxRetryDelay = 30 * time.Minute
+	sagaMaxAttempts   = 10
+	sagaResumeBatch   = 20
+)
+
+type Saga interface {
+	// DeleteClient ends the assignments of the client in job-service and deletes the client, a
+	// failed deletion reopens the assignments. An error of entity.ErrPending means it's retried
+	// in background, entity.ErrConflict that the client is being deleted already.
+	DeleteClient(ctx context.Context, guid string) error
+	// ResumeSagas runs the due sagas and returns them as they ended up
+	ResumeSagas(ctx context.Context) ([]*entity.Saga, error)
+}
+
+// sagaStep is a local change or a call to another service, compensate undoes it and is nil for
+// the step past which the saga can't go back
+type sagaStep struct {
+	name       string
+	run        func(ctx context.Context, saga *entity.Saga) error
+	compensate func(ctx context.Context, saga *entity.Saga) error
+}
+
+type sagaService struct {
+	BaseUseCase
+	repo        repository.Sagas
+	clients     repository.Clients
+	assignments assignments.Assignments
+	ctxTimeout  time.Duration
+}
+
+func NewSagaService(ctxTimeout time.Duration, repo repository.Sagas, clients repository.Clients, assignments assignments.Assignments) Saga {
+	return sagaService{
+		ctxTimeout:  ctxTimeout,
+		repo:        repo,
+		clients:     clients,
+		assignments: assignments,
+	}
+}
+
+func (u sagaService) DeleteClient(ctx context.Context, guid string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeleteClient")
+	defer span.End()
+
+	if _, err := u.clients.GetClient(ctx, map[string]string{"id": guid}); err != nil {
+		return err
+	}
+
+	saga, err := u.startSaga(ctx, entity.SagaDeleteClient, guid)
+	if err != nil {
+		return err
+	}
+
+	return u.runSaga(ctx, saga, u.deleteClientSteps())
+}
+
+func (u sagaService) ResumeSagas(ctx context.Context) ([]*entity.Saga, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ResumeSagas")
+	defer span.End()
+
+	claimCtx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	sagas, err := u.repo.ClaimDueSagas(claimCtx, entity.SagaDeleteClient, time.Now().UTC(), sagaLease, sagaResumeBatch)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, saga := range sagas {
+		sagaCtx, cancel := context.WithTimeout(principal.NewContext(ctx, saga.Actor), u.ctxTimeout)
+		// the outcome is kept on the saga
+		_ = u.runSaga(sagaCtx, saga, u.deleteClientSteps())
+		cancel()
+	}
+
+	return sagas, nil
+}
+
+func (u sagaService) deleteClientSteps() []sagaStep {
+	return []sagaStep{
+		{
+			name: "end_assignments",
+			run: func(ctx context.Context, saga *entity.Saga) error {
+				_, err := u.assignments.End(ctx, saga.GUID, saga.EntityID)
+				return err
+			},
+			compensate: func(ctx context.Context, saga *entity.Saga) error {
+				_, overlapping, err := u.assignments.Reopen(ctx, saga.GUID)
+				noteOverlapping(saga, overlapping)
+				return err
+			},
+		},
+		{
+			name: "delete_client",
+			run: func(ctx context.Context, saga *entity.Saga) error {
+				// a client already gone was deleted by an attempt whose outcome wasn't saved
+				_, err := u.clients.GetClient(ctx, map[string]string{"id": saga.EntityID})
+				var errNotFound *entity.ErrNotFound
+				if errors.As(err, &errNotFound) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				return u.clients.DeleteClient(ctx, saga.EntityID)
+			},
+		},
+	}
+}
+
+// noteOverlapping keeps on the saga how many assignments compensating left ended, the client
+// holds the jobs again in an overlapping period
+func noteOverlapping(saga *entity.Saga, overlapping uint64) {
+	if overlapping != 0 {
+		saga.LastError += fmt.Sprintf("; %d assignments stay ended, they overlap ones made since", overlapping)
+	}
+}
+
+// startSaga saves the saga leased to the caller, a deletion of the entity which is already
+// running makes it pending
+func (u sagaService) startSaga(ctx context.Context, kind, entityID string) (*entity.Saga, error) {
+	saga := entity.Saga{
+		Kind:     kind,
+		EntityID: entityID,
+		Status:   entity.SagaRunning,
+		Actor:    principal.FromContext(ctx),
+	}
+	u.beforeRequest(&saga.GUID, &saga.CreatedAt, &saga.UpdatedAt)
+	saga.NextAttemptAt = saga.CreatedAt.Add(sagaLease)
+
+	err := u.repo.CreateSaga(ctx, &saga)
+	var errConflict *entity.ErrConflict
+	if errors.As(err, &errConflict) {
+		return nil, entity.NewErrConflict(fmt.Sprintf("%s of %s is already in progress", kind, entityID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &saga, nil
+}
+
+// runSaga runs the steps from saga.Step on, or compensates them back from there once the saga
+// is compensating. The progress is saved after every step, so a saga interrupted at any point
+// goes on from its last step.
+func (u sagaService) runSaga(ctx context.Context, saga *entity.Saga, steps []sagaStep) error {
+	var cause error
+	for saga.Status == entity.SagaRunning {
+		if saga.Step == len(steps) {
+			saga.Status = entity.SagaCompleted
+			return u.saveSaga(ctx, saga)
+		}
+
+		step := steps[saga.Step]
+		if err := step.run(ctx, saga); err != nil {
+			if !isPermanent(err) && saga.Attempts+1 < sagaMaxAttempts {
+				return u.retrySaga(ctx, saga, step.name, err)
+			}
+			// the failed step may have changed a part of what it changes, so it's compensated too
+			cause = err
+			saga.Status, saga.Attempts, saga.LastError = entity.SagaCompensating, 0, step.name+": "+err.Error()
+		} else {
+			saga.Step++
+			saga.Attempts, saga.LastError = 0, ""
+		}
+		if err := u.saveSaga(ctx, saga); err != nil {
+			return err
+		}
+	}
+
+	for saga.Status == entity.SagaCompensating {
+		if saga.Step < 0 {
+			saga.Status = entity.SagaCompensated
+			if err := u.saveSaga(ctx, saga); err != nil {
+				return err
+			}
+			break
+		}
+
+		step := steps[min(saga.Step, len(steps)-1)]
+		if step.compensate != nil {
+			if err := step.compensate(ctx, saga); err != nil {
+				if !isPermanent(err) && saga.Attempts+1 < sagaMaxAttempts {
+					return u.retrySaga(ctx, saga, "compensate "+step.name, err)
+				}
+				saga.Status, saga.LastError = entity.SagaFailed, "compensate "+step.name+": "+err.Error()
+				if saveErr := u.saveSaga(ctx, saga); saveErr != nil {
+					return saveErr
+				}
+				return err
+			}
+		}
+		saga.Step--
+		saga.Attempts = 0
+		if err := u.saveSaga(ctx, saga); err != nil {
+			return err
+		}
+	}
+
+	if cause != nil {
+		return cause
+	}
+	return entity.NewErrPrecondition(saga.LastError)
+}
+
+// retrySaga leaves the saga to the resumer
+func (u sagaService) retrySaga(ctx context.Context, saga *entity.Saga, stepName string, err error) error {
+	delay := sagaRetryDelay << saga.Attempts
+	if delay > sagaMaxRetryDelay || delay <= 0 {
+		delay = sagaMaxRetryDelay
+	}
+	saga.Attempts++
+	saga.NextAttemptAt = time.Now().UTC().Add(delay)
+	saga.LastError = stepName + ": " + err.Error()
+	if saveErr := u.saveSaga(ctx, saga); saveErr != nil {
+		return saveErr
+	}
+
+	return entity.NewErrPending(fmt.Sprintf("%s of %s will be retried: %s", saga.Kind, saga.EntityID, saga.LastError))
+}
+
+// saveSaga saves the progress even when ctx is done, a step that ran has to be recorded
+func (u sagaService) saveSaga(ctx context.Context, saga *entity.Saga) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), u.ctxTimeout)
+	defer cancel()
+
+	saga.UpdatedAt = time.Now().UTC()
+	return u.repo.SaveSaga(ctx, saga)
+}
+
+// isPermanent reports whether retrying can't fix the error
+func isPermanent(err error) bool {
+	var (
+		errNotFound     *entity.ErrNotFound
+		errConflict     *entity.ErrConflict
+		errValidation   *entity.ErrValidation
+		errNoParameter  *entity.ErrNoRequiredParameter
+		errPrecondition *entity.ErrPrecondition
+	)
+	return errors.As(err, &errNotFound) ||
+		errors.As(err, &errConflict) ||
+		errors.As(err, &errValidation) ||
+		errors.As(err, &errNoParameter) ||
+		errors.As(err, &errPrecondition)
+}
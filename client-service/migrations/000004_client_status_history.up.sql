@@ -0,0 +1,11 @@
+CREATE TABLE client_status_history(
+    id UUID PRIMARY KEY,
+    client_id UUID NOT NULL,
+    status BOOLEAN NOT NULL, -- new value of clients.status, FALSE is hidden
+    reason TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (client_id) REFERENCES clients(id)
+);
+
+CREATE INDEX client_status_history_client_id_idx ON client_status_history(client_id, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS client_duplicates;
+
+ALTER TABLE clients DROP COLUMN IF EXISTS merged_into;
@@ -0,0 +1,18 @@
+ALTER TABLE clients ADD COLUMN merged_into UUID REFERENCES clients(id);
+
+CREATE TABLE client_duplicates(
+    id UUID PRIMARY KEY,
+    client_id UUID NOT NULL, -- the pair is stored once, client_id < duplicate_id
+    duplicate_id UUID NOT NULL,
+    score FLOAT NOT NULL,
+    reasons TEXT NOT NULL, -- comma separated: email, email_local, phone, name
+    status VARCHAR(10) NOT NULL DEFAULT 'pending', -- pending, merged, dismissed
+    resolved_by TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (client_id, duplicate_id),
+    FOREIGN KEY (client_id) REFERENCES clients(id),
+    FOREIGN KEY (duplicate_id) REFERENCES clients(id)
+);
+
+CREATE INDEX client_duplicates_status_idx ON client_duplicates(status, score);
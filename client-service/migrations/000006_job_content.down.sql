@@ -0,0 +1,5 @@
+ALTER TABLE jobs
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS responsibilities,
+    DROP COLUMN IF EXISTS requirements,
+    DROP COLUMN IF EXISTS benefits;
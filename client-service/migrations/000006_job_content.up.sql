@@ -0,0 +1,6 @@
+-- Markdown sources, HTML is rendered by job-service on read
+ALTER TABLE jobs
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN responsibilities TEXT NOT NULL DEFAULT '',
+    ADD COLUMN requirements TEXT NOT NULL DEFAULT '',
+    ADD COLUMN benefits TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS jobs_salary_idx;
+
+ALTER TABLE jobs ADD COLUMN salary FLOAT;
+
+UPDATE jobs SET salary = COALESCE(salary_max, salary_min)::FLOAT;
+
+ALTER TABLE jobs
+    DROP CONSTRAINT IF EXISTS jobs_salary_range_check,
+    DROP CONSTRAINT IF EXISTS jobs_pay_period_check,
+    DROP COLUMN IF EXISTS salary_min,
+    DROP COLUMN IF EXISTS salary_max,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS pay_period;
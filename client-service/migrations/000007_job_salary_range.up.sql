@@ -0,0 +1,22 @@
+-- salary becomes a decimal range with its currency (ISO 4217) and pay period,
+-- NULL bounds mean the salary isn't disclosed
+ALTER TABLE jobs
+    ADD COLUMN salary_min NUMERIC(14, 2),
+    ADD COLUMN salary_max NUMERIC(14, 2),
+    ADD COLUMN currency CHAR(3),
+    ADD COLUMN pay_period VARCHAR(5);
+
+-- the old salary was a single yearly amount in USD (see 000003_mock)
+UPDATE jobs
+SET salary_min = ROUND(salary::NUMERIC, 2),
+    salary_max = ROUND(salary::NUMERIC, 2),
+    currency = 'USD',
+    pay_period = 'year'
+WHERE salary IS NOT NULL;
+
+ALTER TABLE jobs
+    DROP COLUMN salary,
+    ADD CONSTRAINT jobs_salary_range_check CHECK (salary_min IS NULL OR salary_max IS NULL OR salary_min <= salary_max),
+    ADD CONSTRAINT jobs_pay_period_check CHECK (pay_period IN ('hour', 'month', 'year'));
+
+CREATE INDEX IF NOT EXISTS jobs_salary_idx ON jobs(currency, pay_period, salary_min, salary_max) WHERE deleted_at IS NULL;
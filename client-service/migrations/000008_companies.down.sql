@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS jobs_company_id_idx;
+
+ALTER TABLE jobs ADD COLUMN company TEXT NOT NULL DEFAULT '';
+
+UPDATE jobs
+SET company = companies.name
+FROM companies
+WHERE companies.id = jobs.company_id;
+
+ALTER TABLE jobs ALTER COLUMN company DROP DEFAULT;
+
+ALTER TABLE jobs DROP COLUMN IF EXISTS company_id;
+
+DROP TABLE IF EXISTS companies;
@@ -0,0 +1,46 @@
+CREATE TABLE companies(
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    slug VARCHAR(100) NOT NULL UNIQUE, -- lowercase name without legal suffixes (inc, llc, ...), job-service derives it the same way
+    logo TEXT NOT NULL DEFAULT '', -- URL
+    website TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    verified BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+ALTER TABLE jobs ADD COLUMN company_id UUID REFERENCES companies(id);
+
+-- "Google", "google" and "Google LLC" share a slug and become one company,
+-- names without latin letters or digits get a slug from their hash
+CREATE TEMPORARY TABLE job_company_slugs AS
+SELECT id AS job_id,
+       TRIM(company) AS name,
+       COALESCE(
+           NULLIF(LEFT(TRIM(BOTH '-' FROM REGEXP_REPLACE(
+               REGEXP_REPLACE(LOWER(company), '\m(inc|llc|llp|ltd|corp|corporation|co|gmbh|plc)\M', '', 'g'),
+               '[^a-z0-9]+', '-', 'g'
+           )), 100), ''),
+           'company-' || LEFT(MD5(LOWER(TRIM(company))), 8)
+       ) AS slug
+FROM jobs
+WHERE TRIM(company) <> '';
+
+INSERT INTO companies (id, name, slug)
+SELECT gen_random_uuid(), MIN(name), slug
+FROM job_company_slugs
+GROUP BY slug;
+
+UPDATE jobs
+SET company_id = companies.id
+FROM job_company_slugs
+JOIN companies ON companies.slug = job_company_slugs.slug
+WHERE jobs.id = job_company_slugs.job_id;
+
+DROP TABLE job_company_slugs;
+
+ALTER TABLE jobs DROP COLUMN company;
+
+CREATE INDEX IF NOT EXISTS jobs_company_id_idx ON jobs(company_id) WHERE deleted_at IS NULL;
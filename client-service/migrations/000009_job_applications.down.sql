@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS job_application_history;
+DROP TABLE IF EXISTS job_applications;
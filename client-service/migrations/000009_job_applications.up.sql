@@ -0,0 +1,29 @@
+CREATE TABLE job_applications(
+    id UUID PRIMARY KEY,
+    job_id UUID NOT NULL,
+    client_id UUID NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'applied', -- applied, screening, interview, offer, hired, rejected, withdrawn
+    cover_letter TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (job_id) REFERENCES jobs(id),
+    FOREIGN KEY (client_id) REFERENCES clients(id),
+    CHECK (status IN ('applied', 'screening', 'interview', 'offer', 'hired', 'rejected', 'withdrawn'))
+);
+
+-- a client can apply again only after a rejection or a withdrawal
+CREATE UNIQUE INDEX job_applications_open_idx ON job_applications(job_id, client_id) WHERE status NOT IN ('rejected', 'withdrawn');
+CREATE INDEX job_applications_client_id_idx ON job_applications(client_id);
+
+CREATE TABLE job_application_history(
+    id UUID PRIMARY KEY,
+    application_id UUID NOT NULL,
+    from_status VARCHAR(10) NOT NULL,
+    to_status VARCHAR(10) NOT NULL,
+    actor TEXT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (application_id) REFERENCES job_applications(id)
+);
+
+CREATE INDEX job_application_history_application_id_idx ON job_application_history(application_id, created_at);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS jobs_close_at_idx;
+DROP INDEX IF EXISTS jobs_publish_at_idx;
+DROP INDEX IF EXISTS jobs_status_idx;
+
+ALTER TABLE jobs
+    DROP CONSTRAINT IF EXISTS jobs_close_at_check,
+    DROP CONSTRAINT IF EXISTS jobs_status_check,
+    DROP COLUMN IF EXISTS close_at,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
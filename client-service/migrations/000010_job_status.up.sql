@@ -0,0 +1,15 @@
+-- draft jobs wait for an admin, scheduled ones are published by job-service at publish_at,
+-- published ones are closed at close_at
+ALTER TABLE jobs
+    ADD COLUMN status VARCHAR(10) NOT NULL DEFAULT 'draft',
+    ADD COLUMN publish_at TIMESTAMPTZ,
+    ADD COLUMN close_at TIMESTAMPTZ,
+    ADD CONSTRAINT jobs_status_check CHECK (status IN ('draft', 'scheduled', 'published', 'closed')),
+    ADD CONSTRAINT jobs_close_at_check CHECK (close_at IS NULL OR publish_at IS NULL OR close_at > publish_at);
+
+-- existing jobs were live since they were created
+UPDATE jobs SET status = 'published', publish_at = created_at;
+
+CREATE INDEX IF NOT EXISTS jobs_status_idx ON jobs(status) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS jobs_publish_at_idx ON jobs(publish_at) WHERE status = 'scheduled' AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS jobs_close_at_idx ON jobs(close_at) WHERE status = 'published' AND deleted_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE client_jobs DROP CONSTRAINT IF EXISTS client_jobs_no_overlap;
+ALTER TABLE client_jobs DROP CONSTRAINT IF EXISTS client_jobs_client_id_fkey;
+ALTER TABLE client_jobs DROP CONSTRAINT IF EXISTS client_jobs_dates_check;
+ALTER TABLE client_jobs DROP CONSTRAINT IF EXISTS client_jobs_pkey;
+ALTER TABLE client_jobs DROP COLUMN IF EXISTS id;
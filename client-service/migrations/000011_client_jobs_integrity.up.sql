@@ -0,0 +1,37 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE client_jobs ADD COLUMN id UUID NOT NULL DEFAULT gen_random_uuid();
+ALTER TABLE client_jobs ADD PRIMARY KEY (id);
+
+-- assignments ending before they start can't be valid, they are ended before the checks below
+UPDATE client_jobs
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE deleted_at IS NULL AND end_date <= start_date;
+
+-- of overlapping active assignments of a client to a job the oldest one is kept
+UPDATE client_jobs c
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE c.deleted_at IS NULL
+  AND EXISTS (
+      SELECT 1
+      FROM client_jobs o
+      WHERE o.deleted_at IS NULL
+        AND o.client_id = c.client_id
+        AND o.job_id = c.job_id
+        AND (o.created_at, o.id) < (c.created_at, c.id)
+        AND TSTZRANGE(o.start_date, o.end_date) && TSTZRANGE(c.start_date, c.end_date)
+  );
+
+-- NOT VALID keeps the rows written before the checks existed, new writes are checked
+ALTER TABLE client_jobs
+    ADD CONSTRAINT client_jobs_dates_check CHECK (end_date IS NULL OR end_date > start_date) NOT VALID;
+ALTER TABLE client_jobs
+    ADD CONSTRAINT client_jobs_client_id_fkey FOREIGN KEY (client_id) REFERENCES clients(id) NOT VALID;
+
+-- an empty end_date is an assignment without an end
+ALTER TABLE client_jobs
+    ADD CONSTRAINT client_jobs_no_overlap EXCLUDE USING gist (
+        client_id WITH =,
+        job_id WITH =,
+        TSTZRANGE(start_date, end_date) WITH &&
+    ) WHERE (deleted_at IS NULL);
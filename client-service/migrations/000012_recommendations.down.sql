@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS jobs_skills_idx;
+ALTER TABLE jobs DROP COLUMN IF EXISTS skills;
+
+DROP TABLE IF EXISTS client_profiles;
@@ -0,0 +1,21 @@
+-- what a client looks for, used to match clients with jobs.
+-- skills are lowercase, the expected salary is in expected_currency (ISO 4217) per expected_pay_period
+CREATE TABLE client_profiles(
+    client_id UUID PRIMARY KEY REFERENCES clients(id),
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    desired_level VARCHAR(10) NOT NULL DEFAULT '',
+    desired_location_type VARCHAR(7) NOT NULL DEFAULT '',
+    desired_employment_type VARCHAR(10) NOT NULL DEFAULT '',
+    expected_salary NUMERIC(14, 2) CHECK (expected_salary >= 0),
+    expected_currency CHAR(3),
+    expected_pay_period VARCHAR(5) CHECK (expected_pay_period IN ('hour', 'month', 'year')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS client_profiles_skills_idx ON client_profiles USING GIN (skills);
+
+-- skills a job asks for, lowercase
+ALTER TABLE jobs ADD COLUMN skills TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS jobs_skills_idx ON jobs USING GIN (skills) WHERE deleted_at IS NULL;
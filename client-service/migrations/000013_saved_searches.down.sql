@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS jobs_alerts_pending_idx;
+ALTER TABLE jobs DROP COLUMN IF EXISTS alerts_evaluated_at;
+
+DROP TABLE IF EXISTS saved_search_matches;
+DROP TABLE IF EXISTS saved_searches;
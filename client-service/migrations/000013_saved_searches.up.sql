@@ -0,0 +1,34 @@
+-- job searches clients saved to be alerted about new matching jobs.
+-- filters holds the filter set of the job listing
+CREATE TABLE saved_searches(
+    id UUID PRIMARY KEY,
+    client_id UUID NOT NULL REFERENCES clients(id),
+    name VARCHAR(64) NOT NULL,
+    filters JSONB NOT NULL DEFAULT '{}',
+    frequency VARCHAR(7) NOT NULL CHECK (frequency IN ('instant', 'daily', 'weekly')),
+    last_sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS saved_searches_client_id_idx ON saved_searches (client_id) WHERE deleted_at IS NULL;
+
+-- jobs found by a saved search, sent_at is set once the alert went out
+CREATE TABLE saved_search_matches(
+    saved_search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    job_id UUID NOT NULL REFERENCES jobs(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMPTZ,
+    PRIMARY KEY (saved_search_id, job_id)
+);
+
+CREATE INDEX IF NOT EXISTS saved_search_matches_unsent_idx ON saved_search_matches (saved_search_id) WHERE sent_at IS NULL;
+
+-- set once a published job was matched against the saved searches, so every job alerts once.
+-- jobs published before saved searches existed are taken as matched
+ALTER TABLE jobs ADD COLUMN alerts_evaluated_at TIMESTAMPTZ;
+
+UPDATE jobs SET alerts_evaluated_at = CURRENT_TIMESTAMP WHERE status = 'published';
+
+CREATE INDEX IF NOT EXISTS jobs_alerts_pending_idx ON jobs (created_at) WHERE alerts_evaluated_at IS NULL AND deleted_at IS NULL;
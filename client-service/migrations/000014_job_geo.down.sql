@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS jobs_coordinates_idx;
+DROP INDEX IF EXISTS jobs_earth_idx;
+
+ALTER TABLE jobs DROP CONSTRAINT IF EXISTS jobs_coordinates_check;
+ALTER TABLE jobs DROP COLUMN IF EXISTS longitude;
+ALTER TABLE jobs DROP COLUMN IF EXISTS latitude;
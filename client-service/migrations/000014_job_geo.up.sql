@@ -0,0 +1,12 @@
+-- radius search runs on earthdistance, ll_to_earth needs cube
+CREATE EXTENSION IF NOT EXISTS cube;
+CREATE EXTENSION IF NOT EXISTS earthdistance;
+
+-- coordinates of the job address, NULL when it couldn't be geocoded
+ALTER TABLE jobs
+    ADD COLUMN latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT jobs_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+CREATE INDEX IF NOT EXISTS jobs_earth_idx ON jobs USING GIST (ll_to_earth(latitude, longitude)) WHERE latitude IS NOT NULL AND longitude IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS jobs_coordinates_idx ON jobs (latitude, longitude) WHERE deleted_at IS NULL;
@@ -0,0 +1,9 @@
+-- the columns stay VARCHAR(32), narrowing them back could cut the values longer than before
+ALTER TABLE jobs
+    DROP CONSTRAINT IF EXISTS jobs_employment_type_fkey,
+    DROP CONSTRAINT IF EXISTS jobs_location_type_fkey,
+    DROP CONSTRAINT IF EXISTS jobs_level_fkey;
+
+DROP TABLE IF EXISTS job_employment_types;
+DROP TABLE IF EXISTS job_location_types;
+DROP TABLE IF EXISTS job_levels;
@@ -0,0 +1,67 @@
+-- allowed values of jobs.level, jobs.location_type and jobs.employment_type with their labels
+-- by language. code is what jobs store, position orders the entries for dropdowns
+CREATE TABLE job_levels(
+    code VARCHAR(32) PRIMARY KEY,
+    position SMALLINT NOT NULL DEFAULT 0,
+    labels JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE job_location_types(
+    code VARCHAR(32) PRIMARY KEY,
+    position SMALLINT NOT NULL DEFAULT 0,
+    labels JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE job_employment_types(
+    code VARCHAR(32) PRIMARY KEY,
+    position SMALLINT NOT NULL DEFAULT 0,
+    labels JSONB NOT NULL DEFAULT '{}'
+);
+
+INSERT INTO job_levels (code, position, labels)
+VALUES
+    ('Intern', 1, '{"en": "Intern", "ru": "Стажёр", "uz": "Stajyor"}'),
+    ('Junior', 2, '{"en": "Junior", "ru": "Младший", "uz": "Kichik"}'),
+    ('Middle', 3, '{"en": "Middle", "ru": "Средний", "uz": "O''rta"}'),
+    ('Senior', 4, '{"en": "Senior", "ru": "Старший", "uz": "Katta"}'),
+    ('Lead', 5, '{"en": "Lead", "ru": "Ведущий", "uz": "Yetakchi"}'),
+    ('Staff', 6, '{"en": "Staff", "ru": "Главный", "uz": "Bosh"}');
+
+INSERT INTO job_location_types (code, position, labels)
+VALUES
+    ('On-site', 1, '{"en": "On-site", "ru": "В офисе", "uz": "Ofisda"}'),
+    ('Remote', 2, '{"en": "Remote", "ru": "Удалённо", "uz": "Masofaviy"}'),
+    ('Hybrid', 3, '{"en": "Hybrid", "ru": "Гибрид", "uz": "Gibrid"}');
+
+INSERT INTO job_employment_types (code, position, labels)
+VALUES
+    ('Full-Time', 1, '{"en": "Full-time", "ru": "Полная занятость", "uz": "To''liq stavka"}'),
+    ('Part-Time', 2, '{"en": "Part-time", "ru": "Частичная занятость", "uz": "Yarim stavka"}'),
+    ('Contract', 3, '{"en": "Contract", "ru": "Контракт", "uz": "Shartnoma"}'),
+    ('Temporary', 4, '{"en": "Temporary", "ru": "Временная работа", "uz": "Vaqtinchalik"}'),
+    ('Internship', 5, '{"en": "Internship", "ru": "Стажировка", "uz": "Amaliyot"}'),
+    ('Freelance', 6, '{"en": "Freelance", "ru": "Фриланс", "uz": "Frilans"}');
+
+-- values written differently ("full time", "remote") are moved onto the codes the way the job
+-- usecase matches them, values still unknown become entries so no job loses its value
+UPDATE jobs SET level = d.code FROM job_levels d
+    WHERE jobs.level <> d.code AND regexp_replace(lower(jobs.level), '[\s_-]+', ' ', 'g') = regexp_replace(lower(d.code), '[\s_-]+', ' ', 'g');
+UPDATE jobs SET location_type = d.code FROM job_location_types d
+    WHERE jobs.location_type <> d.code AND regexp_replace(lower(jobs.location_type), '[\s_-]+', ' ', 'g') = regexp_replace(lower(d.code), '[\s_-]+', ' ', 'g');
+UPDATE jobs SET employment_type = d.code FROM job_employment_types d
+    WHERE jobs.employment_type <> d.code AND regexp_replace(lower(jobs.employment_type), '[\s_-]+', ' ', 'g') = regexp_replace(lower(d.code), '[\s_-]+', ' ', 'g');
+
+INSERT INTO job_levels (code, position, labels)
+    SELECT DISTINCT level, 100, jsonb_build_object('en', level) FROM jobs ON CONFLICT DO NOTHING;
+INSERT INTO job_location_types (code, position, labels)
+    SELECT DISTINCT location_type, 100, jsonb_build_object('en', location_type) FROM jobs ON CONFLICT DO NOTHING;
+INSERT INTO job_employment_types (code, position, labels)
+    SELECT DISTINCT employment_type, 100, jsonb_build_object('en', employment_type) FROM jobs ON CONFLICT DO NOTHING;
+
+ALTER TABLE jobs
+    ALTER COLUMN level TYPE VARCHAR(32),
+    ALTER COLUMN location_type TYPE VARCHAR(32),
+    ALTER COLUMN employment_type TYPE VARCHAR(32),
+    ADD CONSTRAINT jobs_level_fkey FOREIGN KEY (level) REFERENCES job_levels(code) ON UPDATE CASCADE,
+    ADD CONSTRAINT jobs_location_type_fkey FOREIGN KEY (location_type) REFERENCES job_location_types(code) ON UPDATE CASCADE,
+    ADD CONSTRAINT jobs_employment_type_fkey FOREIGN KEY (employment_type) REFERENCES job_employment_types(code) ON UPDATE CASCADE;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS job_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS job_categories;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,38 @@
+-- tree of job categories, e.g. Engineering > Backend. Names are unique among siblings
+CREATE TABLE categories(
+    id UUID PRIMARY KEY,
+    parent_id UUID REFERENCES categories(id),
+    name VARCHAR(64) NOT NULL,
+    position SMALLINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (parent_id <> id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS categories_parent_name_idx ON categories (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'), LOWER(name));
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id);
+
+CREATE TABLE job_categories(
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (job_id, category_id)
+);
+
+CREATE INDEX IF NOT EXISTS job_categories_category_id_idx ON job_categories (category_id);
+
+-- free-form tags, created with the first job using them. slug is the lowercase name with
+-- dashes for spaces, job-service derives it the same way
+CREATE TABLE tags(
+    slug VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE job_tags(
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL REFERENCES tags(slug) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (job_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS job_tags_tag_idx ON job_tags (tag);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS clients_record_change ON clients;
+DROP TRIGGER IF EXISTS jobs_record_change ON jobs;
+DROP FUNCTION IF EXISTS record_client_change();
+DROP FUNCTION IF EXISTS record_job_change();
+DROP TABLE IF EXISTS client_changes;
+DROP TABLE IF EXISTS job_changes;
@@ -0,0 +1,85 @@
+-- change feeds behind WatchJobs and WatchClients. Triggers record every change of a row in the
+-- transaction that makes it, so each service instance reads the same feed, and notify the
+-- channel of the feed once the transaction commits. id is the cursor of a watcher.
+CREATE TABLE job_changes(
+    id BIGSERIAL PRIMARY KEY,
+    job_id UUID NOT NULL,
+    company_id UUID,
+    action VARCHAR(7) NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS job_changes_changed_at_idx ON job_changes (changed_at);
+
+CREATE TABLE client_changes(
+    id BIGSERIAL PRIMARY KEY,
+    client_id UUID NOT NULL,
+    action VARCHAR(7) NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS client_changes_changed_at_idx ON client_changes (changed_at);
+
+-- the advisory lock is held until commit, so changes commit in the order of their ids and a
+-- watcher never passes an id that is still to be committed. Updates of deleted rows are not
+-- changes, restoring a row is an update.
+CREATE OR REPLACE FUNCTION record_job_change() RETURNS TRIGGER AS $$
+DECLARE
+    change_action VARCHAR(7) := 'updated';
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        change_action := 'created';
+    ELSIF TG_OP = 'DELETE' THEN
+        change_action := 'deleted';
+    ELSIF NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN
+        change_action := 'deleted';
+    ELSIF NEW.deleted_at IS NOT NULL THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext('job_changes'));
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO job_changes (job_id, company_id, action) VALUES (OLD.id, OLD.company_id, change_action);
+    ELSE
+        INSERT INTO job_changes (job_id, company_id, action) VALUES (NEW.id, NEW.company_id, change_action);
+    END IF;
+    PERFORM pg_notify('job_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_record_change AFTER INSERT OR UPDATE OR DELETE ON jobs
+    FOR EACH ROW EXECUTE FUNCTION record_job_change();
+
+-- refresh tokens and passwords aren't part of the feed, updates of only those are skipped
+CREATE OR REPLACE FUNCTION record_client_change() RETURNS TRIGGER AS $$
+DECLARE
+    change_action VARCHAR(7) := 'updated';
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        change_action := 'created';
+    ELSIF TG_OP = 'DELETE' THEN
+        change_action := 'deleted';
+    ELSIF NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN
+        change_action := 'deleted';
+    ELSIF NEW.deleted_at IS NOT NULL THEN
+        RETURN NULL;
+    ELSIF to_jsonb(NEW) - 'refresh' - 'password' - 'updated_at' = to_jsonb(OLD) - 'refresh' - 'password' - 'updated_at' THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext('client_changes'));
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO client_changes (client_id, action) VALUES (OLD.id, change_action);
+    ELSE
+        INSERT INTO client_changes (client_id, action) VALUES (NEW.id, change_action);
+    END IF;
+    PERFORM pg_notify('client_changes', '');
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER clients_record_change AFTER INSERT OR UPDATE OR DELETE ON clients
+    FOR EACH ROW EXECUTE FUNCTION record_client_change();
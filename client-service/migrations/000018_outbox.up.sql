@@ -0,0 +1,18 @@
+-- domain events written in the transaction of the change they describe, the relay of each
+-- service publishes its own events in id order and marks them published
+CREATE TABLE outbox(
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    source VARCHAR(32) NOT NULL, -- client-service, job-service
+    topic VARCHAR(64) NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    key VARCHAR(64) NOT NULL, -- events of a key are delivered in order
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (source, id) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_published_at_idx ON outbox (published_at) WHERE published_at IS NOT NULL;
@@ -0,0 +1,51 @@
+-- what a client wants to be notified about and how, clients without a row get the defaults.
+-- The email is verified while verified_email is the current email of the client.
+CREATE TABLE notification_preferences(
+    client_id UUID PRIMARY KEY REFERENCES clients (id) ON DELETE CASCADE,
+    locale VARCHAR(8) NOT NULL DEFAULT 'en',
+    email BOOLEAN NOT NULL DEFAULT TRUE,
+    sms BOOLEAN NOT NULL DEFAULT FALSE,
+    job_alerts BOOLEAN NOT NULL DEFAULT TRUE,
+    verified_email TEXT,
+    email_verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- the queue of rendered notifications, a notification is dead after its attempts
+CREATE TABLE notifications(
+    id UUID PRIMARY KEY,
+    client_id UUID REFERENCES clients (id) ON DELETE SET NULL,
+    channel VARCHAR(8) NOT NULL CHECK (channel IN ('email', 'sms')),
+    template VARCHAR(32) NOT NULL,
+    locale VARCHAR(8) NOT NULL,
+    recipient TEXT NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    html TEXT NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS notifications_due_idx ON notifications (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS notifications_client_idx ON notifications (client_id, created_at);
+
+-- one time codes of the email verification and the password reset, only the hash of a code is kept
+CREATE TABLE verification_codes(
+    id UUID PRIMARY KEY,
+    client_id UUID NOT NULL REFERENCES clients (id) ON DELETE CASCADE,
+    purpose VARCHAR(16) NOT NULL CHECK (purpose IN ('verify_email', 'reset_password')),
+    destination TEXT NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS verification_codes_client_idx ON verification_codes (client_id, purpose, created_at) WHERE used_at IS NULL;
@@ -0,0 +1,19 @@
+DROP TRIGGER IF EXISTS webhook_subscriptions_audit ON webhook_subscriptions;
+DROP TRIGGER IF EXISTS job_employment_types_audit ON job_employment_types;
+DROP TRIGGER IF EXISTS job_location_types_audit ON job_location_types;
+DROP TRIGGER IF EXISTS job_levels_audit ON job_levels;
+DROP TRIGGER IF EXISTS tags_audit ON tags;
+DROP TRIGGER IF EXISTS categories_audit ON categories;
+DROP TRIGGER IF EXISTS saved_searches_audit ON saved_searches;
+DROP TRIGGER IF EXISTS job_applications_audit ON job_applications;
+DROP TRIGGER IF EXISTS companies_audit ON companies;
+DROP TRIGGER IF EXISTS client_jobs_audit ON client_jobs;
+DROP TRIGGER IF EXISTS jobs_audit ON jobs;
+DROP TRIGGER IF EXISTS notification_preferences_audit ON notification_preferences;
+DROP TRIGGER IF EXISTS client_profiles_audit ON client_profiles;
+DROP TRIGGER IF EXISTS client_duplicates_audit ON client_duplicates;
+DROP TRIGGER IF EXISTS clients_audit ON clients;
+DROP FUNCTION IF EXISTS record_audit();
+DROP FUNCTION IF EXISTS audit_log_hash(TEXT, BIGINT, TIMESTAMPTZ, TEXT, TEXT, TEXT, TEXT, TEXT, JSONB, JSONB, JSONB);
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
@@ -0,0 +1,142 @@
+-- every create, update, delete and restore of the audited tables. before and after are the
+-- rows without secrets, diff holds the changed columns. Records are chained: hash covers the
+-- record and the hash of the one before it, so an edited or removed record breaks the chain.
+CREATE TABLE audit_log(
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    service VARCHAR(64) NOT NULL DEFAULT '',
+    actor VARCHAR(128) NOT NULL,
+    entity VARCHAR(32) NOT NULL,
+    entity_id VARCHAR(64) NOT NULL,
+    action VARCHAR(7) NOT NULL CHECK (action IN ('create', 'update', 'delete', 'restore')),
+    before JSONB,
+    after JSONB,
+    diff JSONB NOT NULL,
+    prev_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity, entity_id, occurred_at);
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor, occurred_at);
+CREATE INDEX IF NOT EXISTS audit_log_occurred_at_idx ON audit_log (occurred_at);
+
+-- job-service recomputes the same hash when it verifies the chain
+CREATE OR REPLACE FUNCTION audit_log_hash(
+    prev_hash TEXT, id BIGINT, occurred_at TIMESTAMPTZ, service TEXT, actor TEXT, entity TEXT,
+    entity_id TEXT, action TEXT, before JSONB, after JSONB, diff JSONB
+) RETURNS TEXT AS $$
+    SELECT encode(sha256(convert_to(concat_ws(E'\n',
+        prev_hash,
+        id::TEXT,
+        to_char(occurred_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+        service,
+        actor,
+        entity,
+        entity_id,
+        action,
+        COALESCE(before::TEXT, ''),
+        COALESCE(after::TEXT, ''),
+        diff::TEXT
+    ), 'UTF8')), 'hex');
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- TG_ARGV are the entity and the key column of the table. The actor is the audit.actor setting
+-- of the connection, the services set it to the principal of the request. The advisory lock
+-- is held until commit, so records are chained in the order they commit. Updates of only
+-- bookkeeping columns aren't recorded.
+CREATE OR REPLACE FUNCTION record_audit() RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    record_action VARCHAR(7) := 'update';
+    record_diff JSONB;
+    record_id BIGINT;
+    record_at TIMESTAMPTZ := CURRENT_TIMESTAMP;
+    record_service TEXT := COALESCE(current_setting('application_name', true), '');
+    record_actor TEXT := COALESCE(NULLIF(current_setting('audit.actor', true), ''), 'system');
+    prev VARCHAR(64);
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD) - 'password' - 'refresh' - 'secret';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW) - 'password' - 'refresh' - 'secret';
+    END IF;
+
+    IF TG_OP = 'INSERT' THEN
+        record_action := 'create';
+    ELSIF TG_OP = 'DELETE' THEN
+        record_action := 'delete';
+    ELSIF old_row ? 'deleted_at' AND old_row -> 'deleted_at' = 'null' AND new_row -> 'deleted_at' <> 'null' THEN
+        record_action := 'delete';
+    ELSIF old_row ? 'deleted_at' AND old_row -> 'deleted_at' <> 'null' AND new_row -> 'deleted_at' = 'null' THEN
+        record_action := 'restore';
+    END IF;
+
+    SELECT jsonb_object_agg(key, jsonb_build_object('before', old_row -> key, 'after', new_row -> key))
+      INTO record_diff
+      FROM (SELECT jsonb_object_keys(COALESCE(old_row, '{}') || COALESCE(new_row, '{}')) AS key) AS columns
+     WHERE key NOT IN ('updated_at', 'last_sent_at', 'alerts_evaluated_at')
+       AND (old_row -> key) IS DISTINCT FROM (new_row -> key);
+    IF record_diff IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext('audit_log'));
+    SELECT hash INTO prev FROM audit_log ORDER BY id DESC LIMIT 1;
+    prev := COALESCE(prev, '');
+    record_id := nextval(pg_get_serial_sequence('audit_log', 'id'));
+
+    INSERT INTO audit_log (id, occurred_at, service, actor, entity, entity_id, action, before, after, diff, prev_hash, hash)
+    VALUES (
+        record_id, record_at, record_service, record_actor, TG_ARGV[0],
+        COALESCE(new_row, old_row) ->> TG_ARGV[1], record_action, old_row, new_row, record_diff, prev,
+        audit_log_hash(prev, record_id, record_at, record_service, record_actor, TG_ARGV[0],
+            COALESCE(new_row, old_row) ->> TG_ARGV[1], record_action, old_row, new_row, record_diff)
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER clients_audit AFTER INSERT OR UPDATE OR DELETE ON clients
+    FOR EACH ROW EXECUTE FUNCTION record_audit('client', 'id');
+CREATE TRIGGER client_duplicates_audit AFTER INSERT OR UPDATE OR DELETE ON client_duplicates
+    FOR EACH ROW EXECUTE FUNCTION record_audit('duplicate', 'id');
+CREATE TRIGGER client_profiles_audit AFTER INSERT OR UPDATE OR DELETE ON client_profiles
+    FOR EACH ROW EXECUTE FUNCTION record_audit('profile', 'client_id');
+CREATE TRIGGER notification_preferences_audit AFTER INSERT OR UPDATE OR DELETE ON notification_preferences
+    FOR EACH ROW EXECUTE FUNCTION record_audit('notification_preferences', 'client_id');
+CREATE TRIGGER jobs_audit AFTER INSERT OR UPDATE OR DELETE ON jobs
+    FOR EACH ROW EXECUTE FUNCTION record_audit('job', 'id');
+CREATE TRIGGER client_jobs_audit AFTER INSERT OR UPDATE OR DELETE ON client_jobs
+    FOR EACH ROW EXECUTE FUNCTION record_audit('employment', 'id');
+CREATE TRIGGER companies_audit AFTER INSERT OR UPDATE OR DELETE ON companies
+    FOR EACH ROW EXECUTE FUNCTION record_audit('company', 'id');
+CREATE TRIGGER job_applications_audit AFTER INSERT OR UPDATE OR DELETE ON job_applications
+    FOR EACH ROW EXECUTE FUNCTION record_audit('application', 'id');
+CREATE TRIGGER saved_searches_audit AFTER INSERT OR UPDATE OR DELETE ON saved_searches
+    FOR EACH ROW EXECUTE FUNCTION record_audit('saved_search', 'id');
+CREATE TRIGGER categories_audit AFTER INSERT OR UPDATE OR DELETE ON categories
+    FOR EACH ROW EXECUTE FUNCTION record_audit('category', 'id');
+CREATE TRIGGER tags_audit AFTER INSERT OR UPDATE OR DELETE ON tags
+    FOR EACH ROW EXECUTE FUNCTION record_audit('tag', 'slug');
+CREATE TRIGGER job_levels_audit AFTER INSERT OR UPDATE OR DELETE ON job_levels
+    FOR EACH ROW EXECUTE FUNCTION record_audit('job_level', 'code');
+CREATE TRIGGER job_location_types_audit AFTER INSERT OR UPDATE OR DELETE ON job_location_types
+    FOR EACH ROW EXECUTE FUNCTION record_audit('job_location_type', 'code');
+CREATE TRIGGER job_employment_types_audit AFTER INSERT OR UPDATE OR DELETE ON job_employment_types
+    FOR EACH ROW EXECUTE FUNCTION record_audit('job_employment_type', 'code');
+CREATE TRIGGER webhook_subscriptions_audit AFTER INSERT OR UPDATE OR DELETE ON webhook_subscriptions
+    FOR EACH ROW EXECUTE FUNCTION record_audit('webhook', 'id');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS saga_changes;
+DROP TABLE IF EXISTS sagas;
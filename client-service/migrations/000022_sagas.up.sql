@@ -0,0 +1,31 @@
+-- deletions that span the services. step is the next step to run, or to compensate once the
+-- saga is compensating. A saga is leased by pushing next_attempt_at forward while it runs, the
+-- resumer of its service takes over due sagas after a crash. actor is the principal it runs for.
+CREATE TABLE sagas(
+    id UUID PRIMARY KEY,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('delete_client', 'delete_job')),
+    entity_id UUID NOT NULL,
+    status VARCHAR(12) NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'compensating', 'completed', 'compensated', 'failed')),
+    step INT NOT NULL DEFAULT 0,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    actor VARCHAR(128) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- one deletion of an entity runs at a time
+CREATE UNIQUE INDEX IF NOT EXISTS sagas_active_idx ON sagas (kind, entity_id) WHERE status IN ('running', 'compensating');
+CREATE INDEX IF NOT EXISTS sagas_due_idx ON sagas (next_attempt_at) WHERE status IN ('running', 'compensating');
+
+-- rows the steps of a saga changed with their values before it, the compensations put them
+-- back. Steps write them in the transaction of the change, so a repeated step doesn't lose them.
+CREATE TABLE saga_changes(
+    saga_id UUID NOT NULL REFERENCES sagas (id) ON DELETE CASCADE,
+    entity VARCHAR(16) NOT NULL, -- assignment, application
+    entity_id UUID NOT NULL,
+    previous JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (saga_id, entity, entity_id)
+);
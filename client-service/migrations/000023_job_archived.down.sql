@@ -0,0 +1,5 @@
+UPDATE jobs SET status = 'closed' WHERE status = 'archived';
+
+ALTER TABLE jobs
+    DROP CONSTRAINT IF EXISTS jobs_status_check,
+    ADD CONSTRAINT jobs_status_check CHECK (status IN ('draft', 'scheduled', 'published', 'closed'));
@@ -0,0 +1,4 @@
+-- closed jobs can be archived, an archived job never changes its status again
+ALTER TABLE jobs
+    DROP CONSTRAINT IF EXISTS jobs_status_check,
+    ADD CONSTRAINT jobs_status_check CHECK (status IN ('draft', 'scheduled', 'published', 'closed', 'archived'));
@@ -0,0 +1,8 @@
+UPDATE client_profiles SET desired_level = '' WHERE LENGTH(desired_level) > 10;
+UPDATE client_profiles SET desired_location_type = '' WHERE LENGTH(desired_location_type) > 7;
+UPDATE client_profiles SET desired_employment_type = '' WHERE LENGTH(desired_employment_type) > 10;
+
+ALTER TABLE client_profiles
+    ALTER COLUMN desired_level TYPE VARCHAR(10),
+    ALTER COLUMN desired_location_type TYPE VARCHAR(7),
+    ALTER COLUMN desired_employment_type TYPE VARCHAR(10);
@@ -0,0 +1,5 @@
+-- the desired classification takes the codes of the job dictionaries, which are up to 32 characters
+ALTER TABLE client_profiles
+    ALTER COLUMN desired_level TYPE VARCHAR(32),
+    ALTER COLUMN desired_location_type TYPE VARCHAR(32),
+    ALTER COLUMN desired_employment_type TYPE VARCHAR(32);
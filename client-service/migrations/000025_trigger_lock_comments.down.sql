@@ -0,0 +1,3 @@
+COMMENT ON FUNCTION record_job_change() IS NULL;
+COMMENT ON FUNCTION record_client_change() IS NULL;
+COMMENT ON FUNCTION record_audit() IS NULL;
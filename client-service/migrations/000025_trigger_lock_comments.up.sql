@@ -0,0 +1,15 @@
+-- The change feeds and the audit log serialize their writers on a global advisory lock held until
+-- commit. That is what keeps the ids of job_changes and client_changes in commit order and the
+-- audit_log hash chain linear, the price is that every transaction writing jobs, clients or any
+-- audited table waits for the one holding the lock. Keep those transactions short: batch creates
+-- commit every 100 rows and nothing slow (network calls, big exports) runs inside them. If the
+-- lock becomes a bottleneck, the way out is to chain the records asynchronously from a single
+-- writer rather than to drop the lock.
+COMMENT ON FUNCTION record_job_change() IS
+    'Takes pg_advisory_xact_lock(hashtext(''job_changes'')) until commit, so job changes commit in id order; concurrent job writers are serialized, keep their transactions short.';
+
+COMMENT ON FUNCTION record_client_change() IS
+    'Takes pg_advisory_xact_lock(hashtext(''client_changes'')) until commit, so client changes commit in id order; concurrent client writers are serialized, keep their transactions short.';
+
+COMMENT ON FUNCTION record_audit() IS
+    'Takes pg_advisory_xact_lock(hashtext(''audit_log'')) until commit to chain the hashes; every audited write is serialized with the others, keep their transactions short.';
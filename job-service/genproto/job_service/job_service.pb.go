@@ -27,26 +27,79 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("job_service.proto", fileDescriptor_4d14b17d30cb4d50) }
 
 var fileDescriptor_4d14b17d30cb4d50 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x4a, 0xc3, 0x40,
-	0x14, 0xc6, 0xed, 0xc2, 0x42, 0x9e, 0x4a, 0xed, 0x20, 0x28, 0xa9, 0x66, 0xe9, 0xb2, 0x05, 0x15,
-	0x5c, 0xb7, 0x0d, 0x04, 0x47, 0x57, 0x2d, 0x45, 0x70, 0x23, 0x1d, 0xe7, 0xa1, 0x91, 0x31, 0x2f,
-	0x66, 0x5e, 0x3d, 0x8b, 0x87, 0xf0, 0x20, 0x2e, 0x3d, 0x82, 0xc4, 0x8b, 0x88, 0x19, 0x5a, 0xd2,
-	0xc6, 0x76, 0x93, 0xe5, 0xfc, 0xbe, 0xf9, 0x7e, 0xf3, 0x17, 0xda, 0xcf, 0xa4, 0xee, 0x2d, 0x66,
-	0x6f, 0xf1, 0x03, 0x76, 0xd3, 0x8c, 0x98, 0xc4, 0x4e, 0x09, 0xf9, 0xad, 0xbf, 0xc1, 0x0b, 0x69,
-	0x34, 0x2e, 0x3d, 0xfb, 0xd8, 0x06, 0x90, 0xa4, 0xc6, 0x2e, 0x17, 0x97, 0xe0, 0x0d, 0x33, 0x9c,
-	0x32, 0x4a, 0x52, 0x62, 0xbf, 0x5b, 0xb6, 0x49, 0x52, 0xfe, 0xd1, 0x2a, 0xb9, 0x8d, 0xf9, 0x29,
-	0x9a, 0x5c, 0x85, 0xa2, 0x07, 0xde, 0x24, 0xd5, 0x6b, 0x8b, 0x15, 0x22, 0x06, 0xe0, 0x85, 0x68,
-	0xd0, 0x15, 0xd6, 0x7a, 0xfd, 0xce, 0x52, 0x32, 0x42, 0x9b, 0x52, 0x62, 0x71, 0xcc, 0x53, 0x9e,
-	0x59, 0x71, 0x01, 0xcd, 0x08, 0x79, 0xb3, 0xa0, 0xba, 0x72, 0x08, 0x10, 0x21, 0xf7, 0x8d, 0x91,
-	0xa4, 0xec, 0x4a, 0xf3, 0x26, 0xb6, 0x3c, 0xc2, 0xd7, 0x19, 0x5a, 0xf6, 0x8f, 0x2b, 0x89, 0x24,
-	0x35, 0xdf, 0x81, 0xb8, 0x86, 0xb6, 0xb3, 0xb8, 0x53, 0xe8, 0x9a, 0xb2, 0xbd, 0x08, 0x79, 0x68,
-	0x62, 0x4c, 0xb8, 0x10, 0x9d, 0x2c, 0x4d, 0x5f, 0x04, 0x73, 0x5b, 0xa7, 0x62, 0x2b, 0x75, 0x9d,
-	0x4c, 0x92, 0x72, 0xac, 0x9e, 0x2c, 0x84, 0xdd, 0xbe, 0xd6, 0x0b, 0x20, 0x0e, 0xff, 0x77, 0xd9,
-	0xcd, 0x0f, 0x15, 0x41, 0xcb, 0x5d, 0x53, 0x4d, 0xd1, 0xe0, 0xf4, 0x33, 0x0f, 0x1a, 0x5f, 0x79,
-	0xd0, 0xf8, 0xce, 0x83, 0xc6, 0xfb, 0x4f, 0xb0, 0x75, 0x77, 0xf0, 0x88, 0x49, 0xf1, 0x95, 0x7b,
-	0xa5, 0x9a, 0x6a, 0x16, 0xe8, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x36, 0xe9, 0xf2, 0xb6, 0x10,
-	0x03, 0x00, 0x00,
+	// 1146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0xc6, 0x37, 0x65, 0x72, 0xda, 0x26, 0xf1, 0xd6, 0x4d, 0x83, 0x9a, 0xff, 0x34, 0x01, 0x7a,
+	0xd1, 0x64, 0x80, 0x19, 0x2e, 0x60, 0x98, 0x3a, 0x71, 0x63, 0xe2, 0xa6, 0x30, 0x63, 0xe7, 0xa7,
+	0x43, 0xa1, 0x9d, 0xb5, 0x75, 0x70, 0x04, 0xb2, 0xd6, 0x48, 0x9b, 0x80, 0xdf, 0x84, 0x27, 0xe1,
+	0x19, 0xb8, 0xe4, 0x11, 0x98, 0xf0, 0x22, 0xcc, 0x6a, 0xb5, 0xb2, 0x76, 0xb5, 0x52, 0xd4, 0xe4,
+	0xd2, 0xdf, 0xf7, 0x9d, 0xef, 0xac, 0xce, 0xfe, 0x9c, 0x5d, 0x43, 0xfd, 0x17, 0xd6, 0x7f, 0x17,
+	0x61, 0x78, 0xe9, 0x0d, 0xf0, 0xd9, 0x38, 0x64, 0x9c, 0x91, 0xbb, 0x19, 0xc8, 0x99, 0x13, 0x3f,
+	0x46, 0xcc, 0x45, 0x5f, 0xb2, 0xce, 0x83, 0x01, 0x1b, 0x8d, 0x69, 0x30, 0xd1, 0xc0, 0x47, 0x74,
+	0x3c, 0xf6, 0xbd, 0x01, 0xe5, 0x1e, 0x0b, 0x34, 0x62, 0x01, 0x47, 0x63, 0x9f, 0x4d, 0x46, 0x18,
+	0x70, 0x0d, 0x77, 0x42, 0x1c, 0xb0, 0xd1, 0x08, 0x03, 0x37, 0x1f, 0xb3, 0x18, 0xd1, 0x4b, 0x74,
+	0xdf, 0x45, 0x48, 0xc3, 0xc1, 0xb9, 0xee, 0xe6, 0x7a, 0x03, 0x21, 0xa7, 0xa1, 0x9e, 0xbe, 0xc1,
+	0xe9, 0x1f, 0x2c, 0x60, 0x23, 0x1d, 0x7d, 0xf0, 0x3b, 0xf6, 0xcf, 0x19, 0xfb, 0x55, 0x03, 0xeb,
+	0xf4, 0xc2, 0xf5, 0xb4, 0xb1, 0x7c, 0xf6, 0xd7, 0x06, 0x40, 0x87, 0xf5, 0x7b, 0xf2, 0x8b, 0xc9,
+	0x97, 0x30, 0xb3, 0x1f, 0x22, 0xe5, 0xd8, 0x61, 0x7d, 0x32, 0xff, 0x2c, 0x5b, 0x9f, 0x0e, 0xeb,
+	0x3b, 0x8b, 0x26, 0x72, 0xe6, 0xf1, 0xf3, 0xf6, 0xc9, 0x61, 0x8b, 0xec, 0xc0, 0xcc, 0xc9, 0xd8,
+	0x2d, 0x0c, 0xcc, 0x21, 0x64, 0x0f, 0x66, 0x5a, 0xe8, 0xa3, 0x0c, 0x28, 0xf4, 0x75, 0x1e, 0x6b,
+	0x4c, 0x17, 0xa3, 0x31, 0x0b, 0x22, 0xec, 0x71, 0xca, 0x2f, 0x22, 0xf2, 0x05, 0xdc, 0x69, 0x23,
+	0x2f, 0x37, 0xc8, 0x67, 0x7e, 0x05, 0xf7, 0x64, 0x54, 0xb4, 0x37, 0x39, 0x6c, 0x45, 0x64, 0xd9,
+	0x54, 0x48, 0xbc, 0x8b, 0xbf, 0x5d, 0x60, 0xc4, 0x9d, 0x95, 0x22, 0x5a, 0x0e, 0x85, 0xb4, 0x00,
+	0xda, 0xc8, 0x9b, 0xbe, 0x2f, 0x28, 0x63, 0x20, 0x47, 0x5e, 0xc4, 0x95, 0xcf, 0x52, 0x8e, 0xe9,
+	0xb0, 0x7e, 0xea, 0xf2, 0x1d, 0xcc, 0xb5, 0x91, 0xb7, 0xd4, 0x14, 0x7b, 0x18, 0x91, 0x35, 0x2d,
+	0x20, 0x4b, 0x29, 0xcb, 0x8f, 0x0a, 0x15, 0xe4, 0x25, 0xd4, 0xe5, 0xa8, 0x64, 0x91, 0xdd, 0x5b,
+	0x0d, 0xee, 0x25, 0xdc, 0x6f, 0x23, 0xdf, 0xf7, 0x3d, 0x0c, 0x78, 0x6c, 0xa4, 0x97, 0x2c, 0x25,
+	0x94, 0xdb, 0xe3, 0x9c, 0x5b, 0x26, 0x56, 0x9a, 0x75, 0x58, 0x5f, 0x62, 0xb7, 0x33, 0xfb, 0x09,
+	0x1a, 0x6d, 0xe4, 0x2f, 0xd2, 0x7d, 0xf6, 0xad, 0x17, 0x71, 0x16, 0x4e, 0xc8, 0x96, 0x16, 0x94,
+	0xe3, 0xed, 0x73, 0x9b, 0xb7, 0xf9, 0x11, 0x16, 0xba, 0x6a, 0xaf, 0x8a, 0x7c, 0x07, 0x2c, 0x94,
+	0xc9, 0xc9, 0xba, 0xb1, 0x2e, 0x33, 0x22, 0x65, 0xbe, 0x6a, 0x2e, 0x9c, 0xae, 0xb6, 0xed, 0x23,
+	0xd2, 0xcf, 0xb8, 0x27, 0xc5, 0x38, 0x60, 0xa1, 0x58, 0xa2, 0x4f, 0xec, 0xee, 0x89, 0x48, 0x25,
+	0xd8, 0xb0, 0x14, 0xce, 0xcc, 0xd1, 0x82, 0x7b, 0x4d, 0xd7, 0x4d, 0x2b, 0x46, 0x1e, 0xd9, 0x8b,
+	0x1d, 0x95, 0x6f, 0xb4, 0x36, 0xcc, 0xc9, 0x75, 0x74, 0x5b, 0x23, 0x04, 0xd2, 0x45, 0x1a, 0x45,
+	0xde, 0x30, 0xc8, 0xcc, 0xe2, 0xb6, 0x11, 0x62, 0x0a, 0xd4, 0x07, 0x7f, 0x7c, 0xad, 0x2e, 0x59,
+	0xb0, 0x23, 0x58, 0x38, 0x09, 0x5c, 0x66, 0x49, 0xf5, 0x54, 0xb3, 0xb0, 0x8b, 0xde, 0x3b, 0x1d,
+	0x85, 0xc6, 0x0b, 0x35, 0x3b, 0xcd, 0x58, 0x34, 0x8a, 0x57, 0xf6, 0x27, 0xfa, 0xf2, 0xb2, 0x48,
+	0xec, 0x6b, 0x25, 0x23, 0xd8, 0x3f, 0xa7, 0xc1, 0x10, 0x5d, 0xf2, 0x06, 0xea, 0x5d, 0x64, 0x63,
+	0x0c, 0xb2, 0xfe, 0x5b, 0xc6, 0x00, 0x0d, 0xbe, 0xb2, 0xf9, 0x6b, 0x98, 0xdb, 0xa3, 0x7c, 0x70,
+	0x9e, 0x1e, 0xfd, 0x11, 0xd9, 0xd4, 0x62, 0x0c, 0x56, 0x19, 0xaf, 0x15, 0x89, 0xd2, 0xca, 0x3c,
+	0x07, 0xe8, 0xf1, 0x10, 0xe9, 0x28, 0x36, 0xd5, 0xb7, 0xdb, 0x94, 0x50, 0x7e, 0xb9, 0xb3, 0x7a,
+	0xb7, 0x46, 0x8e, 0x60, 0x5e, 0x0a, 0xab, 0x1f, 0x3f, 0x45, 0x4b, 0x73, 0xb7, 0x46, 0x5a, 0x30,
+	0x73, 0x26, 0x86, 0x69, 0xb1, 0x49, 0x71, 0x65, 0xb3, 0x60, 0x8e, 0x46, 0x96, 0x6b, 0xb7, 0x46,
+	0xbe, 0x82, 0xfb, 0xf2, 0x3b, 0xf7, 0xe5, 0x75, 0x80, 0x34, 0xf4, 0x8c, 0x12, 0x75, 0xac, 0xa8,
+	0x08, 0x96, 0x9d, 0xf2, 0x26, 0xc1, 0x1d, 0xb8, 0x9f, 0x6c, 0xc4, 0x04, 0x58, 0xb2, 0xc9, 0xaa,
+	0x75, 0xcf, 0xe7, 0x71, 0xe3, 0xaa, 0x66, 0x64, 0x1f, 0xcd, 0x69, 0xdc, 0xb4, 0x9a, 0xbe, 0x2f,
+	0x01, 0xd1, 0x77, 0xd6, 0xf3, 0xa7, 0xb5, 0xe2, 0xec, 0xab, 0x66, 0x2a, 0x99, 0xa4, 0xab, 0xe6,
+	0x7b, 0x98, 0x9d, 0x8e, 0x2c, 0x9e, 0xaa, 0x55, 0x5b, 0xfe, 0xec, 0x64, 0x95, 0x37, 0xb0, 0x43,
+	0x80, 0xe6, 0x78, 0xec, 0x4f, 0x8e, 0x99, 0x38, 0xba, 0xf4, 0x65, 0x38, 0x25, 0xec, 0x1d, 0xa7,
+	0xc3, 0xfa, 0xcd, 0xe9, 0x05, 0x8f, 0xf4, 0x60, 0xee, 0x15, 0xbb, 0xc4, 0x2c, 0xa4, 0xef, 0x15,
+	0x83, 0xad, 0x64, 0x2a, 0x3f, 0x38, 0x8b, 0xac, 0xe5, 0xc6, 0x98, 0x30, 0x05, 0x73, 0x6b, 0x18,
+	0xbe, 0x95, 0x33, 0x33, 0x45, 0x22, 0xf2, 0x24, 0x57, 0xa1, 0x2c, 0xad, 0x86, 0xb9, 0x75, 0x8d,
+	0x2a, 0x29, 0xe8, 0x5b, 0x78, 0xa8, 0xfb, 0xab, 0x8e, 0x79, 0xfd, 0xb8, 0x37, 0xcb, 0x32, 0x28,
+	0x9b, 0x36, 0xd4, 0xe5, 0x0e, 0xeb, 0x89, 0xeb, 0x70, 0x2f, 0xbe, 0x0d, 0x1b, 0xd7, 0x97, 0x0c,
+	0xe3, 0x14, 0x32, 0xc2, 0x48, 0xee, 0xb6, 0xdb, 0x1a, 0x75, 0xa1, 0x2e, 0x77, 0x5e, 0x16, 0x5c,
+	0x2b, 0x92, 0x57, 0xdb, 0x81, 0x14, 0xe6, 0xdb, 0xc8, 0x33, 0x61, 0x68, 0x9e, 0xe9, 0xa2, 0x3c,
+	0x1a, 0xaf, 0xe6, 0x69, 0xfb, 0x3a, 0x59, 0x32, 0x51, 0xdf, 0xc0, 0x6c, 0x72, 0x54, 0x51, 0x8e,
+	0x43, 0x51, 0xda, 0x87, 0xfa, 0x56, 0x4a, 0x60, 0xc7, 0x0e, 0x8b, 0xf8, 0xe4, 0xb4, 0xba, 0x59,
+	0xfc, 0x11, 0xcc, 0x26, 0x07, 0x96, 0x42, 0x96, 0xad, 0xc2, 0x6a, 0x05, 0x7b, 0x2d, 0x2f, 0xa2,
+	0x32, 0x46, 0x1c, 0x37, 0x1b, 0xf9, 0xb3, 0x24, 0x25, 0x55, 0xa9, 0x36, 0x4b, 0x35, 0x49, 0x9d,
+	0x76, 0xd4, 0xc3, 0xe7, 0x98, 0x0e, 0x8d, 0xf7, 0xcb, 0x31, 0x1d, 0x3a, 0x39, 0x84, 0x7c, 0xad,
+	0x1e, 0x3c, 0xe2, 0x87, 0xfe, 0x4d, 0x29, 0x6e, 0xef, 0x6b, 0x22, 0x20, 0x7d, 0xfd, 0x88, 0x1f,
+	0x8b, 0x26, 0x2d, 0x8a, 0xd1, 0xf3, 0x2f, 0x86, 0xe5, 0xc5, 0x38, 0x80, 0x0f, 0xdb, 0xc8, 0x8f,
+	0xe9, 0x30, 0x22, 0xf9, 0xd3, 0x4f, 0xc0, 0x2a, 0xfd, 0x72, 0x01, 0x9b, 0x7c, 0x7a, 0xda, 0xcd,
+	0xce, 0xe4, 0x93, 0xd1, 0x68, 0x48, 0x09, 0xea, 0x58, 0xd1, 0x69, 0x37, 0xbb, 0x49, 0x70, 0xda,
+	0xcd, 0x14, 0xb0, 0x64, 0x93, 0xbd, 0x4f, 0x37, 0xab, 0x66, 0x64, 0x1f, 0x4d, 0x17, 0xee, 0x4e,
+	0x1d, 0xcc, 0xe7, 0x97, 0xa8, 0x9a, 0xa2, 0x54, 0x5d, 0xd7, 0x4b, 0x14, 0xe9, 0x45, 0xb4, 0x31,
+	0xf5, 0x6c, 0xa1, 0xef, 0x5d, 0x62, 0xbc, 0x6e, 0x3f, 0x2d, 0x0a, 0x9d, 0x6a, 0x54, 0x96, 0xa7,
+	0x55, 0xa4, 0x49, 0xba, 0x37, 0x40, 0x72, 0xe9, 0x26, 0xc6, 0xc9, 0x6f, 0xb0, 0x69, 0x51, 0x56,
+	0xcb, 0x54, 0x47, 0x6c, 0x48, 0x4e, 0x61, 0xbe, 0x8b, 0xae, 0x04, 0x54, 0xcd, 0xaa, 0x59, 0x2f,
+	0x95, 0xa9, 0x54, 0xaf, 0x12, 0x7f, 0x4d, 0x88, 0xd7, 0x4b, 0xe8, 0x5a, 0x7b, 0x55, 0x86, 0x2e,
+	0xe9, 0x55, 0x9a, 0x2a, 0x29, 0xca, 0x31, 0xcc, 0x9e, 0x62, 0xe8, 0xfd, 0x3c, 0x89, 0x59, 0xf1,
+	0x25, 0xfa, 0xa9, 0xa1, 0x93, 0xf6, 0xa7, 0x61, 0xcc, 0xc6, 0xc2, 0xa4, 0x51, 0xed, 0x6d, 0xff,
+	0x7d, 0xb5, 0x52, 0xfb, 0xe7, 0x6a, 0xa5, 0xf6, 0xef, 0xd5, 0x4a, 0xed, 0xcf, 0xff, 0x56, 0x3e,
+	0xf8, 0xa1, 0x31, 0xc4, 0x20, 0xfe, 0x53, 0x65, 0x27, 0x13, 0xd9, 0xbf, 0x13, 0x43, 0x9f, 0xff,
+	0x3f, 0x00, 0x31, 0xf7, 0x2c, 0xff, 0x6c, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -65,12 +118,58 @@ type JobServiceClient interface {
 	UpdateJob(ctx context.Context, in *Job, opts ...grpc.CallOption) (*Job, error)
 	DeleteJob(ctx context.Context, in *JobWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error)
 	GetJob(ctx context.Context, in *JobWithGUID, opts ...grpc.CallOption) (*Job, error)
+	GetJobsByIDs(ctx context.Context, in *JobsByIDsRequest, opts ...grpc.CallOption) (*JobsByIDsResponse, error)
 	GetAllJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListJobResponse, error)
+	GetDictionaries(ctx context.Context, in *DictionariesRequest, opts ...grpc.CallOption) (*Dictionaries, error)
 	GetAllDeletedJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListJobResponse, error)
 	GetClientJobs(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (*ListClientJobs, error)
 	GetJobClients(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (*ListClientJobs, error)
+	GetEmploymentHistory(ctx context.Context, in *EmploymentHistoryRequest, opts ...grpc.CallOption) (*EmploymentHistory, error)
+	RecommendJobsForClient(ctx context.Context, in *RecommendJobsRequest, opts ...grpc.CallOption) (*JobRecommendations, error)
+	RecommendClientsForJob(ctx context.Context, in *RecommendClientsRequest, opts ...grpc.CallOption) (*ClientRecommendations, error)
 	AddClientJob(ctx context.Context, in *ClientJobs, opts ...grpc.CallOption) (*ResponseStatus, error)
 	DeleteClientJob(ctx context.Context, in *ClientJobs, opts ...grpc.CallOption) (*ResponseStatus, error)
+	ReassignClientJobs(ctx context.Context, in *ReassignClientJobsRequest, opts ...grpc.CallOption) (*ReassignClientJobsResponse, error)
+	UndoReassignClientJobs(ctx context.Context, in *UndoReassignClientJobsRequest, opts ...grpc.CallOption) (*ReassignClientJobsResponse, error)
+	EndClientAssignments(ctx context.Context, in *EndClientAssignmentsRequest, opts ...grpc.CallOption) (*AssignmentsChanged, error)
+	ReopenAssignments(ctx context.Context, in *ReopenAssignmentsRequest, opts ...grpc.CallOption) (*AssignmentsChanged, error)
+	BatchCreateJobs(ctx context.Context, in *BatchCreateJobsRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
+	StreamJobs(ctx context.Context, in *StreamJobsRequest, opts ...grpc.CallOption) (JobService_StreamJobsClient, error)
+	StreamClientJobs(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (JobService_StreamClientJobsClient, error)
+	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (JobService_WatchJobsClient, error)
+	CreateCompany(ctx context.Context, in *Company, opts ...grpc.CallOption) (*Company, error)
+	UpdateCompany(ctx context.Context, in *Company, opts ...grpc.CallOption) (*Company, error)
+	DeleteCompany(ctx context.Context, in *CompanyWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error)
+	GetCompany(ctx context.Context, in *CompanyWithGUID, opts ...grpc.CallOption) (*Company, error)
+	GetAllCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompanyResponse, error)
+	GetCompanyJobs(ctx context.Context, in *CompanyJobsRequest, opts ...grpc.CallOption) (*ListJobResponse, error)
+	ApplyToJob(ctx context.Context, in *ApplyToJobRequest, opts ...grpc.CallOption) (*JobApplication, error)
+	MoveApplication(ctx context.Context, in *MoveApplicationRequest, opts ...grpc.CallOption) (*JobApplication, error)
+	GetApplication(ctx context.Context, in *ApplicationWithGUID, opts ...grpc.CallOption) (*JobApplication, error)
+	GetApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	GetApplicationHistory(ctx context.Context, in *ApplicationWithGUID, opts ...grpc.CallOption) (*ListApplicationHistory, error)
+	CreateSavedSearch(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, in *SavedSearchWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error)
+	GetSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error)
+	UpdateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error)
+	DeleteCategory(ctx context.Context, in *CategoryWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error)
+	GetCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	CreateTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*Tag, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*Tag, error)
+	DeleteTag(ctx context.Context, in *TagWithSlug, opts ...grpc.CallOption) (*ResponseStatus, error)
+	GetTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *WebhookWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error)
+	GetWebhook(ctx context.Context, in *WebhookWithGUID, opts ...grpc.CallOption) (*Webhook, error)
+	GetWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	GetWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	GetWebhookDelivery(ctx context.Context, in *WebhookDeliveryWithGUID, opts ...grpc.CallOption) (*WebhookDeliveryLog, error)
+	RedeliverWebhook(ctx context.Context, in *WebhookDeliveryWithGUID, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	GetAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*AuditVerification, error)
 }
 
 type jobServiceClient struct {
@@ -117,6 +216,15 @@ func (c *jobServiceClient) GetJob(ctx context.Context, in *JobWithGUID, opts ...
 	return out, nil
 }
 
+func (c *jobServiceClient) GetJobsByIDs(ctx context.Context, in *JobsByIDsRequest, opts ...grpc.CallOption) (*JobsByIDsResponse, error) {
+	out := new(JobsByIDsResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetJobsByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) GetAllJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListJobResponse, error) {
 	out := new(ListJobResponse)
 	err := c.cc.Invoke(ctx, "/job_service.JobService/GetAllJobs", in, out, opts...)
@@ -126,280 +234,1775 @@ func (c *jobServiceClient) GetAllJobs(ctx context.Context, in *ListRequest, opts
 	return out, nil
 }
 
+func (c *jobServiceClient) GetDictionaries(ctx context.Context, in *DictionariesRequest, opts ...grpc.CallOption) (*Dictionaries, error) {
+	out := new(Dictionaries)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetDictionaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobServiceClient) GetAllDeletedJobs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListJobResponse, error) {
 	out := new(ListJobResponse)
 	err := c.cc.Invoke(ctx, "/job_service.JobService/GetAllDeletedJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *jobServiceClient) GetClientJobs(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (*ListClientJobs, error) {
+	out := new(ListClientJobs)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetClientJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobClients(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (*ListClientJobs, error) {
+	out := new(ListClientJobs)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetJobClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetEmploymentHistory(ctx context.Context, in *EmploymentHistoryRequest, opts ...grpc.CallOption) (*EmploymentHistory, error) {
+	out := new(EmploymentHistory)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetEmploymentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RecommendJobsForClient(ctx context.Context, in *RecommendJobsRequest, opts ...grpc.CallOption) (*JobRecommendations, error) {
+	out := new(JobRecommendations)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/RecommendJobsForClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RecommendClientsForJob(ctx context.Context, in *RecommendClientsRequest, opts ...grpc.CallOption) (*ClientRecommendations, error) {
+	out := new(ClientRecommendations)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/RecommendClientsForJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) AddClientJob(ctx context.Context, in *ClientJobs, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/AddClientJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteClientJob(ctx context.Context, in *ClientJobs, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteClientJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ReassignClientJobs(ctx context.Context, in *ReassignClientJobsRequest, opts ...grpc.CallOption) (*ReassignClientJobsResponse, error) {
+	out := new(ReassignClientJobsResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/ReassignClientJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UndoReassignClientJobs(ctx context.Context, in *UndoReassignClientJobsRequest, opts ...grpc.CallOption) (*ReassignClientJobsResponse, error) {
+	out := new(ReassignClientJobsResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/UndoReassignClientJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) EndClientAssignments(ctx context.Context, in *EndClientAssignmentsRequest, opts ...grpc.CallOption) (*AssignmentsChanged, error) {
+	out := new(AssignmentsChanged)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/EndClientAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ReopenAssignments(ctx context.Context, in *ReopenAssignmentsRequest, opts ...grpc.CallOption) (*AssignmentsChanged, error) {
+	out := new(AssignmentsChanged)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/ReopenAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) BatchCreateJobs(ctx context.Context, in *BatchCreateJobsRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error) {
+	out := new(BatchCreateResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/BatchCreateJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) StreamJobs(ctx context.Context, in *StreamJobsRequest, opts ...grpc.CallOption) (JobService_StreamJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JobService_serviceDesc.Streams[0], "/job_service.JobService/StreamJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceStreamJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_StreamJobsClient interface {
+	Recv() (*Job, error)
+	grpc.ClientStream
+}
+
+type jobServiceStreamJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceStreamJobsClient) Recv() (*Job, error) {
+	m := new(Job)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) StreamClientJobs(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (JobService_StreamClientJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JobService_serviceDesc.Streams[1], "/job_service.JobService/StreamClientJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceStreamClientJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_StreamClientJobsClient interface {
+	Recv() (*ClientJobs, error)
+	grpc.ClientStream
+}
+
+type jobServiceStreamClientJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceStreamClientJobsClient) Recv() (*ClientJobs, error) {
+	m := new(ClientJobs)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (JobService_WatchJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_JobService_serviceDesc.Streams[2], "/job_service.JobService/WatchJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobServiceWatchJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobService_WatchJobsClient interface {
+	Recv() (*JobChange, error)
+	grpc.ClientStream
+}
+
+type jobServiceWatchJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobServiceWatchJobsClient) Recv() (*JobChange, error) {
+	m := new(JobChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobServiceClient) CreateCompany(ctx context.Context, in *Company, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/CreateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateCompany(ctx context.Context, in *Company, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/UpdateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteCompany(ctx context.Context, in *CompanyWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetCompany(ctx context.Context, in *CompanyWithGUID, opts ...grpc.CallOption) (*Company, error) {
+	out := new(Company)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetAllCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompanyResponse, error) {
+	out := new(ListCompanyResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetAllCompanies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetCompanyJobs(ctx context.Context, in *CompanyJobsRequest, opts ...grpc.CallOption) (*ListJobResponse, error) {
+	out := new(ListJobResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetCompanyJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) ApplyToJob(ctx context.Context, in *ApplyToJobRequest, opts ...grpc.CallOption) (*JobApplication, error) {
+	out := new(JobApplication)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/ApplyToJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) MoveApplication(ctx context.Context, in *MoveApplicationRequest, opts ...grpc.CallOption) (*JobApplication, error) {
+	out := new(JobApplication)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/MoveApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetApplication(ctx context.Context, in *ApplicationWithGUID, opts ...grpc.CallOption) (*JobApplication, error) {
+	out := new(JobApplication)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
+	out := new(ListApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetApplicationHistory(ctx context.Context, in *ApplicationWithGUID, opts ...grpc.CallOption) (*ListApplicationHistory, error) {
+	out := new(ListApplicationHistory)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetApplicationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateSavedSearch(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error) {
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/CreateSavedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateSavedSearch(ctx context.Context, in *SavedSearch, opts ...grpc.CallOption) (*SavedSearch, error) {
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/UpdateSavedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteSavedSearch(ctx context.Context, in *SavedSearchWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteSavedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetSavedSearches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*Category, error) {
+	out := new(Category)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteCategory(ctx context.Context, in *CategoryWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/CreateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/UpdateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteTag(ctx context.Context, in *TagWithSlug, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/UpdateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) DeleteWebhook(ctx context.Context, in *WebhookWithGUID, opts ...grpc.CallOption) (*ResponseStatus, error) {
+	out := new(ResponseStatus)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetWebhook(ctx context.Context, in *WebhookWithGUID, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetWebhookDelivery(ctx context.Context, in *WebhookDeliveryWithGUID, opts ...grpc.CallOption) (*WebhookDeliveryLog, error) {
+	out := new(WebhookDeliveryLog)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RedeliverWebhook(ctx context.Context, in *WebhookDeliveryWithGUID, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/RedeliverWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/GetAuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*AuditVerification, error) {
+	out := new(AuditVerification)
+	err := c.cc.Invoke(ctx, "/job_service.JobService/VerifyAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobServiceServer is the server API for JobService service.
+type JobServiceServer interface {
+	CreateJob(context.Context, *Job) (*JobWithGUID, error)
+	UpdateJob(context.Context, *Job) (*Job, error)
+	DeleteJob(context.Context, *JobWithGUID) (*ResponseStatus, error)
+	GetJob(context.Context, *JobWithGUID) (*Job, error)
+	GetJobsByIDs(context.Context, *JobsByIDsRequest) (*JobsByIDsResponse, error)
+	GetAllJobs(context.Context, *ListRequest) (*ListJobResponse, error)
+	GetDictionaries(context.Context, *DictionariesRequest) (*Dictionaries, error)
+	GetAllDeletedJobs(context.Context, *ListRequest) (*ListJobResponse, error)
+	GetClientJobs(context.Context, *ClientJobRequest) (*ListClientJobs, error)
+	GetJobClients(context.Context, *ClientJobRequest) (*ListClientJobs, error)
+	GetEmploymentHistory(context.Context, *EmploymentHistoryRequest) (*EmploymentHistory, error)
+	RecommendJobsForClient(context.Context, *RecommendJobsRequest) (*JobRecommendations, error)
+	RecommendClientsForJob(context.Context, *RecommendClientsRequest) (*ClientRecommendations, error)
+	AddClientJob(context.Context, *ClientJobs) (*ResponseStatus, error)
+	DeleteClientJob(context.Context, *ClientJobs) (*ResponseStatus, error)
+	ReassignClientJobs(context.Context, *ReassignClientJobsRequest) (*ReassignClientJobsResponse, error)
+	UndoReassignClientJobs(context.Context, *UndoReassignClientJobsRequest) (*ReassignClientJobsResponse, error)
+	EndClientAssignments(context.Context, *EndClientAssignmentsRequest) (*AssignmentsChanged, error)
+	ReopenAssignments(context.Context, *ReopenAssignmentsRequest) (*AssignmentsChanged, error)
+	BatchCreateJobs(context.Context, *BatchCreateJobsRequest) (*BatchCreateResponse, error)
+	StreamJobs(*StreamJobsRequest, JobService_StreamJobsServer) error
+	StreamClientJobs(*ClientJobRequest, JobService_StreamClientJobsServer) error
+	WatchJobs(*WatchJobsRequest, JobService_WatchJobsServer) error
+	CreateCompany(context.Context, *Company) (*Company, error)
+	UpdateCompany(context.Context, *Company) (*Company, error)
+	DeleteCompany(context.Context, *CompanyWithGUID) (*ResponseStatus, error)
+	GetCompany(context.Context, *CompanyWithGUID) (*Company, error)
+	GetAllCompanies(context.Context, *ListCompaniesRequest) (*ListCompanyResponse, error)
+	GetCompanyJobs(context.Context, *CompanyJobsRequest) (*ListJobResponse, error)
+	ApplyToJob(context.Context, *ApplyToJobRequest) (*JobApplication, error)
+	MoveApplication(context.Context, *MoveApplicationRequest) (*JobApplication, error)
+	GetApplication(context.Context, *ApplicationWithGUID) (*JobApplication, error)
+	GetApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	GetApplicationHistory(context.Context, *ApplicationWithGUID) (*ListApplicationHistory, error)
+	CreateSavedSearch(context.Context, *SavedSearch) (*SavedSearch, error)
+	UpdateSavedSearch(context.Context, *SavedSearch) (*SavedSearch, error)
+	DeleteSavedSearch(context.Context, *SavedSearchWithGUID) (*ResponseStatus, error)
+	GetSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	CreateCategory(context.Context, *Category) (*Category, error)
+	UpdateCategory(context.Context, *Category) (*Category, error)
+	DeleteCategory(context.Context, *CategoryWithGUID) (*ResponseStatus, error)
+	GetCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	CreateTag(context.Context, *Tag) (*Tag, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*Tag, error)
+	DeleteTag(context.Context, *TagWithSlug) (*ResponseStatus, error)
+	GetTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	CreateWebhook(context.Context, *Webhook) (*Webhook, error)
+	UpdateWebhook(context.Context, *Webhook) (*Webhook, error)
+	DeleteWebhook(context.Context, *WebhookWithGUID) (*ResponseStatus, error)
+	GetWebhook(context.Context, *WebhookWithGUID) (*Webhook, error)
+	GetWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	GetWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	GetWebhookDelivery(context.Context, *WebhookDeliveryWithGUID) (*WebhookDeliveryLog, error)
+	RedeliverWebhook(context.Context, *WebhookDeliveryWithGUID) (*WebhookDelivery, error)
+	GetAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*AuditVerification, error)
+}
+
+// UnimplementedJobServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedJobServiceServer struct {
+}
+
+func (*UnimplementedJobServiceServer) CreateJob(ctx context.Context, req *Job) (*JobWithGUID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJob not implemented")
+}
+func (*UnimplementedJobServiceServer) UpdateJob(ctx context.Context, req *Job) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJob not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteJob(ctx context.Context, req *JobWithGUID) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
+}
+func (*UnimplementedJobServiceServer) GetJob(ctx context.Context, req *JobWithGUID) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (*UnimplementedJobServiceServer) GetJobsByIDs(ctx context.Context, req *JobsByIDsRequest) (*JobsByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobsByIDs not implemented")
+}
+func (*UnimplementedJobServiceServer) GetAllJobs(ctx context.Context, req *ListRequest) (*ListJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) GetDictionaries(ctx context.Context, req *DictionariesRequest) (*Dictionaries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictionaries not implemented")
+}
+func (*UnimplementedJobServiceServer) GetAllDeletedJobs(ctx context.Context, req *ListRequest) (*ListJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDeletedJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) GetClientJobs(ctx context.Context, req *ClientJobRequest) (*ListClientJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) GetJobClients(ctx context.Context, req *ClientJobRequest) (*ListClientJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobClients not implemented")
+}
+func (*UnimplementedJobServiceServer) GetEmploymentHistory(ctx context.Context, req *EmploymentHistoryRequest) (*EmploymentHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmploymentHistory not implemented")
+}
+func (*UnimplementedJobServiceServer) RecommendJobsForClient(ctx context.Context, req *RecommendJobsRequest) (*JobRecommendations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendJobsForClient not implemented")
+}
+func (*UnimplementedJobServiceServer) RecommendClientsForJob(ctx context.Context, req *RecommendClientsRequest) (*ClientRecommendations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendClientsForJob not implemented")
+}
+func (*UnimplementedJobServiceServer) AddClientJob(ctx context.Context, req *ClientJobs) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClientJob not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteClientJob(ctx context.Context, req *ClientJobs) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientJob not implemented")
+}
+func (*UnimplementedJobServiceServer) ReassignClientJobs(ctx context.Context, req *ReassignClientJobsRequest) (*ReassignClientJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignClientJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) UndoReassignClientJobs(ctx context.Context, req *UndoReassignClientJobsRequest) (*ReassignClientJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoReassignClientJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) EndClientAssignments(ctx context.Context, req *EndClientAssignmentsRequest) (*AssignmentsChanged, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndClientAssignments not implemented")
+}
+func (*UnimplementedJobServiceServer) ReopenAssignments(ctx context.Context, req *ReopenAssignmentsRequest) (*AssignmentsChanged, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenAssignments not implemented")
+}
+func (*UnimplementedJobServiceServer) BatchCreateJobs(ctx context.Context, req *BatchCreateJobsRequest) (*BatchCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) StreamJobs(req *StreamJobsRequest, srv JobService_StreamJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) StreamClientJobs(req *ClientJobRequest, srv JobService_StreamClientJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamClientJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) WatchJobs(req *WatchJobsRequest, srv JobService_WatchJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) CreateCompany(ctx context.Context, req *Company) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+}
+func (*UnimplementedJobServiceServer) UpdateCompany(ctx context.Context, req *Company) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteCompany(ctx context.Context, req *CompanyWithGUID) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompany not implemented")
+}
+func (*UnimplementedJobServiceServer) GetCompany(ctx context.Context, req *CompanyWithGUID) (*Company, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
+}
+func (*UnimplementedJobServiceServer) GetAllCompanies(ctx context.Context, req *ListCompaniesRequest) (*ListCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCompanies not implemented")
+}
+func (*UnimplementedJobServiceServer) GetCompanyJobs(ctx context.Context, req *CompanyJobsRequest) (*ListJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyJobs not implemented")
+}
+func (*UnimplementedJobServiceServer) ApplyToJob(ctx context.Context, req *ApplyToJobRequest) (*JobApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToJob not implemented")
+}
+func (*UnimplementedJobServiceServer) MoveApplication(ctx context.Context, req *MoveApplicationRequest) (*JobApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveApplication not implemented")
+}
+func (*UnimplementedJobServiceServer) GetApplication(ctx context.Context, req *ApplicationWithGUID) (*JobApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (*UnimplementedJobServiceServer) GetApplications(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplications not implemented")
+}
+func (*UnimplementedJobServiceServer) GetApplicationHistory(ctx context.Context, req *ApplicationWithGUID) (*ListApplicationHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationHistory not implemented")
+}
+func (*UnimplementedJobServiceServer) CreateSavedSearch(ctx context.Context, req *SavedSearch) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (*UnimplementedJobServiceServer) UpdateSavedSearch(ctx context.Context, req *SavedSearch) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteSavedSearch(ctx context.Context, req *SavedSearchWithGUID) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (*UnimplementedJobServiceServer) GetSavedSearches(ctx context.Context, req *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearches not implemented")
+}
+func (*UnimplementedJobServiceServer) CreateCategory(ctx context.Context, req *Category) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (*UnimplementedJobServiceServer) UpdateCategory(ctx context.Context, req *Category) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteCategory(ctx context.Context, req *CategoryWithGUID) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (*UnimplementedJobServiceServer) GetCategories(ctx context.Context, req *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
+}
+func (*UnimplementedJobServiceServer) CreateTag(ctx context.Context, req *Tag) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (*UnimplementedJobServiceServer) UpdateTag(ctx context.Context, req *UpdateTagRequest) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteTag(ctx context.Context, req *TagWithSlug) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (*UnimplementedJobServiceServer) GetTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (*UnimplementedJobServiceServer) CreateWebhook(ctx context.Context, req *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (*UnimplementedJobServiceServer) UpdateWebhook(ctx context.Context, req *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (*UnimplementedJobServiceServer) DeleteWebhook(ctx context.Context, req *WebhookWithGUID) (*ResponseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (*UnimplementedJobServiceServer) GetWebhook(ctx context.Context, req *WebhookWithGUID) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (*UnimplementedJobServiceServer) GetWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (*UnimplementedJobServiceServer) GetWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (*UnimplementedJobServiceServer) GetWebhookDelivery(ctx context.Context, req *WebhookDeliveryWithGUID) (*WebhookDeliveryLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDelivery not implemented")
+}
+func (*UnimplementedJobServiceServer) RedeliverWebhook(ctx context.Context, req *WebhookDeliveryWithGUID) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (*UnimplementedJobServiceServer) GetAuditRecords(ctx context.Context, req *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditRecords not implemented")
+}
+func (*UnimplementedJobServiceServer) VerifyAuditLog(ctx context.Context, req *VerifyAuditLogRequest) (*AuditVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+
+func RegisterJobServiceServer(s *grpc.Server, srv JobServiceServer) {
+	s.RegisterService(&_JobService_serviceDesc, srv)
+}
+
+func _JobService_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/CreateJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateJob(ctx, req.(*Job))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Job)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/UpdateJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateJob(ctx, req.(*Job))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/DeleteJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteJob(ctx, req.(*JobWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJob(ctx, req.(*JobWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetJobsByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobsByIDs(ctx, req.(*JobsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetAllJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetAllJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetAllJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetAllJobs(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetDictionaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetDictionaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetDictionaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetDictionaries(ctx, req.(*DictionariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetAllDeletedJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetAllDeletedJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetAllDeletedJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetAllDeletedJobs(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetClientJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetClientJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetClientJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetClientJobs(ctx, req.(*ClientJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetJobClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobClients(ctx, req.(*ClientJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetEmploymentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmploymentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetEmploymentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetEmploymentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetEmploymentHistory(ctx, req.(*EmploymentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RecommendJobsForClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RecommendJobsForClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/RecommendJobsForClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RecommendJobsForClient(ctx, req.(*RecommendJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RecommendClientsForJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RecommendClientsForJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/RecommendClientsForJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RecommendClientsForJob(ctx, req.(*RecommendClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_AddClientJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientJobs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).AddClientJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/AddClientJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).AddClientJob(ctx, req.(*ClientJobs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteClientJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientJobs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteClientJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/DeleteClientJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteClientJob(ctx, req.(*ClientJobs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ReassignClientJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignClientJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ReassignClientJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/ReassignClientJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ReassignClientJobs(ctx, req.(*ReassignClientJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UndoReassignClientJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoReassignClientJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UndoReassignClientJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/UndoReassignClientJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UndoReassignClientJobs(ctx, req.(*UndoReassignClientJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_EndClientAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndClientAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).EndClientAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/EndClientAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).EndClientAssignments(ctx, req.(*EndClientAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ReopenAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ReopenAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/ReopenAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ReopenAssignments(ctx, req.(*ReopenAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_BatchCreateJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).BatchCreateJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/BatchCreateJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).BatchCreateJobs(ctx, req.(*BatchCreateJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_StreamJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).StreamJobs(m, &jobServiceStreamJobsServer{stream})
+}
+
+type JobService_StreamJobsServer interface {
+	Send(*Job) error
+	grpc.ServerStream
+}
+
+type jobServiceStreamJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceStreamJobsServer) Send(m *Job) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_StreamClientJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClientJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).StreamClientJobs(m, &jobServiceStreamClientJobsServer{stream})
+}
+
+type JobService_StreamClientJobsServer interface {
+	Send(*ClientJobs) error
+	grpc.ServerStream
+}
+
+type jobServiceStreamClientJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceStreamClientJobsServer) Send(m *ClientJobs) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).WatchJobs(m, &jobServiceWatchJobsServer{stream})
+}
+
+type JobService_WatchJobsServer interface {
+	Send(*JobChange) error
+	grpc.ServerStream
+}
+
+type jobServiceWatchJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobServiceWatchJobsServer) Send(m *JobChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JobService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Company)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/CreateCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateCompany(ctx, req.(*Company))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Company)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/UpdateCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateCompany(ctx, req.(*Company))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompanyWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/DeleteCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteCompany(ctx, req.(*CompanyWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompanyWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetCompany(ctx, req.(*CompanyWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetAllCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompaniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetAllCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetAllCompanies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetAllCompanies(ctx, req.(*ListCompaniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetCompanyJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompanyJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetCompanyJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetCompanyJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetCompanyJobs(ctx, req.(*CompanyJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_ApplyToJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyToJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ApplyToJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/ApplyToJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ApplyToJob(ctx, req.(*ApplyToJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_MoveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).MoveApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/MoveApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).MoveApplication(ctx, req.(*MoveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetApplication(ctx, req.(*ApplicationWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetApplications(ctx, req.(*ListApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetApplicationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetApplicationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetApplicationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetApplicationHistory(ctx, req.(*ApplicationWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/CreateSavedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateSavedSearch(ctx, req.(*SavedSearch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/UpdateSavedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateSavedSearch(ctx, req.(*SavedSearch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/DeleteSavedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteSavedSearch(ctx, req.(*SavedSearchWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetSavedSearches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Category)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateCategory(ctx, req.(*Category))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Category)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateCategory(ctx, req.(*Category))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryWithGUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteCategory(ctx, req.(*CategoryWithGUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *jobServiceClient) GetClientJobs(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (*ListClientJobs, error) {
-	out := new(ListClientJobs)
-	err := c.cc.Invoke(ctx, "/job_service.JobService/GetClientJobs", in, out, opts...)
-	if err != nil {
+func _JobService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tag)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(JobServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/CreateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CreateTag(ctx, req.(*Tag))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *jobServiceClient) GetJobClients(ctx context.Context, in *ClientJobRequest, opts ...grpc.CallOption) (*ListClientJobs, error) {
-	out := new(ListClientJobs)
-	err := c.cc.Invoke(ctx, "/job_service.JobService/GetJobClients", in, out, opts...)
-	if err != nil {
+func _JobService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(JobServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/UpdateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *jobServiceClient) AddClientJob(ctx context.Context, in *ClientJobs, opts ...grpc.CallOption) (*ResponseStatus, error) {
-	out := new(ResponseStatus)
-	err := c.cc.Invoke(ctx, "/job_service.JobService/AddClientJob", in, out, opts...)
-	if err != nil {
+func _JobService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagWithSlug)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(JobServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).DeleteTag(ctx, req.(*TagWithSlug))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *jobServiceClient) DeleteClientJob(ctx context.Context, in *ClientJobs, opts ...grpc.CallOption) (*ResponseStatus, error) {
-	out := new(ResponseStatus)
-	err := c.cc.Invoke(ctx, "/job_service.JobService/DeleteClientJob", in, out, opts...)
-	if err != nil {
+func _JobService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// JobServiceServer is the server API for JobService service.
-type JobServiceServer interface {
-	CreateJob(context.Context, *Job) (*JobWithGUID, error)
-	UpdateJob(context.Context, *Job) (*Job, error)
-	DeleteJob(context.Context, *JobWithGUID) (*ResponseStatus, error)
-	GetJob(context.Context, *JobWithGUID) (*Job, error)
-	GetAllJobs(context.Context, *ListRequest) (*ListJobResponse, error)
-	GetAllDeletedJobs(context.Context, *ListRequest) (*ListJobResponse, error)
-	GetClientJobs(context.Context, *ClientJobRequest) (*ListClientJobs, error)
-	GetJobClients(context.Context, *ClientJobRequest) (*ListClientJobs, error)
-	AddClientJob(context.Context, *ClientJobs) (*ResponseStatus, error)
-	DeleteClientJob(context.Context, *ClientJobs) (*ResponseStatus, error)
-}
-
-// UnimplementedJobServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedJobServiceServer struct {
-}
-
-func (*UnimplementedJobServiceServer) CreateJob(ctx context.Context, req *Job) (*JobWithGUID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateJob not implemented")
-}
-func (*UnimplementedJobServiceServer) UpdateJob(ctx context.Context, req *Job) (*Job, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJob not implemented")
-}
-func (*UnimplementedJobServiceServer) DeleteJob(ctx context.Context, req *JobWithGUID) (*ResponseStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
-}
-func (*UnimplementedJobServiceServer) GetJob(ctx context.Context, req *JobWithGUID) (*Job, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
-}
-func (*UnimplementedJobServiceServer) GetAllJobs(ctx context.Context, req *ListRequest) (*ListJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllJobs not implemented")
-}
-func (*UnimplementedJobServiceServer) GetAllDeletedJobs(ctx context.Context, req *ListRequest) (*ListJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllDeletedJobs not implemented")
-}
-func (*UnimplementedJobServiceServer) GetClientJobs(ctx context.Context, req *ClientJobRequest) (*ListClientJobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClientJobs not implemented")
-}
-func (*UnimplementedJobServiceServer) GetJobClients(ctx context.Context, req *ClientJobRequest) (*ListClientJobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobClients not implemented")
-}
-func (*UnimplementedJobServiceServer) AddClientJob(ctx context.Context, req *ClientJobs) (*ResponseStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddClientJob not implemented")
-}
-func (*UnimplementedJobServiceServer) DeleteClientJob(ctx context.Context, req *ClientJobs) (*ResponseStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientJob not implemented")
-}
-
-func RegisterJobServiceServer(s *grpc.Server, srv JobServiceServer) {
-	s.RegisterService(&_JobService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/job_service.JobService/GetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Job)
+func _JobService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).CreateJob(ctx, in)
+		return srv.(JobServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/CreateJob",
+		FullMethod: "/job_service.JobService/CreateWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).CreateJob(ctx, req.(*Job))
+		return srv.(JobServiceServer).CreateWebhook(ctx, req.(*Webhook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_UpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Job)
+func _JobService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).UpdateJob(ctx, in)
+		return srv.(JobServiceServer).UpdateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/UpdateJob",
+		FullMethod: "/job_service.JobService/UpdateWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).UpdateJob(ctx, req.(*Job))
+		return srv.(JobServiceServer).UpdateWebhook(ctx, req.(*Webhook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobWithGUID)
+func _JobService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookWithGUID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).DeleteJob(ctx, in)
+		return srv.(JobServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/DeleteJob",
+		FullMethod: "/job_service.JobService/DeleteWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).DeleteJob(ctx, req.(*JobWithGUID))
+		return srv.(JobServiceServer).DeleteWebhook(ctx, req.(*WebhookWithGUID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobWithGUID)
+func _JobService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookWithGUID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).GetJob(ctx, in)
+		return srv.(JobServiceServer).GetWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/GetJob",
+		FullMethod: "/job_service.JobService/GetWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).GetJob(ctx, req.(*JobWithGUID))
+		return srv.(JobServiceServer).GetWebhook(ctx, req.(*WebhookWithGUID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_GetAllJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
+func _JobService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).GetAllJobs(ctx, in)
+		return srv.(JobServiceServer).GetWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/GetAllJobs",
+		FullMethod: "/job_service.JobService/GetWebhooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).GetAllJobs(ctx, req.(*ListRequest))
+		return srv.(JobServiceServer).GetWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_GetAllDeletedJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
+func _JobService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).GetAllDeletedJobs(ctx, in)
+		return srv.(JobServiceServer).GetWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/GetAllDeletedJobs",
+		FullMethod: "/job_service.JobService/GetWebhookDeliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).GetAllDeletedJobs(ctx, req.(*ListRequest))
+		return srv.(JobServiceServer).GetWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_GetClientJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClientJobRequest)
+func _JobService_GetWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryWithGUID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).GetClientJobs(ctx, in)
+		return srv.(JobServiceServer).GetWebhookDelivery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/GetClientJobs",
+		FullMethod: "/job_service.JobService/GetWebhookDelivery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).GetClientJobs(ctx, req.(*ClientJobRequest))
+		return srv.(JobServiceServer).GetWebhookDelivery(ctx, req.(*WebhookDeliveryWithGUID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_GetJobClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClientJobRequest)
+func _JobService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryWithGUID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).GetJobClients(ctx, in)
+		return srv.(JobServiceServer).RedeliverWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/GetJobClients",
+		FullMethod: "/job_service.JobService/RedeliverWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).GetJobClients(ctx, req.(*ClientJobRequest))
+		return srv.(JobServiceServer).RedeliverWebhook(ctx, req.(*WebhookDeliveryWithGUID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_AddClientJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClientJobs)
+func _JobService_GetAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).AddClientJob(ctx, in)
+		return srv.(JobServiceServer).GetAuditRecords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/AddClientJob",
+		FullMethod: "/job_service.JobService/GetAuditRecords",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).AddClientJob(ctx, req.(*ClientJobs))
+		return srv.(JobServiceServer).GetAuditRecords(ctx, req.(*ListAuditRecordsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JobService_DeleteClientJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClientJobs)
+func _JobService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JobServiceServer).DeleteClientJob(ctx, in)
+		return srv.(JobServiceServer).VerifyAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/job_service.JobService/DeleteClientJob",
+		FullMethod: "/job_service.JobService/VerifyAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JobServiceServer).DeleteClientJob(ctx, req.(*ClientJobs))
+		return srv.(JobServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -424,10 +2027,18 @@ var _JobService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJob",
 			Handler:    _JobService_GetJob_Handler,
 		},
+		{
+			MethodName: "GetJobsByIDs",
+			Handler:    _JobService_GetJobsByIDs_Handler,
+		},
 		{
 			MethodName: "GetAllJobs",
 			Handler:    _JobService_GetAllJobs_Handler,
 		},
+		{
+			MethodName: "GetDictionaries",
+			Handler:    _JobService_GetDictionaries_Handler,
+		},
 		{
 			MethodName: "GetAllDeletedJobs",
 			Handler:    _JobService_GetAllDeletedJobs_Handler,
@@ -440,6 +2051,18 @@ var _JobService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJobClients",
 			Handler:    _JobService_GetJobClients_Handler,
 		},
+		{
+			MethodName: "GetEmploymentHistory",
+			Handler:    _JobService_GetEmploymentHistory_Handler,
+		},
+		{
+			MethodName: "RecommendJobsForClient",
+			Handler:    _JobService_RecommendJobsForClient_Handler,
+		},
+		{
+			MethodName: "RecommendClientsForJob",
+			Handler:    _JobService_RecommendClientsForJob_Handler,
+		},
 		{
 			MethodName: "AddClientJob",
 			Handler:    _JobService_AddClientJob_Handler,
@@ -448,7 +2071,175 @@ var _JobService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteClientJob",
 			Handler:    _JobService_DeleteClientJob_Handler,
 		},
+		{
+			MethodName: "ReassignClientJobs",
+			Handler:    _JobService_ReassignClientJobs_Handler,
+		},
+		{
+			MethodName: "UndoReassignClientJobs",
+			Handler:    _JobService_UndoReassignClientJobs_Handler,
+		},
+		{
+			MethodName: "EndClientAssignments",
+			Handler:    _JobService_EndClientAssignments_Handler,
+		},
+		{
+			MethodName: "ReopenAssignments",
+			Handler:    _JobService_ReopenAssignments_Handler,
+		},
+		{
+			MethodName: "BatchCreateJobs",
+			Handler:    _JobService_BatchCreateJobs_Handler,
+		},
+		{
+			MethodName: "CreateCompany",
+			Handler:    _JobService_CreateCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _JobService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "DeleteCompany",
+			Handler:    _JobService_DeleteCompany_Handler,
+		},
+		{
+			MethodName: "GetCompany",
+			Handler:    _JobService_GetCompany_Handler,
+		},
+		{
+			MethodName: "GetAllCompanies",
+			Handler:    _JobService_GetAllCompanies_Handler,
+		},
+		{
+			MethodName: "GetCompanyJobs",
+			Handler:    _JobService_GetCompanyJobs_Handler,
+		},
+		{
+			MethodName: "ApplyToJob",
+			Handler:    _JobService_ApplyToJob_Handler,
+		},
+		{
+			MethodName: "MoveApplication",
+			Handler:    _JobService_MoveApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _JobService_GetApplication_Handler,
+		},
+		{
+			MethodName: "GetApplications",
+			Handler:    _JobService_GetApplications_Handler,
+		},
+		{
+			MethodName: "GetApplicationHistory",
+			Handler:    _JobService_GetApplicationHistory_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _JobService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _JobService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _JobService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearches",
+			Handler:    _JobService_GetSavedSearches_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _JobService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _JobService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _JobService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "GetCategories",
+			Handler:    _JobService_GetCategories_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _JobService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _JobService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _JobService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _JobService_GetTags_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _JobService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _JobService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _JobService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _JobService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _JobService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _JobService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "GetWebhookDelivery",
+			Handler:    _JobService_GetWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _JobService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "GetAuditRecords",
+			Handler:    _JobService_GetAuditRecords_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _JobService_VerifyAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamJobs",
+			Handler:       _JobService_StreamJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamClientJobs",
+			Handler:       _JobService_StreamClientJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _JobService_WatchJobs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "job_service.proto",
 }
@@ -1,16 +1,26 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	jobproto "job-service/genproto/job_service"
+	"job-service/internal/delivery/dispatcher"
 	grpc_server "job-service/internal/delivery/grpc/server"
 	client_service_services "job-service/internal/delivery/grpc/services"
+	"job-service/internal/delivery/relay"
+	"job-service/internal/delivery/resumer"
+	"job-service/internal/delivery/scheduler"
+	"job-service/internal/infrastructure/broker"
+	"job-service/internal/infrastructure/geocoder"
 	"job-service/internal/infrastructure/grpc_service_clients"
+	"job-service/internal/infrastructure/notifier"
 	repo "job-service/internal/infrastructure/repository/postgresql"
+	"job-service/internal/infrastructure/webhook"
 	"job-service/internal/pkg/config"
 	"job-service/internal/pkg/logger"
 	"job-service/internal/pkg/postgres"
 	"job-service/internal/usecase"
+	"strconv"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -28,6 +38,12 @@ type App struct {
 	GrpcServer     *grpc.Server
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
+	stopScheduler  context.CancelFunc
+	stopListener   context.CancelFunc
+	stopRelay      context.CancelFunc
+	stopDispatcher context.CancelFunc
+	stopResumer    context.CancelFunc
+	Broker         broker.Broker
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -49,6 +65,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 			grpc_ctxtags.StreamServerInterceptor(),
 			grpc_zap.StreamServerInterceptor(logger),
 			grpc_recovery.StreamServerInterceptor(),
+			grpc_server.StreamInterceptorErrors(),
 		)),
 		grpc.UnaryInterceptor(grpc_server.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
@@ -57,6 +74,7 @@ func NewApp(cfg *config.Config) (*App, error) {
 				grpc_recovery.UnaryServerInterceptor(),
 			),
 			grpc_server.UnaryInterceptorData(logger),
+			grpc_server.UnaryInterceptorErrors(),
 		)),
 	)
 
@@ -79,6 +97,46 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error during parse duration for context timeout : %w", err)
 	}
+	schedulerInterval, err := time.ParseDuration(a.Config.Scheduler.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for scheduler interval : %w", err)
+	}
+	changeRetention, err := time.ParseDuration(a.Config.ChangeFeed.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for change feed retention : %w", err)
+	}
+	outboxInterval, err := time.ParseDuration(a.Config.Outbox.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox interval : %w", err)
+	}
+	outboxRetention, err := time.ParseDuration(a.Config.Outbox.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox retention : %w", err)
+	}
+	outboxBatchSize, err := strconv.ParseUint(a.Config.Outbox.BatchSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse outbox batch size : %w", err)
+	}
+	webhookInterval, err := time.ParseDuration(a.Config.Webhook.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for webhook interval : %w", err)
+	}
+	webhookTimeout, err := time.ParseDuration(a.Config.Webhook.Timeout)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for webhook timeout : %w", err)
+	}
+	sagaInterval, err := time.ParseDuration(a.Config.Saga.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for saga interval : %w", err)
+	}
+	recommendationWeights, err := usecase.ParseRecommendationWeights(a.Config.Recommendation.Weights)
+	if err != nil {
+		return fmt.Errorf("error during parse recommendation weights : %w", err)
+	}
+	recommendationPoolSize, err := strconv.ParseUint(a.Config.Recommendation.PoolSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse recommendation pool size : %w", err)
+	}
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -88,11 +146,77 @@ func (a *App) Run() error {
 
 	// repositories initialization
 	jobRepo := repo.NewJobsRepo(a.DB)
+	sagaRepo := repo.NewSagasRepo(a.DB)
+	companyRepo := repo.NewCompaniesRepo(a.DB)
+	applicationRepo := repo.NewApplicationsRepo(a.DB)
+	savedSearchRepo := repo.NewSavedSearchesRepo(a.DB)
+	dictionaryRepo := repo.NewDictionariesRepo(a.DB)
+	taxonomyRepo := repo.NewTaxonomyRepo(a.DB)
+	outboxRepo := repo.NewOutboxRepo(a.DB)
+	webhookRepo := repo.NewWebhooksRepo(a.DB)
+	auditRepo := repo.NewAuditRepo(a.DB)
+
+	// notifier of saved search alerts
+	alertNotifier, err := notifier.New(a.Config, a.ServiceClients)
+	if err != nil {
+		return fmt.Errorf("error during initialize notifier: %w", err)
+	}
+
+	// broker of the domain events
+	eventBroker, err := broker.New(a.Config, a.Logger)
+	if err != nil {
+		return fmt.Errorf("error during initialize broker: %w", err)
+	}
+	a.Broker = eventBroker
+
+	// geocoder of job addresses
+	jobGeocoder, err := geocoder.New(a.Config)
+	if err != nil {
+		return fmt.Errorf("error during initialize geocoder: %w", err)
+	}
+
+	// one connection listens for job changes on behalf of every watcher
+	jobChanges := postgres.NewListener(a.DB, "job_changes")
+	listenerCtx, stopListener := context.WithCancel(context.Background())
+	a.stopListener = stopListener
+	go jobChanges.Run(listenerCtx, func(err error) {
+		a.Logger.Error("listen for job changes", zap.Error(err))
+	})
 
 	// usecase initialization
-	jobUsecase := usecase.NewJobService(contextTimeout, jobRepo)
+	jobUsecase := usecase.NewJobService(contextTimeout, jobRepo, companyRepo, dictionaryRepo, taxonomyRepo, jobGeocoder, jobChanges)
+	companyUsecase := usecase.NewCompanyService(contextTimeout, companyRepo)
+	applicationUsecase := usecase.NewApplicationService(contextTimeout, applicationRepo, jobRepo)
+	recommendationUsecase := usecase.NewRecommendationService(contextTimeout, jobRepo, applicationRepo, recommendationWeights, recommendationPoolSize)
+	dictionaryUsecase := usecase.NewDictionaryService(contextTimeout, dictionaryRepo)
+	taxonomyUsecase := usecase.NewTaxonomyService(contextTimeout, taxonomyRepo)
+	savedSearchUsecase := usecase.NewSavedSearchService(contextTimeout, savedSearchRepo, dictionaryRepo, taxonomyRepo, jobGeocoder, alertNotifier)
+	outboxUsecase := usecase.NewOutboxService(contextTimeout, outboxRepo, eventBroker, outboxBatchSize)
+	webhookUsecase := usecase.NewWebhookService(contextTimeout, webhookRepo, webhook.NewHTTP(webhookTimeout))
+	auditUsecase := usecase.NewAuditService(contextTimeout, auditRepo)
+	sagaUsecase := usecase.NewSagaService(contextTimeout, sagaRepo, jobRepo)
 
-	jobproto.RegisterJobServiceServer(a.GrpcServer, client_service_services.NewRPC(a.Logger, jobUsecase, &a.ServiceClients))
+	// job scheduler
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	a.stopScheduler = stopScheduler
+	go scheduler.New(a.Logger, jobUsecase, savedSearchUsecase, schedulerInterval, changeRetention).Run(schedulerCtx)
+
+	// outbox relay
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	a.stopRelay = stopRelay
+	go relay.New(a.Logger, outboxUsecase, outboxInterval, outboxRetention).Run(relayCtx)
+
+	// webhook dispatcher
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	a.stopDispatcher = stopDispatcher
+	go dispatcher.New(a.Logger, webhookUsecase, webhookInterval).Run(dispatcherCtx)
+
+	// deletion saga resumer
+	resumerCtx, stopResumer := context.WithCancel(context.Background())
+	a.stopResumer = stopResumer
+	go resumer.New(a.Logger, sagaUsecase, sagaInterval).Run(resumerCtx)
+
+	jobproto.RegisterJobServiceServer(a.GrpcServer, client_service_services.NewRPC(a.Logger, jobUsecase, companyUsecase, applicationUsecase, recommendationUsecase, savedSearchUsecase, dictionaryUsecase, taxonomyUsecase, webhookUsecase, auditUsecase, sagaUsecase, &a.ServiceClients))
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -102,6 +226,31 @@ func (a *App) Run() error {
 }
 
 func (a *App) Stop() {
+	// stop job scheduler
+	if a.stopScheduler != nil {
+		a.stopScheduler()
+	}
+	// stop listening for job changes
+	if a.stopListener != nil {
+		a.stopListener()
+	}
+	// stop outbox relay, the events it was publishing stay pending
+	if a.stopRelay != nil {
+		a.stopRelay()
+	}
+	// stop webhook dispatcher, the deliveries it was sending are taken again after their lease
+	if a.stopDispatcher != nil {
+		a.stopDispatcher()
+	}
+	// stop saga resumer, the sagas it was running are taken again after their lease
+	if a.stopResumer != nil {
+		a.stopResumer()
+	}
+	if a.Broker != nil {
+		if err := a.Broker.Close(); err != nil {
+			a.Logger.Error("close broker", zap.Error(err))
+		}
+	}
 	// closing client service connections
 	a.ServiceClients.Close()
 	// stop gRPC server
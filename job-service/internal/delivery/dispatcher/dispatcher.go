@@ -0,0 +1,48 @@
+package dispatcher
+
+import (
+	"context"
+	"job-service/internal/usecase"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Dispatcher sends the due webhook deliveries, again right away while there are any and every
+// interval otherwise
+type Dispatcher struct {
+	logger         *zap.Logger
+	webhookUsecase usecase.Webhook
+	interval       time.Duration
+}
+
+func New(logger *zap.Logger, webhookUsecase usecase.Webhook, interval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		logger:         logger,
+		webhookUsecase: webhookUsecase,
+		interval:       interval,
+	}
+}
+
+// Run blocks until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		wait := d.interval
+		delivered, failed, err := d.webhookUsecase.DeliverDueWebhooks(ctx)
+		if err != nil {
+			d.logger.Error("dispatcher: deliver webhooks", zap.Error(err))
+		}
+		if delivered != 0 || failed != 0 {
+			d.logger.Info("dispatcher: webhooks sent", zap.Uint64("delivered", delivered), zap.Uint64("failed", failed))
+			wait = 0
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
@@ -2,10 +2,17 @@ package server
 
 import (
 	"context"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/idempotency"
+	"job-service/internal/pkg/principal"
+	"sort"
 
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func UnaryInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
@@ -24,13 +31,102 @@ func UnaryInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.UnarySer
 	}
 }
 
+// UnaryInterceptorData puts the principal and the Idempotency-Key of the incoming metadata into
+// the context, the database records the principal as the actor of the changes
 func UnaryInterceptorData(logger *zap.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			_ = md
-			//...
+		if actor := principal.FromIncomingContext(ctx); actor != "" {
+			ctx = principal.NewContext(ctx, actor)
+		}
+		if key := idempotency.FromIncomingContext(ctx); key != "" {
+			ctx = idempotency.NewContext(ctx, key)
 		}
 		return handler(ctx, req)
 	}
 }
+
+// UnaryInterceptorErrors gives the errors of the usecases their gRPC codes,
+// errors which already carry a status (e.g. of client-service) are passed through
+func UnaryInterceptorErrors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		return resp, statusError(err)
+	}
+}
+
+// StreamInterceptorErrors does the same as UnaryInterceptorErrors for streams
+func StreamInterceptorErrors() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return statusError(err)
+		}
+		return nil
+	}
+}
+
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var (
+		errNotFound     *entity.ErrNotFound
+		errConflict     *entity.ErrConflict
+		errValidation   *entity.ErrValidation
+		errNoParameter  *entity.ErrNoRequiredParameter
+		errTransition   *entity.ErrInvalidTransition
+		errPrecondition *entity.ErrPrecondition
+		errPending      *entity.ErrPending
+	)
+	code := codes.Unknown
+	switch {
+	case errors.As(err, &errNotFound):
+		code = codes.NotFound
+	case errors.As(err, &errConflict):
+		code = codes.AlreadyExists
+	case errors.As(err, &errValidation), errors.As(err, &errNoParameter):
+		code = codes.InvalidArgument
+	case errors.As(err, &errTransition), errors.As(err, &errPrecondition):
+		code = codes.FailedPrecondition
+	case errors.As(err, &errPending):
+		// accepted and finished in background, the gateways answer 202
+		code = codes.Aborted
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	}
+
+	st := status.New(code, err.Error())
+	// the fields which failed validation go along as BadRequest details
+	if errValidation != nil && len(errValidation.Errors) != 0 {
+		st = withFieldViolations(st, errValidation.Errors)
+	}
+
+	return st.Err()
+}
+
+func withFieldViolations(st *status.Status, errs map[string]string) *status.Status {
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: errs[field],
+		})
+	}
+
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: entity.NewErrNotFound("job"), code: codes.NotFound},
+		{err: entity.NewErrConflict("delete_job of j1 is already in progress"), code: codes.AlreadyExists},
+		{err: entity.NewErrNoRequiredParameter("id"), code: codes.InvalidArgument},
+		{err: entity.NewErrInvalidTransition("closed", "draft"), code: codes.FailedPrecondition},
+		{err: entity.NewErrPrecondition("the job has hires"), code: codes.FailedPrecondition},
+		// the gateways answer 202 to a deletion finished in background
+		{err: entity.NewErrPending("delete_job of j1 will be retried"), code: codes.Aborted},
+		{err: fmt.Errorf("run saga: %w", entity.NewErrPending("will be retried")), code: codes.Aborted},
+		{err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+		{err: status.Error(codes.Unavailable, "client-service is down"), code: codes.Unavailable},
+		{err: fmt.Errorf("connection reset"), code: codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := status.Code(statusError(tt.err)); got != tt.code {
+			t.Errorf("statusError(%v) = %v, want %v", tt.err, got, tt.code)
+		}
+	}
+}
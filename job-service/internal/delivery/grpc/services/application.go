@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	clientproto "job-service/genproto/client_service"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) ApplyToJob(ctx context.Context, in *jobproto.ApplyToJobRequest) (*jobproto.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ApplyToJob")
+	span.SetAttributes(
+		attribute.Key("job_id").String(in.JobId),
+		attribute.Key("client_id").String(in.ClientId),
+	)
+	defer span.End()
+
+	application, err := s.applicationUsecase.ApplyToJob(ctx, &entity.JobApplication{
+		JobID:       in.JobId,
+		ClientID:    in.ClientId,
+		CoverLetter: in.CoverLetter,
+	}, func(ctx context.Context) error {
+		_, err := s.clients.ClientService().GetClient(ctx, &clientproto.ClientWithGUID{
+			Guid: in.ClientId,
+		})
+		return err
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return applicationToProto(application), nil
+}
+
+func (s jobRPC) MoveApplication(ctx context.Context, in *jobproto.MoveApplicationRequest) (*jobproto.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "MoveApplication")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ApplicationId),
+		attribute.Key("status").String(in.Status),
+	)
+	defer span.End()
+
+	application, err := s.applicationUsecase.MoveApplication(ctx, &entity.ApplicationStatusChange{
+		ApplicationID: in.ApplicationId,
+		ToStatus:      in.Status,
+		Actor:         in.Actor,
+		Note:          in.Note,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return applicationToProto(application), nil
+}
+
+func (s jobRPC) GetApplication(ctx context.Context, in *jobproto.ApplicationWithGUID) (*jobproto.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetApplication")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ApplicationId),
+	)
+	defer span.End()
+
+	application, err := s.applicationUsecase.GetApplication(ctx, in.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return applicationToProto(application), nil
+}
+
+func (s jobRPC) GetApplications(ctx context.Context, in *jobproto.ListApplicationsRequest) (*jobproto.ListApplicationsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ListApplications")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.String()),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+
+	applications, err := s.applicationUsecase.GetApplications(ctx, in.Limit, offset, map[string]string{
+		"job_id":    in.JobId,
+		"client_id": in.ClientId,
+		"status":    in.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListApplicationsResponse
+	for _, application := range applications {
+		response.Applications = append(response.Applications, applicationToProto(application))
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) GetApplicationHistory(ctx context.Context, in *jobproto.ApplicationWithGUID) (*jobproto.ListApplicationHistory, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetApplicationHistory")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ApplicationId),
+	)
+	defer span.End()
+
+	changes, err := s.applicationUsecase.GetApplicationHistory(ctx, in.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListApplicationHistory
+	for _, change := range changes {
+		response.Changes = append(response.Changes, &jobproto.ApplicationStatusChange{
+			Id:            change.GUID,
+			ApplicationId: change.ApplicationID,
+			FromStatus:    change.FromStatus,
+			ToStatus:      change.ToStatus,
+			Actor:         change.Actor,
+			Note:          change.Note,
+			CreatedAt:     change.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &response, nil
+}
+
+func applicationToProto(application *entity.JobApplication) *jobproto.JobApplication {
+	return &jobproto.JobApplication{
+		Id:          application.GUID,
+		JobId:       application.JobID,
+		ClientId:    application.ClientID,
+		Status:      application.Status,
+		CoverLetter: application.CoverLetter,
+		CreatedAt:   application.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   application.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) GetAuditRecords(ctx context.Context, in *jobproto.ListAuditRecordsRequest) (*jobproto.ListAuditRecordsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetAuditRecords")
+	span.SetAttributes(
+		attribute.Key("entity").String(in.Entity),
+		attribute.Key("actor").String(in.Actor),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+
+	records, err := s.auditUsecase.GetAuditRecords(ctx, in.Limit, offset, map[string]string{
+		"entity":    in.Entity,
+		"entity_id": in.EntityId,
+		"actor":     in.Actor,
+		"action":    in.Action,
+		"service":   in.Service,
+		"from":      in.From,
+		"to":        in.To,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	var response jobproto.ListAuditRecordsResponse
+	for _, record := range records {
+		response.Records = append(response.Records, &jobproto.AuditRecord{
+			Id:         record.ID,
+			OccurredAt: record.OccurredAt.Format(time.RFC3339Nano),
+			Service:    record.Service,
+			Actor:      record.Actor,
+			Entity:     record.Entity,
+			EntityId:   record.EntityID,
+			Action:     record.Action,
+			Before:     string(record.Before),
+			After:      string(record.After),
+			Diff:       string(record.Diff),
+			PrevHash:   record.PrevHash,
+			Hash:       record.Hash,
+		})
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) VerifyAuditLog(ctx context.Context, in *jobproto.VerifyAuditLogRequest) (*jobproto.AuditVerification, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "VerifyAuditLog")
+	defer span.End()
+
+	verification, err := s.auditUsecase.VerifyAuditLog(ctx)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &jobproto.AuditVerification{
+		Valid:    verification.Valid,
+		Checked:  verification.Checked,
+		BrokenAt: verification.BrokenAt,
+		Reason:   verification.Reason,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) CreateCompany(ctx context.Context, in *jobproto.Company) (*jobproto.Company, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "CreateCompany")
+	span.SetAttributes(
+		attribute.Key("slug").String(in.Slug),
+	)
+	defer span.End()
+
+	company, err := s.companyUsecase.CreateCompany(ctx, companyFromProto(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return companyToProto(company), nil
+}
+
+func (s jobRPC) UpdateCompany(ctx context.Context, in *jobproto.Company) (*jobproto.Company, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpdateCompany")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	company, err := s.companyUsecase.UpdateCompany(ctx, companyFromProto(in))
+	if err != nil {
+		return nil, err
+	}
+
+	return companyToProto(company), nil
+}
+
+func (s jobRPC) DeleteCompany(ctx context.Context, in *jobproto.CompanyWithGUID) (*jobproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "DeleteCompany")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.CompanyId),
+	)
+	defer span.End()
+
+	err := s.companyUsecase.DeleteCompany(ctx, in.CompanyId)
+	if err != nil {
+		return &jobproto.ResponseStatus{Status: false}, err
+	}
+
+	return &jobproto.ResponseStatus{Status: true}, nil
+}
+
+func (s jobRPC) GetCompany(ctx context.Context, in *jobproto.CompanyWithGUID) (*jobproto.Company, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetCompany")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.CompanyId),
+	)
+	defer span.End()
+
+	company, err := s.companyUsecase.GetCompany(ctx, map[string]string{
+		"id": in.CompanyId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return companyToProto(company), nil
+}
+
+func (s jobRPC) GetAllCompanies(ctx context.Context, in *jobproto.ListCompaniesRequest) (*jobproto.ListCompanyResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ListCompanies")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.String()),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+
+	companies, err := s.companyUsecase.GetAllCompanies(ctx, in.Limit, offset, map[string]string{
+		"search": in.Search,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListCompanyResponse
+	for _, company := range companies {
+		response.Companies = append(response.Companies, companyToProto(company))
+	}
+
+	return &response, nil
+}
+
+// GetCompanyJobs answers not found for an unknown company instead of an empty list
+func (s jobRPC) GetCompanyJobs(ctx context.Context, in *jobproto.CompanyJobsRequest) (*jobproto.ListJobResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetCompanyJobs")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.CompanyId),
+	)
+	defer span.End()
+
+	if _, err := s.companyUsecase.GetCompany(ctx, map[string]string{"id": in.CompanyId}); err != nil {
+		return nil, err
+	}
+
+	offset := in.Limit * (in.Page - 1)
+
+	listJobs, err := s.jobUsecase.GetAllJobs(ctx, in.Limit, offset, map[string]string{
+		"company_id": in.CompanyId,
+		"status":     in.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListJobResponse
+	for _, job := range listJobs {
+		response.Jobs = append(response.Jobs, jobToProto(job))
+	}
+
+	return &response, nil
+}
+
+func companyFromProto(company *jobproto.Company) *entity.Company {
+	return &entity.Company{
+		GUID:        company.Id,
+		Name:        company.Name,
+		Slug:        company.Slug,
+		Logo:        company.Logo,
+		Website:     company.Website,
+		Description: company.Description,
+		Verified:    company.Verified,
+	}
+}
+
+func companyToProto(company *entity.Company) *jobproto.Company {
+	return &jobproto.Company{
+		Id:          company.GUID,
+		Name:        company.Name,
+		Slug:        company.Slug,
+		Logo:        company.Logo,
+		Website:     company.Website,
+		Description: company.Description,
+		Verified:    company.Verified,
+		CreatedAt:   company.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   company.UpdatedAt.Format(time.RFC3339),
+	}
+}
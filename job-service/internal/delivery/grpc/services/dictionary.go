@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+)
+
+func (s jobRPC) GetDictionaries(ctx context.Context, in *jobproto.DictionariesRequest) (*jobproto.Dictionaries, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetDictionaries")
+	span.SetAttributes(
+		attribute.Key("lang").String(in.Lang),
+	)
+	defer span.End()
+
+	dictionaries, err := s.dictionaryUsecase.GetDictionaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.Dictionaries
+	for _, name := range []string{entity.DictionaryLevel, entity.DictionaryLocationType, entity.DictionaryEmploymentType} {
+		dictionary := &jobproto.Dictionary{Name: name}
+		for _, entry := range dictionaries[name] {
+			dictionary.Entries = append(dictionary.Entries, &jobproto.DictionaryEntry{
+				Code:   entry.Code,
+				Label:  entry.Label(in.Lang),
+				Labels: entry.Labels,
+			})
+		}
+		response.Dictionaries = append(response.Dictionaries, dictionary)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) GetEmploymentHistory(ctx context.Context, in *jobproto.EmploymentHistoryRequest) (*jobproto.EmploymentHistory, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetEmploymentHistory")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+	)
+	defer span.End()
+
+	history, err := s.jobUsecase.GetEmploymentHistory(ctx, in.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := jobproto.EmploymentHistory{
+		ClientId:        history.ClientID,
+		TotalTenureDays: history.TotalTenureDays,
+	}
+	for _, position := range history.Positions {
+		response.Positions = append(response.Positions, &jobproto.EmploymentPosition{
+			JobId:      position.JobID,
+			JobName:    position.JobName,
+			CompanyId:  position.CompanyID,
+			Company:    position.Company,
+			StartDate:  position.StartDate.Format(time.RFC3339),
+			EndDate:    formatTime(position.EndDate),
+			Current:    position.Current,
+			TenureDays: position.TenureDays,
+		})
+	}
+	for _, gap := range history.Gaps {
+		response.Gaps = append(response.Gaps, &jobproto.EmploymentGap{
+			From:        gap.From.Format(time.RFC3339),
+			To:          gap.To.Format(time.RFC3339),
+			Days:        gap.Days,
+			AfterJobId:  gap.AfterJobID,
+			BeforeJobId: gap.BeforeJobID,
+		})
+	}
+	for _, overlap := range history.Overlaps {
+		response.Overlaps = append(response.Overlaps, &jobproto.EmploymentOverlap{
+			FirstJobId:  overlap.FirstJobID,
+			SecondJobId: overlap.SecondJobID,
+			From:        overlap.From.Format(time.RFC3339),
+			To:          formatTime(overlap.To),
+			Days:        overlap.Days,
+		})
+	}
+
+	return &response, nil
+}
@@ -5,25 +5,49 @@ import (
 	"fmt"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	clientproto "job-service/genproto/client_service"
 	jobproto "job-service/genproto/job_service"
 	"job-service/internal/entity"
 	"job-service/internal/infrastructure/grpc_service_clients"
+	"job-service/internal/pkg/markdown"
 	"job-service/internal/pkg/otlp"
 	"job-service/internal/usecase"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type jobRPC struct {
-	logger     *zap.Logger
-	jobUsecase usecase.Job
-	clients    grpc_service_clients.ServiceClients
+	logger                *zap.Logger
+	jobUsecase            usecase.Job
+	companyUsecase        usecase.Company
+	applicationUsecase    usecase.Application
+	recommendationUsecase usecase.Recommendation
+	savedSearchUsecase    usecase.SavedSearch
+	dictionaryUsecase     usecase.Dictionary
+	taxonomyUsecase       usecase.Taxonomy
+	webhookUsecase        usecase.Webhook
+	auditUsecase          usecase.Audit
+	sagaUsecase           usecase.Saga
+	clients               grpc_service_clients.ServiceClients
 }
 
-func NewRPC(logger *zap.Logger, jobUsecase usecase.Job, services *grpc_service_clients.ServiceClients) jobproto.JobServiceServer {
+func NewRPC(logger *zap.Logger, jobUsecase usecase.Job, companyUsecase usecase.Company, applicationUsecase usecase.Application, recommendationUsecase usecase.Recommendation, savedSearchUsecase usecase.SavedSearch, dictionaryUsecase usecase.Dictionary, taxonomyUsecase usecase.Taxonomy, webhookUsecase usecase.Webhook, auditUsecase usecase.Audit, sagaUsecase usecase.Saga, services *grpc_service_clients.ServiceClients) jobproto.JobServiceServer {
 	return &jobRPC{
-		logger:     logger,
-		jobUsecase: jobUsecase,
-		clients:    *services,
+		logger:                logger,
+		jobUsecase:            jobUsecase,
+		companyUsecase:        companyUsecase,
+		applicationUsecase:    applicationUsecase,
+		recommendationUsecase: recommendationUsecase,
+		savedSearchUsecase:    savedSearchUsecase,
+		dictionaryUsecase:     dictionaryUsecase,
+		taxonomyUsecase:       taxonomyUsecase,
+		webhookUsecase:        webhookUsecase,
+		auditUsecase:          auditUsecase,
+		sagaUsecase:           sagaUsecase,
+		clients:               *services,
 	}
 }
 
@@ -34,19 +58,18 @@ func (s jobRPC) CreateJob(ctx context.Context, in *jobproto.Job) (*jobproto.JobW
 	)
 	defer span.End()
 
-	createdJob, err := s.jobUsecase.CreateJob(ctx, &entity.Job{
-		GUID:           in.Id,
-		Name:           in.Name,
-		Salary:         float64(in.Salary),
-		Level:          in.Level,
-		LocationType:   in.LocationType,
-		EmploymentType: in.EmploymentType,
-		Address:        in.Address,
-		Company:        in.Company,
-	})
+	job, err := jobFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	createdJob, err := s.jobUsecase.CreateJob(ctx, job)
 	if err != nil {
 		return nil, err
 	}
+	if createdJob.Status == entity.JobStatusPublished {
+		s.evaluateAlerts(ctx)
+	}
 
 	return &jobproto.JobWithGUID{
 		JobId: createdJob.GUID,
@@ -60,31 +83,20 @@ func (s jobRPC) UpdateJob(ctx context.Context, in *jobproto.Job) (*jobproto.Job,
 	)
 	defer span.End()
 
-	updatedJob, err := s.jobUsecase.UpdateJob(ctx, &entity.Job{
-		GUID:           in.Id,
-		Name:           in.Name,
-		Salary:         float64(in.Salary),
-		Level:          in.Level,
-		LocationType:   in.LocationType,
-		EmploymentType: in.EmploymentType,
-		Address:        in.Address,
-	})
+	job, err := jobFromProto(in)
 	if err != nil {
 		return nil, err
 	}
 
-	return &jobproto.Job{
-		Id:             updatedJob.GUID,
-		Name:           updatedJob.Name,
-		Salary:         float32(updatedJob.Salary),
-		Level:          updatedJob.Level,
-		LocationType:   updatedJob.LocationType,
-		EmploymentType: updatedJob.EmploymentType,
-		Address:        updatedJob.Address,
-		Company:        updatedJob.Company,
-		CreatedAt:      updatedJob.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:      updatedJob.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	updatedJob, err := s.jobUsecase.UpdateJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if updatedJob.Status == entity.JobStatusPublished {
+		s.evaluateAlerts(ctx)
+	}
+
+	return jobToProto(updatedJob), nil
 }
 
 func (s jobRPC) DeleteJob(ctx context.Context, in *jobproto.JobWithGUID) (*jobproto.ResponseStatus, error) {
@@ -94,7 +106,8 @@ func (s jobRPC) DeleteJob(ctx context.Context, in *jobproto.JobWithGUID) (*jobpr
 	)
 	defer span.End()
 
-	err := s.jobUsecase.DeleteJob(ctx, in.JobId)
+	// the applications and assignments of the job are closed first, see usecase.Saga
+	err := s.sagaUsecase.DeleteJob(ctx, in.JobId)
 	if err != nil {
 		return &jobproto.ResponseStatus{Status: false}, err
 	}
@@ -116,18 +129,29 @@ func (s jobRPC) GetJob(ctx context.Context, in *jobproto.JobWithGUID) (*jobproto
 		return nil, err
 	}
 
-	return &jobproto.Job{
-		Id:             job.GUID,
-		Name:           job.Name,
-		Salary:         float32(job.Salary),
-		Level:          job.Level,
-		LocationType:   job.LocationType,
-		EmploymentType: job.EmploymentType,
-		Address:        job.Address,
-		Company:        job.Company,
-		CreatedAt:      job.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:      job.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	return jobToProto(job), nil
+}
+
+func (s jobRPC) GetJobsByIDs(ctx context.Context, in *jobproto.JobsByIDsRequest) (*jobproto.JobsByIDsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetJobsByIDs")
+	span.SetAttributes(
+		attribute.Key("ids").Int(len(in.Ids)),
+	)
+	defer span.End()
+
+	jobs, missing, err := s.jobUsecase.GetJobsByIDs(ctx, in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	response := jobproto.JobsByIDsResponse{
+		MissingIds: missing,
+	}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, jobToProto(job))
+	}
+
+	return &response, nil
 }
 
 func (s jobRPC) GetAllJobs(ctx context.Context, in *jobproto.ListRequest) (*jobproto.ListJobResponse, error) {
@@ -139,25 +163,32 @@ func (s jobRPC) GetAllJobs(ctx context.Context, in *jobproto.ListRequest) (*jobp
 
 	offset := in.Limit * (in.Page - 1)
 
-	listJobs, err := s.jobUsecase.GetAllJobs(ctx, in.Limit, offset, map[string]string{})
+	listJobs, err := s.jobUsecase.GetAllJobs(ctx, in.Limit, offset, map[string]string{
+		"salary_from":     in.SalaryFrom,
+		"salary_to":       in.SalaryTo,
+		"currency":        in.Currency,
+		"pay_period":      in.PayPeriod,
+		"status":          in.Status,
+		"level":           in.Level,
+		"location_type":   in.LocationType,
+		"employment_type": in.EmploymentType,
+		"company_id":      in.CompanyId,
+		"skills":          strings.Join(in.Skills, ","),
+		"latitude":        in.Latitude,
+		"longitude":       in.Longitude,
+		"near":            in.Near,
+		"radius_km":       in.RadiusKm,
+		"bbox":            in.Bbox,
+		"category_id":     in.CategoryId,
+		"tags":            strings.Join(in.Tags, ","),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	var response jobproto.ListJobResponse
 	for _, job := range listJobs {
-		response.Jobs = append(response.Jobs, &jobproto.Job{
-			Id:             job.GUID,
-			Name:           job.Name,
-			Salary:         float32(job.Salary),
-			Level:          job.Level,
-			LocationType:   job.LocationType,
-			EmploymentType: job.EmploymentType,
-			Address:        job.Address,
-			Company:        job.Company,
-			CreatedAt:      job.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:      job.UpdatedAt.Format(time.RFC3339),
-		})
+		response.Jobs = append(response.Jobs, jobToProto(job))
 	}
 
 	return &response, nil
@@ -179,17 +210,7 @@ func (s jobRPC) GetAllDeletedJobs(ctx context.Context, in *jobproto.ListRequest)
 
 	var response jobproto.ListJobResponse
 	for _, job := range listDeletedJobs {
-		response.Jobs = append(response.Jobs, &jobproto.Job{
-			Id:             job.GUID,
-			Name:           job.Name,
-			Salary:         float32(job.Salary),
-			Level:          job.Level,
-			EmploymentType: job.EmploymentType,
-			Address:        job.Address,
-			Company:        job.Company,
-			CreatedAt:      job.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:      job.UpdatedAt.Format(time.RFC3339),
-		})
+		response.Jobs = append(response.Jobs, jobToProto(job))
 	}
 
 	return &response, nil
@@ -219,7 +240,7 @@ func (s jobRPC) GetClientJobs(ctx context.Context, in *jobproto.ClientJobRequest
 			ClientId:  clientJob.ClientID,
 			JobId:     clientJob.JobID,
 			StartDate: clientJob.StartDate.Format(time.RFC3339),
-			EndDate:   clientJob.EndDate.Format(time.RFC3339),
+			EndDate:   formatTime(clientJob.EndDate),
 		})
 	}
 
@@ -248,7 +269,7 @@ func (s jobRPC) GetJobClients(ctx context.Context, in *jobproto.ClientJobRequest
 			ClientId:  clientJob.ClientID,
 			JobId:     clientJob.JobID,
 			StartDate: clientJob.StartDate.Format(time.RFC3339),
-			EndDate:   clientJob.EndDate.Format(time.RFC3339),
+			EndDate:   formatTime(clientJob.EndDate),
 		})
 	}
 
@@ -262,20 +283,38 @@ func (s jobRPC) AddClientJob(ctx context.Context, in *jobproto.ClientJobs) (*job
 	)
 	defer span.End()
 
-	startDate, err := time.Parse(time.RFC3339, in.StartDate)
-	if err != nil {
-		return nil, err
+	var startDate, endDate time.Time
+	if in.StartDate != "" {
+		parsed, err := time.Parse(time.RFC3339, in.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "start_date: %s", err)
+		}
+		startDate = parsed
 	}
-	endDate, err := time.Parse(time.RFC3339, in.EndDate)
-	if err != nil {
-		return nil, err
+	if in.EndDate != "" {
+		parsed, err := time.Parse(time.RFC3339, in.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "end_date: %s", err)
+		}
+		endDate = parsed
 	}
 
-	_, err = s.jobUsecase.AddClientJob(ctx, &entity.ClientJob{
+	_, err := s.jobUsecase.AddClientJob(ctx, &entity.ClientJob{
 		ClientID:  in.ClientId,
 		JobID:     in.JobId,
 		StartDate: startDate,
 		EndDate:   endDate,
+	}, func(ctx context.Context) error {
+		client, err := s.clients.ClientService().GetClient(ctx, &clientproto.ClientWithGUID{
+			Guid: in.ClientId,
+		})
+		if err != nil {
+			return err
+		}
+		if !client.Status {
+			return entity.NewErrPrecondition("client is not active")
+		}
+		return nil
 	})
 	if err != nil {
 		return &jobproto.ResponseStatus{Status: false}, err
@@ -301,3 +340,326 @@ func (s jobRPC) DeleteClientJob(ctx context.Context, in *jobproto.ClientJobs) (*
 
 	return &jobproto.ResponseStatus{Status: true}, nil
 }
+
+func (s jobRPC) ReassignClientJobs(ctx context.Context, in *jobproto.ReassignClientJobsRequest) (*jobproto.ReassignClientJobsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ReassignClientJobs")
+	span.SetAttributes(
+		attribute.Key("from_client_id").String(in.FromClientId),
+		attribute.Key("to_client_id").String(in.ToClientId),
+	)
+	defer span.End()
+
+	reassignment, err := s.jobUsecase.ReassignClientJobs(ctx, in.FromClientId, in.ToClientId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &jobproto.ReassignClientJobsResponse{
+		Moved:    uint64(len(reassignment.Moved)),
+		MovedIds: reassignment.Moved,
+		EndedIds: reassignment.Ended,
+	}, nil
+}
+
+func (s jobRPC) UndoReassignClientJobs(ctx context.Context, in *jobproto.UndoReassignClientJobsRequest) (*jobproto.ReassignClientJobsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UndoReassignClientJobs")
+	span.SetAttributes(
+		attribute.Key("from_client_id").String(in.FromClientId),
+		attribute.Key("to_client_id").String(in.ToClientId),
+	)
+	defer span.End()
+
+	moved, err := s.jobUsecase.UndoReassignClientJobs(ctx, in.FromClientId, in.ToClientId, &entity.Reassignment{
+		Moved: in.MovedIds,
+		Ended: in.EndedIds,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &jobproto.ReassignClientJobsResponse{Moved: moved}, nil
+}
+
+// EndClientAssignments is a step of the deletion saga of a client in client-service
+func (s jobRPC) EndClientAssignments(ctx context.Context, in *jobproto.EndClientAssignmentsRequest) (*jobproto.AssignmentsChanged, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "EndClientAssignments")
+	span.SetAttributes(
+		attribute.Key("saga_id").String(in.SagaId),
+		attribute.Key("client_id").String(in.ClientId),
+	)
+	defer span.End()
+
+	changed, err := s.sagaUsecase.EndClientAssignments(ctx, in.SagaId, in.ClientId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &jobproto.AssignmentsChanged{Changed: changed}, nil
+}
+
+// ReopenAssignments compensates EndClientAssignments
+func (s jobRPC) ReopenAssignments(ctx context.Context, in *jobproto.ReopenAssignmentsRequest) (*jobproto.AssignmentsChanged, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "ReopenAssignments")
+	span.SetAttributes(
+		attribute.Key("saga_id").String(in.SagaId),
+	)
+	defer span.End()
+
+	changed, overlapping, err := s.sagaUsecase.ReopenAssignments(ctx, in.SagaId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &jobproto.AssignmentsChanged{Changed: changed, Overlapping: overlapping}, nil
+}
+
+func (s jobRPC) BatchCreateJobs(ctx context.Context, in *jobproto.BatchCreateJobsRequest) (*jobproto.BatchCreateResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "BatchCreateJobs")
+	span.SetAttributes(
+		attribute.Key("count").Int(len(in.Jobs)),
+	)
+	defer span.End()
+
+	jobs := make([]*entity.Job, 0, len(in.Jobs))
+	for index, in := range in.Jobs {
+		job, err := jobFromProto(in)
+		if err != nil {
+			return nil, fmt.Errorf("job %d: %w", index, err)
+		}
+		// ids are always generated for new jobs
+		job.GUID = ""
+		jobs = append(jobs, job)
+	}
+
+	results, err := s.jobUsecase.BatchCreateJobs(ctx, jobs)
+	if err != nil {
+		return nil, err
+	}
+	s.evaluateAlerts(ctx)
+
+	var response jobproto.BatchCreateResponse
+	for _, result := range results {
+		response.Results = append(response.Results, &jobproto.BatchItemResult{
+			Index: result.Index,
+			Id:    result.GUID,
+			Error: result.Error,
+		})
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) StreamJobs(in *jobproto.StreamJobsRequest, stream jobproto.JobService_StreamJobsServer) error {
+	ctx, span := otlp.Start(stream.Context(), "user_grpc-delivery", "StreamJobs")
+	span.SetAttributes(
+		attribute.Key("scope").String(in.Scope),
+	)
+	defer span.End()
+
+	err := s.jobUsecase.StreamJobs(ctx, in.Scope, map[string]string{
+		"salary_from":     in.SalaryFrom,
+		"salary_to":       in.SalaryTo,
+		"currency":        in.Currency,
+		"pay_period":      in.PayPeriod,
+		"status":          in.Status,
+		"level":           in.Level,
+		"location_type":   in.LocationType,
+		"employment_type": in.EmploymentType,
+		"company_id":      in.CompanyId,
+		"skills":          strings.Join(in.Skills, ","),
+		"latitude":        in.Latitude,
+		"longitude":       in.Longitude,
+		"near":            in.Near,
+		"radius_km":       in.RadiusKm,
+		"bbox":            in.Bbox,
+		"category_id":     in.CategoryId,
+		"tags":            strings.Join(in.Tags, ","),
+	}, func(job *entity.Job) error {
+		return stream.Send(jobToProto(job))
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// StreamClientJobs ignores page and limit of the request, client_id and job_id are optional filters
+func (s jobRPC) StreamClientJobs(in *jobproto.ClientJobRequest, stream jobproto.JobService_StreamClientJobsServer) error {
+	ctx, span := otlp.Start(stream.Context(), "user_grpc-delivery", "StreamClientJobs")
+	span.SetAttributes(
+		attribute.Key("client_id").String(in.ClientId),
+		attribute.Key("job_id").String(in.JobId),
+	)
+	defer span.End()
+
+	err := s.jobUsecase.StreamClientJobs(ctx, map[string]string{
+		"client_id": in.ClientId,
+		"job_id":    in.JobId,
+	}, func(clientJob *entity.ClientJob) error {
+		response := &jobproto.ClientJobs{
+			ClientId:  clientJob.ClientID,
+			JobId:     clientJob.JobID,
+			StartDate: clientJob.StartDate.Format(time.RFC3339),
+			CreatedAt: clientJob.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: clientJob.UpdatedAt.Format(time.RFC3339),
+		}
+		if !clientJob.EndDate.IsZero() {
+			response.EndDate = clientJob.EndDate.Format(time.RFC3339)
+		}
+
+		return stream.Send(response)
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// jobFromProto parses the schedule timestamps, they are RFC3339 or empty, and the coordinates
+// WatchJobs streams the job changes until the client goes away
+func (s jobRPC) WatchJobs(in *jobproto.WatchJobsRequest, stream jobproto.JobService_WatchJobsServer) error {
+	ctx, span := otlp.Start(stream.Context(), "user_grpc-delivery", "WatchJobs")
+	span.SetAttributes(
+		attribute.Key("cursor").Int64(int64(in.Cursor)),
+	)
+	defer span.End()
+
+	err := s.jobUsecase.WatchJobs(ctx, in.Cursor, map[string]string{
+		"actions":    strings.Join(in.Actions, ","),
+		"company_id": in.CompanyId,
+		"job_id":     in.JobId,
+	}, func(change *entity.JobChange) error {
+		response := &jobproto.JobChange{
+			Cursor:    change.Cursor,
+			Action:    change.Action,
+			JobId:     change.JobID,
+			CompanyId: change.CompanyID,
+			ChangedAt: formatTime(change.ChangedAt),
+		}
+		if change.Job != nil {
+			response.Job = jobToProto(change.Job)
+		}
+		return stream.Send(response)
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func jobFromProto(in *jobproto.Job) (*entity.Job, error) {
+	job := &entity.Job{
+		GUID:             in.Id,
+		Name:             in.Name,
+		SalaryMin:        in.SalaryMin,
+		SalaryMax:        in.SalaryMax,
+		Currency:         in.Currency,
+		PayPeriod:        in.PayPeriod,
+		Level:            in.Level,
+		LocationType:     in.LocationType,
+		EmploymentType:   in.EmploymentType,
+		Address:          in.Address,
+		CompanyID:        in.CompanyId,
+		Company:          in.Company,
+		Description:      in.Description,
+		Responsibilities: in.Responsibilities,
+		Requirements:     in.Requirements,
+		Benefits:         in.Benefits,
+		Skills:           in.Skills,
+		CategoryIDs:      in.CategoryIds,
+		Tags:             in.Tags,
+		Status:           in.Status,
+	}
+
+	var err error
+	if in.PublishAt != "" {
+		if job.PublishAt, err = time.Parse(time.RFC3339, in.PublishAt); err != nil {
+			return nil, fmt.Errorf("publish_at: %w", err)
+		}
+	}
+	if in.CloseAt != "" {
+		if job.CloseAt, err = time.Parse(time.RFC3339, in.CloseAt); err != nil {
+			return nil, fmt.Errorf("close_at: %w", err)
+		}
+	}
+	if job.Latitude, err = parseDegrees(in.Latitude); err != nil {
+		return nil, fmt.Errorf("latitude: %w", err)
+	}
+	if job.Longitude, err = parseDegrees(in.Longitude); err != nil {
+		return nil, fmt.Errorf("longitude: %w", err)
+	}
+
+	return job, nil
+}
+
+// jobToProto renders the Markdown fields into sanitized HTML
+func jobToProto(job *entity.Job) *jobproto.Job {
+	return &jobproto.Job{
+		Id:                   job.GUID,
+		Name:                 job.Name,
+		SalaryMin:            job.SalaryMin,
+		SalaryMax:            job.SalaryMax,
+		Currency:             job.Currency,
+		PayPeriod:            job.PayPeriod,
+		Level:                job.Level,
+		LocationType:         job.LocationType,
+		EmploymentType:       job.EmploymentType,
+		Address:              job.Address,
+		CompanyId:            job.CompanyID,
+		Company:              job.Company,
+		Description:          job.Description,
+		Responsibilities:     job.Responsibilities,
+		Requirements:         job.Requirements,
+		Benefits:             job.Benefits,
+		DescriptionHtml:      markdown.ToHTML(job.Description),
+		ResponsibilitiesHtml: markdown.ToHTML(job.Responsibilities),
+		RequirementsHtml:     markdown.ToHTML(job.Requirements),
+		BenefitsHtml:         markdown.ToHTML(job.Benefits),
+		Skills:               job.Skills,
+		Latitude:             formatDegrees(job.Latitude),
+		Longitude:            formatDegrees(job.Longitude),
+		DistanceKm:           job.DistanceKm,
+		CategoryIds:          job.CategoryIDs,
+		Tags:                 job.Tags,
+		Status:               job.Status,
+		PublishAt:            formatTime(job.PublishAt),
+		CloseAt:              formatTime(job.CloseAt),
+		CreatedAt:            job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:            job.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+func parseDegrees(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	degrees, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &degrees, nil
+}
+
+func formatDegrees(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	clientproto "job-service/genproto/client_service"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+)
+
+func (s jobRPC) RecommendJobsForClient(ctx context.Context, in *jobproto.RecommendJobsRequest) (*jobproto.JobRecommendations, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "RecommendJobsForClient")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.ClientId),
+	)
+	defer span.End()
+
+	if in.ClientId == "" {
+		return nil, entity.NewErrNoRequiredParameter("client_id")
+	}
+
+	profile, err := s.clients.ClientService().GetClientProfile(ctx, &clientproto.ClientWithGUID{
+		Guid: in.ClientId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations, err := s.recommendationUsecase.RecommendJobsForClient(ctx, candidateFromProto(profile), in.Limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	response := jobproto.JobRecommendations{
+		Recommendations: make([]*jobproto.JobRecommendation, 0, len(recommendations)),
+	}
+	for _, recommendation := range recommendations {
+		response.Recommendations = append(response.Recommendations, &jobproto.JobRecommendation{
+			Job:         jobToProto(recommendation.Job),
+			Score:       recommendation.Score,
+			Explanation: recommendation.Explanation,
+			Factors:     factorsToProto(recommendation.Factors),
+		})
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) RecommendClientsForJob(ctx context.Context, in *jobproto.RecommendClientsRequest) (*jobproto.ClientRecommendations, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "RecommendClientsForJob")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.JobId),
+	)
+	defer span.End()
+
+	recommendations, err := s.recommendationUsecase.RecommendClientsForJob(ctx, in.JobId, in.Limit, func(ctx context.Context, skills []string, limit uint64) ([]*entity.CandidateProfile, error) {
+		profiles, err := s.clients.ClientService().GetClientProfiles(ctx, &clientproto.ListClientProfilesRequest{
+			Page:   1,
+			Limit:  int64(limit),
+			Skills: skills,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]*entity.CandidateProfile, 0, len(profiles.Profiles))
+		for _, profile := range profiles.Profiles {
+			candidates = append(candidates, candidateFromProto(profile))
+		}
+		return candidates, nil
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	response := jobproto.ClientRecommendations{
+		Recommendations: make([]*jobproto.ClientRecommendation, 0, len(recommendations)),
+	}
+	for _, recommendation := range recommendations {
+		response.Recommendations = append(response.Recommendations, &jobproto.ClientRecommendation{
+			ClientId:    recommendation.Profile.ClientID,
+			FirstName:   recommendation.Profile.FirstName,
+			LastName:    recommendation.Profile.LastName,
+			Score:       recommendation.Score,
+			Explanation: recommendation.Explanation,
+			Factors:     factorsToProto(recommendation.Factors),
+		})
+	}
+
+	return &response, nil
+}
+
+func candidateFromProto(profile *clientproto.ClientProfile) *entity.CandidateProfile {
+	return &entity.CandidateProfile{
+		ClientID:              profile.ClientId,
+		FirstName:             profile.FirstName,
+		LastName:              profile.LastName,
+		Skills:                profile.Skills,
+		DesiredLevel:          profile.DesiredLevel,
+		DesiredLocationType:   profile.DesiredLocationType,
+		DesiredEmploymentType: profile.DesiredEmploymentType,
+		ExpectedSalary:        profile.ExpectedSalary,
+		ExpectedCurrency:      profile.ExpectedCurrency,
+		ExpectedPayPeriod:     profile.ExpectedPayPeriod,
+	}
+}
+
+func factorsToProto(factors []*entity.RecommendationFactor) []*jobproto.RecommendationFactor {
+	response := make([]*jobproto.RecommendationFactor, 0, len(factors))
+	for _, factor := range factors {
+		response = append(response, &jobproto.RecommendationFactor{
+			Name:   factor.Name,
+			Score:  factor.Score,
+			Weight: factor.Weight,
+			Detail: factor.Detail,
+		})
+	}
+	return response
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) CreateSavedSearch(ctx context.Context, in *jobproto.SavedSearch) (*jobproto.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "CreateSavedSearch")
+	span.SetAttributes(
+		attribute.Key("client_id").String(in.ClientId),
+	)
+	defer span.End()
+
+	search, err := s.savedSearchUsecase.CreateSavedSearch(ctx, savedSearchFromProto(in))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return savedSearchToProto(search), nil
+}
+
+func (s jobRPC) UpdateSavedSearch(ctx context.Context, in *jobproto.SavedSearch) (*jobproto.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpdateSavedSearch")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	search, err := s.savedSearchUsecase.UpdateSavedSearch(ctx, savedSearchFromProto(in))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return savedSearchToProto(search), nil
+}
+
+func (s jobRPC) DeleteSavedSearch(ctx context.Context, in *jobproto.SavedSearchWithGUID) (*jobproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "DeleteSavedSearch")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	if err := s.savedSearchUsecase.DeleteSavedSearch(ctx, in.ClientId, in.Id); err != nil {
+		return &jobproto.ResponseStatus{Status: false}, err
+	}
+
+	return &jobproto.ResponseStatus{Status: true}, nil
+}
+
+func (s jobRPC) GetSavedSearches(ctx context.Context, in *jobproto.ListSavedSearchesRequest) (*jobproto.ListSavedSearchesResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetSavedSearches")
+	span.SetAttributes(
+		attribute.Key("client_id").String(in.ClientId),
+	)
+	defer span.End()
+
+	searches, err := s.savedSearchUsecase.GetSavedSearches(ctx, in.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListSavedSearchesResponse
+	for _, search := range searches {
+		response.SavedSearches = append(response.SavedSearches, savedSearchToProto(search))
+	}
+
+	return &response, nil
+}
+
+// evaluateAlerts matches newly published jobs right away, the scheduler catches up with
+// what fails here so the request itself doesn't fail
+func (s jobRPC) evaluateAlerts(ctx context.Context) {
+	if _, err := s.savedSearchUsecase.EvaluateNewJobs(ctx); err != nil {
+		s.logger.Error("evaluate saved searches", zap.Error(err))
+	}
+}
+
+func savedSearchFromProto(in *jobproto.SavedSearch) *entity.SavedSearch {
+	search := &entity.SavedSearch{
+		GUID:      in.Id,
+		ClientID:  in.ClientId,
+		Name:      in.Name,
+		Frequency: in.Frequency,
+	}
+	if in.Filter != nil {
+		search.Filter = entity.JobSearchFilter{
+			SalaryFrom:     in.Filter.SalaryFrom,
+			SalaryTo:       in.Filter.SalaryTo,
+			Currency:       in.Filter.Currency,
+			PayPeriod:      in.Filter.PayPeriod,
+			Level:          in.Filter.Level,
+			LocationType:   in.Filter.LocationType,
+			EmploymentType: in.Filter.EmploymentType,
+			CompanyID:      in.Filter.CompanyId,
+			Skills:         in.Filter.Skills,
+			Near:           in.Filter.Near,
+			Latitude:       in.Filter.Latitude,
+			Longitude:      in.Filter.Longitude,
+			RadiusKm:       in.Filter.RadiusKm,
+			Bbox:           in.Filter.Bbox,
+			CategoryID:     in.Filter.CategoryId,
+			Tags:           in.Filter.Tags,
+		}
+	}
+	return search
+}
+
+func savedSearchToProto(search *entity.SavedSearch) *jobproto.SavedSearch {
+	return &jobproto.SavedSearch{
+		Id:       search.GUID,
+		ClientId: search.ClientID,
+		Name:     search.Name,
+		Filter: &jobproto.JobSearchFilter{
+			SalaryFrom:     search.Filter.SalaryFrom,
+			SalaryTo:       search.Filter.SalaryTo,
+			Currency:       search.Filter.Currency,
+			PayPeriod:      search.Filter.PayPeriod,
+			Level:          search.Filter.Level,
+			LocationType:   search.Filter.LocationType,
+			EmploymentType: search.Filter.EmploymentType,
+			CompanyId:      search.Filter.CompanyID,
+			Skills:         search.Filter.Skills,
+			Near:           search.Filter.Near,
+			Latitude:       search.Filter.Latitude,
+			Longitude:      search.Filter.Longitude,
+			RadiusKm:       search.Filter.RadiusKm,
+			Bbox:           search.Filter.Bbox,
+			CategoryId:     search.Filter.CategoryID,
+			Tags:           search.Filter.Tags,
+		},
+		Frequency:  search.Frequency,
+		LastSentAt: formatTime(search.LastSentAt),
+		CreatedAt:  search.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  search.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) CreateCategory(ctx context.Context, in *jobproto.Category) (*jobproto.Category, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "CreateCategory")
+	span.SetAttributes(
+		attribute.Key("name").String(in.Name),
+	)
+	defer span.End()
+
+	category, err := s.taxonomyUsecase.CreateCategory(ctx, categoryFromProto(in))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return categoryToProto(category), nil
+}
+
+func (s jobRPC) UpdateCategory(ctx context.Context, in *jobproto.Category) (*jobproto.Category, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpdateCategory")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	category, err := s.taxonomyUsecase.UpdateCategory(ctx, categoryFromProto(in))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return categoryToProto(category), nil
+}
+
+func (s jobRPC) DeleteCategory(ctx context.Context, in *jobproto.CategoryWithGUID) (*jobproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "DeleteCategory")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	if err := s.taxonomyUsecase.DeleteCategory(ctx, in.Id); err != nil {
+		return &jobproto.ResponseStatus{Status: false}, err
+	}
+
+	return &jobproto.ResponseStatus{Status: true}, nil
+}
+
+func (s jobRPC) GetCategories(ctx context.Context, in *jobproto.ListCategoriesRequest) (*jobproto.ListCategoriesResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetCategories")
+	span.SetAttributes(
+		attribute.Key("with_counts").Bool(in.WithCounts),
+	)
+	defer span.End()
+
+	categories, err := s.taxonomyUsecase.GetCategories(ctx, in.WithCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListCategoriesResponse
+	for _, category := range categories {
+		response.Categories = append(response.Categories, categoryToProto(category))
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) CreateTag(ctx context.Context, in *jobproto.Tag) (*jobproto.Tag, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "CreateTag")
+	span.SetAttributes(
+		attribute.Key("name").String(in.Name),
+	)
+	defer span.End()
+
+	tag, err := s.taxonomyUsecase.CreateTag(ctx, &entity.Tag{Name: in.Name})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return tagToProto(tag), nil
+}
+
+func (s jobRPC) UpdateTag(ctx context.Context, in *jobproto.UpdateTagRequest) (*jobproto.Tag, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpdateTag")
+	span.SetAttributes(
+		attribute.Key("slug").String(in.Slug),
+	)
+	defer span.End()
+
+	tag, err := s.taxonomyUsecase.UpdateTag(ctx, in.Slug, &entity.Tag{Name: in.Name})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return tagToProto(tag), nil
+}
+
+func (s jobRPC) DeleteTag(ctx context.Context, in *jobproto.TagWithSlug) (*jobproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "DeleteTag")
+	span.SetAttributes(
+		attribute.Key("slug").String(in.Slug),
+	)
+	defer span.End()
+
+	if err := s.taxonomyUsecase.DeleteTag(ctx, in.Slug); err != nil {
+		return &jobproto.ResponseStatus{Status: false}, err
+	}
+
+	return &jobproto.ResponseStatus{Status: true}, nil
+}
+
+func (s jobRPC) GetTags(ctx context.Context, in *jobproto.ListTagsRequest) (*jobproto.ListTagsResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetTags")
+	defer span.End()
+
+	tags, err := s.taxonomyUsecase.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListTagsResponse
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, tagToProto(tag))
+	}
+
+	return &response, nil
+}
+
+func categoryFromProto(in *jobproto.Category) *entity.Category {
+	return &entity.Category{
+		GUID:     in.Id,
+		ParentID: in.ParentId,
+		Name:     in.Name,
+		Position: int(in.Position),
+	}
+}
+
+func categoryToProto(category *entity.Category) *jobproto.Category {
+	return &jobproto.Category{
+		Id:        category.GUID,
+		ParentId:  category.ParentID,
+		Name:      category.Name,
+		Position:  int32(category.Position),
+		JobCount:  category.JobCount,
+		CreatedAt: category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: category.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func tagToProto(tag *entity.Tag) *jobproto.Tag {
+	return &jobproto.Tag{
+		Slug:      tag.Slug,
+		Name:      tag.Name,
+		JobCount:  tag.JobCount,
+		CreatedAt: tag.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: tag.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/attribute"
+	jobproto "job-service/genproto/job_service"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+func (s jobRPC) CreateWebhook(ctx context.Context, in *jobproto.Webhook) (*jobproto.Webhook, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "CreateWebhook")
+	span.SetAttributes(
+		attribute.Key("url").String(in.Url),
+	)
+	defer span.End()
+
+	hook, err := s.webhookUsecase.CreateWebhook(ctx, webhookFromProto(in))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return webhookToProto(hook), nil
+}
+
+func (s jobRPC) UpdateWebhook(ctx context.Context, in *jobproto.Webhook) (*jobproto.Webhook, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "UpdateWebhook")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	hook, err := s.webhookUsecase.UpdateWebhook(ctx, webhookFromProto(in))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return webhookToProto(hook), nil
+}
+
+func (s jobRPC) DeleteWebhook(ctx context.Context, in *jobproto.WebhookWithGUID) (*jobproto.ResponseStatus, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "DeleteWebhook")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	if err := s.webhookUsecase.DeleteWebhook(ctx, in.Id); err != nil {
+		return &jobproto.ResponseStatus{Status: false}, err
+	}
+
+	return &jobproto.ResponseStatus{Status: true}, nil
+}
+
+func (s jobRPC) GetWebhook(ctx context.Context, in *jobproto.WebhookWithGUID) (*jobproto.Webhook, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetWebhook")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	hook, err := s.webhookUsecase.GetWebhook(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookToProto(hook), nil
+}
+
+func (s jobRPC) GetWebhooks(ctx context.Context, in *jobproto.ListWebhooksRequest) (*jobproto.ListWebhooksResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetWebhooks")
+	defer span.End()
+
+	hooks, err := s.webhookUsecase.GetWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListWebhooksResponse
+	for _, hook := range hooks {
+		response.Webhooks = append(response.Webhooks, webhookToProto(hook))
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) GetWebhookDeliveries(ctx context.Context, in *jobproto.ListWebhookDeliveriesRequest) (*jobproto.ListWebhookDeliveriesResponse, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetWebhookDeliveries")
+	span.SetAttributes(
+		attribute.Key("webhook_id").String(in.WebhookId),
+	)
+	defer span.End()
+
+	offset := in.Limit * (in.Page - 1)
+
+	deliveries, err := s.webhookUsecase.GetWebhookDeliveries(ctx, in.Limit, offset, map[string]string{
+		"webhook_id": in.WebhookId,
+		"status":     in.Status,
+		"event_type": in.EventType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response jobproto.ListWebhookDeliveriesResponse
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, webhookDeliveryToProto(delivery))
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) GetWebhookDelivery(ctx context.Context, in *jobproto.WebhookDeliveryWithGUID) (*jobproto.WebhookDeliveryLog, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "GetWebhookDelivery")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	delivery, attempts, err := s.webhookUsecase.GetWebhookDelivery(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := jobproto.WebhookDeliveryLog{
+		Delivery: webhookDeliveryToProto(delivery),
+	}
+	for _, attempt := range attempts {
+		response.Attempts = append(response.Attempts, &jobproto.WebhookAttempt{
+			Id:          attempt.ID,
+			StatusCode:  int32(attempt.StatusCode),
+			Error:       attempt.Error,
+			DurationMs:  attempt.Duration.Milliseconds(),
+			AttemptedAt: attempt.AttemptedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &response, nil
+}
+
+func (s jobRPC) RedeliverWebhook(ctx context.Context, in *jobproto.WebhookDeliveryWithGUID) (*jobproto.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-delivery", "RedeliverWebhook")
+	span.SetAttributes(
+		attribute.Key("guid").String(in.Id),
+	)
+	defer span.End()
+
+	delivery, err := s.webhookUsecase.RedeliverWebhook(ctx, in.Id)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return webhookDeliveryToProto(delivery), nil
+}
+
+func webhookFromProto(in *jobproto.Webhook) *entity.Webhook {
+	return &entity.Webhook{
+		GUID:       in.Id,
+		URL:        in.Url,
+		EventTypes: in.EventTypes,
+		Secret:     in.Secret,
+		Active:     in.Active,
+	}
+}
+
+func webhookToProto(hook *entity.Webhook) *jobproto.Webhook {
+	return &jobproto.Webhook{
+		Id:         hook.GUID,
+		Url:        hook.URL,
+		EventTypes: hook.EventTypes,
+		Secret:     hook.Secret,
+		Active:     hook.Active,
+		CreatedAt:  hook.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  hook.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func webhookDeliveryToProto(delivery *entity.WebhookDelivery) *jobproto.WebhookDelivery {
+	return &jobproto.WebhookDelivery{
+		Id:             delivery.GUID,
+		WebhookId:      delivery.WebhookID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        string(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		NextAttemptAt:  delivery.NextAttemptAt.Format(time.RFC3339),
+		LastStatusCode: int32(delivery.LastStatusCode),
+		LastError:      delivery.LastError,
+		DeliveredAt:    formatTime(delivery.DeliveredAt),
+		CreatedAt:      delivery.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      delivery.UpdatedAt.Format(time.RFC3339),
+	}
+}
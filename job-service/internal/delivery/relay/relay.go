@@ -0,0 +1,73 @@
+package relay
+
+import (
+	"context"
+	"job-service/internal/usecase"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	maxBackoff    = time.Minute
+	pruneInterval = time.Hour
+)
+
+// Relay publishes the events of the outbox, again right away while there are events and every
+// interval otherwise. After a failure it waits twice as long as after the one before, up to a minute.
+type Relay struct {
+	logger        *zap.Logger
+	outboxUsecase usecase.Outbox
+	interval      time.Duration
+	retention     time.Duration
+}
+
+func New(logger *zap.Logger, outboxUsecase usecase.Outbox, interval, retention time.Duration) *Relay {
+	return &Relay{
+		logger:        logger,
+		outboxUsecase: outboxUsecase,
+		interval:      interval,
+		retention:     retention,
+	}
+}
+
+// Run blocks until ctx is done
+func (r *Relay) Run(ctx context.Context) {
+	var (
+		backoff   = r.interval
+		lastPrune time.Time
+	)
+	for {
+		wait := r.interval
+		published, err := r.outboxUsecase.RelayEvents(ctx)
+		switch {
+		case err != nil:
+			r.logger.Error("relay: publish events", zap.Uint64("published", published), zap.Duration("retry_in", backoff), zap.Error(err))
+			wait = backoff
+			backoff = min(backoff*2, maxBackoff)
+		case published != 0:
+			wait = 0
+			backoff = r.interval
+		default:
+			backoff = r.interval
+		}
+
+		if time.Since(lastPrune) >= pruneInterval {
+			lastPrune = time.Now()
+			pruned, err := r.outboxUsecase.PruneEvents(ctx, r.retention)
+			if err != nil {
+				r.logger.Error("relay: prune published events", zap.Error(err))
+			} else if pruned != 0 {
+				r.logger.Info("relay: published events pruned", zap.Uint64("pruned", pruned))
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"job-service/internal/usecase"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Scheduler publishes scheduled jobs, closes expired ones, sends the due saved search alerts
+// and prunes the job change feed every interval
+type Scheduler struct {
+	logger             *zap.Logger
+	jobUsecase         usecase.Job
+	savedSearchUsecase usecase.SavedSearch
+	interval           time.Duration
+	changeRetention    time.Duration
+}
+
+func New(logger *zap.Logger, jobUsecase usecase.Job, savedSearchUsecase usecase.SavedSearch, interval, changeRetention time.Duration) *Scheduler {
+	return &Scheduler{
+		logger:             logger,
+		jobUsecase:         jobUsecase,
+		savedSearchUsecase: savedSearchUsecase,
+		interval:           interval,
+		changeRetention:    changeRetention,
+	}
+}
+
+// Run blocks until ctx is done, the first pass runs right away
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	published, closed, err := s.jobUsecase.PublishAndCloseDueJobs(ctx)
+	if err != nil {
+		s.logger.Error("scheduler: publish and close due jobs", zap.Error(err))
+	} else if published != 0 || closed != 0 {
+		s.logger.Info("scheduler: jobs updated", zap.Uint64("published", published), zap.Uint64("closed", closed))
+	}
+
+	// jobs published above and any missed after a create or update
+	matched, err := s.savedSearchUsecase.EvaluateNewJobs(ctx)
+	if err != nil {
+		s.logger.Error("scheduler: evaluate saved searches", zap.Error(err))
+	} else if matched != 0 {
+		s.logger.Info("scheduler: saved search matches queued", zap.Uint64("matched", matched))
+	}
+
+	sent, err := s.savedSearchUsecase.SendDueAlerts(ctx)
+	if err != nil {
+		s.logger.Error("scheduler: send saved search alerts", zap.Error(err))
+	}
+	if sent != 0 {
+		s.logger.Info("scheduler: saved search alerts sent", zap.Uint64("sent", sent))
+	}
+
+	pruned, err := s.jobUsecase.PruneJobChanges(ctx, s.changeRetention)
+	if err != nil {
+		s.logger.Error("scheduler: prune job changes", zap.Error(err))
+	} else if pruned != 0 {
+		s.logger.Info("scheduler: job changes pruned", zap.Uint64("pruned", pruned))
+	}
+}
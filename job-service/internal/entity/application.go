@@ -0,0 +1,61 @@
+package entity
+
+import "time"
+
+// statuses of a job application
+const (
+	ApplicationStatusApplied   = "applied"
+	ApplicationStatusScreening = "screening"
+	ApplicationStatusInterview = "interview"
+	ApplicationStatusOffer     = "offer"
+	ApplicationStatusHired     = "hired"
+	ApplicationStatusRejected  = "rejected"
+	ApplicationStatusWithdrawn = "withdrawn"
+)
+
+// applicationTransitions lists the statuses an application can move to,
+// hired, rejected and withdrawn are final
+var applicationTransitions = map[string][]string{
+	ApplicationStatusApplied:   {ApplicationStatusScreening, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusScreening: {ApplicationStatusInterview, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusInterview: {ApplicationStatusOffer, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusOffer:     {ApplicationStatusHired, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+}
+
+type JobApplication struct {
+	GUID        string
+	JobID       string
+	ClientID    string
+	Status      string
+	CoverLetter string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ApplicationStatusChange struct {
+	GUID          string
+	ApplicationID string
+	FromStatus    string
+	ToStatus      string
+	Actor         string
+	Note          string
+	CreatedAt     time.Time
+}
+
+// CanMoveApplication reports whether an application in status from may move to status to
+func CanMoveApplication(from, to string) bool {
+	for _, status := range applicationTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApplicationStatus reports whether status is one of the application statuses
+func IsApplicationStatus(status string) bool {
+	if _, ok := applicationTransitions[status]; ok {
+		return true
+	}
+	return status == ApplicationStatusHired || status == ApplicationStatusRejected || status == ApplicationStatusWithdrawn
+}
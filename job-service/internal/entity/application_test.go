@@ -0,0 +1,49 @@
+package entity
+
+import "testing"
+
+func TestCanMoveApplication(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{ApplicationStatusApplied, ApplicationStatusScreening, true},
+		{ApplicationStatusScreening, ApplicationStatusInterview, true},
+		{ApplicationStatusInterview, ApplicationStatusOffer, true},
+		{ApplicationStatusOffer, ApplicationStatusHired, true},
+		{ApplicationStatusApplied, ApplicationStatusRejected, true},
+		{ApplicationStatusOffer, ApplicationStatusWithdrawn, true},
+
+		// stages can't be skipped or gone back to
+		{ApplicationStatusApplied, ApplicationStatusInterview, false},
+		{ApplicationStatusApplied, ApplicationStatusHired, false},
+		{ApplicationStatusInterview, ApplicationStatusScreening, false},
+		{ApplicationStatusScreening, ApplicationStatusScreening, false},
+		{ApplicationStatusApplied, "unknown", false},
+		{"unknown", ApplicationStatusScreening, false},
+
+		// final statuses don't move
+		{ApplicationStatusHired, ApplicationStatusWithdrawn, false},
+		{ApplicationStatusRejected, ApplicationStatusScreening, false},
+		{ApplicationStatusWithdrawn, ApplicationStatusApplied, false},
+	}
+	for _, tt := range tests {
+		if got := CanMoveApplication(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanMoveApplication(%q, %q) = %t, want %t", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestIsApplicationStatus(t *testing.T) {
+	for _, status := range []string{
+		ApplicationStatusApplied, ApplicationStatusScreening, ApplicationStatusInterview, ApplicationStatusOffer,
+		ApplicationStatusHired, ApplicationStatusRejected, ApplicationStatusWithdrawn,
+	} {
+		if !IsApplicationStatus(status) {
+			t.Errorf("IsApplicationStatus(%q) = false, want true", status)
+		}
+	}
+	if IsApplicationStatus("archived") {
+		t.Error("IsApplicationStatus(\"archived\") = true, want false")
+	}
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	AuditCreate  = "create"
+	AuditUpdate  = "update"
+	AuditDelete  = "delete"
+	AuditRestore = "restore"
+)
+
+// AuditActions are the actions an audit record can have
+var AuditActions = []string{AuditCreate, AuditUpdate, AuditDelete, AuditRestore}
+
+// AuditRecord is a change of an audited row made by Actor, the principal of the request or
+// "system". Before is empty for a create and After for a hard delete, Diff maps the changed
+// columns to their before and after values.
+type AuditRecord struct {
+	ID         uint64
+	OccurredAt time.Time
+	Service    string
+	Actor      string
+	Entity     string
+	EntityID   string
+	Action     string
+	Before     json.RawMessage
+	After      json.RawMessage
+	Diff       json.RawMessage
+	PrevHash   string
+	Hash       string
+}
+
+// ComputeHash hashes the record together with PrevHash the way the audit_log_hash function of
+// the database does, the JSON has to be the text the database gives for the jsonb columns
+func (r *AuditRecord) ComputeHash() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		r.PrevHash,
+		strconv.FormatUint(r.ID, 10),
+		r.OccurredAt.UTC().Format("2006-01-02T15:04:05.000000Z"),
+		r.Service,
+		r.Actor,
+		r.Entity,
+		r.EntityID,
+		r.Action,
+		string(r.Before),
+		string(r.After),
+		string(r.Diff),
+	}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditVerification is the result of checking the hash chain, BrokenAt is the first record
+// whose hash or link to the record before it doesn't match
+type AuditVerification struct {
+	Valid    bool
+	Checked  uint64
+	BrokenAt uint64
+	Reason   string
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// actions of the change feeds
+const (
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// JobChange is an entry of the job change feed, Cursor orders the entries
+type JobChange struct {
+	Cursor    uint64
+	JobID     string
+	CompanyID string
+	Action    string
+	ChangedAt time.Time
+	// the job as it is now, nil for deletions and jobs deleted since
+	Job *Job
+}
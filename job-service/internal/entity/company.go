@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type Company struct {
+	GUID        string
+	Name        string
+	Slug        string
+	Logo        string
+	Website     string
+	Description string
+	Verified    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
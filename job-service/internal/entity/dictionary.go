@@ -0,0 +1,33 @@
+package entity
+
+// names of the dictionaries, the same as the job fields they hold the values of
+const (
+	DictionaryLevel          = "level"
+	DictionaryLocationType   = "location_type"
+	DictionaryEmploymentType = "employment_type"
+)
+
+// DefaultLanguage is the language every dictionary entry has a label in
+const DefaultLanguage = "en"
+
+// DictionaryEntry is an allowed value of a job field, Code is what jobs store
+type DictionaryEntry struct {
+	Code     string
+	Position int
+	// by language, e.g. "en", "ru", "uz"
+	Labels map[string]string
+}
+
+// Dictionaries are the entries of every dictionary by its name, in display order
+type Dictionaries map[string][]*DictionaryEntry
+
+// Label falls back to the default language and then to the code
+func (e *DictionaryEntry) Label(language string) string {
+	if label := e.Labels[language]; label != "" {
+		return label
+	}
+	if label := e.Labels[DefaultLanguage]; label != "" {
+		return label
+	}
+	return e.Code
+}
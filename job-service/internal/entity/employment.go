@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// EmploymentPosition is an assignment of a client to a job, a zero EndDate is a current position
+type EmploymentPosition struct {
+	JobID      string
+	JobName    string
+	CompanyID  string
+	Company    string
+	StartDate  time.Time
+	EndDate    time.Time
+	Current    bool
+	TenureDays int64
+}
+
+type EmploymentGap struct {
+	From        time.Time
+	To          time.Time
+	Days        int64
+	AfterJobID  string
+	BeforeJobID string
+}
+
+// EmploymentOverlap is a period two positions share, a zero To is an overlap still going on
+type EmploymentOverlap struct {
+	FirstJobID  string
+	SecondJobID string
+	From        time.Time
+	To          time.Time
+	Days        int64
+}
+
+type EmploymentHistory struct {
+	ClientID        string
+	Positions       []*EmploymentPosition
+	Gaps            []*EmploymentGap
+	Overlaps        []*EmploymentOverlap
+	TotalTenureDays int64
+}
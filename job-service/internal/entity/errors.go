@@ -69,3 +69,43 @@ func (e ErrNoRequiredParameter) Error() string {
 
 	return fmt.Sprintf("could not find required parameter(s): %s", str.String())
 }
+
+// error of a status change the state machine doesn't allow
+type ErrInvalidTransition struct {
+	from string
+	to   string
+}
+
+func (e *ErrInvalidTransition) Error() string {
+	return fmt.Sprintf("can't move from %s to %s", e.from, e.to)
+}
+
+func NewErrInvalidTransition(from, to string) *ErrInvalidTransition {
+	return &ErrInvalidTransition{from: from, to: to}
+}
+
+// error of a rule the current state of an object breaks, e.g. an inactive client
+type ErrPrecondition struct {
+	reason string
+}
+
+func (e *ErrPrecondition) Error() string {
+	return e.reason
+}
+
+func NewErrPrecondition(reason string) *ErrPrecondition {
+	return &ErrPrecondition{reason: reason}
+}
+
+// error of a saga waiting to retry a step, it's finished in background
+type ErrPending struct {
+	reason string
+}
+
+func (e *ErrPending) Error() string {
+	return e.reason
+}
+
+func NewErrPending(reason string) *ErrPending {
+	return &ErrPending{reason: reason}
+}
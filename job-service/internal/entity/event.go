@@ -0,0 +1,52 @@
+package entity
+
+import "time"
+
+// topics of the domain events, events of one key are delivered in order
+const (
+	TopicJobs       = "jobs"
+	TopicClientJobs = "client_jobs"
+)
+
+// types of the domain events
+const (
+	EventJobCreated       = "JobCreated"
+	EventJobUpdated       = "JobUpdated"
+	EventJobDeleted       = "JobDeleted"
+	EventJobPublished     = "JobPublished"
+	EventJobClosed        = "JobClosed"
+	EventClientHired      = "ClientHired"
+	EventClientUnassigned = "ClientUnassigned"
+	EventClientReassigned = "ClientJobsReassigned"
+)
+
+// Event is a domain event of the outbox, Data is marshalled to the JSON Payload when it's written
+type Event struct {
+	ID        uint64
+	GUID      string
+	Topic     string
+	Type      string
+	Key       string
+	Data      any
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// JobEvent is the payload of the job events
+type JobEvent struct {
+	JobID     string    `json:"job_id"`
+	CompanyID string    `json:"company_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// ClientJobEvent is the payload of the assignment events, keyed by the client
+type ClientJobEvent struct {
+	ClientID     string     `json:"client_id"`
+	JobID        string     `json:"job_id,omitempty"`
+	FromClientID string     `json:"from_client_id,omitempty"`
+	StartDate    *time.Time `json:"start_date,omitempty"`
+	EndDate      *time.Time `json:"end_date,omitempty"`
+	At           time.Time  `json:"at"`
+}
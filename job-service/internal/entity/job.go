@@ -3,19 +3,43 @@ package entity
 import "time"
 
 type Job struct {
-	GUID           string
-	Name           string
-	Salary         float64
+	GUID string
+	Name string
+	// decimal strings, empty when the salary isn't disclosed
+	SalaryMin      string
+	SalaryMax      string
+	Currency       string
+	PayPeriod      string
 	Level          string
 	LocationType   string
 	EmploymentType string
 	Address        string
+	CompanyID      string
 	Company        string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// Markdown
+	Description      string
+	Responsibilities string
+	Requirements     string
+	Benefits         string
+	Skills           []string
+	CategoryIDs      []string
+	// names, the tags are created with the first job using them
+	Tags []string
+	// nil when the address couldn't be geocoded
+	Latitude  *float64
+	Longitude *float64
+	// from the center of a radius search, in kilometers
+	DistanceKm float64
+	Status     string
+	// zero when not set
+	PublishAt time.Time
+	CloseAt   time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type ClientJob struct {
+	GUID      string
 	ClientID  string
 	JobID     string
 	StartDate time.Time
@@ -24,6 +48,13 @@ type ClientJob struct {
 	UpdatedAt time.Time
 }
 
+// Reassignment holds the client_jobs rows a reassignment moved to the other client and the ones
+// it ended because the other client held the job at the same time, so it can be undone
+type Reassignment struct {
+	Moved []string
+	Ended []string
+}
+
 type JobClientRequest struct {
 	JobID    string
 	ClientID string
@@ -34,3 +65,57 @@ type JobClientRequest struct {
 type Response struct {
 	Status bool
 }
+
+type BatchResult struct {
+	Index uint64
+	GUID  string
+	Error string
+}
+
+// scopes of a job export, they match the list endpoints
+const (
+	JobScopeActive  = "active"
+	JobScopeDeleted = "deleted"
+)
+
+// statuses of a job, only published jobs are public
+const (
+	JobStatusDraft     = "draft"
+	JobStatusScheduled = "scheduled"
+	JobStatusPublished = "published"
+	JobStatusClosed    = "closed"
+	JobStatusArchived  = "archived"
+)
+
+// jobTransitions lists the statuses a job can move to, a job may keep its status. Once published
+// a job can only be closed, a closed one archived, and archived is final.
+var jobTransitions = map[string][]string{
+	JobStatusDraft:     {JobStatusDraft, JobStatusScheduled, JobStatusPublished, JobStatusClosed, JobStatusArchived},
+	JobStatusScheduled: {JobStatusDraft, JobStatusScheduled, JobStatusPublished, JobStatusClosed, JobStatusArchived},
+	JobStatusPublished: {JobStatusPublished, JobStatusClosed},
+	JobStatusClosed:    {JobStatusClosed, JobStatusArchived},
+	JobStatusArchived:  {JobStatusArchived},
+}
+
+// CanMoveJob reports whether a job in status from may move to status to
+func CanMoveJob(from, to string) bool {
+	for _, status := range jobTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsJobStatus reports whether status is one of the job statuses
+func IsJobStatus(status string) bool {
+	_, ok := jobTransitions[status]
+	return ok
+}
+
+// pay periods of a salary
+const (
+	PayPeriodHour  = "hour"
+	PayPeriodMonth = "month"
+	PayPeriodYear  = "year"
+)
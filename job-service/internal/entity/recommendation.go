@@ -0,0 +1,63 @@
+package entity
+
+// factors of a recommendation score
+const (
+	FactorSkills         = "skills"
+	FactorLevel          = "level"
+	FactorLocationType   = "location_type"
+	FactorEmploymentType = "employment_type"
+	FactorSalary         = "salary"
+	FactorHistory        = "history"
+)
+
+// RecommendationWeights are the relative weights of the factors, they don't have to add up to 1
+type RecommendationWeights map[string]float64
+
+// CandidateProfile is what a client looks for, kept by client-service
+type CandidateProfile struct {
+	ClientID              string
+	FirstName             string
+	LastName              string
+	Skills                []string
+	DesiredLevel          string
+	DesiredLocationType   string
+	DesiredEmploymentType string
+	ExpectedSalary        string
+	ExpectedCurrency      string
+	ExpectedPayPeriod     string
+}
+
+// AppliedJob is a job a client applied to, as much of it as matching needs
+type AppliedJob struct {
+	ClientID       string
+	JobID          string
+	Status         string
+	CompanyID      string
+	Level          string
+	LocationType   string
+	EmploymentType string
+}
+
+// RecommendationFactor explains one part of a score, Score is between 0 and 1.
+// Factors without data on either side have a zero Weight and don't count.
+type RecommendationFactor struct {
+	Name   string
+	Score  float64
+	Weight float64
+	Detail string
+}
+
+// Score of recommendations is between 0 and 100
+type JobRecommendation struct {
+	Job         *Job
+	Score       float64
+	Explanation string
+	Factors     []*RecommendationFactor
+}
+
+type ClientRecommendation struct {
+	Profile     *CandidateProfile
+	Score       float64
+	Explanation string
+	Factors     []*RecommendationFactor
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+const (
+	SagaDeleteJob = "delete_job"
+
+	SagaRunning      = "running"
+	SagaCompensating = "compensating"
+	SagaCompleted    = "completed"
+	SagaCompensated  = "compensated"
+	SagaFailed       = "failed"
+)
+
+// Saga is a deletion spanning the services. Step is the next step to run, or to compensate
+// once the saga is compensating. Actor is the principal the steps run for.
+type Saga struct {
+	GUID          string
+	Kind          string
+	EntityID      string
+	Status        string
+	Step          int
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	Actor         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,226 @@
+package entity
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// earthRadiusKm is the radius the earthdistance extension of the job listing uses
+const earthRadiusKm = 6378.168
+
+// how often the alerts of a saved search are sent
+const (
+	AlertFrequencyInstant = "instant"
+	AlertFrequencyDaily   = "daily"
+	AlertFrequencyWeekly  = "weekly"
+)
+
+// AlertPeriods are the least times between two digests
+var AlertPeriods = map[string]time.Duration{
+	AlertFrequencyInstant: 0,
+	AlertFrequencyDaily:   24 * time.Hour,
+	AlertFrequencyWeekly:  7 * 24 * time.Hour,
+}
+
+// JobSearchFilter is the filter set of the job listing, empty fields match any job
+type JobSearchFilter struct {
+	// decimal strings, the salary range of a job has to overlap [SalaryFrom, SalaryTo]
+	SalaryFrom     string   `json:"salary_from,omitempty"`
+	SalaryTo       string   `json:"salary_to,omitempty"`
+	Currency       string   `json:"currency,omitempty"`
+	PayPeriod      string   `json:"pay_period,omitempty"`
+	Level          string   `json:"level,omitempty"`
+	LocationType   string   `json:"location_type,omitempty"`
+	EmploymentType string   `json:"employment_type,omitempty"`
+	CompanyID      string   `json:"company_id,omitempty"`
+	Skills         []string `json:"skills,omitempty"`
+	// the place Latitude and Longitude were geocoded from, kept as the client gave it
+	Near string `json:"near,omitempty"`
+	// decimal strings, a center always comes with a radius in kilometers
+	Latitude  string `json:"latitude,omitempty"`
+	Longitude string `json:"longitude,omitempty"`
+	RadiusKm  string `json:"radius_km,omitempty"`
+	// south,west,north,east
+	Bbox string `json:"bbox,omitempty"`
+	// jobs in the category or its subcategories
+	CategoryID string `json:"category_id,omitempty"`
+	// slugs, jobs with at least one of them
+	Tags []string `json:"tags,omitempty"`
+}
+
+type SavedSearch struct {
+	GUID      string
+	ClientID  string
+	Name      string
+	Filter    JobSearchFilter
+	Frequency string
+	// zero until the first alert
+	LastSentAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// SearchMatch is a job found by a saved search, waiting to be sent
+type SearchMatch struct {
+	SavedSearchID string
+	Job           *Job
+	CreatedAt     time.Time
+}
+
+const TemplateJobAlert = "job_alert"
+
+// Notification asks client-service to render the template with Data for the client
+type Notification struct {
+	ClientID string
+	Template string
+	Data     map[string]any
+}
+
+// Matches applies the filter the way the job listing does, categories are the tree of all
+// categories for the category filter
+func (f JobSearchFilter) Matches(job *Job, categories CategoryTree) bool {
+	for _, pair := range [][2]string{
+		{f.Currency, job.Currency},
+		{f.PayPeriod, job.PayPeriod},
+		{f.Level, job.Level},
+		{f.LocationType, job.LocationType},
+		{f.EmploymentType, job.EmploymentType},
+		{f.CompanyID, job.CompanyID},
+	} {
+		if pair[0] != "" && pair[0] != pair[1] {
+			return false
+		}
+	}
+
+	if len(f.Skills) != 0 && !overlaps(f.Skills, job.Skills) {
+		return false
+	}
+
+	if len(f.Tags) != 0 {
+		slugs := make([]string, 0, len(job.Tags))
+		for _, name := range job.Tags {
+			slugs = append(slugs, TagSlug(name))
+		}
+		if !overlaps(f.Tags, slugs) {
+			return false
+		}
+	}
+	if f.CategoryID != "" {
+		within := false
+		for _, id := range job.CategoryIDs {
+			within = within || categories.Within(id, f.CategoryID)
+		}
+		if !within {
+			return false
+		}
+	}
+
+	if !f.matchesLocation(job) {
+		return false
+	}
+
+	// an open upper bound counts as its lower one, jobs without a salary don't match salary filters
+	if f.SalaryFrom != "" {
+		top := job.SalaryMax
+		if top == "" {
+			top = job.SalaryMin
+		}
+		if !lessOrEqual(f.SalaryFrom, top) {
+			return false
+		}
+	}
+	if f.SalaryTo != "" {
+		bottom := job.SalaryMin
+		if bottom == "" {
+			bottom = job.SalaryMax
+		}
+		if !lessOrEqual(bottom, f.SalaryTo) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesLocation checks the radius and the bounding box, jobs without a location don't match either
+func (f JobSearchFilter) matchesLocation(job *Job) bool {
+	if f.Latitude == "" && f.Bbox == "" {
+		return true
+	}
+	if job.Latitude == nil || job.Longitude == nil {
+		return false
+	}
+
+	if f.Latitude != "" {
+		latitude, err1 := strconv.ParseFloat(f.Latitude, 64)
+		longitude, err2 := strconv.ParseFloat(f.Longitude, 64)
+		radius, err3 := strconv.ParseFloat(f.RadiusKm, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			return false
+		}
+		if DistanceKm(latitude, longitude, *job.Latitude, *job.Longitude) > radius {
+			return false
+		}
+	}
+
+	if f.Bbox != "" {
+		parts := strings.Split(f.Bbox, ",")
+		if len(parts) != 4 {
+			return false
+		}
+		var edges [4]float64
+		for i, part := range parts {
+			value, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return false
+			}
+			edges[i] = value
+		}
+		south, west, north, east := edges[0], edges[1], edges[2], edges[3]
+		if *job.Latitude < south || *job.Latitude > north {
+			return false
+		}
+		// a box crossing the antimeridian has its west edge east of its east edge
+		if west <= east && (*job.Longitude < west || *job.Longitude > east) ||
+			west > east && *job.Longitude < west && *job.Longitude > east {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DistanceKm is the great-circle distance between two points in decimal degrees
+func DistanceKm(latitude1, longitude1, latitude2, longitude2 float64) float64 {
+	radians := math.Pi / 180
+	dLatitude := (latitude2 - latitude1) * radians
+	dLongitude := (longitude2 - longitude1) * radians
+	a := math.Sin(dLatitude/2)*math.Sin(dLatitude/2) +
+		math.Cos(latitude1*radians)*math.Cos(latitude2*radians)*math.Sin(dLongitude/2)*math.Sin(dLongitude/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func lessOrEqual(a, b string) bool {
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return false
+	}
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return false
+	}
+	return x <= y
+}
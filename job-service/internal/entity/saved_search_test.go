@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJobSearchFilterMatches(t *testing.T) {
+	tashkent := [2]float64{41.2995, 69.2401}
+	samarkand := [2]float64{39.6542, 66.9597}
+	categories := NewCategoryTree([]*Category{
+		{GUID: "it"},
+		{GUID: "backend", ParentID: "it"},
+		{GUID: "go", ParentID: "backend"},
+		{GUID: "sales"},
+	})
+	job := func(location *[2]float64, categoryIDs []string, tags ...string) *Job {
+		job := &Job{CategoryIDs: categoryIDs, Tags: tags}
+		if location != nil {
+			job.Latitude, job.Longitude = &location[0], &location[1]
+		}
+		return job
+	}
+
+	tests := []struct {
+		name   string
+		filter JobSearchFilter
+		job    *Job
+		want   bool
+	}{
+		{name: "empty filter", job: job(nil, nil), want: true},
+		{name: "in radius", filter: JobSearchFilter{Latitude: "41.3", Longitude: "69.24", RadiusKm: "10"}, job: job(&tashkent, nil), want: true},
+		{name: "out of radius", filter: JobSearchFilter{Latitude: "41.3", Longitude: "69.24", RadiusKm: "10"}, job: job(&samarkand, nil), want: false},
+		{name: "radius without a location", filter: JobSearchFilter{Latitude: "41.3", Longitude: "69.24", RadiusKm: "10"}, job: job(nil, nil), want: false},
+		{name: "in bbox", filter: JobSearchFilter{Bbox: "40,68,42,70"}, job: job(&tashkent, nil), want: true},
+		{name: "out of bbox", filter: JobSearchFilter{Bbox: "40,68,42,70"}, job: job(&samarkand, nil), want: false},
+		{name: "bbox over the antimeridian", filter: JobSearchFilter{Bbox: "-50,170,-30,-170"}, job: job(&[2]float64{-41.28, 174.77}, nil), want: true},
+		{name: "outside a bbox over the antimeridian", filter: JobSearchFilter{Bbox: "-50,170,-30,-170"}, job: job(&[2]float64{-41.28, 0}, nil), want: false},
+		{name: "malformed bbox", filter: JobSearchFilter{Bbox: "40,68,42"}, job: job(&tashkent, nil), want: false},
+		{name: "subcategory", filter: JobSearchFilter{CategoryID: "it"}, job: job(nil, []string{"sales", "go"}), want: true},
+		{name: "other category", filter: JobSearchFilter{CategoryID: "backend"}, job: job(nil, []string{"sales"}), want: false},
+		{name: "parent category", filter: JobSearchFilter{CategoryID: "go"}, job: job(nil, []string{"backend"}), want: false},
+		{name: "tag by slug", filter: JobSearchFilter{Tags: []string{"remote-first"}}, job: job(nil, nil, "Remote First", "Visa"), want: true},
+		{name: "no tag", filter: JobSearchFilter{Tags: []string{"remote-first"}}, job: job(nil, nil, "Visa"), want: false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Matches(tt.job, categories); got != tt.want {
+			t.Errorf("%s: Matches = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCategoryTreeCycle(t *testing.T) {
+	tree := CategoryTree{"a": "b", "b": "a"}
+	if tree.Within("a", "c") {
+		t.Error("a category in a cycle is within an unrelated one")
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	// Tashkent to Samarkand is about 267 km as the crow flies
+	if got := DistanceKm(41.2995, 69.2401, 39.6542, 66.9597); math.Abs(got-267) > 5 {
+		t.Errorf("DistanceKm = %v, want about 267", got)
+	}
+	if got := DistanceKm(10, 20, 10, 20); got != 0 {
+		t.Errorf("DistanceKm of a point to itself = %v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+type Category struct {
+	GUID string
+	// empty for the top level
+	ParentID string
+	Name     string
+	Position int
+	// published jobs in the category and its subcategories
+	JobCount  uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Tag struct {
+	Slug string
+	Name string
+	// published jobs with the tag
+	JobCount  uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TagSlug is the lowercase name with dashes for spaces, so "Machine Learning" and
+// "machine-learning" are the same tag
+func TagSlug(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == '_' || r == ','
+	}), "-")
+}
+
+// CategoryTree maps the categories to their parents, the top level ones to ""
+type CategoryTree map[string]string
+
+func NewCategoryTree(categories []*Category) CategoryTree {
+	tree := make(CategoryTree, len(categories))
+	for _, category := range categories {
+		tree[category.GUID] = category.ParentID
+	}
+	return tree
+}
+
+// Within reports whether the category is the ancestor or one of its subcategories
+func (t CategoryTree) Within(categoryID, ancestorID string) bool {
+	// a broken tree may have a cycle, no path is longer than the tree
+	for depth := 0; categoryID != "" && depth <= len(t); depth++ {
+		if categoryID == ancestorID {
+			return true
+		}
+		categoryID = t[categoryID]
+	}
+	return false
+}
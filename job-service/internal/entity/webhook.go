@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// statuses of a webhook delivery, a delivery is dead once its attempts are used up
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+// WebhookEventTypes are the events a webhook can subscribe to
+var WebhookEventTypes = []string{
+	EventJobCreated,
+	EventJobUpdated,
+	EventJobDeleted,
+	EventJobPublished,
+	EventJobClosed,
+	EventClientHired,
+	EventClientUnassigned,
+	EventClientReassigned,
+}
+
+// Webhook is a partner subscription, the events of EventTypes are posted to URL signed with Secret
+type Webhook struct {
+	GUID       string
+	URL        string
+	EventTypes []string
+	Secret     string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WebhookDelivery is an event queued for a webhook, URL and Secret are those of the webhook
+type WebhookDelivery struct {
+	GUID           string
+	WebhookID      string
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	URL            string
+	Secret         string
+}
+
+// WebhookAttempt is an entry of the delivery log
+type WebhookAttempt struct {
+	ID          uint64
+	DeliveryID  string
+	StatusCode  int
+	Error       string
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
+
+// WebhookPayload is the body posted to a webhook, Data is the payload of the event
+type WebhookPayload struct {
+	EventID   string          `json:"event_id"`
+	EventType string          `json:"event_type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
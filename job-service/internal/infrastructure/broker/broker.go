@@ -0,0 +1,32 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/config"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Broker publishes the domain events of the outbox, New picks the broker by BROKER.
+// Publish returns only once the broker has the event, it may get the same event again.
+type Broker interface {
+	Publish(ctx context.Context, event *entity.Event) error
+	Close() error
+}
+
+func New(cfg *config.Config, logger *zap.Logger) (Broker, error) {
+	switch cfg.Broker.Kind {
+	case "kafka":
+		return NewKafka(strings.Split(cfg.Broker.Kafka.Brokers, ","), cfg.Broker.TopicPrefix), nil
+	case "nats":
+		return NewNATS(cfg.Broker.NATS.URL, cfg.Broker.TopicPrefix)
+	case "log":
+		return NewLog(logger), nil
+	case "memory":
+		return NewMemory(), nil
+	}
+	return nil, fmt.Errorf("unknown broker %q, should be kafka, nats, log or memory", cfg.Broker.Kind)
+}
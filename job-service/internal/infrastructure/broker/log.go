@@ -0,0 +1,34 @@
+package broker
+
+import (
+	"context"
+	"job-service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+type logBroker struct {
+	logger *zap.Logger
+}
+
+// NewLog only logs the events, for development
+func NewLog(logger *zap.Logger) Broker {
+	return &logBroker{
+		logger: logger,
+	}
+}
+
+func (l *logBroker) Publish(_ context.Context, event *entity.Event) error {
+	l.logger.Info("event",
+		zap.String("id", event.GUID),
+		zap.String("topic", event.Topic),
+		zap.String("type", event.Type),
+		zap.String("key", event.Key),
+		zap.ByteString("payload", event.Payload),
+	)
+	return nil
+}
+
+func (l *logBroker) Close() error {
+	return nil
+}
@@ -0,0 +1,37 @@
+package broker
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"sync"
+)
+
+// Memory keeps the events it was given, for tests
+type Memory struct {
+	mu        sync.Mutex
+	published []*entity.Event
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Publish(_ context.Context, event *entity.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.published = append(m.published, event)
+	return nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+// Published returns the events published so far
+func (m *Memory) Published() []*entity.Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*entity.Event(nil), m.published...)
+}
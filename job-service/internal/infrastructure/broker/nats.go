@@ -0,0 +1,51 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+
+	"github.com/nats-io/nats.go"
+)
+
+type natsBroker struct {
+	conn   *nats.Conn
+	js     nats.JetStreamContext
+	prefix string
+}
+
+// NewNATS publishes the events to JetStream under the subject prefix+topic, a stream has to
+// cover the subjects. The event id is the message id, so JetStream drops redeliveries.
+func NewNATS(url, prefix string) (Broker, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("connect to nats: %w", err)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("jetstream context: %w", err)
+	}
+
+	return &natsBroker{
+		conn:   conn,
+		js:     js,
+		prefix: prefix,
+	}, nil
+}
+
+func (n *natsBroker) Publish(ctx context.Context, event *entity.Event) error {
+	msg := nats.NewMsg(n.prefix + event.Topic)
+	msg.Header.Set("Event-Type", event.Type)
+	msg.Header.Set("Event-Key", event.Key)
+	msg.Data = event.Payload
+
+	if _, err := n.js.PublishMsg(msg, nats.MsgId(event.GUID), nats.Context(ctx)); err != nil {
+		return fmt.Errorf("publish event %s to nats: %w", event.GUID, err)
+	}
+	return nil
+}
+
+func (n *natsBroker) Close() error {
+	return n.conn.Drain()
+}
@@ -0,0 +1,125 @@
+package geocoder
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed gazetteer.csv
+var builtinGazetteer string
+
+type place struct {
+	latitude  float64
+	longitude float64
+}
+
+type gazetteer struct {
+	places map[string]place
+}
+
+// NewGazetteer looks places up in a table of names, the built in one or the file at path.
+// Lines are "name|alternate name,latitude,longitude", # starts a comment.
+func NewGazetteer(path string) (Geocoder, error) {
+	var source io.Reader = strings.NewReader(builtinGazetteer)
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open gazetteer: %w", err)
+		}
+		defer file.Close()
+		source = file
+	}
+
+	g := &gazetteer{places: make(map[string]place)}
+	scanner := bufio.NewScanner(source)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ",")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("gazetteer line %d: should be names,latitude,longitude", line)
+		}
+		latitude, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
+		if err != nil || latitude < -90 || latitude > 90 {
+			return nil, fmt.Errorf("gazetteer line %d: bad latitude %q", line, fields[1])
+		}
+		longitude, err := strconv.ParseFloat(strings.TrimSpace(fields[2]), 64)
+		if err != nil || longitude < -180 || longitude > 180 {
+			return nil, fmt.Errorf("gazetteer line %d: bad longitude %q", line, fields[2])
+		}
+
+		for _, name := range strings.Split(fields[0], "|") {
+			if name = normalizePlace(name); name != "" {
+				g.places[name] = place{latitude: latitude, longitude: longitude}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read gazetteer: %w", err)
+	}
+
+	return g, nil
+}
+
+// Geocode finds the longest place name standing as whole words in the address,
+// so "Saint Petersburg" wins over a shorter name inside it
+func (g *gazetteer) Geocode(_ context.Context, address string) (float64, float64, bool, error) {
+	address = normalizePlace(address)
+
+	var (
+		found   place
+		longest int
+	)
+	for name, place := range g.places {
+		if len(name) > longest && containsWords(address, name) {
+			found, longest = place, len(name)
+		}
+	}
+
+	return found.latitude, found.longitude, longest != 0, nil
+}
+
+// normalizePlace lowercases and unifies the apostrophes of Uzbek latin names
+func normalizePlace(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case 'ʻ', 'ʼ', '‘', '’', '`':
+			return '\''
+		}
+		return unicode.ToLower(r)
+	}, name)
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func containsWords(text, words string) bool {
+	for start := 0; start < len(text); {
+		index := strings.Index(text[start:], words)
+		if index < 0 {
+			return false
+		}
+		index += start
+		before, _ := utf8.DecodeLastRuneInString(text[:index])
+		after, _ := utf8.DecodeRuneInString(text[index+len(words):])
+		if !inWord(before) && !inWord(after) {
+			return true
+		}
+		start = index + 1
+	}
+	return false
+}
+
+// inWord reports whether r is part of a word, utf8.RuneError stands for the edges of the text
+func inWord(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'')
+}
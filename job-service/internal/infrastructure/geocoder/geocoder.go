@@ -0,0 +1,29 @@
+package geocoder
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/pkg/config"
+)
+
+// Geocoder finds the coordinates of a free text address, ok is false when it doesn't know the place
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (latitude, longitude float64, ok bool, err error)
+}
+
+// New picks the geocoder by GEOCODER, the default gazetteer works without network
+func New(cfg *config.Config) (Geocoder, error) {
+	switch cfg.Geocoder.Kind {
+	case "gazetteer":
+		return NewGazetteer(cfg.Geocoder.Gazetteer)
+	case "none":
+		return none{}, nil
+	}
+	return nil, fmt.Errorf("unknown geocoder %q, should be gazetteer or none", cfg.Geocoder.Kind)
+}
+
+type none struct{}
+
+func (none) Geocode(context.Context, string) (float64, float64, bool, error) {
+	return 0, 0, false, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	clientproto "job-service/genproto/client_service"
 	"job-service/internal/pkg/config"
+	"job-service/internal/pkg/principal"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -24,7 +25,7 @@ func New(config *config.Config) (ServiceClients, error) {
 	clientServiceConnection, err := grpc.Dial(
 		fmt.Sprintf("%s%s", config.ClientService.Host, config.ClientService.Port),
 		grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), principal.UnaryClientInterceptor()),
 		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	)
 	if err != nil {
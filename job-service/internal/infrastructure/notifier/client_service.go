@@ -0,0 +1,41 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	clientproto "job-service/genproto/client_service"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/grpc_service_clients"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type clientServiceNotifier struct {
+	clients grpc_service_clients.ServiceClients
+}
+
+// NewClientService queues the notifications in client-service, which renders them in the locale
+// and sends them on the channels the client chose. Deleted clients are skipped.
+func NewClientService(clients grpc_service_clients.ServiceClients) Notifier {
+	return &clientServiceNotifier{
+		clients: clients,
+	}
+}
+
+func (c *clientServiceNotifier) Send(ctx context.Context, notification *entity.Notification) error {
+	data, err := json.Marshal(notification.Data)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clients.ClientService().SendNotification(ctx, &clientproto.NotificationRequest{
+		ClientId: notification.ClientID,
+		Template: notification.Template,
+		Data:     string(data),
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,33 @@
+package notifier
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"sync"
+)
+
+// Memory keeps the notifications it was given, for tests
+type Memory struct {
+	mu   sync.Mutex
+	sent []*entity.Notification
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Send(_ context.Context, notification *entity.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = append(m.sent, notification)
+	return nil
+}
+
+// Sent returns the notifications sent so far
+func (m *Memory) Sent() []*entity.Notification {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*entity.Notification(nil), m.sent...)
+}
@@ -0,0 +1,52 @@
+package notifier
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/config"
+	"sync"
+	"testing"
+)
+
+func TestMemory(t *testing.T) {
+	memory := NewMemory()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := memory.Send(context.Background(), &entity.Notification{ClientID: "c1", Template: entity.TemplateJobAlert}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	sent := memory.Sent()
+	if len(sent) != 50 {
+		t.Fatalf("sent = %d, want 50", len(sent))
+	}
+
+	// Sent is a copy, what the caller does with it doesn't change the notifier
+	sent[0] = nil
+	if memory.Sent()[0] == nil {
+		t.Error("Sent shares its slice with the notifier")
+	}
+}
+
+func TestNew(t *testing.T) {
+	var cfg config.Config
+
+	cfg.Notifier.Kind = "memory"
+	if notifier, err := New(&cfg, nil); err != nil {
+		t.Fatal(err)
+	} else if _, ok := notifier.(*Memory); !ok {
+		t.Errorf("New = %T, want *Memory", notifier)
+	}
+
+	cfg.Notifier.Kind = "pigeon"
+	if _, err := New(&cfg, nil); err == nil {
+		t.Error("an unknown notifier is accepted")
+	}
+}
@@ -0,0 +1,24 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/grpc_service_clients"
+	"job-service/internal/pkg/config"
+)
+
+// Notifier hands a notification of a client over for sending, New picks the notifier by NOTIFIER
+type Notifier interface {
+	Send(ctx context.Context, notification *entity.Notification) error
+}
+
+func New(cfg *config.Config, clients grpc_service_clients.ServiceClients) (Notifier, error) {
+	switch cfg.Notifier.Kind {
+	case "client-service":
+		return NewClientService(clients), nil
+	case "memory":
+		return NewMemory(), nil
+	}
+	return nil, fmt.Errorf("unknown notifier %q, should be client-service or memory", cfg.Notifier.Kind)
+}
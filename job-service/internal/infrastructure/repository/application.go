@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+)
+
+type Applications interface {
+	CreateApplication(ctx context.Context, application *entity.JobApplication) (*entity.JobApplication, error)
+	MoveApplication(ctx context.Context, change *entity.ApplicationStatusChange) (*entity.JobApplication, error)
+	GetApplication(ctx context.Context, guid string) (*entity.JobApplication, error)
+	GetApplications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.JobApplication, error)
+	GetApplicationHistory(ctx context.Context, applicationID string) ([]*entity.ApplicationStatusChange, error)
+	GetAppliedJobs(ctx context.Context, clientIDs []string) ([]*entity.AppliedJob, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+)
+
+type Audit interface {
+	GetAuditRecords(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditRecord, error)
+	// GetAuditChain returns up to limit records after the id in the order of the chain
+	GetAuditChain(ctx context.Context, afterID, limit uint64) ([]*entity.AuditRecord, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+)
+
+type Companies interface {
+	CreateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error)
+	UpdateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error)
+	DeleteCompany(ctx context.Context, guid string) error
+	GetCompany(ctx context.Context, params map[string]string) (*entity.Company, error)
+	GetAllCompanies(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Company, error)
+	EnsureCompany(ctx context.Context, company *entity.Company) (*entity.Company, error)
+}
@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+)
+
+type Dictionaries interface {
+	GetDictionaries(ctx context.Context) (entity.Dictionaries, error)
+}
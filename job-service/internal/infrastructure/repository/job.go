@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"job-service/internal/entity"
+	"time"
 )
 
 type Jobs interface {
@@ -10,10 +11,22 @@ type Jobs interface {
 	UpdateJob(ctx context.Context, kyc *entity.Job) (*entity.Job, error)
 	DeleteJob(ctx context.Context, guid string) error
 	GetJob(ctx context.Context, params map[string]string) (*entity.Job, error)
+	GetJobsByIDs(ctx context.Context, ids []string) ([]*entity.Job, error)
 	GetAllJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Job, error)
 	GetAllDeletedJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Job, error)
 	GetClientJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.ClientJob, error)
 	GetJobClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.ClientJob, error)
+	GetEmploymentPositions(ctx context.Context, clientID string) ([]*entity.EmploymentPosition, error)
 	AddClientJob(ctx context.Context, kyc *entity.ClientJob) (*entity.Response, error)
 	DeleteClientJob(ctx context.Context, clientJob *entity.ClientJob) error
+	ReassignClientJobs(ctx context.Context, fromClientID, toClientID string) (*entity.Reassignment, error)
+	UndoReassignClientJobs(ctx context.Context, fromClientID, toClientID string, reassignment *entity.Reassignment) (uint64, error)
+	BatchCreateJobs(ctx context.Context, jobs []*entity.Job) ([]*entity.BatchResult, error)
+	StreamJobs(ctx context.Context, scope string, filter map[string]string, fn func(job *entity.Job) error) error
+	StreamClientJobs(ctx context.Context, filter map[string]string, fn func(clientJob *entity.ClientJob) error) error
+	GetJobChanges(ctx context.Context, after, limit uint64) ([]*entity.JobChange, error)
+	GetJobChangesRange(ctx context.Context) (first, last uint64, err error)
+	PruneJobChanges(ctx context.Context, before time.Time) (uint64, error)
+	PublishDueJobs(ctx context.Context, now time.Time) (uint64, error)
+	CloseDueJobs(ctx context.Context, now time.Time) (uint64, error)
 }
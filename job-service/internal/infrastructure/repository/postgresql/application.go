@@ -0,0 +1,356 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+)
+
+const (
+	applicationTableName        = "job_applications"
+	applicationHistoryTableName = "job_application_history"
+	applicationsSpanRepoPrefix  = "applicationsRepo"
+)
+
+type applicationRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewApplicationsRepo(db *postgres.PostgresDB) repository.Applications {
+	return &applicationRepo{
+		tableName: applicationTableName,
+		db:        db,
+	}
+}
+
+func (p *applicationRepo) applicationsSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(
+			"id",
+			"job_id",
+			"client_id",
+			"status",
+			"cover_letter",
+			"created_at",
+			"updated_at",
+		).From(p.tableName)
+}
+
+func (p applicationRepo) CreateApplication(ctx context.Context, application *entity.JobApplication) (*entity.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, applicationsSpanRepoPrefix+"_grpc-repository", "CreateApplication")
+	defer span.End()
+
+	data := map[string]any{
+		"id":           application.GUID,
+		"job_id":       application.JobID,
+		"client_id":    application.ClientID,
+		"status":       application.Status,
+		"cover_letter": application.CoverLetter,
+		"created_at":   application.CreatedAt,
+		"updated_at":   application.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	// an open application of the client for the job makes it a conflict
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return application, nil
+}
+
+// MoveApplication changes the status under a row lock, so concurrent moves can't skip a stage.
+// On hired the client_jobs row of the assignment and its ClientHired event are created in the
+// same transaction.
+func (p applicationRepo) MoveApplication(ctx context.Context, change *entity.ApplicationStatusChange) (*entity.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, applicationsSpanRepoPrefix+"_grpc-repository", "MoveApplication")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.applicationsSelectQueryPrefix().
+		Where(p.db.Sq.Equal("id", change.ApplicationID)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
+	}
+
+	var application entity.JobApplication
+	if err = tx.QueryRow(ctx, query, args...).Scan(
+		&application.GUID,
+		&application.JobID,
+		&application.ClientID,
+		&application.Status,
+		&application.CoverLetter,
+		&application.CreatedAt,
+		&application.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if !entity.CanMoveApplication(application.Status, change.ToStatus) {
+		return nil, entity.NewErrInvalidTransition(application.Status, change.ToStatus)
+	}
+	change.FromStatus = application.Status
+
+	query, args, err = p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"status":     change.ToStatus,
+			"updated_at": change.CreatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", change.ApplicationID)).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update status")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	query, args, err = p.db.Sq.Builder.
+		Insert(applicationHistoryTableName).
+		SetMap(map[string]any{
+			"id":             change.GUID,
+			"application_id": change.ApplicationID,
+			"from_status":    change.FromStatus,
+			"to_status":      change.ToStatus,
+			"actor":          change.Actor,
+			"note":           change.Note,
+			"created_at":     change.CreatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, applicationHistoryTableName+" create")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	if change.ToStatus == entity.ApplicationStatusHired {
+		clientJob := &entity.ClientJob{
+			ClientID:  application.ClientID,
+			JobID:     application.JobID,
+			StartDate: change.CreatedAt,
+			CreatedAt: change.CreatedAt,
+			UpdatedAt: change.CreatedAt,
+		}
+		query, args, err = p.db.Sq.Builder.
+			Insert(clientJobTableName).
+			SetMap(map[string]any{
+				"client_id":  clientJob.ClientID,
+				"job_id":     clientJob.JobID,
+				"start_date": clientJob.StartDate,
+				"created_at": clientJob.CreatedAt,
+				"updated_at": clientJob.UpdatedAt,
+			}).
+			ToSql()
+		if err != nil {
+			return nil, p.db.ErrSQLBuild(err, clientJobTableName+" create")
+		}
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return nil, p.db.Error(err)
+		}
+		// the same ClientHired event, and partner webhook, as a hire through AddClientJob
+		if err = writeEvents(ctx, p.db, tx, clientJobEvent(entity.EventClientHired, clientJob)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	application.Status = change.ToStatus
+	application.UpdatedAt = change.CreatedAt
+
+	return &application, nil
+}
+
+func (p applicationRepo) GetApplication(ctx context.Context, guid string) (*entity.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, applicationsSpanRepoPrefix+"_grpc-repository", "GetApplication")
+	defer span.End()
+
+	query, args, err := p.applicationsSelectQueryPrefix().
+		Where(p.db.Sq.Equal("id", guid)).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
+	}
+
+	var application entity.JobApplication
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&application.GUID,
+		&application.JobID,
+		&application.ClientID,
+		&application.Status,
+		&application.CoverLetter,
+		&application.CreatedAt,
+		&application.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &application, nil
+}
+
+func (p applicationRepo) GetApplications(ctx context.Context, limit uint64, offset uint64, filter map[string]string) ([]*entity.JobApplication, error) {
+	ctx, span := otlp.Start(ctx, applicationsSpanRepoPrefix+"_grpc-repository", "ListApplications")
+	defer span.End()
+
+	queryBuilder := p.applicationsSelectQueryPrefix()
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		if (key == "job_id" || key == "client_id" || key == "status") && value != "" {
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+	queryBuilder = queryBuilder.OrderBy("created_at DESC", "id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	applications := make([]*entity.JobApplication, 0)
+	for rows.Next() {
+		var application entity.JobApplication
+		if err = rows.Scan(
+			&application.GUID,
+			&application.JobID,
+			&application.ClientID,
+			&application.Status,
+			&application.CoverLetter,
+			&application.CreatedAt,
+			&application.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		applications = append(applications, &application)
+	}
+
+	return applications, p.db.Error(rows.Err())
+}
+
+func (p applicationRepo) GetApplicationHistory(ctx context.Context, applicationID string) ([]*entity.ApplicationStatusChange, error) {
+	ctx, span := otlp.Start(ctx, applicationsSpanRepoPrefix+"_grpc-repository", "GetApplicationHistory")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"application_id",
+			"from_status",
+			"to_status",
+			"actor",
+			"note",
+			"created_at",
+		).
+		From(applicationHistoryTableName).
+		Where(p.db.Sq.Equal("application_id", applicationID)).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", applicationHistoryTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	changes := make([]*entity.ApplicationStatusChange, 0)
+	for rows.Next() {
+		var change entity.ApplicationStatusChange
+		if err = rows.Scan(
+			&change.GUID,
+			&change.ApplicationID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.Actor,
+			&change.Note,
+			&change.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, p.db.Error(rows.Err())
+}
+
+// GetAppliedJobs returns the jobs the clients applied to, newest application first
+func (p applicationRepo) GetAppliedJobs(ctx context.Context, clientIDs []string) ([]*entity.AppliedJob, error) {
+	ctx, span := otlp.Start(ctx, applicationsSpanRepoPrefix+"_grpc-repository", "GetAppliedJobs")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"a.client_id",
+			"a.job_id",
+			"a.status",
+			"COALESCE(j.company_id::TEXT, '')",
+			"j.level",
+			"j.location_type",
+			"j.employment_type",
+		).
+		From(p.tableName+" a").
+		Join(jobTableName+" j ON j.id = a.job_id").
+		Where("a.client_id = ANY(?)", clientIDs).
+		OrderBy("a.created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "applied jobs"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	applied := make([]*entity.AppliedJob, 0)
+	for rows.Next() {
+		var job entity.AppliedJob
+		if err = rows.Scan(
+			&job.ClientID,
+			&job.JobID,
+			&job.Status,
+			&job.CompanyID,
+			&job.Level,
+			&job.LocationType,
+			&job.EmploymentType,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		applied = append(applied, &job)
+	}
+
+	return applied, p.db.Error(rows.Err())
+}
@@ -0,0 +1,140 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	auditTableName      = "audit_log"
+	auditSpanRepoPrefix = "auditRepo"
+)
+
+// auditColumns give the jsonb columns as text, the hashes are computed over that text
+var auditColumns = []string{
+	"id",
+	"occurred_at",
+	"service",
+	"actor",
+	"entity",
+	"entity_id",
+	"action",
+	"COALESCE(before::TEXT, '')",
+	"COALESCE(after::TEXT, '')",
+	"diff::TEXT",
+	"prev_hash",
+	"hash",
+}
+
+type auditRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewAuditRepo(db *postgres.PostgresDB) repository.Audit {
+	return &auditRepo{
+		db: db,
+	}
+}
+
+func (p auditRepo) GetAuditRecords(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditRecord, error) {
+	ctx, span := otlp.Start(ctx, auditSpanRepoPrefix+"_grpc-repository", "GetAuditRecords")
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(auditColumns...).From(auditTableName)
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		if value == "" {
+			continue
+		}
+		switch key {
+		case "entity", "entity_id", "actor", "action", "service":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		case "from":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Expr("occurred_at >= ?::TIMESTAMPTZ", value))
+		case "to":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Expr("occurred_at < ?::TIMESTAMPTZ", value))
+		}
+	}
+	queryBuilder = queryBuilder.OrderBy("id DESC")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", auditTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	records, err := scanAuditRecords(rows)
+	return records, p.db.Error(err)
+}
+
+func (p auditRepo) GetAuditChain(ctx context.Context, afterID, limit uint64) ([]*entity.AuditRecord, error) {
+	ctx, span := otlp.Start(ctx, auditSpanRepoPrefix+"_grpc-repository", "GetAuditChain")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(auditColumns...).
+		From(auditTableName).
+		Where(p.db.Sq.Expr("id > ?", afterID)).
+		OrderBy("id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", auditTableName, "chain"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	records, err := scanAuditRecords(rows)
+	return records, p.db.Error(err)
+}
+
+func scanAuditRecords(rows pgx.Rows) ([]*entity.AuditRecord, error) {
+	records := make([]*entity.AuditRecord, 0)
+	for rows.Next() {
+		var (
+			record              entity.AuditRecord
+			before, after, diff string
+		)
+		err := rows.Scan(
+			&record.ID,
+			&record.OccurredAt,
+			&record.Service,
+			&record.Actor,
+			&record.Entity,
+			&record.EntityID,
+			&record.Action,
+			&before,
+			&after,
+			&diff,
+			&record.PrevHash,
+			&record.Hash,
+		)
+		if err != nil {
+			return nil, err
+		}
+		record.Before, record.After, record.Diff = []byte(before), []byte(after), []byte(diff)
+
+		records = append(records, &record)
+	}
+
+	return records, rows.Err()
+}
@@ -0,0 +1,56 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"job-service/internal/entity"
+)
+
+// TestAuditLogHash checks that the verification of the chain hashes the way the trigger does
+func TestAuditLogHash(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+
+	record := entity.AuditRecord{
+		ID:         42,
+		OccurredAt: time.Date(2024, 5, 1, 15, 20, 30, 123456000, time.FixedZone("UZT", 5*60*60)),
+		Service:    "job-service",
+		Actor:      "client:1",
+		Entity:     "job",
+		EntityID:   "j1",
+		Action:     entity.AuditUpdate,
+		PrevHash:   "abc",
+	}
+
+	for _, before := range []*string{ptr(`{"name":"Old","tags":["a"]}`), nil} {
+		var (
+			hash                    string
+			beforeText, after, diff *string
+		)
+		err := db.QueryRow(ctx, `
+			SELECT audit_log_hash($1, $2, $3, $4, $5, $6, $7, $8, $9::JSONB, $10::JSONB, $11::JSONB),
+			       $9::JSONB::TEXT, $10::JSONB::TEXT, $11::JSONB::TEXT`,
+			record.PrevHash, int64(record.ID), record.OccurredAt, record.Service, record.Actor,
+			record.Entity, record.EntityID, record.Action,
+			before, `{"name":"New","tags":["a","b"]}`, `{"name":{"from":"Old","to":"New"}}`,
+		).Scan(&hash, &beforeText, &after, &diff)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the records are read back as the text of the jsonb columns
+		record.Before, record.After, record.Diff = nil, []byte(*after), []byte(*diff)
+		if beforeText != nil {
+			record.Before = []byte(*beforeText)
+		}
+		if got := record.ComputeHash(); got != hash {
+			t.Errorf("before %v: ComputeHash = %s, audit_log_hash = %s", before != nil, got, hash)
+		}
+	}
+}
+
+func ptr(value string) *string {
+	return &value
+}
@@ -0,0 +1,101 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+const jobChangeTableName = "job_changes"
+
+// GetJobChanges returns up to limit changes after the cursor in the order they were committed
+func (p jobRepo) GetJobChanges(ctx context.Context, after, limit uint64) ([]*entity.JobChange, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "GetJobChanges")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"job_id",
+			"COALESCE(company_id::TEXT, '')",
+			"action",
+			"changed_at",
+		).
+		From(jobChangeTableName).
+		Where(p.db.Sq.Expr("id > ?", after)).
+		OrderBy("id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", jobChangeTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	changes := make([]*entity.JobChange, 0, limit)
+	for rows.Next() {
+		var change entity.JobChange
+		if err = rows.Scan(
+			&change.Cursor,
+			&change.JobID,
+			&change.CompanyID,
+			&change.Action,
+			&change.ChangedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		changes = append(changes, &change)
+	}
+
+	return changes, p.db.Error(rows.Err())
+}
+
+// GetJobChangesRange returns the oldest cursor the feed can resume from and the cursor of its last change
+func (p jobRepo) GetJobChangesRange(ctx context.Context) (uint64, uint64, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "GetJobChangesRange")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("COALESCE(MIN(id) - 1, 0)", "COALESCE(MAX(id), 0)").
+		From(jobChangeTableName).
+		ToSql()
+	if err != nil {
+		return 0, 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", jobChangeTableName, "range"))
+	}
+
+	var first, last uint64
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&first, &last); err != nil {
+		return 0, 0, p.db.Error(err)
+	}
+
+	return first, last, nil
+}
+
+// PruneJobChanges deletes the changes made before the time, the last change is kept so the
+// cursors of watchers stay valid
+func (p jobRepo) PruneJobChanges(ctx context.Context, before time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "PruneJobChanges")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(jobChangeTableName).
+		Where(p.db.Sq.Expr("changed_at < ?", before)).
+		Where("id < (SELECT MAX(id) FROM " + jobChangeTableName + ")").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, jobChangeTableName+" prune")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(commandTag.RowsAffected()), nil
+}
@@ -0,0 +1,261 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+	"time"
+)
+
+const (
+	companyTableName        = "companies"
+	companiesSpanRepoPrefix = "companiesRepo"
+)
+
+type companyRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewCompaniesRepo(db *postgres.PostgresDB) repository.Companies {
+	return &companyRepo{
+		tableName: companyTableName,
+		db:        db,
+	}
+}
+
+func (p *companyRepo) companiesSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(
+			"id",
+			"name",
+			"slug",
+			"logo",
+			"website",
+			"description",
+			"verified",
+			"created_at",
+			"updated_at",
+		).From(p.tableName)
+}
+
+func (p companyRepo) CreateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error) {
+	ctx, span := otlp.Start(ctx, companiesSpanRepoPrefix+"_grpc-repository", "CreateCompany")
+	defer span.End()
+
+	data := map[string]any{
+		"id":          company.GUID,
+		"name":        company.Name,
+		"slug":        company.Slug,
+		"logo":        company.Logo,
+		"website":     company.Website,
+		"description": company.Description,
+		"verified":    company.Verified,
+		"created_at":  company.CreatedAt,
+		"updated_at":  company.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	_, err = p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return company, nil
+}
+
+func (p companyRepo) UpdateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error) {
+	ctx, span := otlp.Start(ctx, companiesSpanRepoPrefix+"_grpc-repository", "UpdateCompany")
+	defer span.End()
+
+	clauses := map[string]any{
+		"name":        company.Name,
+		"slug":        company.Slug,
+		"logo":        company.Logo,
+		"website":     company.Website,
+		"description": company.Description,
+		"verified":    company.Verified,
+		"updated_at":  company.UpdatedAt,
+	}
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", company.GUID)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
+	}
+
+	if err = p.db.QueryRow(ctx, sqlStr, args...).Scan(&company.CreatedAt); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return company, nil
+}
+
+func (p companyRepo) DeleteCompany(ctx context.Context, guid string) error {
+	ctx, span := otlp.Start(ctx, companiesSpanRepoPrefix+"_grpc-repository", "DeleteCompany")
+	defer span.End()
+
+	clauses := map[string]any{
+		"deleted_at": time.Now().Format(time.RFC3339),
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", guid)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (p companyRepo) GetCompany(ctx context.Context, params map[string]string) (*entity.Company, error) {
+	ctx, span := otlp.Start(ctx, companiesSpanRepoPrefix+"_grpc-repository", "GetCompany")
+	defer span.End()
+
+	var company entity.Company
+
+	queryBuilder := p.companiesSelectQueryPrefix()
+
+	for key, value := range params {
+		if key == "id" || key == "slug" {
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+	queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
+	}
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&company.GUID,
+		&company.Name,
+		&company.Slug,
+		&company.Logo,
+		&company.Website,
+		&company.Description,
+		&company.Verified,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &company, nil
+}
+
+func (p companyRepo) GetAllCompanies(ctx context.Context, limit uint64, offset uint64, filter map[string]string) ([]*entity.Company, error) {
+	ctx, span := otlp.Start(ctx, companiesSpanRepoPrefix+"_grpc-repository", "ListCompanies")
+	defer span.End()
+
+	queryBuilder := p.companiesSelectQueryPrefix()
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	if search := filter["search"]; search != "" {
+		queryBuilder = queryBuilder.Where(p.db.Sq.Or(
+			p.db.Sq.ILike("name", "%"+search+"%"),
+			p.db.Sq.ILike("slug", "%"+search+"%"),
+		))
+	}
+
+	queryBuilder = queryBuilder.Where("deleted_at IS NULL").OrderBy("name", "id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	companies := make([]*entity.Company, 0)
+	for rows.Next() {
+		var company entity.Company
+		if err = rows.Scan(
+			&company.GUID,
+			&company.Name,
+			&company.Slug,
+			&company.Logo,
+			&company.Website,
+			&company.Description,
+			&company.Verified,
+			&company.CreatedAt,
+			&company.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		companies = append(companies, &company)
+	}
+
+	return companies, p.db.Error(rows.Err())
+}
+
+// EnsureCompany returns the company with the slug of company, creating it from company when there is none.
+// A soft-deleted company keeps its slug, jobs can't be attached to it.
+func (p companyRepo) EnsureCompany(ctx context.Context, company *entity.Company) (*entity.Company, error) {
+	ctx, span := otlp.Start(ctx, companiesSpanRepoPrefix+"_grpc-repository", "EnsureCompany")
+	defer span.End()
+
+	data := map[string]any{
+		"id":         company.GUID,
+		"name":       company.Name,
+		"slug":       company.Slug,
+		"created_at": company.CreatedAt,
+		"updated_at": company.UpdatedAt,
+	}
+	// the no-op update makes RETURNING see the existing row
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).
+		Suffix("ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug RETURNING id, name, created_at, updated_at, deleted_at IS NOT NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "ensure"))
+	}
+
+	var deleted bool
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&company.GUID,
+		&company.Name,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+		&deleted,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if deleted {
+		return nil, fmt.Errorf("company %q is deleted", company.Slug)
+	}
+
+	return company, nil
+}
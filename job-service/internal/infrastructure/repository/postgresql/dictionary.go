@@ -0,0 +1,76 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+)
+
+const dictionariesSpanRepoPrefix = "dictionariesRepo"
+
+// dictionaryTables holds the table of every dictionary
+var dictionaryTables = map[string]string{
+	entity.DictionaryLevel:          "job_levels",
+	entity.DictionaryLocationType:   "job_location_types",
+	entity.DictionaryEmploymentType: "job_employment_types",
+}
+
+type dictionaryRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewDictionariesRepo(db *postgres.PostgresDB) repository.Dictionaries {
+	return &dictionaryRepo{
+		db: db,
+	}
+}
+
+func (p *dictionaryRepo) GetDictionaries(ctx context.Context) (entity.Dictionaries, error) {
+	ctx, span := otlp.Start(ctx, dictionariesSpanRepoPrefix+"_grpc-repository", "GetDictionaries")
+	defer span.End()
+
+	dictionaries := make(entity.Dictionaries, len(dictionaryTables))
+	for name, table := range dictionaryTables {
+		query, args, err := p.db.Sq.Builder.
+			Select("code", "position", "labels").
+			From(table).
+			OrderBy("position", "code").
+			ToSql()
+		if err != nil {
+			return nil, p.db.ErrSQLBuild(err, table+" list")
+		}
+
+		rows, err := p.db.Query(ctx, query, args...)
+		if err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		entries := make([]*entity.DictionaryEntry, 0)
+		for rows.Next() {
+			var (
+				entry  entity.DictionaryEntry
+				labels []byte
+			)
+			if err = rows.Scan(&entry.Code, &entry.Position, &labels); err != nil {
+				rows.Close()
+				return nil, p.db.Error(err)
+			}
+			if err = json.Unmarshal(labels, &entry.Labels); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			entries = append(entries, &entry)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		dictionaries[name] = entries
+	}
+
+	return dictionaries, nil
+}
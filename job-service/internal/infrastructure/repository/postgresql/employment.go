@@ -0,0 +1,67 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+)
+
+// GetEmploymentPositions returns the active assignments of a client with their jobs,
+// deleted jobs are kept so the history doesn't lose past positions
+func (p jobRepo) GetEmploymentPositions(ctx context.Context, clientID string) ([]*entity.EmploymentPosition, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "GetEmploymentPositions")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"cj.job_id",
+			"j.name",
+			"COALESCE(j.company_id::TEXT, '')",
+			"COALESCE(c.name, '')",
+			"cj.start_date",
+			"cj.end_date",
+		).
+		From(clientJobTableName+" cj").
+		Join(p.tableName+" j ON j.id = cj.job_id").
+		LeftJoin("companies c ON c.id = j.company_id").
+		Where(p.db.Sq.Equal("cj.client_id", clientID)).
+		Where("cj.deleted_at IS NULL").
+		OrderBy("cj.start_date", "cj.end_date NULLS LAST").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientJobTableName, "employment history"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	positions := make([]*entity.EmploymentPosition, 0)
+	for rows.Next() {
+		var (
+			position entity.EmploymentPosition
+			endDate  sql.NullTime
+		)
+		if err = rows.Scan(
+			&position.JobID,
+			&position.JobName,
+			&position.CompanyID,
+			&position.Company,
+			&position.StartDate,
+			&endDate,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if endDate.Valid {
+			position.EndDate = endDate.Time
+		}
+
+		positions = append(positions, &position)
+	}
+
+	return positions, p.db.Error(rows.Err())
+}
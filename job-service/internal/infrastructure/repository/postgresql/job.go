@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"job-service/internal/entity"
 	"job-service/internal/infrastructure/repository"
 	"job-service/internal/pkg/otlp"
 	"job-service/internal/pkg/postgres"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -35,12 +38,28 @@ func (p *jobRepo) jobsSelectQueryPrefix() squirrel.SelectBuilder {
 		Select(
 			"id",
 			"name",
-			"salary",
+			"COALESCE(salary_min::TEXT, '')",
+			"COALESCE(salary_max::TEXT, '')",
+			"COALESCE(currency, '')",
+			"COALESCE(pay_period, '')",
 			"level",
 			"location_type",
 			"employment_type",
 			"address",
-			"company",
+			"COALESCE(company_id::TEXT, '')",
+			"COALESCE((SELECT name FROM companies WHERE companies.id = jobs.company_id), '')",
+			"description",
+			"responsibilities",
+			"requirements",
+			"benefits",
+			"skills",
+			"latitude",
+			"longitude",
+			"ARRAY(SELECT category_id::TEXT FROM job_categories WHERE job_categories.job_id = jobs.id ORDER BY category_id)",
+			"ARRAY(SELECT tags.name FROM job_tags JOIN tags ON tags.slug = job_tags.tag WHERE job_tags.job_id = jobs.id ORDER BY tags.slug)",
+			"status",
+			"publish_at",
+			"close_at",
 			"created_at",
 			"updated_at",
 		).From(p.tableName)
@@ -63,26 +82,54 @@ func (p jobRepo) CreateJob(ctx context.Context, job *entity.Job) (*entity.Job, e
 	defer span.End()
 
 	data := map[string]any{
-		"id":              job.GUID,
-		"name":            job.Name,
-		"salary":          job.Salary,
-		"level":           job.Level,
-		"location_type":   job.LocationType,
-		"employment_type": job.EmploymentType,
-		"address":         job.Address,
-		"company":         job.Company,
-		"created_at":      job.CreatedAt,
-		"updated_at":      job.UpdatedAt,
+		"id":               job.GUID,
+		"name":             job.Name,
+		"salary_min":       nullIfEmpty(job.SalaryMin),
+		"salary_max":       nullIfEmpty(job.SalaryMax),
+		"currency":         nullIfEmpty(job.Currency),
+		"pay_period":       nullIfEmpty(job.PayPeriod),
+		"level":            job.Level,
+		"location_type":    job.LocationType,
+		"employment_type":  job.EmploymentType,
+		"address":          job.Address,
+		"company_id":       nullIfEmpty(job.CompanyID),
+		"description":      job.Description,
+		"responsibilities": job.Responsibilities,
+		"requirements":     job.Requirements,
+		"benefits":         job.Benefits,
+		"skills":           job.Skills,
+		"latitude":         job.Latitude,
+		"longitude":        job.Longitude,
+		"status":           job.Status,
+		"publish_at":       nullIfZero(job.PublishAt),
+		"close_at":         nullIfZero(job.CloseAt),
+		"created_at":       job.CreatedAt,
+		"updated_at":       job.UpdatedAt,
 	}
 	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = p.saveTaxonomy(ctx, tx, job); err != nil {
+		return nil, err
+	}
+	if err = writeEvents(ctx, p.db, tx, createdEvents(job)...); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 
 	return job, nil
 }
@@ -93,14 +140,33 @@ func (p jobRepo) UpdateJob(ctx context.Context, job *entity.Job) (*entity.Job, e
 	defer span.End()
 
 	clauses := map[string]any{
-		"name":            job.Name,
-		"salary":          job.Salary,
-		"level":           job.Level,
-		"location_type":   job.LocationType,
-		"employment_type": job.EmploymentType,
-		"address":         job.Address,
-		"company":         job.Company,
-		"updated_at":      job.UpdatedAt,
+		"name":             job.Name,
+		"salary_min":       nullIfEmpty(job.SalaryMin),
+		"salary_max":       nullIfEmpty(job.SalaryMax),
+		"currency":         nullIfEmpty(job.Currency),
+		"pay_period":       nullIfEmpty(job.PayPeriod),
+		"level":            job.Level,
+		"location_type":    job.LocationType,
+		"employment_type":  job.EmploymentType,
+		"address":          job.Address,
+		"description":      job.Description,
+		"responsibilities": job.Responsibilities,
+		"requirements":     job.Requirements,
+		"benefits":         job.Benefits,
+		"skills":           job.Skills,
+		"latitude":         job.Latitude,
+		"longitude":        job.Longitude,
+		"updated_at":       job.UpdatedAt,
+	}
+	// a job always belongs to a company, an empty company_id keeps the current one
+	if job.CompanyID != "" {
+		clauses["company_id"] = job.CompanyID
+	}
+	// the schedule changes only together with the status
+	if job.Status != "" {
+		clauses["status"] = job.Status
+		clauses["publish_at"] = nullIfZero(job.PublishAt)
+		clauses["close_at"] = nullIfZero(job.CloseAt)
 	}
 	sqlStr, args, err := p.db.Sq.Builder.
 		Update(p.tableName).
@@ -111,14 +177,55 @@ func (p jobRepo) UpdateJob(ctx context.Context, job *entity.Job) (*entity.Job, e
 		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
-
-	if commandTag.RowsAffected() == 0 {
+	defer tx.Rollback(ctx)
+
+	// the row stays locked until commit, so the events of concurrent updates keep their order
+	var oldStatus, oldCompanyID string
+	err = tx.QueryRow(ctx,
+		"SELECT status, COALESCE(company_id::TEXT, '') FROM jobs WHERE id = $1 FOR UPDATE",
+		job.GUID,
+	).Scan(&oldStatus, &oldCompanyID)
+	if err == pgx.ErrNoRows {
 		return nil, p.db.Error(fmt.Errorf("no sql rows"))
 	}
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if job.Status != "" && !entity.CanMoveJob(oldStatus, job.Status) {
+		return nil, entity.NewErrInvalidTransition(oldStatus, job.Status)
+	}
+
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if err = p.saveTaxonomy(ctx, tx, job); err != nil {
+		return nil, err
+	}
+
+	updated := *job
+	if updated.CompanyID == "" {
+		updated.CompanyID = oldCompanyID
+	}
+	if updated.Status == "" {
+		updated.Status = oldStatus
+	}
+	events := []*entity.Event{jobEvent(entity.EventJobUpdated, &updated)}
+	if updated.Status != oldStatus {
+		if event := statusEvent(&updated); event != nil {
+			events = append(events, event)
+		}
+	}
+	if err = writeEvents(ctx, p.db, tx, events...); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
 
 	return job, nil
 }
@@ -137,19 +244,33 @@ func (p jobRepo) DeleteJob(ctx context.Context, guid string) error {
 		SetMap(clauses).
 		Where(p.db.Sq.Equal("id", guid)).
 		Where("deleted_at IS NULL").
+		Suffix("RETURNING name, COALESCE(company_id::TEXT, ''), status").
 		ToSql()
 	if err != nil {
 		return p.db.ErrSQLBuild(err, p.tableName+" delete")
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return p.db.Error(err)
 	}
+	defer tx.Rollback(ctx)
 
-	if commandTag.RowsAffected() == 0 {
+	job := entity.Job{GUID: guid, UpdatedAt: time.Now().UTC()}
+	err = tx.QueryRow(ctx, sqlStr, args...).Scan(&job.Name, &job.CompanyID, &job.Status)
+	if err == pgx.ErrNoRows {
 		return p.db.Error(fmt.Errorf("no sql rows"))
 	}
+	if err != nil {
+		return p.db.Error(err)
+	}
+	if err = writeEvents(ctx, p.db, tx, jobEvent(entity.EventJobDeleted, &job)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
 
 	return nil
 }
@@ -160,7 +281,8 @@ func (p jobRepo) GetJob(ctx context.Context, params map[string]string) (*entity.
 	defer span.End()
 
 	var (
-		job entity.Job
+		job                entity.Job
+		publishAt, closeAt sql.NullTime
 	)
 
 	queryBuilder := p.jobsSelectQueryPrefix()
@@ -180,21 +302,159 @@ func (p jobRepo) GetJob(ctx context.Context, params map[string]string) (*entity.
 	if err = p.db.QueryRow(ctx, query, args...).Scan(
 		&job.GUID,
 		&job.Name,
-		&job.Salary,
+		&job.SalaryMin,
+		&job.SalaryMax,
+		&job.Currency,
+		&job.PayPeriod,
 		&job.Level,
 		&job.LocationType,
 		&job.EmploymentType,
 		&job.Address,
+		&job.CompanyID,
 		&job.Company,
+		&job.Description,
+		&job.Responsibilities,
+		&job.Requirements,
+		&job.Benefits,
+		&job.Skills,
+		&job.Latitude,
+		&job.Longitude,
+		&job.CategoryIDs,
+		&job.Tags,
+		&job.Status,
+		&publishAt,
+		&closeAt,
 		&job.CreatedAt,
 		&job.UpdatedAt,
 	); err != nil {
-		return nil, err
+		return nil, p.db.Error(err)
 	}
+	job.PublishAt, job.CloseAt = publishAt.Time, closeAt.Time
 
 	return &job, nil
 }
 
+// GetJobsByIDs returns the jobs found among ids in no particular order
+func (p jobRepo) GetJobsByIDs(ctx context.Context, ids []string) ([]*entity.Job, error) {
+
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "GetJobsByIDs")
+	defer span.End()
+
+	query, args, err := p.jobsSelectQueryPrefix().
+		Where("id = ANY(?::UUID[])", ids).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get by ids"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	jobs := make([]*entity.Job, 0, len(ids))
+	for rows.Next() {
+		var (
+			job                entity.Job
+			publishAt, closeAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&job.GUID,
+			&job.Name,
+			&job.SalaryMin,
+			&job.SalaryMax,
+			&job.Currency,
+			&job.PayPeriod,
+			&job.Level,
+			&job.LocationType,
+			&job.EmploymentType,
+			&job.Address,
+			&job.CompanyID,
+			&job.Company,
+			&job.Description,
+			&job.Responsibilities,
+			&job.Requirements,
+			&job.Benefits,
+			&job.Skills,
+			&job.Latitude,
+			&job.Longitude,
+			&job.CategoryIDs,
+			&job.Tags,
+			&job.Status,
+			&publishAt,
+			&closeAt,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		job.PublishAt, job.CloseAt = publishAt.Time, closeAt.Time
+
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, p.db.Error(rows.Err())
+}
+
+// filterJobs applies the filters of a job list and adds the distance_km column, the geo
+// filters order the jobs closest first
+func (p jobRepo) filterJobs(queryBuilder squirrel.SelectBuilder, filter map[string]string) squirrel.SelectBuilder {
+	for key, value := range filter {
+		switch key {
+		case "company_id", "currency", "pay_period", "status", "level", "location_type", "employment_type":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		case "salary_from":
+			// ranges overlap, an open upper bound counts as its lower one
+			queryBuilder = queryBuilder.Where("COALESCE(salary_max, salary_min) >= ?::NUMERIC", value)
+		case "salary_to":
+			queryBuilder = queryBuilder.Where("COALESCE(salary_min, salary_max) <= ?::NUMERIC", value)
+		case "skills":
+			// comma separated, jobs sharing at least one of them
+			queryBuilder = queryBuilder.Where("skills && ?", strings.Split(value, ","))
+		case "category_id":
+			// the category and its subcategories
+			queryBuilder = queryBuilder.Where(`id IN (SELECT job_id FROM job_categories WHERE category_id IN (
+				WITH RECURSIVE tree AS (
+					SELECT id FROM categories WHERE id = ?
+					UNION ALL
+					SELECT categories.id FROM categories JOIN tree ON categories.parent_id = tree.id
+				) SELECT id FROM tree))`, value)
+		case "tags":
+			// comma separated slugs, jobs with at least one of them
+			queryBuilder = queryBuilder.Where("id IN (SELECT job_id FROM job_tags WHERE tag = ANY(?))", strings.Split(value, ","))
+		}
+	}
+
+	// the usecase checks the geo filters, a center always comes with a radius in kilometers
+	if filter["latitude"] != "" && filter["longitude"] != "" {
+		center := "ll_to_earth(?::FLOAT8, ?::FLOAT8)"
+		queryBuilder = queryBuilder.
+			Column(squirrel.Expr("COALESCE(earth_distance("+center+", ll_to_earth(latitude, longitude)) / 1000, 0) AS distance_km", filter["latitude"], filter["longitude"])).
+			// earth_box narrows the rows down on the index, earth_distance is the exact check
+			Where("latitude IS NOT NULL AND longitude IS NOT NULL").
+			Where(squirrel.Expr("earth_box("+center+", ?::FLOAT8 * 1000) @> ll_to_earth(latitude, longitude)", filter["latitude"], filter["longitude"], filter["radius_km"])).
+			Where(squirrel.Expr("earth_distance("+center+", ll_to_earth(latitude, longitude)) <= ?::FLOAT8 * 1000", filter["latitude"], filter["longitude"], filter["radius_km"])).
+			OrderBy("distance_km")
+	} else {
+		queryBuilder = queryBuilder.Column("0::FLOAT8 AS distance_km")
+	}
+	if bbox := strings.Split(filter["bbox"], ","); len(bbox) == 4 {
+		queryBuilder = queryBuilder.Where("latitude BETWEEN ?::FLOAT8 AND ?::FLOAT8", bbox[0], bbox[2])
+		// a box crossing the antimeridian has its west edge east of its east edge
+		west, _ := strconv.ParseFloat(bbox[1], 64)
+		east, _ := strconv.ParseFloat(bbox[3], 64)
+		if west <= east {
+			queryBuilder = queryBuilder.Where("longitude BETWEEN ?::FLOAT8 AND ?::FLOAT8", bbox[1], bbox[3])
+		} else {
+			queryBuilder = queryBuilder.Where("(longitude >= ?::FLOAT8 OR longitude <= ?::FLOAT8)", bbox[1], bbox[3])
+		}
+	}
+
+	return queryBuilder
+}
+
 func (p jobRepo) GetAllJobs(ctx context.Context, limit uint64, offset uint64, filter map[string]string) ([]*entity.Job, error) {
 
 	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "ListJobs")
@@ -209,6 +469,7 @@ func (p jobRepo) GetAllJobs(ctx context.Context, limit uint64, offset uint64, fi
 		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
 	}
 
+	queryBuilder = p.filterJobs(queryBuilder, filter)
 	queryBuilder = queryBuilder.Where("deleted_at IS NULL")
 
 	query, args, err := queryBuilder.ToSql()
@@ -224,21 +485,42 @@ func (p jobRepo) GetAllJobs(ctx context.Context, limit uint64, offset uint64, fi
 
 	jobs = make([]*entity.Job, 0)
 	for rows.Next() {
-		var job entity.Job
+		var (
+			job                entity.Job
+			publishAt, closeAt sql.NullTime
+		)
 		if err = rows.Scan(
 			&job.GUID,
 			&job.Name,
-			&job.Salary,
+			&job.SalaryMin,
+			&job.SalaryMax,
+			&job.Currency,
+			&job.PayPeriod,
 			&job.Level,
 			&job.LocationType,
 			&job.EmploymentType,
 			&job.Address,
+			&job.CompanyID,
 			&job.Company,
+			&job.Description,
+			&job.Responsibilities,
+			&job.Requirements,
+			&job.Benefits,
+			&job.Skills,
+			&job.Latitude,
+			&job.Longitude,
+			&job.CategoryIDs,
+			&job.Tags,
+			&job.Status,
+			&publishAt,
+			&closeAt,
 			&job.CreatedAt,
 			&job.UpdatedAt,
+			&job.DistanceKm,
 		); err != nil {
 			return nil, p.db.Error(err)
 		}
+		job.PublishAt, job.CloseAt = publishAt.Time, closeAt.Time
 
 		jobs = append(jobs, &job)
 	}
@@ -274,21 +556,41 @@ func (p jobRepo) GetAllDeletedJobs(ctx context.Context, limit uint64, offset uin
 
 	jobs = make([]*entity.Job, 0)
 	for rows.Next() {
-		var job entity.Job
+		var (
+			job                entity.Job
+			publishAt, closeAt sql.NullTime
+		)
 		if err = rows.Scan(
 			&job.GUID,
 			&job.Name,
-			&job.Salary,
+			&job.SalaryMin,
+			&job.SalaryMax,
+			&job.Currency,
+			&job.PayPeriod,
 			&job.Level,
 			&job.LocationType,
 			&job.EmploymentType,
 			&job.Address,
+			&job.CompanyID,
 			&job.Company,
+			&job.Description,
+			&job.Responsibilities,
+			&job.Requirements,
+			&job.Benefits,
+			&job.Skills,
+			&job.Latitude,
+			&job.Longitude,
+			&job.CategoryIDs,
+			&job.Tags,
+			&job.Status,
+			&publishAt,
+			&closeAt,
 			&job.CreatedAt,
 			&job.UpdatedAt,
 		); err != nil {
 			return nil, p.db.Error(err)
 		}
+		job.PublishAt, job.CloseAt = publishAt.Time, closeAt.Time
 
 		jobs = append(jobs, &job)
 	}
@@ -424,23 +726,40 @@ func (p jobRepo) GetJobClients(ctx context.Context, limit uint64, offset uint64,
 
 func (p jobRepo) AddClientJob(ctx context.Context, clientJob *entity.ClientJob) (*entity.Response, error) {
 	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "AddClientJob")
-	span.End()
+	defer span.End()
 
 	data := map[string]any{
+		"id":         clientJob.GUID,
 		"client_id":  clientJob.ClientID,
 		"job_id":     clientJob.JobID,
 		"start_date": clientJob.StartDate,
-		"end_date":   clientJob.EndDate,
+		"end_date":   nullIfZero(clientJob.EndDate),
+		"created_at": clientJob.CreatedAt,
+		"updated_at": clientJob.UpdatedAt,
 	}
 	query, args, err := p.db.Sq.Builder.Insert(clientJobTableName).SetMap(data).ToSql()
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientJobTableName, "create"))
 	}
 
-	_, err = p.db.Exec(ctx, query, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
 		return &entity.Response{Status: false}, p.db.Error(err)
 	}
+	defer tx.Rollback(ctx)
+
+	// an overlapping active assignment makes it a conflict, an unknown client a not found
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return &entity.Response{Status: false}, p.db.Error(err)
+	}
+	if err = writeEvents(ctx, p.db, tx, clientJobEvent(entity.EventClientHired, clientJob)); err != nil {
+		return &entity.Response{Status: false}, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return &entity.Response{Status: false}, p.db.Error(err)
+	}
 
 	return &entity.Response{Status: true}, nil
 }
@@ -464,7 +783,13 @@ func (p jobRepo) DeleteClientJob(ctx context.Context, clientJob *entity.ClientJo
 		return p.db.ErrSQLBuild(err, clientJobTableName+" delete")
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, sqlStr, args...)
 	if err != nil {
 		return p.db.Error(err)
 	}
@@ -473,7 +798,568 @@ func (p jobRepo) DeleteClientJob(ctx context.Context, clientJob *entity.ClientJo
 		return p.db.Error(fmt.Errorf("no sql rows"))
 	}
 
-	fmt.Println(commandTag.RowsAffected())
+	event := clientJobEvent(entity.EventClientUnassigned, &entity.ClientJob{
+		ClientID:  clientJob.ClientID,
+		JobID:     clientJob.JobID,
+		UpdatedAt: time.Now().UTC(),
+	})
+	if err = writeEvents(ctx, p.db, tx, event); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// ReassignClientJobs moves the active assignments of one client to another, used when clients are
+// merged. An assignment overlapping an active one of the other client to the same job would break
+// client_jobs_no_overlap, the other client already holds that job then, so it's ended instead.
+func (p jobRepo) ReassignClientJobs(ctx context.Context, fromClientID, toClientID string) (*entity.Reassignment, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "ReassignClientJobs")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	endSQL, endArgs, err := p.db.Sq.Builder.
+		Update(clientJobTableName + " f").
+		SetMap(map[string]any{
+			"deleted_at": now,
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("f.client_id", fromClientID)).
+		Where("f.deleted_at IS NULL").
+		Where(p.db.Sq.Expr(`EXISTS (
+			SELECT 1 FROM `+clientJobTableName+` t
+			WHERE t.client_id = ? AND t.job_id = f.job_id AND t.deleted_at IS NULL
+			  AND TSTZRANGE(t.start_date, t.end_date) && TSTZRANGE(f.start_date, f.end_date)
+		)`, toClientID)).
+		Suffix("RETURNING f.id, f.job_id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, clientJobTableName+" reassign")
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(clientJobTableName).
+		SetMap(map[string]any{
+			"client_id":  toClientID,
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("client_id", fromClientID)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING id, job_id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, clientJobTableName+" reassign")
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	ended, err := queryAssignments(ctx, tx, endSQL, endArgs...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	moved, err := queryAssignments(ctx, tx, sqlStr, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	events := make([]*entity.Event, 0, len(ended)+len(moved))
+	reassignment := &entity.Reassignment{}
+	for _, assignment := range ended {
+		reassignment.Ended = append(reassignment.Ended, assignment.id)
+		events = append(events, clientJobEvent(entity.EventClientUnassigned, &entity.ClientJob{
+			ClientID:  fromClientID,
+			JobID:     assignment.jobID,
+			UpdatedAt: now,
+		}))
+	}
+	for _, assignment := range moved {
+		reassignment.Moved = append(reassignment.Moved, assignment.id)
+		events = append(events, &entity.Event{
+			Topic: entity.TopicClientJobs,
+			Type:  entity.EventClientReassigned,
+			Key:   toClientID,
+			Data: entity.ClientJobEvent{
+				ClientID:     toClientID,
+				JobID:        assignment.jobID,
+				FromClientID: fromClientID,
+				At:           now,
+			},
+		})
+	}
+
+	if err = writeEvents(ctx, p.db, tx, events...); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return reassignment, nil
+}
+
+// UndoReassignClientJobs moves the rows of the reassignment back to the client they came from and
+// reopens the ones it ended. Rows changed since aren't touched, so it can be repeated.
+func (p jobRepo) UndoReassignClientJobs(ctx context.Context, fromClientID, toClientID string, reassignment *entity.Reassignment) (uint64, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "UndoReassignClientJobs")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	backSQL, backArgs, err := p.db.Sq.Builder.
+		Update(clientJobTableName).
+		SetMap(map[string]any{
+			"client_id":  fromClientID,
+			"updated_at": now,
+		}).
+		Where("id = ANY(?::UUID[])", reassignment.Moved).
+		Where(p.db.Sq.Equal("client_id", toClientID)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING id, job_id").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, clientJobTableName+" undo reassign")
+	}
+
+	reopenSQL, reopenArgs, err := p.db.Sq.Builder.
+		Update(clientJobTableName).
+		SetMap(map[string]any{
+			"deleted_at": nil,
+			"updated_at": now,
+		}).
+		Where("id = ANY(?::UUID[])", reassignment.Ended).
+		Where(p.db.Sq.Equal("client_id", fromClientID)).
+		Where("deleted_at IS NOT NULL").
+		Suffix("RETURNING id, job_id").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, clientJobTableName+" undo reassign")
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	movedBack, err := queryAssignments(ctx, tx, backSQL, backArgs...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	reopened, err := queryAssignments(ctx, tx, reopenSQL, reopenArgs...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	events := make([]*entity.Event, 0, len(movedBack)+len(reopened))
+	for _, assignment := range movedBack {
+		events = append(events, &entity.Event{
+			Topic: entity.TopicClientJobs,
+			Type:  entity.EventClientReassigned,
+			Key:   fromClientID,
+			Data: entity.ClientJobEvent{
+				ClientID:     fromClientID,
+				JobID:        assignment.jobID,
+				FromClientID: toClientID,
+				At:           now,
+			},
+		})
+	}
+	for _, assignment := range reopened {
+		events = append(events, clientJobEvent(entity.EventClientHired, &entity.ClientJob{
+			ClientID:  fromClientID,
+			JobID:     assignment.jobID,
+			UpdatedAt: now,
+		}))
+	}
+
+	if err = writeEvents(ctx, p.db, tx, events...); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(len(movedBack) + len(reopened)), nil
+}
+
+// assignment is a client_jobs row a statement returned
+type assignment struct {
+	id    string
+	jobID string
+}
+
+func queryAssignments(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]assignment, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []assignment
+	for rows.Next() {
+		var row assignment
+		if err = rows.Scan(&row.id, &row.jobID); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, row)
+	}
+
+	return assignments, rows.Err()
+}
+
+// batchTxSize bounds the rows created in one transaction. The change feed and the audit log
+// triggers take global advisory locks held until commit, so every other write of jobs and
+// audited rows waits for the transaction; a big batch in one would stall them all.
+const batchTxSize = 100
+
+// every row is inserted under its own savepoint, so one bad row doesn't abort the whole batch.
+// The batch is committed every batchTxSize rows, when a commit fails the rows before stay created.
+func (p jobRepo) BatchCreateJobs(ctx context.Context, jobs []*entity.Job) ([]*entity.BatchResult, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "BatchCreateJobs")
+	defer span.End()
+
+	results := make([]*entity.BatchResult, 0, len(jobs))
+	for start := 0; start < len(jobs); start += batchTxSize {
+		created, err := p.batchCreateJobs(ctx, jobs[start:min(start+batchTxSize, len(jobs))], start)
+		if err != nil && start == 0 {
+			return nil, err
+		}
+		if err != nil {
+			// the transactions before are committed, the rest of the batch is reported as failed
+			for index := start; index < len(jobs); index++ {
+				results = append(results, &entity.BatchResult{Index: uint64(index), Error: err.Error()})
+			}
+			return results, nil
+		}
+		results = append(results, created...)
+	}
+
+	return results, nil
+}
+
+// batchCreateJobs creates the jobs in one transaction, offset is the index of the first one in the batch
+func (p jobRepo) batchCreateJobs(ctx context.Context, jobs []*entity.Job, offset int) ([]*entity.BatchResult, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	results := make([]*entity.BatchResult, 0, len(jobs))
+	for index, job := range jobs {
+		data := map[string]any{
+			"id":               job.GUID,
+			"name":             job.Name,
+			"salary_min":       nullIfEmpty(job.SalaryMin),
+			"salary_max":       nullIfEmpty(job.SalaryMax),
+			"currency":         nullIfEmpty(job.Currency),
+			"pay_period":       nullIfEmpty(job.PayPeriod),
+			"level":            job.Level,
+			"location_type":    job.LocationType,
+			"employment_type":  job.EmploymentType,
+			"address":          job.Address,
+			"company_id":       nullIfEmpty(job.CompanyID),
+			"description":      job.Description,
+			"responsibilities": job.Responsibilities,
+			"requirements":     job.Requirements,
+			"benefits":         job.Benefits,
+			"skills":           job.Skills,
+			"latitude":         job.Latitude,
+			"longitude":        job.Longitude,
+			"status":           job.Status,
+			"publish_at":       nullIfZero(job.PublishAt),
+			"close_at":         nullIfZero(job.CloseAt),
+			"created_at":       job.CreatedAt,
+			"updated_at":       job.UpdatedAt,
+		}
+		query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+		if err != nil {
+			return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "batch create"))
+		}
+
+		if _, err = tx.Exec(ctx, "SAVEPOINT batch_item"); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		result := &entity.BatchResult{Index: uint64(offset + index), GUID: job.GUID}
+		if _, err = tx.Exec(ctx, query, args...); err == nil {
+			err = p.saveTaxonomy(ctx, tx, job)
+		}
+		if err == nil {
+			err = writeEvents(ctx, p.db, tx, createdEvents(job)...)
+		}
+		if err != nil {
+			if _, rollbackErr := tx.Exec(ctx, "ROLLBACK TO SAVEPOINT batch_item"); rollbackErr != nil {
+				return nil, p.db.Error(rollbackErr)
+			}
+			result.GUID = ""
+			result.Error = p.db.Error(err).Error()
+		}
+		results = append(results, result)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return results, nil
+}
+
+// StreamJobs scans rows one by one and hands them to fn, the result set is never held in memory
+func (p jobRepo) StreamJobs(ctx context.Context, scope string, filter map[string]string, fn func(job *entity.Job) error) error {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "StreamJobs")
+	defer span.End()
+
+	queryBuilder := p.filterJobs(p.jobsSelectQueryPrefix(), filter)
+
+	if scope == entity.JobScopeDeleted {
+		queryBuilder = queryBuilder.Where("deleted_at IS NOT NULL")
+	} else {
+		queryBuilder = queryBuilder.Where("deleted_at IS NULL")
+	}
+	queryBuilder = queryBuilder.OrderBy("created_at", "id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "stream"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			job                entity.Job
+			publishAt, closeAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&job.GUID,
+			&job.Name,
+			&job.SalaryMin,
+			&job.SalaryMax,
+			&job.Currency,
+			&job.PayPeriod,
+			&job.Level,
+			&job.LocationType,
+			&job.EmploymentType,
+			&job.Address,
+			&job.CompanyID,
+			&job.Company,
+			&job.Description,
+			&job.Responsibilities,
+			&job.Requirements,
+			&job.Benefits,
+			&job.Skills,
+			&job.Latitude,
+			&job.Longitude,
+			&job.CategoryIDs,
+			&job.Tags,
+			&job.Status,
+			&publishAt,
+			&closeAt,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.DistanceKm,
+		); err != nil {
+			return p.db.Error(err)
+		}
+		job.PublishAt, job.CloseAt = publishAt.Time, closeAt.Time
+
+		if err = fn(&job); err != nil {
+			return err
+		}
+	}
+
+	return p.db.Error(rows.Err())
+}
+
+func (p jobRepo) StreamClientJobs(ctx context.Context, filter map[string]string, fn func(clientJob *entity.ClientJob) error) error {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "StreamClientJobs")
+	defer span.End()
+
+	queryBuilder := p.clientJobsSelectQueryPrefix()
+
+	for key, value := range filter {
+		if (key == "client_id" || key == "job_id") && value != "" {
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+	queryBuilder = queryBuilder.Where("deleted_at IS NULL").OrderBy("start_date", "client_id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientJobTableName, "stream"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			nullEndDate sql.NullTime
+			clientJob   entity.ClientJob
+		)
+		if err = rows.Scan(
+			&clientJob.ClientID,
+			&clientJob.JobID,
+			&clientJob.StartDate,
+			&nullEndDate,
+			&clientJob.CreatedAt,
+			&clientJob.UpdatedAt,
+		); err != nil {
+			return p.db.Error(err)
+		}
+		if nullEndDate.Valid {
+			clientJob.EndDate = nullEndDate.Time
+		}
+
+		if err = fn(&clientJob); err != nil {
+			return err
+		}
+	}
+
+	return p.db.Error(rows.Err())
+}
+
+// nullIfEmpty keeps undisclosed salary fields NULL instead of failing the NUMERIC cast
+func nullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func nullIfZero(value time.Time) any {
+	if value.IsZero() {
+		return nil
+	}
+	return value
+}
+
+// PublishDueJobs publishes the scheduled jobs whose publish_at has come
+func (p jobRepo) PublishDueJobs(ctx context.Context, now time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "PublishDueJobs")
+	defer span.End()
+
+	return p.moveDueJobs(ctx, entity.JobStatusScheduled, entity.JobStatusPublished, "publish_at", now)
+}
+
+// CloseDueJobs closes the published jobs whose close_at has come
+func (p jobRepo) CloseDueJobs(ctx context.Context, now time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, jobsSpanRepoPrefix+"_grpc-repository", "CloseDueJobs")
+	defer span.End()
+
+	return p.moveDueJobs(ctx, entity.JobStatusPublished, entity.JobStatusClosed, "close_at", now)
+}
+
+// moveDueJobs moves the jobs in the status from whose date column has come to the status to
+func (p jobRepo) moveDueJobs(ctx context.Context, from, to, column string, now time.Time) (uint64, error) {
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"status":     to,
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("status", from)).
+		Where(p.db.Sq.Expr(column+" <= ?", now)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING id, name, COALESCE(company_id::TEXT, '')").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, p.tableName+" "+to)
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	events := make([]*entity.Event, 0)
+	for rows.Next() {
+		job := entity.Job{Status: to, UpdatedAt: now}
+		if err = rows.Scan(&job.GUID, &job.Name, &job.CompanyID); err != nil {
+			rows.Close()
+			return 0, p.db.Error(err)
+		}
+		events = append(events, statusEvent(&job))
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	if err = writeEvents(ctx, p.db, tx, events...); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(len(events)), nil
+}
+
+// saveTaxonomy replaces the categories and the tags of the job, tags used for the first time are created
+func (p jobRepo) saveTaxonomy(ctx context.Context, tx pgx.Tx, job *entity.Job) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM job_categories WHERE job_id = $1", job.GUID); err != nil {
+		return p.db.Error(err)
+	}
+	if len(job.CategoryIDs) != 0 {
+		if _, err := tx.Exec(ctx,
+			"INSERT INTO job_categories (job_id, category_id) SELECT $1, UNNEST($2::UUID[])",
+			job.GUID, job.CategoryIDs,
+		); err != nil {
+			return p.db.Error(err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM job_tags WHERE job_id = $1", job.GUID); err != nil {
+		return p.db.Error(err)
+	}
+	if len(job.Tags) == 0 {
+		return nil
+	}
+
+	slugs := make([]string, 0, len(job.Tags))
+	for _, name := range job.Tags {
+		slugs = append(slugs, entity.TagSlug(name))
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO tags (slug, name, created_at, updated_at)
+			SELECT UNNEST($1::VARCHAR[]), UNNEST($2::VARCHAR[]), $3, $3
+			ON CONFLICT (slug) DO NOTHING`,
+		slugs, job.Tags, job.UpdatedAt,
+	); err != nil {
+		return p.db.Error(err)
+	}
+	if _, err := tx.Exec(ctx,
+		"INSERT INTO job_tags (job_id, tag) SELECT $1, UNNEST($2::VARCHAR[])",
+		job.GUID, slugs,
+	); err != nil {
+		return p.db.Error(err)
+	}
 
 	return nil
 }
@@ -0,0 +1,247 @@
+package postgresql
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"job-service/internal/entity"
+	"job-service/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// testDB connects to TEST_DATABASE_URL, a database with the migrations of client-service applied.
+// The tests are skipped without it.
+func testDB(t *testing.T) *postgres.PostgresDB {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	pool, err := pgxpool.Connect(context.Background(), url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	return &postgres.PostgresDB{Pool: pool, Sq: *postgres.NewSquirrel()}
+}
+
+func TestReassignClientJobsOverlap(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewJobsRepo(db)
+
+	var (
+		keepID, mergeID = uuid.NewString(), uuid.NewString()
+		sharedJobID     = uuid.NewString()
+		otherJobID      = uuid.NewString()
+		now             = time.Now().UTC()
+	)
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := db.Exec(ctx, query, args...); err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+	}
+	for _, id := range []string{keepID, mergeID} {
+		exec(`INSERT INTO clients (id, first_name, last_name, email, password, refresh)
+			VALUES ($1, 'Test', 'Client', $1 || '@example.com', '', '')`, id)
+	}
+	for _, id := range []string{sharedJobID, otherJobID} {
+		exec(`INSERT INTO jobs (id, name, level, location_type, employment_type, address)
+			VALUES ($1, 'Test job', 'Junior', 'Remote', 'Full-Time', '')`, id)
+	}
+	t.Cleanup(func() {
+		exec(`DELETE FROM client_jobs WHERE client_id IN ($1, $2)`, keepID, mergeID)
+		exec(`DELETE FROM jobs WHERE id IN ($1, $2)`, sharedJobID, otherJobID)
+		exec(`DELETE FROM clients WHERE id IN ($1, $2)`, keepID, mergeID)
+	})
+
+	// both duplicates hold the shared job at the same time, only the merged one holds the other
+	exec(`INSERT INTO client_jobs (client_id, job_id, start_date) VALUES ($1, $2, $3)`, keepID, sharedJobID, now.AddDate(0, 0, -10))
+	exec(`INSERT INTO client_jobs (client_id, job_id, start_date) VALUES ($1, $2, $3)`, mergeID, sharedJobID, now.AddDate(0, 0, -5))
+	exec(`INSERT INTO client_jobs (client_id, job_id, start_date) VALUES ($1, $2, $3)`, mergeID, otherJobID, now.AddDate(0, 0, -5))
+
+	reassignment, err := repo.ReassignClientJobs(ctx, mergeID, keepID)
+	if err != nil {
+		t.Fatalf("ReassignClientJobs: %v", err)
+	}
+	if len(reassignment.Moved) != 1 || len(reassignment.Ended) != 1 {
+		t.Errorf("moved %d and ended %d assignments, want 1 and 1", len(reassignment.Moved), len(reassignment.Ended))
+	}
+
+	active := func(clientID, jobID string) int {
+		t.Helper()
+		var count int
+		err := db.QueryRow(ctx,
+			`SELECT COUNT(*) FROM client_jobs WHERE client_id = $1 AND job_id = $2 AND deleted_at IS NULL`,
+			clientID, jobID,
+		).Scan(&count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return count
+	}
+	if got := active(keepID, sharedJobID); got != 1 {
+		t.Errorf("kept client has %d active assignments to the shared job, want 1", got)
+	}
+	if got := active(keepID, otherJobID); got != 1 {
+		t.Errorf("kept client has %d active assignments to the other job, want 1", got)
+	}
+	if got := active(mergeID, sharedJobID) + active(mergeID, otherJobID); got != 0 {
+		t.Errorf("merged client has %d active assignments left, want 0", got)
+	}
+
+	// reassigning again is a no-op, so a merge that failed half way can be retried
+	again, err := repo.ReassignClientJobs(ctx, mergeID, keepID)
+	if err != nil || len(again.Moved)+len(again.Ended) != 0 {
+		t.Errorf("second ReassignClientJobs = %+v, %v, want nothing changed", again, err)
+	}
+
+	// undoing gives the merged client back both of its assignments and leaves the kept one alone
+	undone, err := repo.UndoReassignClientJobs(ctx, mergeID, keepID, reassignment)
+	if err != nil {
+		t.Fatalf("UndoReassignClientJobs: %v", err)
+	}
+	if undone != 2 {
+		t.Errorf("undone = %d, want 2", undone)
+	}
+	if got := active(mergeID, sharedJobID) + active(mergeID, otherJobID); got != 2 {
+		t.Errorf("merged client has %d active assignments after the undo, want 2", got)
+	}
+	if got := active(keepID, sharedJobID); got != 1 {
+		t.Errorf("kept client has %d active assignments to the shared job after the undo, want 1", got)
+	}
+	if got := active(keepID, otherJobID); got != 0 {
+		t.Errorf("kept client has %d active assignments to the other job after the undo, want 0", got)
+	}
+
+	if undone, err = repo.UndoReassignClientJobs(ctx, mergeID, keepID, reassignment); err != nil || undone != 0 {
+		t.Errorf("second UndoReassignClientJobs = %d, %v, want 0, nil", undone, err)
+	}
+}
+
+func TestBatchCreateJobsAcrossTransactions(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewJobsRepo(db)
+
+	now := time.Now().UTC()
+	jobs := make([]*entity.Job, 2*batchTxSize+10)
+	ids := make([]string, len(jobs))
+	for i := range jobs {
+		ids[i] = uuid.NewString()
+		jobs[i] = &entity.Job{
+			GUID:           ids[i],
+			Name:           "Batch job",
+			Level:          "Junior",
+			LocationType:   "Remote",
+			EmploymentType: "Full-Time",
+			Skills:         []string{},
+			Status:         entity.JobStatusDraft,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+	}
+	// a repeated id fails on its own row only
+	jobs[batchTxSize+1].GUID = ids[0]
+	t.Cleanup(func() {
+		if _, err := db.Exec(ctx, `DELETE FROM jobs WHERE id = ANY($1)`, ids); err != nil {
+			t.Error(err)
+		}
+	})
+
+	results, err := repo.BatchCreateJobs(ctx, jobs)
+	if err != nil {
+		t.Fatalf("BatchCreateJobs: %v", err)
+	}
+	if len(results) != len(jobs) {
+		t.Fatalf("results = %d, want %d", len(results), len(jobs))
+	}
+	for i, result := range results {
+		if result.Index != uint64(i) {
+			t.Errorf("result %d has index %d", i, result.Index)
+		}
+		if failed := result.Error != ""; failed != (i == batchTxSize+1) {
+			t.Errorf("result %d: error %q", i, result.Error)
+		}
+	}
+}
+
+func TestStreamJobsFilter(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewJobsRepo(db)
+
+	// a skill and a tag no other job has keep the rows of other tests out
+	skill := "stream-" + uuid.NewString()
+	tag := entity.TagSlug("stream " + uuid.NewString()[:8])
+	now := time.Now().UTC()
+	jobs := []*entity.Job{
+		{GUID: uuid.NewString(), Level: "Senior", Tags: []string{tag}},
+		{GUID: uuid.NewString(), Level: "Junior"},
+		{GUID: uuid.NewString(), Level: "Senior", Skills: []string{"other"}, Tags: []string{tag}},
+	}
+	ids := make([]string, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.GUID
+		job.Name = "Stream job"
+		job.LocationType = "Remote"
+		job.EmploymentType = "Full-Time"
+		if job.Skills == nil {
+			job.Skills = []string{skill}
+		}
+		job.Status = entity.JobStatusPublished
+		job.CreatedAt, job.UpdatedAt = now, now
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec(ctx, `DELETE FROM jobs WHERE id = ANY($1)`, ids); err != nil {
+			t.Error(err)
+		}
+		if _, err := db.Exec(ctx, `DELETE FROM tags WHERE slug = $1`, tag); err != nil {
+			t.Error(err)
+		}
+	})
+	if _, err := repo.BatchCreateJobs(ctx, jobs); err != nil {
+		t.Fatalf("BatchCreateJobs: %v", err)
+	}
+
+	var streamed []string
+	err := repo.StreamJobs(ctx, entity.JobScopeActive, map[string]string{
+		"skills": skill,
+		"level":  "Senior",
+		"status": entity.JobStatusPublished,
+	}, func(job *entity.Job) error {
+		streamed = append(streamed, job.GUID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamJobs: %v", err)
+	}
+	if len(streamed) != 1 || streamed[0] != ids[0] {
+		t.Errorf("streamed %v, want [%s]", streamed, ids[0])
+	}
+
+	streamed = nil
+	err = repo.StreamJobs(ctx, entity.JobScopeActive, map[string]string{"tags": tag}, func(job *entity.Job) error {
+		streamed = append(streamed, job.GUID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamJobs by tag: %v", err)
+	}
+	sort.Strings(streamed)
+	want := []string{ids[0], ids[2]}
+	sort.Strings(want)
+	if !reflect.DeepEqual(streamed, want) {
+		t.Errorf("streamed by tag %v, want %v", streamed, want)
+	}
+}
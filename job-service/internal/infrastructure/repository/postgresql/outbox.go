@@ -0,0 +1,259 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	outboxTableName      = "outbox"
+	outboxSource         = "job-service"
+	outboxSpanRepoPrefix = "outboxRepo"
+)
+
+type outboxRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewOutboxRepo(db *postgres.PostgresDB) repository.Outbox {
+	return &outboxRepo{
+		db: db,
+	}
+}
+
+// RelayEvents hands publish the pending events in outbox order and marks the published ones.
+// It stops at the first failure, so no later event of the same key gets ahead of the failed one.
+// While another instance relays, the lock isn't taken and nothing is published.
+func (p outboxRepo) RelayEvents(ctx context.Context, limit uint64, publish func(ctx context.Context, event *entity.Event) error) (uint64, error) {
+	ctx, span := otlp.Start(ctx, outboxSpanRepoPrefix+"_grpc-repository", "RelayEvents")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err = tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", "outbox:"+outboxSource).Scan(&locked); err != nil {
+		return 0, p.db.Error(err)
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"event_id",
+			"topic",
+			"type",
+			"key",
+			"payload",
+			"created_at",
+		).
+		From(outboxTableName).
+		Where(p.db.Sq.Equal("source", outboxSource)).
+		Where("published_at IS NULL").
+		OrderBy("id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", outboxTableName, "pending"))
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	events := make([]*entity.Event, 0)
+	for rows.Next() {
+		var event entity.Event
+		if err = rows.Scan(
+			&event.ID,
+			&event.GUID,
+			&event.Topic,
+			&event.Type,
+			&event.Key,
+			&event.Payload,
+			&event.CreatedAt,
+		); err != nil {
+			rows.Close()
+			return 0, p.db.Error(err)
+		}
+		events = append(events, &event)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	var (
+		published  = make([]uint64, 0, len(events))
+		publishErr error
+	)
+	for _, event := range events {
+		if publishErr = publish(ctx, event); publishErr != nil {
+			if _, err = tx.Exec(ctx,
+				"UPDATE outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1",
+				event.ID, publishErr.Error(),
+			); err != nil {
+				return 0, p.db.Error(err)
+			}
+			break
+		}
+		published = append(published, event.ID)
+	}
+
+	if len(published) != 0 {
+		if _, err = tx.Exec(ctx,
+			"UPDATE outbox SET published_at = $2, attempts = attempts + 1, last_error = '' WHERE id = ANY($1)",
+			published, time.Now().UTC(),
+		); err != nil {
+			return 0, p.db.Error(err)
+		}
+	}
+
+	// a failed commit leaves the events pending, they are published again
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(len(published)), publishErr
+}
+
+// PruneEvents deletes the events published before the time
+func (p outboxRepo) PruneEvents(ctx context.Context, before time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, outboxSpanRepoPrefix+"_grpc-repository", "PruneEvents")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(outboxTableName).
+		Where(p.db.Sq.Equal("source", outboxSource)).
+		Where(p.db.Sq.Expr("published_at < ?", before)).
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, outboxTableName+" prune")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(commandTag.RowsAffected()), nil
+}
+
+// writeEvents adds the events to the outbox in the transaction of the change they describe
+// and queues them for the webhooks subscribed to their types
+func writeEvents(ctx context.Context, db *postgres.PostgresDB, tx pgx.Tx, events ...*entity.Event) error {
+	for _, event := range events {
+		payload, err := json.Marshal(event.Data)
+		if err != nil {
+			return fmt.Errorf("marshal %s event: %w", event.Type, err)
+		}
+		event.GUID, event.CreatedAt = uuid.New().String(), time.Now().UTC()
+
+		data := map[string]any{
+			"event_id":   event.GUID,
+			"source":     outboxSource,
+			"topic":      event.Topic,
+			"type":       event.Type,
+			"key":        event.Key,
+			"payload":    string(payload),
+			"created_at": event.CreatedAt,
+		}
+		query, args, err := db.Sq.Builder.Insert(outboxTableName).SetMap(data).ToSql()
+		if err != nil {
+			return db.ErrSQLBuild(err, fmt.Sprintf("%s %s", outboxTableName, "create"))
+		}
+
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return db.Error(err)
+		}
+
+		body, err := json.Marshal(entity.WebhookPayload{
+			EventID:   event.GUID,
+			EventType: event.Type,
+			CreatedAt: event.CreatedAt,
+			Data:      payload,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal %s webhook payload: %w", event.Type, err)
+		}
+		if _, err = tx.Exec(ctx,
+			`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at, created_at, updated_at)
+				SELECT id, $1::UUID, $2::VARCHAR, $3::JSONB, $4::TIMESTAMPTZ, $4, $4 FROM webhook_subscriptions
+				WHERE active AND deleted_at IS NULL AND $2::VARCHAR = ANY(event_types)`,
+			event.GUID, event.Type, string(body), event.CreatedAt,
+		); err != nil {
+			return db.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func jobEvent(eventType string, job *entity.Job) *entity.Event {
+	return &entity.Event{
+		Topic: entity.TopicJobs,
+		Type:  eventType,
+		Key:   job.GUID,
+		Data: entity.JobEvent{
+			JobID:     job.GUID,
+			CompanyID: job.CompanyID,
+			Name:      job.Name,
+			Status:    job.Status,
+			At:        job.UpdatedAt,
+		},
+	}
+}
+
+// createdEvents are the events of a new job, a job created published is published too
+func createdEvents(job *entity.Job) []*entity.Event {
+	events := []*entity.Event{jobEvent(entity.EventJobCreated, job)}
+	if job.Status == entity.JobStatusPublished {
+		events = append(events, jobEvent(entity.EventJobPublished, job))
+	}
+	return events
+}
+
+// statusEvent is the event of a job entering the status, none for the other statuses
+func statusEvent(job *entity.Job) *entity.Event {
+	switch job.Status {
+	case entity.JobStatusPublished:
+		return jobEvent(entity.EventJobPublished, job)
+	case entity.JobStatusClosed:
+		return jobEvent(entity.EventJobClosed, job)
+	}
+	return nil
+}
+
+func clientJobEvent(eventType string, clientJob *entity.ClientJob) *entity.Event {
+	data := entity.ClientJobEvent{
+		ClientID: clientJob.ClientID,
+		JobID:    clientJob.JobID,
+		At:       clientJob.UpdatedAt,
+	}
+	if !clientJob.StartDate.IsZero() {
+		data.StartDate = &clientJob.StartDate
+	}
+	if !clientJob.EndDate.IsZero() {
+		data.EndDate = &clientJob.EndDate
+	}
+	return &entity.Event{
+		Topic: entity.TopicClientJobs,
+		Type:  eventType,
+		Key:   clientJob.ClientID,
+		Data:  data,
+	}
+}
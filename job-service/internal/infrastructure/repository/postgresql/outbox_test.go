@@ -0,0 +1,100 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"job-service/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestRelayEventsStopsAtFirstFailure(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewOutboxRepo(db)
+
+	// two keys, the events of each have to be published in the order they were written
+	keys := []string{uuid.NewString(), uuid.NewString()}
+	t.Cleanup(func() {
+		if _, err := db.Exec(ctx, `DELETE FROM outbox WHERE key = ANY($1)`, keys); err != nil {
+			t.Error(err)
+		}
+	})
+	types := []string{"test.0", "test.1", "test.2", "test.3"}
+	events := make([]*entity.Event, 0, len(types))
+	for i, eventType := range types {
+		events = append(events, &entity.Event{Topic: "test", Type: eventType, Key: keys[i%2], Data: map[string]int{"n": i}})
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = writeEvents(ctx, db, tx, events...); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		published []string
+		failing   = "test.1"
+		errBroker = errors.New("broker is down")
+	)
+	publish := func(_ context.Context, event *entity.Event) error {
+		if event.Key != keys[0] && event.Key != keys[1] {
+			return nil
+		}
+		if event.Type == failing {
+			return errBroker
+		}
+		published = append(published, event.Type)
+		return nil
+	}
+
+	// the failed event stops the pass, test.2 and test.3 wait for it
+	if _, err = repo.RelayEvents(ctx, 1000, publish); !errors.Is(err, errBroker) {
+		t.Fatalf("RelayEvents = %v, want the broker error", err)
+	}
+	if want := []string{"test.0"}; !reflect.DeepEqual(published, want) {
+		t.Errorf("published %v, want %v", published, want)
+	}
+	var (
+		attempts  int
+		lastError string
+	)
+	if err = db.QueryRow(ctx,
+		`SELECT attempts, last_error FROM outbox WHERE key = $1 AND type = $2 AND published_at IS NULL`,
+		keys[1], failing,
+	).Scan(&attempts, &lastError); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 1 || lastError != errBroker.Error() {
+		t.Errorf("failed event has %d attempts and error %q, want 1 and %q", attempts, lastError, errBroker)
+	}
+
+	failing = ""
+	if _, err = repo.RelayEvents(ctx, 1000, publish); err != nil {
+		t.Fatal(err)
+	}
+	if want := types; !reflect.DeepEqual(published, want) {
+		t.Errorf("published %v, want %v", published, want)
+	}
+
+	// everything is published, a pass publishes nothing of the keys again
+	if _, err = repo.RelayEvents(ctx, 1000, publish); err != nil || len(published) != len(types) {
+		t.Errorf("third pass = %v, published %v", err, published)
+	}
+
+	pruned, err := repo.PruneEvents(ctx, time.Now().UTC().Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned < uint64(len(types)) {
+		t.Errorf("pruned %d events, want at least %d", pruned, len(types))
+	}
+}
@@ -0,0 +1,630 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	sagaTableName       = "sagas"
+	sagaChangeTableName = "saga_changes"
+	sagasSpanRepoPrefix = "sagasRepo"
+
+	sagaChangeAssignment  = "assignment"
+	sagaChangeApplication = "application"
+)
+
+// assignmentChange is what EndAssignments did to an assignment, an assignment that had not
+// started yet is deleted rather than ended
+type assignmentChange struct {
+	EndDate *time.Time `json:"end_date"`
+	EndedAt time.Time  `json:"ended_at"`
+	Deleted bool       `json:"deleted"`
+}
+
+type applicationChange struct {
+	Status string `json:"status"`
+}
+
+var sagaColumns = []string{
+	"id",
+	"kind",
+	"entity_id",
+	"status",
+	"step",
+	"attempts",
+	"next_attempt_at",
+	"last_error",
+	"actor",
+	"created_at",
+	"updated_at",
+}
+
+type sagaRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewSagasRepo(db *postgres.PostgresDB) repository.Sagas {
+	return &sagaRepo{
+		db: db,
+	}
+}
+
+func (p sagaRepo) CreateSaga(ctx context.Context, saga *entity.Saga) error {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "CreateSaga")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Insert(sagaTableName).
+		SetMap(map[string]any{
+			"id":              saga.GUID,
+			"kind":            saga.Kind,
+			"entity_id":       saga.EntityID,
+			"status":          saga.Status,
+			"step":            saga.Step,
+			"attempts":        saga.Attempts,
+			"next_attempt_at": saga.NextAttemptAt,
+			"last_error":      saga.LastError,
+			"actor":           saga.Actor,
+			"created_at":      saga.CreatedAt,
+			"updated_at":      saga.UpdatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaTableName, "create"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p sagaRepo) SaveSaga(ctx context.Context, saga *entity.Saga) error {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "SaveSaga")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Update(sagaTableName).
+		SetMap(map[string]any{
+			"status":          saga.Status,
+			"step":            saga.Step,
+			"attempts":        saga.Attempts,
+			"next_attempt_at": saga.NextAttemptAt,
+			"last_error":      saga.LastError,
+			"updated_at":      saga.UpdatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", saga.GUID)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaTableName, "update"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// ClaimDueSagas takes the unfinished sagas of the kind that are due and moves their next attempt
+// a lease ahead, so no other instance runs them meanwhile. A saga whose instance died while it
+// ran is taken again once the lease is over.
+func (p sagaRepo) ClaimDueSagas(ctx context.Context, kind string, now time.Time, lease time.Duration, limit uint64) ([]*entity.Saga, error) {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "ClaimDueSagas")
+	defer span.End()
+
+	due := p.db.Sq.Builder.
+		Select("id").
+		From(sagaTableName).
+		Where(p.db.Sq.Equal("kind", kind)).
+		Where(p.db.Sq.Expr("status IN (?, ?)", entity.SagaRunning, entity.SagaCompensating)).
+		Where(p.db.Sq.Expr("next_attempt_at <= ?", now)).
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := p.db.Sq.Builder.
+		Update(sagaTableName).
+		Set("next_attempt_at", now.Add(lease)).
+		Where(due.Prefix("id IN (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(sagaColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaTableName, "claim"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	sagas := make([]*entity.Saga, 0)
+	for rows.Next() {
+		var saga entity.Saga
+		if err = rows.Scan(sagaScanArgs(&saga)...); err != nil {
+			return nil, p.db.Error(err)
+		}
+		sagas = append(sagas, &saga)
+	}
+
+	return sagas, p.db.Error(rows.Err())
+}
+
+func sagaScanArgs(saga *entity.Saga) []any {
+	return []any{
+		&saga.GUID,
+		&saga.Kind,
+		&saga.EntityID,
+		&saga.Status,
+		&saga.Step,
+		&saga.Attempts,
+		&saga.NextAttemptAt,
+		&saga.LastError,
+		&saga.Actor,
+		&saga.CreatedAt,
+		&saga.UpdatedAt,
+	}
+}
+
+func (p sagaRepo) EndAssignments(ctx context.Context, sagaID string, filter map[string]string, now time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "EndAssignments")
+	defer span.End()
+
+	now = now.UTC().Truncate(time.Microsecond)
+	queryBuilder := p.db.Sq.Builder.
+		Select("id", "client_id", "job_id", "start_date", "end_date").
+		From(clientJobTableName).
+		Where("deleted_at IS NULL").
+		Where(p.db.Sq.Expr("(end_date IS NULL OR end_date > ?)", now))
+	for key, value := range filter {
+		switch key {
+		case "client_id", "job_id":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+	query, args, err := queryBuilder.Suffix("FOR UPDATE").ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientJobTableName, "end"))
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	clientJobs := make([]*entity.ClientJob, 0)
+	for rows.Next() {
+		var (
+			clientJob entity.ClientJob
+			endDate   *time.Time
+		)
+		if err = rows.Scan(&clientJob.GUID, &clientJob.ClientID, &clientJob.JobID, &clientJob.StartDate, &endDate); err != nil {
+			rows.Close()
+			return 0, p.db.Error(err)
+		}
+		if endDate != nil {
+			clientJob.EndDate = *endDate
+		}
+		clientJobs = append(clientJobs, &clientJob)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	events := make([]*entity.Event, 0, len(clientJobs))
+	for _, clientJob := range clientJobs {
+		change := assignmentChange{EndedAt: now, Deleted: !clientJob.StartDate.Before(now)}
+		if !clientJob.EndDate.IsZero() {
+			change.EndDate = &clientJob.EndDate
+		}
+		clauses := map[string]any{"updated_at": now}
+		if change.Deleted {
+			clauses["deleted_at"] = now
+		} else {
+			clauses["end_date"] = now
+		}
+		if err = p.changeInSaga(ctx, tx, sagaID, sagaChangeAssignment, clientJob.GUID, change, clientJobTableName, clauses); err != nil {
+			return 0, err
+		}
+
+		clientJob.EndDate, clientJob.UpdatedAt = now, now
+		events = append(events, clientJobEvent(entity.EventClientUnassigned, clientJob))
+	}
+
+	if err = writeEvents(ctx, p.db, tx, events...); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(len(events)), nil
+}
+
+// ReopenAssignments puts back the assignments the saga ended, the ones changed since are left
+// as they are. An assignment overlapping one made since stays ended, reopening it would break
+// client_jobs_no_overlap and failing would leave the rest of the saga uncompensated.
+func (p sagaRepo) ReopenAssignments(ctx context.Context, sagaID string, now time.Time) (changed, overlapping uint64, err error) {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "ReopenAssignments")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("c.id", "c.client_id", "c.job_id", "c.start_date", "c.end_date", "c.deleted_at", "s.previous::TEXT").
+		From(sagaChangeTableName + " s").
+		Join(clientJobTableName + " c ON c.id = s.entity_id").
+		Where(p.db.Sq.Equal("s.saga_id", sagaID)).
+		Where(p.db.Sq.Equal("s.entity", sagaChangeAssignment)).
+		Suffix("FOR UPDATE OF c").
+		ToSql()
+	if err != nil {
+		return 0, 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", clientJobTableName, "reopen"))
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	type reopened struct {
+		clientJob entity.ClientJob
+		endDate   *time.Time
+		deletedAt *time.Time
+		change    assignmentChange
+	}
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, 0, p.db.Error(err)
+	}
+	changes := make([]*reopened, 0)
+	for rows.Next() {
+		var (
+			row      reopened
+			previous string
+		)
+		if err = rows.Scan(
+			&row.clientJob.GUID,
+			&row.clientJob.ClientID,
+			&row.clientJob.JobID,
+			&row.clientJob.StartDate,
+			&row.endDate,
+			&row.deletedAt,
+			&previous,
+		); err != nil {
+			rows.Close()
+			return 0, 0, p.db.Error(err)
+		}
+		if err = json.Unmarshal([]byte(previous), &row.change); err != nil {
+			rows.Close()
+			return 0, 0, err
+		}
+		changes = append(changes, &row)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, 0, p.db.Error(err)
+	}
+
+	events := make([]*entity.Event, 0, len(changes))
+	for _, row := range changes {
+		untouched := row.deletedAt == nil && row.endDate != nil && row.endDate.Equal(row.change.EndedAt)
+		if row.change.Deleted {
+			untouched = row.deletedAt != nil && row.deletedAt.Equal(row.change.EndedAt)
+		}
+		if untouched {
+			if _, err = tx.Exec(ctx, "SAVEPOINT reopen_assignment"); err != nil {
+				return 0, 0, p.db.Error(err)
+			}
+			clauses := map[string]any{"updated_at": now, "deleted_at": nil, "end_date": row.change.EndDate}
+			err = p.restoreInSaga(ctx, tx, sagaID, sagaChangeAssignment, row.clientJob.GUID, clientJobTableName, clauses)
+			if errors.Is(err, entity.ErrorConflict) {
+				if _, err = tx.Exec(ctx, "ROLLBACK TO SAVEPOINT reopen_assignment"); err != nil {
+					return 0, 0, p.db.Error(err)
+				}
+				if err = p.forgetSagaChange(ctx, tx, sagaID, sagaChangeAssignment, row.clientJob.GUID); err != nil {
+					return 0, 0, err
+				}
+				overlapping++
+				continue
+			}
+			if err != nil {
+				return 0, 0, err
+			}
+
+			clientJob := row.clientJob
+			if row.change.EndDate != nil {
+				clientJob.EndDate = *row.change.EndDate
+			}
+			clientJob.UpdatedAt = now
+			events = append(events, clientJobEvent(entity.EventClientHired, &clientJob))
+			continue
+		}
+		if err = p.forgetSagaChange(ctx, tx, sagaID, sagaChangeAssignment, row.clientJob.GUID); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if err = writeEvents(ctx, p.db, tx, events...); err != nil {
+		return 0, 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, 0, p.db.Error(err)
+	}
+
+	return uint64(len(events)), overlapping, nil
+}
+
+func (p sagaRepo) CloseApplications(ctx context.Context, sagaID, jobID, actor string, now time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "CloseApplications")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("id", "status").
+		From(applicationTableName).
+		Where(p.db.Sq.Equal("job_id", jobID)).
+		Where(p.db.Sq.Expr("status IN (?, ?, ?, ?)",
+			entity.ApplicationStatusApplied,
+			entity.ApplicationStatusScreening,
+			entity.ApplicationStatusInterview,
+			entity.ApplicationStatusOffer,
+		)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", applicationTableName, "close"))
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	applications := make([]*entity.JobApplication, 0)
+	for rows.Next() {
+		var application entity.JobApplication
+		if err = rows.Scan(&application.GUID, &application.Status); err != nil {
+			rows.Close()
+			return 0, p.db.Error(err)
+		}
+		applications = append(applications, &application)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	for _, application := range applications {
+		clauses := map[string]any{"status": entity.ApplicationStatusRejected, "updated_at": now}
+		err = p.changeInSaga(ctx, tx, sagaID, sagaChangeApplication, application.GUID,
+			applicationChange{Status: application.Status}, applicationTableName, clauses)
+		if err != nil {
+			return 0, err
+		}
+		err = p.addApplicationHistory(ctx, tx, &entity.ApplicationStatusChange{
+			ApplicationID: application.GUID,
+			FromStatus:    application.Status,
+			ToStatus:      entity.ApplicationStatusRejected,
+			Actor:         actor,
+			Note:          "job deleted",
+			CreatedAt:     now,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return uint64(len(applications)), nil
+}
+
+// ReopenApplications puts back the status of the applications the saga rejected which are still
+// rejected
+func (p sagaRepo) ReopenApplications(ctx context.Context, sagaID, actor string, now time.Time) (uint64, error) {
+	ctx, span := otlp.Start(ctx, sagasSpanRepoPrefix+"_grpc-repository", "ReopenApplications")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("a.id", "a.status", "s.previous::TEXT").
+		From(sagaChangeTableName + " s").
+		Join(applicationTableName + " a ON a.id = s.entity_id").
+		Where(p.db.Sq.Equal("s.saga_id", sagaID)).
+		Where(p.db.Sq.Equal("s.entity", sagaChangeApplication)).
+		Suffix("FOR UPDATE OF a").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", applicationTableName, "reopen"))
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	changes := make(map[*entity.JobApplication]applicationChange)
+	for rows.Next() {
+		var (
+			application entity.JobApplication
+			change      applicationChange
+			previous    string
+		)
+		if err = rows.Scan(&application.GUID, &application.Status, &previous); err != nil {
+			rows.Close()
+			return 0, p.db.Error(err)
+		}
+		if err = json.Unmarshal([]byte(previous), &change); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		changes[&application] = change
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	var reopened uint64
+	for application, change := range changes {
+		if application.Status != entity.ApplicationStatusRejected {
+			if err = p.forgetSagaChange(ctx, tx, sagaID, sagaChangeApplication, application.GUID); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		clauses := map[string]any{"status": change.Status, "updated_at": now}
+		if err = p.restoreInSaga(ctx, tx, sagaID, sagaChangeApplication, application.GUID, applicationTableName, clauses); err != nil {
+			return 0, err
+		}
+		err = p.addApplicationHistory(ctx, tx, &entity.ApplicationStatusChange{
+			ApplicationID: application.GUID,
+			FromStatus:    entity.ApplicationStatusRejected,
+			ToStatus:      change.Status,
+			Actor:         actor,
+			Note:          "job deletion undone",
+			CreatedAt:     now,
+		})
+		if err != nil {
+			return 0, err
+		}
+		reopened++
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return reopened, nil
+}
+
+// changeInSaga updates the row and keeps its previous values for the compensation, a row the
+// saga already changed keeps the values from before the first change
+func (p sagaRepo) changeInSaga(ctx context.Context, tx pgx.Tx, sagaID, kind, guid string, previous any, tableName string, clauses map[string]any) error {
+	data, err := json.Marshal(previous)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Insert(sagaChangeTableName).
+		SetMap(map[string]any{
+			"saga_id":   sagaID,
+			"entity":    kind,
+			"entity_id": guid,
+			"previous":  string(data),
+		}).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaChangeTableName, "create"))
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	query, args, err = p.db.Sq.Builder.
+		Update(tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", guid)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", tableName, "update"))
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// restoreInSaga puts the previous values back and forgets the change, so a repeated
+// compensation doesn't restore it twice
+func (p sagaRepo) restoreInSaga(ctx context.Context, tx pgx.Tx, sagaID, kind, guid, tableName string, clauses map[string]any) error {
+	query, args, err := p.db.Sq.Builder.
+		Update(tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", guid)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", tableName, "restore"))
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return p.forgetSagaChange(ctx, tx, sagaID, kind, guid)
+}
+
+func (p sagaRepo) forgetSagaChange(ctx context.Context, tx pgx.Tx, sagaID, kind, guid string) error {
+	query, args, err := p.db.Sq.Builder.
+		Delete(sagaChangeTableName).
+		Where(p.db.Sq.Equal("saga_id", sagaID)).
+		Where(p.db.Sq.Equal("entity", kind)).
+		Where(p.db.Sq.Equal("entity_id", guid)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", sagaChangeTableName, "delete"))
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p sagaRepo) addApplicationHistory(ctx context.Context, tx pgx.Tx, change *entity.ApplicationStatusChange) error {
+	query, args, err := p.db.Sq.Builder.
+		Insert(applicationHistoryTableName).
+		SetMap(map[string]any{
+			"id":             uuid.New().String(),
+			"application_id": change.ApplicationID,
+			"from_status":    change.FromStatus,
+			"to_status":      change.ToStatus,
+			"actor":          change.Actor,
+			"note":           change.Note,
+			"created_at":     change.CreatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, applicationHistoryTableName+" create")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"job-service/internal/entity"
+
+	"github.com/google/uuid"
+)
+
+func TestReopenAssignmentsOverlap(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	repo := NewSagasRepo(db)
+
+	var (
+		clientID, jobID = uuid.NewString(), uuid.NewString()
+		endedID         = uuid.NewString()
+		now             = time.Now().UTC().Truncate(time.Microsecond)
+	)
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := db.Exec(ctx, query, args...); err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+	}
+	exec(`INSERT INTO clients (id, first_name, last_name, email, password, refresh)
+		VALUES ($1, 'Test', 'Client', $1 || '@example.com', '', '')`, clientID)
+	exec(`INSERT INTO jobs (id, name, level, location_type, employment_type, address)
+		VALUES ($1, 'Test job', 'Junior', 'Remote', 'Full-Time', '')`, jobID)
+	exec(`INSERT INTO client_jobs (id, client_id, job_id, start_date) VALUES ($1, $2, $3, $4)`,
+		endedID, clientID, jobID, now.AddDate(0, 0, -10))
+
+	saga := &entity.Saga{
+		GUID:          uuid.NewString(),
+		Kind:          entity.SagaDeleteJob,
+		EntityID:      jobID,
+		Status:        entity.SagaRunning,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	t.Cleanup(func() {
+		exec(`DELETE FROM saga_changes WHERE saga_id = $1`, saga.GUID)
+		exec(`DELETE FROM sagas WHERE id = $1`, saga.GUID)
+		exec(`DELETE FROM client_jobs WHERE client_id = $1`, clientID)
+		exec(`DELETE FROM jobs WHERE id = $1`, jobID)
+		exec(`DELETE FROM clients WHERE id = $1`, clientID)
+	})
+	if err := repo.CreateSaga(ctx, saga); err != nil {
+		t.Fatalf("CreateSaga: %v", err)
+	}
+
+	if ended, err := repo.EndAssignments(ctx, saga.GUID, map[string]string{"job_id": jobID}, now); err != nil || ended != 1 {
+		t.Fatalf("EndAssignments = %d, %v, want 1", ended, err)
+	}
+	// the client is assigned to the job again before the saga compensates
+	exec(`INSERT INTO client_jobs (client_id, job_id, start_date) VALUES ($1, $2, $3)`, clientID, jobID, now.Add(time.Hour))
+
+	reopened, overlapping, err := repo.ReopenAssignments(ctx, saga.GUID, now)
+	if err != nil || reopened != 0 || overlapping != 1 {
+		t.Fatalf("ReopenAssignments = %d, %d, %v, want 0, 1, nil", reopened, overlapping, err)
+	}
+
+	var endDate *time.Time
+	if err = db.QueryRow(ctx, `SELECT end_date FROM client_jobs WHERE id = $1`, endedID).Scan(&endDate); err != nil {
+		t.Fatal(err)
+	}
+	if endDate == nil {
+		t.Error("the overlapping assignment was reopened")
+	}
+
+	// the change is forgotten, compensating again is a no-op
+	if reopened, overlapping, err = repo.ReopenAssignments(ctx, saga.GUID, now); err != nil || reopened+overlapping != 0 {
+		t.Errorf("second ReopenAssignments = %d, %d, %v, want 0, 0, nil", reopened, overlapping, err)
+	}
+}
@@ -0,0 +1,474 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+	"time"
+)
+
+const (
+	savedSearchTableName        = "saved_searches"
+	savedSearchMatchTableName   = "saved_search_matches"
+	savedSearchesSpanRepoPrefix = "savedSearchesRepo"
+)
+
+type savedSearchRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewSavedSearchesRepo(db *postgres.PostgresDB) repository.SavedSearches {
+	return &savedSearchRepo{
+		tableName: savedSearchTableName,
+		db:        db,
+	}
+}
+
+func (p *savedSearchRepo) savedSearchesSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(
+			"id",
+			"client_id",
+			"name",
+			"filters",
+			"frequency",
+			"last_sent_at",
+			"created_at",
+			"updated_at",
+		).From(p.tableName)
+}
+
+func (p savedSearchRepo) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "CreateSavedSearch")
+	defer span.End()
+
+	filters, err := json.Marshal(search.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Insert(p.tableName).
+		SetMap(map[string]any{
+			"id":         search.GUID,
+			"client_id":  search.ClientID,
+			"name":       search.Name,
+			"filters":    squirrel.Expr("?::JSONB", string(filters)),
+			"frequency":  search.Frequency,
+			"created_at": search.CreatedAt,
+			"updated_at": search.UpdatedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return search, nil
+}
+
+// UpdateSavedSearch changes a search of the client, the time of its last alert stays
+func (p savedSearchRepo) UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "UpdateSavedSearch")
+	defer span.End()
+
+	filters, err := json.Marshal(search.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"name":       search.Name,
+			"filters":    squirrel.Expr("?::JSONB", string(filters)),
+			"frequency":  search.Frequency,
+			"updated_at": search.UpdatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", search.GUID)).
+		Where(p.db.Sq.Equal("client_id", search.ClientID)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING last_sent_at, created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" update")
+	}
+
+	var lastSentAt sql.NullTime
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&lastSentAt, &search.CreatedAt); err != nil {
+		return nil, p.db.Error(err)
+	}
+	search.LastSentAt = lastSentAt.Time
+
+	return search, nil
+}
+
+func (p savedSearchRepo) DeleteSavedSearch(ctx context.Context, clientID, guid string) error {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "DeleteSavedSearch")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"deleted_at": time.Now().Format(time.RFC3339),
+		}).
+		Where(p.db.Sq.Equal("id", guid)).
+		Where(p.db.Sq.Equal("client_id", clientID)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+
+	commandTag, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (p savedSearchRepo) GetSavedSearches(ctx context.Context, clientID string) ([]*entity.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "GetSavedSearches")
+	defer span.End()
+
+	return p.list(ctx, p.savedSearchesSelectQueryPrefix().
+		Where(p.db.Sq.Equal("client_id", clientID)).
+		Where("deleted_at IS NULL").
+		OrderBy("created_at"))
+}
+
+func (p savedSearchRepo) CountSavedSearches(ctx context.Context, clientID string) (uint64, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "CountSavedSearches")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("COUNT(*)").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("client_id", clientID)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, p.tableName+" count")
+	}
+
+	var count uint64
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return count, nil
+}
+
+func (p savedSearchRepo) GetAllSavedSearches(ctx context.Context) ([]*entity.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "GetAllSavedSearches")
+	defer span.End()
+
+	return p.list(ctx, p.savedSearchesSelectQueryPrefix().
+		Where("deleted_at IS NULL"))
+}
+
+// GetUnevaluatedJobs returns the published jobs not yet matched against the saved searches, oldest first
+func (p savedSearchRepo) GetUnevaluatedJobs(ctx context.Context, limit uint64) ([]*entity.Job, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "GetUnevaluatedJobs")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"name",
+			"COALESCE(salary_min::TEXT, '')",
+			"COALESCE(salary_max::TEXT, '')",
+			"COALESCE(currency, '')",
+			"COALESCE(pay_period, '')",
+			"level",
+			"location_type",
+			"employment_type",
+			"COALESCE(company_id::TEXT, '')",
+			"skills",
+			"latitude",
+			"longitude",
+			"ARRAY(SELECT category_id::TEXT FROM job_categories WHERE job_categories.job_id = jobs.id ORDER BY category_id)",
+			"ARRAY(SELECT tags.name FROM job_tags JOIN tags ON tags.slug = job_tags.tag WHERE job_tags.job_id = jobs.id ORDER BY tags.slug)",
+		).
+		From(jobTableName).
+		Where(p.db.Sq.Equal("status", entity.JobStatusPublished)).
+		Where("alerts_evaluated_at IS NULL").
+		Where("deleted_at IS NULL").
+		OrderBy("created_at").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, jobTableName+" unevaluated")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	jobs := make([]*entity.Job, 0)
+	for rows.Next() {
+		var job entity.Job
+		if err = rows.Scan(
+			&job.GUID,
+			&job.Name,
+			&job.SalaryMin,
+			&job.SalaryMax,
+			&job.Currency,
+			&job.PayPeriod,
+			&job.Level,
+			&job.LocationType,
+			&job.EmploymentType,
+			&job.CompanyID,
+			&job.Skills,
+			&job.Latitude,
+			&job.Longitude,
+			&job.CategoryIDs,
+			&job.Tags,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, p.db.Error(rows.Err())
+}
+
+// SaveSearchMatches queues the matches and marks the jobs as evaluated in one transaction,
+// a match queued before is kept as it is
+func (p savedSearchRepo) SaveSearchMatches(ctx context.Context, jobIDs []string, matches []*entity.SearchMatch, now time.Time) error {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "SaveSearchMatches")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if len(matches) != 0 {
+		insert := p.db.Sq.Builder.
+			Insert(savedSearchMatchTableName).
+			Columns("saved_search_id", "job_id", "created_at")
+		for _, match := range matches {
+			insert = insert.Values(match.SavedSearchID, match.Job.GUID, now)
+		}
+
+		query, args, err := insert.Suffix("ON CONFLICT DO NOTHING").ToSql()
+		if err != nil {
+			return p.db.ErrSQLBuild(err, savedSearchMatchTableName+" create")
+		}
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return p.db.Error(err)
+		}
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Update(jobTableName).
+		Set("alerts_evaluated_at", now).
+		Where("id = ANY(?)", jobIDs).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, jobTableName+" evaluated")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return p.db.Error(tx.Commit(ctx))
+}
+
+// GetDueSavedSearches returns the searches with unsent matches whose alert is due,
+// instant ones right away and digests once their period passed since the last one
+func (p savedSearchRepo) GetDueSavedSearches(ctx context.Context, now time.Time) ([]*entity.SavedSearch, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "GetDueSavedSearches")
+	defer span.End()
+
+	due := squirrel.Or{}
+	for frequency, period := range entity.AlertPeriods {
+		due = append(due, squirrel.And{
+			p.db.Sq.Equal("frequency", frequency),
+			squirrel.Expr("COALESCE(last_sent_at, created_at) <= ?", now.Add(-period)),
+		})
+	}
+
+	return p.list(ctx, p.savedSearchesSelectQueryPrefix().
+		Where("deleted_at IS NULL").
+		Where(due).
+		Where("EXISTS (SELECT 1 FROM "+savedSearchMatchTableName+" m WHERE m.saved_search_id = "+p.tableName+".id AND m.sent_at IS NULL)").
+		OrderBy("created_at"))
+}
+
+// GetUnsentMatches returns the matches queued until then, jobs no longer published are left out
+func (p savedSearchRepo) GetUnsentMatches(ctx context.Context, savedSearchID string, until time.Time) ([]*entity.SearchMatch, error) {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "GetUnsentMatches")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"m.saved_search_id",
+			"m.created_at",
+			"j.id",
+			"j.name",
+			"COALESCE(j.salary_min::TEXT, '')",
+			"COALESCE(j.salary_max::TEXT, '')",
+			"COALESCE(j.currency, '')",
+			"COALESCE(j.pay_period, '')",
+			"j.level",
+			"j.location_type",
+			"j.employment_type",
+			"j.address",
+			"COALESCE(j.company_id::TEXT, '')",
+			"COALESCE(c.name, '')",
+		).
+		From(savedSearchMatchTableName+" m").
+		Join(jobTableName+" j ON j.id = m.job_id").
+		LeftJoin("companies c ON c.id = j.company_id").
+		Where(p.db.Sq.Equal("m.saved_search_id", savedSearchID)).
+		Where("m.sent_at IS NULL").
+		Where("m.created_at <= ?", until).
+		Where(p.db.Sq.Equal("j.status", entity.JobStatusPublished)).
+		Where("j.deleted_at IS NULL").
+		OrderBy("m.created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, savedSearchMatchTableName+" unsent")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	matches := make([]*entity.SearchMatch, 0)
+	for rows.Next() {
+		var (
+			match entity.SearchMatch
+			job   entity.Job
+		)
+		if err = rows.Scan(
+			&match.SavedSearchID,
+			&match.CreatedAt,
+			&job.GUID,
+			&job.Name,
+			&job.SalaryMin,
+			&job.SalaryMax,
+			&job.Currency,
+			&job.PayPeriod,
+			&job.Level,
+			&job.LocationType,
+			&job.EmploymentType,
+			&job.Address,
+			&job.CompanyID,
+			&job.Company,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		match.Job = &job
+
+		matches = append(matches, &match)
+	}
+
+	return matches, p.db.Error(rows.Err())
+}
+
+// MarkMatchesSent marks the matches queued until then as sent, including the ones of jobs closed
+// in the meantime, and records the time of the alert
+func (p savedSearchRepo) MarkMatchesSent(ctx context.Context, savedSearchID string, until, now time.Time) error {
+	ctx, span := otlp.Start(ctx, savedSearchesSpanRepoPrefix+"_grpc-repository", "MarkMatchesSent")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.db.Sq.Builder.
+		Update(savedSearchMatchTableName).
+		Set("sent_at", now).
+		Where(p.db.Sq.Equal("saved_search_id", savedSearchID)).
+		Where("sent_at IS NULL").
+		Where("created_at <= ?", until).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, savedSearchMatchTableName+" sent")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	query, args, err = p.db.Sq.Builder.
+		Update(p.tableName).
+		Set("last_sent_at", now).
+		Where(p.db.Sq.Equal("id", savedSearchID)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" sent")
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return p.db.Error(tx.Commit(ctx))
+}
+
+func (p savedSearchRepo) list(ctx context.Context, queryBuilder squirrel.SelectBuilder) ([]*entity.SavedSearch, error) {
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	searches := make([]*entity.SavedSearch, 0)
+	for rows.Next() {
+		var (
+			search     entity.SavedSearch
+			filters    []byte
+			lastSentAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&search.GUID,
+			&search.ClientID,
+			&search.Name,
+			&filters,
+			&search.Frequency,
+			&lastSentAt,
+			&search.CreatedAt,
+			&search.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if err = json.Unmarshal(filters, &search.Filter); err != nil {
+			return nil, err
+		}
+		search.LastSentAt = lastSentAt.Time
+
+		searches = append(searches, &search)
+	}
+
+	return searches, p.db.Error(rows.Err())
+}
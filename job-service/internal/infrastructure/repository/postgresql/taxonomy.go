@@ -0,0 +1,308 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+)
+
+const (
+	categoryTableName      = "categories"
+	tagTableName           = "tags"
+	taxonomySpanRepoPrefix = "taxonomyRepo"
+)
+
+// categoryJobCountsQuery counts the published jobs of every category together with the jobs
+// of its subcategories, a job in both a category and its subcategory is counted once
+const categoryJobCountsQuery = `
+WITH RECURSIVE tree AS (
+    SELECT id AS root_id, id FROM categories
+    UNION ALL
+    SELECT tree.root_id, categories.id FROM categories JOIN tree ON categories.parent_id = tree.id
+)
+SELECT tree.root_id, COUNT(DISTINCT jobs.id)
+FROM tree
+JOIN job_categories ON job_categories.category_id = tree.id
+JOIN jobs ON jobs.id = job_categories.job_id AND jobs.status = 'published' AND jobs.deleted_at IS NULL
+GROUP BY tree.root_id`
+
+type taxonomyRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewTaxonomyRepo(db *postgres.PostgresDB) repository.Taxonomy {
+	return &taxonomyRepo{
+		db: db,
+	}
+}
+
+func (p taxonomyRepo) CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "CreateCategory")
+	defer span.End()
+
+	data := map[string]any{
+		"id":         category.GUID,
+		"parent_id":  nullIfEmpty(category.ParentID),
+		"name":       category.Name,
+		"position":   category.Position,
+		"created_at": category.CreatedAt,
+		"updated_at": category.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(categoryTableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", categoryTableName, "create"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return category, nil
+}
+
+func (p taxonomyRepo) UpdateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "UpdateCategory")
+	defer span.End()
+
+	clauses := map[string]any{
+		"parent_id":  nullIfEmpty(category.ParentID),
+		"name":       category.Name,
+		"position":   category.Position,
+		"updated_at": category.UpdatedAt,
+	}
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(categoryTableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", category.GUID)).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, categoryTableName+" update")
+	}
+
+	if err = p.db.QueryRow(ctx, sqlStr, args...).Scan(&category.CreatedAt); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return category, nil
+}
+
+// DeleteCategory unlinks the jobs of the category, the usecase keeps categories with subcategories
+func (p taxonomyRepo) DeleteCategory(ctx context.Context, guid string) error {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "DeleteCategory")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(categoryTableName).
+		Where(p.db.Sq.Equal("id", guid)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, categoryTableName+" delete")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (p taxonomyRepo) GetCategories(ctx context.Context, withCounts bool) ([]*entity.Category, error) {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "GetCategories")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"COALESCE(parent_id::TEXT, '')",
+			"name",
+			"position",
+			"created_at",
+			"updated_at",
+		).
+		From(categoryTableName).
+		OrderBy("position", "name", "id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", categoryTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	categories := make([]*entity.Category, 0)
+	byID := make(map[string]*entity.Category)
+	for rows.Next() {
+		var category entity.Category
+		if err = rows.Scan(
+			&category.GUID,
+			&category.ParentID,
+			&category.Name,
+			&category.Position,
+			&category.CreatedAt,
+			&category.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		categories = append(categories, &category)
+		byID[category.GUID] = &category
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+	rows.Close()
+
+	if !withCounts || len(categories) == 0 {
+		return categories, nil
+	}
+
+	countRows, err := p.db.Query(ctx, categoryJobCountsQuery)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer countRows.Close()
+
+	for countRows.Next() {
+		var (
+			guid  string
+			count uint64
+		)
+		if err = countRows.Scan(&guid, &count); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if category, ok := byID[guid]; ok {
+			category.JobCount = count
+		}
+	}
+
+	return categories, p.db.Error(countRows.Err())
+}
+
+func (p taxonomyRepo) CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "CreateTag")
+	defer span.End()
+
+	data := map[string]any{
+		"slug":       tag.Slug,
+		"name":       tag.Name,
+		"created_at": tag.CreatedAt,
+		"updated_at": tag.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(tagTableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", tagTableName, "create"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return tag, nil
+}
+
+// UpdateTag renames the tag with the slug, a new slug is carried over to the jobs by the foreign key
+func (p taxonomyRepo) UpdateTag(ctx context.Context, slug string, tag *entity.Tag) (*entity.Tag, error) {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "UpdateTag")
+	defer span.End()
+
+	clauses := map[string]any{
+		"slug":       tag.Slug,
+		"name":       tag.Name,
+		"updated_at": tag.UpdatedAt,
+	}
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(tagTableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("slug", slug)).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, tagTableName+" update")
+	}
+
+	if err = p.db.QueryRow(ctx, sqlStr, args...).Scan(&tag.CreatedAt); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return tag, nil
+}
+
+// DeleteTag removes the tag from its jobs as well
+func (p taxonomyRepo) DeleteTag(ctx context.Context, slug string) error {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "DeleteTag")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Delete(tagTableName).
+		Where(p.db.Sq.Equal("slug", slug)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, tagTableName+" delete")
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	return nil
+}
+
+func (p taxonomyRepo) GetTags(ctx context.Context) ([]*entity.Tag, error) {
+	ctx, span := otlp.Start(ctx, taxonomySpanRepoPrefix+"_grpc-repository", "GetTags")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"slug",
+			"name",
+			`(SELECT COUNT(*) FROM job_tags JOIN jobs ON jobs.id = job_tags.job_id
+				WHERE job_tags.tag = tags.slug AND jobs.status = 'published' AND jobs.deleted_at IS NULL)`,
+			"created_at",
+			"updated_at",
+		).
+		From(tagTableName).
+		OrderBy("slug").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", tagTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	tags := make([]*entity.Tag, 0)
+	for rows.Next() {
+		var tag entity.Tag
+		if err = rows.Scan(
+			&tag.Slug,
+			&tag.Name,
+			&tag.JobCount,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		tags = append(tags, &tag)
+	}
+
+	return tags, p.db.Error(rows.Err())
+}
@@ -0,0 +1,534 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"job-service/internal/pkg/postgres"
+	"strings"
+	"time"
+)
+
+const (
+	webhookTableName         = "webhook_subscriptions"
+	webhookDeliveryTableName = "webhook_deliveries"
+	webhookAttemptTableName  = "webhook_attempts"
+	webhooksSpanRepoPrefix   = "webhooksRepo"
+)
+
+// webhookDeliveryColumns are selected and returned for a delivery together with its webhook
+var webhookDeliveryColumns = []string{
+	"id",
+	"subscription_id",
+	"event_id",
+	"event_type",
+	"payload",
+	"status",
+	"attempts",
+	"next_attempt_at",
+	"last_status_code",
+	"last_error",
+	"delivered_at",
+	"created_at",
+	"updated_at",
+	"(SELECT url FROM webhook_subscriptions WHERE webhook_subscriptions.id = webhook_deliveries.subscription_id)",
+	"(SELECT secret FROM webhook_subscriptions WHERE webhook_subscriptions.id = webhook_deliveries.subscription_id)",
+}
+
+type webhookRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewWebhooksRepo(db *postgres.PostgresDB) repository.Webhooks {
+	return &webhookRepo{
+		db: db,
+	}
+}
+
+func (p webhookRepo) CreateWebhook(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "CreateWebhook")
+	defer span.End()
+
+	data := map[string]any{
+		"id":          webhook.GUID,
+		"url":         webhook.URL,
+		"event_types": webhook.EventTypes,
+		"secret":      webhook.Secret,
+		"active":      webhook.Active,
+		"created_at":  webhook.CreatedAt,
+		"updated_at":  webhook.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(webhookTableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", webhookTableName, "create"))
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return webhook, nil
+}
+
+// UpdateWebhook keeps the secret when the webhook has none
+func (p webhookRepo) UpdateWebhook(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "UpdateWebhook")
+	defer span.End()
+
+	clauses := map[string]any{
+		"url":         webhook.URL,
+		"event_types": webhook.EventTypes,
+		"active":      webhook.Active,
+		"updated_at":  webhook.UpdatedAt,
+	}
+	if webhook.Secret != "" {
+		clauses["secret"] = webhook.Secret
+	}
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(webhookTableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", webhook.GUID)).
+		Where("deleted_at IS NULL").
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, webhookTableName+" update")
+	}
+
+	if err = p.db.QueryRow(ctx, sqlStr, args...).Scan(&webhook.CreatedAt); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook gives up the pending deliveries of the webhook, the delivery log is kept
+func (p webhookRepo) DeleteWebhook(ctx context.Context, guid string) error {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "DeleteWebhook")
+	defer span.End()
+
+	now := time.Now().UTC()
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(webhookTableName).
+		SetMap(map[string]any{
+			"deleted_at": now,
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("id", guid)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, webhookTableName+" delete")
+	}
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.ErrorNotFound
+	}
+
+	sqlStr, args, err = p.db.Sq.Builder.
+		Update(webhookDeliveryTableName).
+		SetMap(map[string]any{
+			"status":     entity.WebhookDeliveryDead,
+			"last_error": "webhook deleted",
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("subscription_id", guid)).
+		Where(p.db.Sq.Equal("status", entity.WebhookDeliveryPending)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, webhookDeliveryTableName+" give up")
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p webhookRepo) GetWebhook(ctx context.Context, guid string) (*entity.Webhook, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "GetWebhook")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"url",
+			"event_types",
+			"secret",
+			"active",
+			"created_at",
+			"updated_at",
+		).
+		From(webhookTableName).
+		Where(p.db.Sq.Equal("id", guid)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", webhookTableName, "get"))
+	}
+
+	var webhook entity.Webhook
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&webhook.GUID,
+		&webhook.URL,
+		&webhook.EventTypes,
+		&webhook.Secret,
+		&webhook.Active,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &webhook, nil
+}
+
+func (p webhookRepo) GetWebhooks(ctx context.Context) ([]*entity.Webhook, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "GetWebhooks")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"url",
+			"event_types",
+			"secret",
+			"active",
+			"created_at",
+			"updated_at",
+		).
+		From(webhookTableName).
+		Where("deleted_at IS NULL").
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", webhookTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]*entity.Webhook, 0)
+	for rows.Next() {
+		var webhook entity.Webhook
+		if err = rows.Scan(
+			&webhook.GUID,
+			&webhook.URL,
+			&webhook.EventTypes,
+			&webhook.Secret,
+			&webhook.Active,
+			&webhook.CreatedAt,
+			&webhook.UpdatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+
+	return webhooks, p.db.Error(rows.Err())
+}
+
+func (p webhookRepo) GetWebhookDeliveries(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "GetWebhookDeliveries")
+	defer span.End()
+
+	queryBuilder := p.db.Sq.Builder.Select(webhookDeliveryColumns...).From(webhookDeliveryTableName)
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		if value == "" {
+			continue
+		}
+		switch key {
+		case "webhook_id":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal("subscription_id", value))
+		case "status", "event_type":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+	queryBuilder = queryBuilder.OrderBy("created_at DESC", "id")
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", webhookDeliveryTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for rows.Next() {
+		var (
+			delivery    entity.WebhookDelivery
+			deliveredAt sql.NullTime
+		)
+		if err = rows.Scan(webhookDeliveryScanArgs(&delivery, &deliveredAt)...); err != nil {
+			return nil, p.db.Error(err)
+		}
+		delivery.DeliveredAt = deliveredAt.Time
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, p.db.Error(rows.Err())
+}
+
+func (p webhookRepo) GetWebhookDelivery(ctx context.Context, guid string) (*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "GetWebhookDelivery")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(webhookDeliveryColumns...).
+		From(webhookDeliveryTableName).
+		Where(p.db.Sq.Equal("id", guid)).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", webhookDeliveryTableName, "get"))
+	}
+
+	var (
+		delivery    entity.WebhookDelivery
+		deliveredAt sql.NullTime
+	)
+	if err = p.db.QueryRow(ctx, query, args...).Scan(webhookDeliveryScanArgs(&delivery, &deliveredAt)...); err != nil {
+		return nil, p.db.Error(err)
+	}
+	delivery.DeliveredAt = deliveredAt.Time
+
+	return &delivery, nil
+}
+
+func (p webhookRepo) GetWebhookAttempts(ctx context.Context, deliveryID string) ([]*entity.WebhookAttempt, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "GetWebhookAttempts")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"id",
+			"delivery_id",
+			"status_code",
+			"error",
+			"duration_ms",
+			"attempted_at",
+		).
+		From(webhookAttemptTableName).
+		Where(p.db.Sq.Equal("delivery_id", deliveryID)).
+		OrderBy("attempted_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", webhookAttemptTableName, "list"))
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*entity.WebhookAttempt, 0)
+	for rows.Next() {
+		var (
+			attempt    entity.WebhookAttempt
+			durationMS int64
+		)
+		if err = rows.Scan(
+			&attempt.ID,
+			&attempt.DeliveryID,
+			&attempt.StatusCode,
+			&attempt.Error,
+			&durationMS,
+			&attempt.AttemptedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		attempt.Duration = time.Duration(durationMS) * time.Millisecond
+
+		attempts = append(attempts, &attempt)
+	}
+
+	return attempts, p.db.Error(rows.Err())
+}
+
+// ClaimDueWebhookDeliveries takes the pending deliveries of active webhooks that are due and
+// moves their next attempt a lease ahead, so no other instance takes them while they are sent.
+// A delivery whose instance dies before saving the attempt is taken again once the lease is over.
+func (p webhookRepo) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "ClaimDueWebhookDeliveries")
+	defer span.End()
+
+	due := p.db.Sq.Builder.
+		Select("webhook_deliveries.id").
+		From(webhookDeliveryTableName).
+		Join("webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id").
+		Where(p.db.Sq.Equal("webhook_deliveries.status", entity.WebhookDeliveryPending)).
+		Where(p.db.Sq.Expr("webhook_deliveries.next_attempt_at <= ?", now)).
+		Where("webhook_subscriptions.active AND webhook_subscriptions.deleted_at IS NULL").
+		OrderBy("webhook_deliveries.next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE OF webhook_deliveries SKIP LOCKED")
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(webhookDeliveryTableName).
+		Set("next_attempt_at", now.Add(lease)).
+		Where(due.Prefix("id IN (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(webhookDeliveryColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, webhookDeliveryTableName+" claim")
+	}
+
+	rows, err := p.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for rows.Next() {
+		var (
+			delivery    entity.WebhookDelivery
+			deliveredAt sql.NullTime
+		)
+		if err = rows.Scan(webhookDeliveryScanArgs(&delivery, &deliveredAt)...); err != nil {
+			return nil, p.db.Error(err)
+		}
+		delivery.DeliveredAt = deliveredAt.Time
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, p.db.Error(rows.Err())
+}
+
+// SaveWebhookAttempt stores the outcome of an attempt on the delivery and adds it to the log
+func (p webhookRepo) SaveWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "SaveWebhookAttempt")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(webhookDeliveryTableName).
+		SetMap(map[string]any{
+			"status":           delivery.Status,
+			"attempts":         delivery.Attempts,
+			"next_attempt_at":  delivery.NextAttemptAt,
+			"last_status_code": delivery.LastStatusCode,
+			"last_error":       delivery.LastError,
+			"delivered_at":     nullIfZero(delivery.DeliveredAt),
+			"updated_at":       delivery.UpdatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", delivery.GUID)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, webhookDeliveryTableName+" update")
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	sqlStr, args, err = p.db.Sq.Builder.
+		Insert(webhookAttemptTableName).
+		SetMap(map[string]any{
+			"delivery_id":  delivery.GUID,
+			"status_code":  attempt.StatusCode,
+			"error":        attempt.Error,
+			"duration_ms":  attempt.Duration.Milliseconds(),
+			"attempted_at": attempt.AttemptedAt,
+		}).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, webhookAttemptTableName+" create")
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// RedeliverWebhook queues the delivery again with all its attempts, whatever its status
+func (p webhookRepo) RedeliverWebhook(ctx context.Context, guid string, now time.Time) (*entity.WebhookDelivery, error) {
+	ctx, span := otlp.Start(ctx, webhooksSpanRepoPrefix+"_grpc-repository", "RedeliverWebhook")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.
+		Update(webhookDeliveryTableName).
+		SetMap(map[string]any{
+			"status":          entity.WebhookDeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": now,
+			"updated_at":      now,
+		}).
+		Where(p.db.Sq.Equal("id", guid)).
+		Suffix("RETURNING " + strings.Join(webhookDeliveryColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, webhookDeliveryTableName+" redeliver")
+	}
+
+	var (
+		delivery    entity.WebhookDelivery
+		deliveredAt sql.NullTime
+	)
+	if err = p.db.QueryRow(ctx, sqlStr, args...).Scan(webhookDeliveryScanArgs(&delivery, &deliveredAt)...); err != nil {
+		return nil, p.db.Error(err)
+	}
+	delivery.DeliveredAt = deliveredAt.Time
+
+	return &delivery, nil
+}
+
+func webhookDeliveryScanArgs(delivery *entity.WebhookDelivery, deliveredAt *sql.NullTime) []any {
+	return []any{
+		&delivery.GUID,
+		&delivery.WebhookID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastStatusCode,
+		&delivery.LastError,
+		deliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+		&delivery.URL,
+		&delivery.Secret,
+	}
+}
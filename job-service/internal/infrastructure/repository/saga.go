@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"time"
+)
+
+type Sagas interface {
+	// CreateSaga is a conflict while a saga of the kind runs for the entity
+	CreateSaga(ctx context.Context, saga *entity.Saga) error
+	SaveSaga(ctx context.Context, saga *entity.Saga) error
+	ClaimDueSagas(ctx context.Context, kind string, now time.Time, lease time.Duration, limit uint64) ([]*entity.Saga, error)
+	// EndAssignments ends the current assignments of a client_id or a job_id, the ones yet to
+	// start are deleted. ReopenAssignments undoes what the saga ended, but for the assignments
+	// overlapping ones made since, which stay ended and are counted in overlapping.
+	EndAssignments(ctx context.Context, sagaID string, filter map[string]string, now time.Time) (uint64, error)
+	ReopenAssignments(ctx context.Context, sagaID string, now time.Time) (reopened, overlapping uint64, err error)
+	// CloseApplications rejects the open applications of the job, ReopenApplications undoes it
+	CloseApplications(ctx context.Context, sagaID, jobID, actor string, now time.Time) (uint64, error)
+	ReopenApplications(ctx context.Context, sagaID, actor string, now time.Time) (uint64, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"time"
+)
+
+type SavedSearches interface {
+	CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, clientID, guid string) error
+	GetSavedSearches(ctx context.Context, clientID string) ([]*entity.SavedSearch, error)
+	CountSavedSearches(ctx context.Context, clientID string) (uint64, error)
+	GetAllSavedSearches(ctx context.Context) ([]*entity.SavedSearch, error)
+	GetUnevaluatedJobs(ctx context.Context, limit uint64) ([]*entity.Job, error)
+	SaveSearchMatches(ctx context.Context, jobIDs []string, matches []*entity.SearchMatch, now time.Time) error
+	GetDueSavedSearches(ctx context.Context, now time.Time) ([]*entity.SavedSearch, error)
+	GetUnsentMatches(ctx context.Context, savedSearchID string, until time.Time) ([]*entity.SearchMatch, error)
+	MarkMatchesSent(ctx context.Context, savedSearchID string, until, now time.Time) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+)
+
+type Taxonomy interface {
+	CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	UpdateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	DeleteCategory(ctx context.Context, guid string) error
+	GetCategories(ctx context.Context, withCounts bool) ([]*entity.Category, error)
+	CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
+	UpdateTag(ctx context.Context, slug string, tag *entity.Tag) (*entity.Tag, error)
+	DeleteTag(ctx context.Context, slug string) error
+	GetTags(ctx context.Context) ([]*entity.Tag, error)
+}
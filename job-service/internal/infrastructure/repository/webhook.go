@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"time"
+)
+
+type Webhooks interface {
+	CreateWebhook(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, guid string) error
+	GetWebhook(ctx context.Context, guid string) (*entity.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*entity.Webhook, error)
+	GetWebhookDeliveries(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, guid string) (*entity.WebhookDelivery, error)
+	GetWebhookAttempts(ctx context.Context, deliveryID string) ([]*entity.WebhookAttempt, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*entity.WebhookDelivery, error)
+	SaveWebhookAttempt(ctx context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error
+	RedeliverWebhook(ctx context.Context, guid string, now time.Time) (*entity.WebhookDelivery, error)
+}
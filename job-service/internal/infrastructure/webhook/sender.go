@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"job-service/internal/entity"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// headers of a webhook request, the receiver checks the signature against its copy of the secret
+const (
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Sender posts a delivery to the URL of its webhook and returns the status code of the response,
+// anything but a 2xx response is an error
+type Sender interface {
+	Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error)
+}
+
+type httpSender struct {
+	client *http.Client
+}
+
+// NewHTTP gives every request the timeout, redirects are not followed
+func NewHTTP(timeout time.Duration) Sender {
+	return &httpSender{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (h *httpSender) Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "job-service-webhooks")
+	request.Header.Set(HeaderDelivery, delivery.GUID)
+	request.Header.Set(HeaderEvent, delivery.EventType)
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	// drained so the connection is reused, a receiver has nothing to tell beyond the status
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// Sign is the HMAC-SHA256 of "timestamp.body" with the secret as "sha256=<hex>", signing the
+// timestamp lets receivers reject replayed requests
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
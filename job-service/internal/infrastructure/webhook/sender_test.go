@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"job-service/internal/entity"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// computed independently with Python's hmac module
+	want := "sha256=db0fbf118882428977a33ea6fa6c92df01879a0560f78df7e7521a2eb9b149bd"
+	if got := Sign("whsec-0123456789abcdef", 1700000000, []byte(`{"event":"job.created"}`)); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+	if Sign("another-secret-value", 1700000000, []byte(`{"event":"job.created"}`)) == want {
+		t.Error("the signature doesn't depend on the secret")
+	}
+	if Sign("whsec-0123456789abcdef", 1700000001, []byte(`{"event":"job.created"}`)) == want {
+		t.Error("the signature doesn't depend on the timestamp")
+	}
+}
+
+func TestSend(t *testing.T) {
+	delivery := &entity.WebhookDelivery{
+		GUID:      "d1",
+		EventType: "job.created",
+		Payload:   []byte(`{"event":"job.created"}`),
+		Secret:    "whsec-0123456789abcdef",
+	}
+
+	// the receiver checks the request the way a subscriber would
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		switch {
+		case r.Method != http.MethodPost, r.Header.Get("Content-Type") != "application/json":
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case err != nil, time.Since(time.Unix(timestamp, 0)) > time.Minute:
+			w.WriteHeader(http.StatusBadRequest)
+		case r.Header.Get(HeaderSignature) != Sign(delivery.Secret, timestamp, body):
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Header.Get(HeaderDelivery) != "d1", r.Header.Get(HeaderEvent) != "job.created":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTP(time.Second)
+
+	delivery.URL = receiver.URL
+	if status, err := sender.Send(context.Background(), delivery); err != nil || status != http.StatusNoContent {
+		t.Errorf("Send = %d, %v, want 204", status, err)
+	}
+
+	// a receiver with another copy of the secret rejects the request, which is an error
+	forged := *delivery
+	forged.Secret = "another-secret-value"
+	if status, err := sender.Send(context.Background(), &forged); err == nil || status != http.StatusUnauthorized {
+		t.Errorf("Send with a wrong secret = %d, %v, want 401 and an error", status, err)
+	}
+}
+
+func TestSendNoRedirects(t *testing.T) {
+	followed := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	}))
+	defer target.Close()
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusFound)
+	}))
+	defer redirect.Close()
+
+	status, err := NewHTTP(time.Second).Send(context.Background(), &entity.WebhookDelivery{URL: redirect.URL})
+	if err == nil || status != http.StatusFound {
+		t.Errorf("Send = %d, %v, want 302 and an error", status, err)
+	}
+	if followed {
+		t.Error("the redirect was followed")
+	}
+}
+
+func TestSendTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer slow.Close()
+
+	if _, err := NewHTTP(50*time.Millisecond).Send(context.Background(), &entity.WebhookDelivery{URL: slow.URL}); err == nil {
+		t.Error("a slow receiver doesn't time out")
+	}
+}
@@ -14,6 +14,19 @@ type Config struct {
 		Timeout string
 	}
 
+	Scheduler struct {
+		Interval string
+	}
+
+	ChangeFeed struct {
+		Retention string
+	}
+
+	Recommendation struct {
+		Weights  string
+		PoolSize string
+	}
+
 	DB struct {
 		Host     string
 		Port     string
@@ -28,6 +41,41 @@ type Config struct {
 		Port string
 	}
 
+	Geocoder struct {
+		Kind      string
+		Gazetteer string
+	}
+
+	Notifier struct {
+		Kind string
+	}
+
+	Broker struct {
+		Kind        string
+		TopicPrefix string
+		Kafka       struct {
+			Brokers string
+		}
+		NATS struct {
+			URL string
+		}
+	}
+
+	Outbox struct {
+		Interval  string
+		BatchSize string
+		Retention string
+	}
+
+	Webhook struct {
+		Interval string
+		Timeout  string
+	}
+
+	Saga struct {
+		Interval string
+	}
+
 	OTLPCollector struct {
 		Host string
 		Port string
@@ -44,6 +92,16 @@ func New() *Config {
 	config.RPCPort = getEnv("RPC_PORT", ":2222")
 	config.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
 
+	// how often scheduled jobs are published and expired ones closed
+	config.Scheduler.Interval = getEnv("SCHEDULER_INTERVAL", "1m")
+
+	// how long job changes are kept for watchers resuming from a cursor
+	config.ChangeFeed.Retention = getEnv("CHANGE_FEED_RETENTION", "168h")
+
+	// recommendation factor weights like "skills=0.4,salary=0.15" and how many candidates one request scores
+	config.Recommendation.Weights = getEnv("RECOMMENDATION_WEIGHTS", "skills=0.4,level=0.15,location_type=0.1,employment_type=0.1,salary=0.15,history=0.1")
+	config.Recommendation.PoolSize = getEnv("RECOMMENDATION_POOL_SIZE", "500")
+
 	// db configuration
 	config.DB.Host = getEnv("POSTGRES_HOST", "postgres")
 	config.DB.Port = getEnv("POSTGRES_PORT", "5432")
@@ -56,6 +114,31 @@ func New() *Config {
 	config.ClientService.Host = getEnv("CLIENT_SERVICE_RPC_HOST", "client-service")
 	config.ClientService.Port = getEnv("CLIENT_SERVICE_RPC_PORT", ":1111")
 
+	// geocoding of job addresses: gazetteer or none, the built in gazetteer is used without a file
+	config.Geocoder.Kind = getEnv("GEOCODER", "gazetteer")
+	config.Geocoder.Gazetteer = getEnv("GEOCODER_GAZETTEER", "")
+
+	// notifications of saved search alerts: client-service or memory
+	config.Notifier.Kind = getEnv("NOTIFIER", "client-service")
+
+	// publishing of the domain events: kafka, nats, log or memory
+	config.Broker.Kind = getEnv("BROKER", "log")
+	config.Broker.TopicPrefix = getEnv("BROKER_TOPIC_PREFIX", "")
+	config.Broker.Kafka.Brokers = getEnv("KAFKA_BROKERS", "kafka:9092")
+	config.Broker.NATS.URL = getEnv("NATS_URL", "nats://nats:4222")
+
+	// how often the outbox is relayed, how many events one pass publishes and how long published ones are kept
+	config.Outbox.Interval = getEnv("OUTBOX_INTERVAL", "1s")
+	config.Outbox.BatchSize = getEnv("OUTBOX_BATCH_SIZE", "100")
+	config.Outbox.Retention = getEnv("OUTBOX_RETENTION", "168h")
+
+	// how often due webhook deliveries are sent and how long a partner has to answer one
+	config.Webhook.Interval = getEnv("WEBHOOK_INTERVAL", "5s")
+	config.Webhook.Timeout = getEnv("WEBHOOK_TIMEOUT", "10s")
+
+	// how often the due deletion sagas are resumed
+	config.Saga.Interval = getEnv("SAGA_INTERVAL", "30s")
+
 	// otlp collector configuration
 	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "localhost")
 	config.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
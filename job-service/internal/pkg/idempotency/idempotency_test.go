@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"context"
+	"job-service/internal/pkg/principal"
+	"testing"
+)
+
+func TestID(t *testing.T) {
+	tests := []struct {
+		principal string
+		key       string
+		ok        bool
+	}{
+		{principal: "client:42", key: "key-1", ok: true},
+		{principal: "client:42", key: "", ok: false},
+		{principal: "anonymous", key: "key-1", ok: false},
+		{principal: "", key: "key-1", ok: false},
+	}
+	for _, tt := range tests {
+		ctx := NewContext(principal.NewContext(context.Background(), tt.principal), tt.key)
+		id, ok := ID(ctx, "client")
+		if ok != tt.ok || (id != "") != tt.ok {
+			t.Errorf("principal %q, key %q: ID = %q, %t, want ok %t", tt.principal, tt.key, id, ok, tt.ok)
+		}
+	}
+
+	ctx := NewContext(principal.NewContext(context.Background(), "client:42"), "key-1")
+	first, _ := ID(ctx, "client")
+	if again, _ := ID(ctx, "client"); again != first {
+		t.Errorf("ID of a retry = %q, want %q", again, first)
+	}
+	if other, _ := ID(ctx, "job"); other == first {
+		t.Errorf("ID of another kind = %q, want it to differ", other)
+	}
+	other, _ := ID(NewContext(principal.NewContext(context.Background(), "client:7"), "key-1"), "client")
+	if other == first {
+		t.Errorf("ID of another principal = %q, want it to differ", other)
+	}
+}
@@ -0,0 +1,30 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	// raw HTML in the source is dropped by goldmark, the policy is a second line
+	// for links and attributes that markdown itself can produce
+	renderer = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	policy   = bluemonday.UGCPolicy()
+)
+
+// ToHTML renders Markdown into sanitized HTML, safe to embed into a page
+func ToHTML(source string) string {
+	if source == "" {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(source), &buf); err != nil {
+		return policy.Sanitize(source)
+	}
+
+	return policy.Sanitize(buf.String())
+}
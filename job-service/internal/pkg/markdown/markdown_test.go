@@ -0,0 +1,76 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToHTMLStripsScripts(t *testing.T) {
+	tests := []struct {
+		name, source, banned string
+	}{
+		{"script tag", "intro\n\n<script>alert(1)</script>", "<script"},
+		{"inline script", "text <script>alert(1)</script> text", "<script"},
+		{"javascript link", "[click](javascript:alert(1))", `href="javascript`},
+		{"javascript autolink", "<javascript:alert(1)>", `href="javascript`},
+		{"event handler", `<img src="x.png" onerror="alert(1)">`, "onerror"},
+		{"inline event handler", `text <a href="https://example.com" onclick="alert(1)">link</a>`, "onclick"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := ToHTML(tt.source)
+			if strings.Contains(strings.ToLower(html), tt.banned) {
+				t.Errorf("ToHTML(%q) = %q, contains %q", tt.source, html, tt.banned)
+			}
+		})
+	}
+}
+
+func TestToHTMLKeepsGFM(t *testing.T) {
+	tests := []struct {
+		name, source string
+		want         []string
+	}{
+		{
+			name:   "table",
+			source: "| Skill | Years |\n| --- | --- |\n| Go | 3 |\n",
+			want:   []string{"<table>", "<th>Skill</th>", "<td>Go</td>", "<td>3</td>"},
+		},
+		{
+			name:   "unordered list",
+			source: "- Go\n- SQL\n",
+			want:   []string{"<ul>", "<li>Go</li>", "<li>SQL</li>"},
+		},
+		{
+			name:   "ordered list",
+			source: "1. apply\n2. interview\n",
+			want:   []string{"<ol>", "<li>apply</li>", "<li>interview</li>"},
+		},
+		{
+			name:   "safe link",
+			source: "[site](https://example.com)",
+			want:   []string{`href="https://example.com"`, ">site</a>"},
+		},
+		{
+			name:   "strikethrough",
+			source: "~~remote~~",
+			want:   []string{"<del>remote</del>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := ToHTML(tt.source)
+			for _, want := range tt.want {
+				if !strings.Contains(html, want) {
+					t.Errorf("ToHTML(%q) = %q, want %q in it", tt.source, html, want)
+				}
+			}
+		})
+	}
+}
+
+func TestToHTMLEmpty(t *testing.T) {
+	if html := ToHTML(""); html != "" {
+		t.Errorf("ToHTML(\"\") = %q, want empty", html)
+	}
+}
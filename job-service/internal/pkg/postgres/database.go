@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"job-service/internal/entity"
 	configpkg "job-service/internal/pkg/config"
+	"job-service/internal/pkg/principal"
 	"strings"
+	"sync"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
@@ -16,6 +18,8 @@ import (
 type PostgresDB struct {
 	*pgxpool.Pool
 	Sq Squirrel
+	// actors holds the audit actor set on each connection
+	actors sync.Map
 }
 
 func New(config *configpkg.Config) (*PostgresDB, error) {
@@ -35,6 +39,9 @@ func (p *PostgresDB) connectDB(config *configpkg.Config) error {
 	if err != nil {
 		return fmt.Errorf("unable to parse db conifg: %s", err.Error())
 	}
+	pgxConfig.ConnConfig.RuntimeParams["application_name"] = "job-service"
+	pgxConfig.BeforeAcquire = p.setActor
+	pgxConfig.AfterRelease = p.forgetClosed
 
 	pgxPool, err := pgxpool.ConnectConfig(context.Background(), pgxConfig)
 	if err != nil {
@@ -46,6 +53,29 @@ func (p *PostgresDB) connectDB(config *configpkg.Config) error {
 	return nil
 }
 
+// setActor makes the principal of the request the audit.actor of the connection, the audit
+// triggers record it as the actor of the changes. Work without a principal resets it.
+func (p *PostgresDB) setActor(ctx context.Context, conn *pgx.Conn) bool {
+	actor := principal.FromContext(ctx)
+	if current, _ := p.actors.Load(conn); current == nil && actor == "" || current == actor {
+		return true
+	}
+
+	if _, err := conn.Exec(ctx, "SELECT set_config('audit.actor', $1, false)", actor); err != nil {
+		return false
+	}
+	p.actors.Store(conn, actor)
+
+	return true
+}
+
+func (p *PostgresDB) forgetClosed(conn *pgx.Conn) bool {
+	if conn.IsClosed() {
+		p.actors.Delete(conn)
+	}
+	return true
+}
+
 func (p *PostgresDB) configToString(config *configpkg.Config) string {
 	var conn []string
 	if len(config.DB.Host) != 0 {
@@ -83,8 +113,10 @@ func (p *PostgresDB) Error(err error) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
 		switch pgErr.Code {
-		case "23505":
+		case "23505", "23P01":
 			return entity.ErrorConflict
+		case "23503":
+			return entity.ErrorNotFound
 		}
 	}
 
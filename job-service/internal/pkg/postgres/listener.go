@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// listenRetryInterval is how long the listener waits before it reconnects after an error
+const listenRetryInterval = 5 * time.Second
+
+// Listener keeps one connection of the pool LISTENing on a channel and wakes every
+// subscriber up on its notifications, so watchers share a single connection
+type Listener struct {
+	db          *PostgresDB
+	channel     string
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewListener(db *PostgresDB, channel string) *Listener {
+	return &Listener{
+		db:          db,
+		channel:     channel,
+		subscribers: make(map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives a value after notifications, notifications
+// arriving before the subscriber reads are coalesced into one
+func (l *Listener) Subscribe() (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	l.subscribers[wake] = struct{}{}
+	l.mu.Unlock()
+
+	return wake, func() {
+		l.mu.Lock()
+		delete(l.subscribers, wake)
+		l.mu.Unlock()
+	}
+}
+
+// Run listens until ctx is done and reconnects after errors, every subscriber is woken up
+// after a reconnect as notifications may have been missed meanwhile
+func (l *Listener) Run(ctx context.Context, onError func(err error)) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		onError(err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := l.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return err
+	}
+	// the connection goes back to the pool, it must not keep listening there
+	defer conn.Exec(context.Background(), "UNLISTEN *")
+
+	l.broadcast()
+	for {
+		if _, err = conn.Conn().WaitForNotification(ctx); err != nil {
+			return err
+		}
+		l.broadcast()
+	}
+}
+
+func (l *Listener) broadcast() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for wake := range l.subscribers {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
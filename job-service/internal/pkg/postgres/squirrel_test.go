@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSquirrelExpr(t *testing.T) {
+	sq := NewSquirrel()
+	query, args, err := sq.Builder.
+		Select("id").
+		From("jobs").
+		Where(sq.Expr("status IN (?, ?)", "draft", "closed")).
+		Where(sq.Expr("close_at <= ?", 1)).
+		ToSql()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "SELECT id FROM jobs WHERE status IN ($1, $2) AND close_at <= $3"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if want := []interface{}{"draft", "closed", 1}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"strings"
+	"time"
+)
+
+type Application interface {
+	ApplyToJob(ctx context.Context, application *entity.JobApplication, checkClient func(ctx context.Context) error) (*entity.JobApplication, error)
+	MoveApplication(ctx context.Context, change *entity.ApplicationStatusChange) (*entity.JobApplication, error)
+	GetApplication(ctx context.Context, guid string) (*entity.JobApplication, error)
+	GetApplications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.JobApplication, error)
+	GetApplicationHistory(ctx context.Context, applicationID string) ([]*entity.ApplicationStatusChange, error)
+}
+
+type applicationService struct {
+	BaseUseCase
+	repo       repository.Applications
+	jobs       repository.Jobs
+	ctxTimeout time.Duration
+}
+
+func NewApplicationService(ctxTimeout time.Duration, repo repository.Applications, jobs repository.Jobs) Application {
+	return applicationService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		jobs:       jobs,
+	}
+}
+
+// ApplyToJob opens an application in status applied, only published jobs take applications.
+// checkClient runs after validation, it should fail when the client can't apply, e.g. it doesn't exist.
+func (u applicationService) ApplyToJob(ctx context.Context, application *entity.JobApplication, checkClient func(ctx context.Context) error) (*entity.JobApplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ApplyToJob")
+	defer span.End()
+
+	var missing []string
+	if strings.TrimSpace(application.JobID) == "" {
+		missing = append(missing, "job_id")
+	}
+	if strings.TrimSpace(application.ClientID) == "" {
+		missing = append(missing, "client_id")
+	}
+	if len(missing) != 0 {
+		return nil, entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	job, err := u.jobs.GetJob(ctx, map[string]string{"id": application.JobID})
+	if err != nil {
+		if errors.Is(err, entity.ErrorNotFound) {
+			return nil, entity.NewErrNotFound("job")
+		}
+		return nil, err
+	}
+	if job.Status != entity.JobStatusPublished {
+		return nil, entity.NewErrPrecondition(fmt.Sprintf("the job is %s, only published jobs take applications", job.Status))
+	}
+	if err := checkClient(ctx); err != nil {
+		return nil, err
+	}
+
+	application.Status = entity.ApplicationStatusApplied
+	u.beforeRequest(&application.GUID, &application.CreatedAt, &application.UpdatedAt)
+
+	created, err := u.repo.CreateApplication(ctx, application)
+	if errors.Is(err, entity.ErrorConflict) {
+		return nil, entity.NewErrConflict("open application of the client for this job")
+	}
+
+	return created, err
+}
+
+func (u applicationService) MoveApplication(ctx context.Context, change *entity.ApplicationStatusChange) (*entity.JobApplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "MoveApplication")
+	defer span.End()
+
+	var missing []string
+	if strings.TrimSpace(change.ApplicationID) == "" {
+		missing = append(missing, "application_id")
+	}
+	if strings.TrimSpace(change.ToStatus) == "" {
+		missing = append(missing, "status")
+	}
+	if strings.TrimSpace(change.Actor) == "" {
+		missing = append(missing, "actor")
+	}
+	if len(missing) != 0 {
+		return nil, entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	change.ToStatus = strings.ToLower(strings.TrimSpace(change.ToStatus))
+	if !entity.IsApplicationStatus(change.ToStatus) {
+		validation := entity.NewErrValidation()
+		validation.Errors["status"] = fmt.Sprintf("unknown %q, expected screening, interview, offer, hired, rejected or withdrawn", change.ToStatus)
+		return nil, validationError(validation)
+	}
+
+	u.beforeRequest(&change.GUID, &change.CreatedAt, nil)
+
+	return u.repo.MoveApplication(ctx, change)
+}
+
+func (u applicationService) GetApplication(ctx context.Context, guid string) (*entity.JobApplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetApplication")
+	defer span.End()
+
+	return u.repo.GetApplication(ctx, guid)
+}
+
+func (u applicationService) GetApplications(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.JobApplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ListApplications")
+	defer span.End()
+
+	if status := filter["status"]; status != "" && !entity.IsApplicationStatus(status) {
+		validation := entity.NewErrValidation()
+		validation.Errors["status"] = fmt.Sprintf("unknown %q", status)
+		return nil, validationError(validation)
+	}
+
+	return u.repo.GetApplications(ctx, limit, offset, filter)
+}
+
+func (u applicationService) GetApplicationHistory(ctx context.Context, applicationID string) ([]*entity.ApplicationStatusChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetApplicationHistory")
+	defer span.End()
+
+	return u.repo.GetApplicationHistory(ctx, applicationID)
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"testing"
+	"time"
+)
+
+// statusJobs has every job in status, it's the only method the applications use
+type statusJobs struct {
+	repository.Jobs
+	status string
+}
+
+func (r statusJobs) GetJob(_ context.Context, params map[string]string) (*entity.Job, error) {
+	return &entity.Job{GUID: params["id"], Status: r.status}, nil
+}
+
+// createdApplications keeps the created application
+type createdApplications struct {
+	repository.Applications
+	created *entity.JobApplication
+}
+
+func (r *createdApplications) CreateApplication(_ context.Context, application *entity.JobApplication) (*entity.JobApplication, error) {
+	r.created = application
+	return application, nil
+}
+
+func TestApplyToJobNeedsPublishedJob(t *testing.T) {
+	for _, status := range []string{
+		entity.JobStatusDraft, entity.JobStatusScheduled, entity.JobStatusClosed, entity.JobStatusArchived,
+	} {
+		t.Run(status, func(t *testing.T) {
+			repo := &createdApplications{}
+			service := NewApplicationService(time.Second, repo, statusJobs{status: status})
+
+			_, err := service.ApplyToJob(context.Background(), &entity.JobApplication{JobID: "j1", ClientID: "c1"},
+				func(context.Context) error { return nil })
+			var errPrecondition *entity.ErrPrecondition
+			if !errors.As(err, &errPrecondition) {
+				t.Errorf("ApplyToJob = %v, want a precondition error", err)
+			}
+			if repo.created != nil {
+				t.Error("the application was created")
+			}
+		})
+	}
+
+	repo := &createdApplications{}
+	service := NewApplicationService(time.Second, repo, statusJobs{status: entity.JobStatusPublished})
+	application, err := service.ApplyToJob(context.Background(), &entity.JobApplication{JobID: "j1", ClientID: "c1"},
+		func(context.Context) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if application.Status != entity.ApplicationStatusApplied || repo.created == nil {
+		t.Errorf("application = %+v, want a created applied one", application)
+	}
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// auditChainBatch is how many records VerifyAuditLog reads at a time
+const auditChainBatch = 1000
+
+type Audit interface {
+	GetAuditRecords(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditRecord, error)
+	VerifyAuditLog(ctx context.Context) (*entity.AuditVerification, error)
+}
+
+type auditService struct {
+	BaseUseCase
+	repo       repository.Audit
+	ctxTimeout time.Duration
+}
+
+func NewAuditService(ctxTimeout time.Duration, repo repository.Audit) Audit {
+	return auditService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+// GetAuditRecords filters by entity, entity_id, actor, action, service and the [from, to)
+// range of RFC3339 times, the newest come first
+func (u auditService) GetAuditRecords(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetAuditRecords")
+	defer span.End()
+
+	validation := entity.NewErrValidation()
+	if action := filter["action"]; action != "" && !slices.Contains(entity.AuditActions, action) {
+		validation.Errors["action"] = "should be one of " + strings.Join(entity.AuditActions, ", ")
+	}
+	var from, to time.Time
+	for _, key := range []string{"from", "to"} {
+		if filter[key] == "" {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, filter[key])
+		if err != nil {
+			validation.Errors[key] = "should be an RFC3339 time"
+			continue
+		}
+		if key == "from" {
+			from = at
+		} else {
+			to = at
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		validation.Errors["to"] = "should be after from"
+	}
+	if err := validationError(validation); err != nil {
+		return nil, err
+	}
+
+	return u.repo.GetAuditRecords(ctx, limit, offset, filter)
+}
+
+// VerifyAuditLog walks the whole chain and recomputes the hashes, the timeout applies to each
+// batch as the log only grows
+func (u auditService) VerifyAuditLog(ctx context.Context) (*entity.AuditVerification, error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "VerifyAuditLog")
+	defer span.End()
+
+	var (
+		verification = entity.AuditVerification{Valid: true}
+		lastID       uint64
+		lastHash     string
+	)
+	for {
+		records, err := u.auditChain(ctx, lastID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			switch {
+			case record.PrevHash != lastHash:
+				verification.Reason = "the record doesn't link to the one before it"
+			case record.ComputeHash() != record.Hash:
+				verification.Reason = "the record doesn't match its hash"
+			}
+			if verification.Reason != "" {
+				verification.Valid = false
+				verification.BrokenAt = record.ID
+				return &verification, nil
+			}
+
+			verification.Checked++
+			lastID, lastHash = record.ID, record.Hash
+		}
+
+		if len(records) < auditChainBatch {
+			return &verification, nil
+		}
+	}
+}
+
+func (u auditService) auditChain(ctx context.Context, afterID uint64) ([]*entity.AuditRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	return u.repo.GetAuditChain(ctx, afterID, auditChainBatch)
+}
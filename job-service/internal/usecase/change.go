@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	watchBatchSize = 100
+	// wakes watchers up when a notification got lost, e.g. while the listener reconnected
+	watchPollInterval = 30 * time.Second
+)
+
+// WatchJobs hands fn the job changes after the cursor until ctx is done, a zero cursor starts
+// at the end of the feed. The filter holds comma separated actions, company_id and job_id.
+func (u jobService) WatchJobs(ctx context.Context, cursor uint64, filter map[string]string, fn func(change *entity.JobChange) error) error {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "WatchJobs")
+	defer span.End()
+
+	actions, err := watchActions(filter["actions"])
+	if err != nil {
+		return err
+	}
+	companyID, err := watchID("company_id", filter["company_id"])
+	if err != nil {
+		return err
+	}
+	jobID, err := watchID("job_id", filter["job_id"])
+	if err != nil {
+		return err
+	}
+
+	// subscribed before the first read, so no change between the read and the wait is missed
+	wake, unsubscribe := u.changes.Subscribe()
+	defer unsubscribe()
+
+	first, last, err := u.repo.GetJobChangesRange(ctx)
+	if err != nil {
+		return err
+	}
+	if cursor, err = watchCursor(cursor, first, last); err != nil {
+		return err
+	}
+
+	poll := time.NewTicker(watchPollInterval)
+	defer poll.Stop()
+
+	for {
+		changes, err := u.nextJobChanges(ctx, cursor, func(change *entity.JobChange) bool {
+			return (len(actions) == 0 || actions[change.Action]) &&
+				(companyID == "" || change.CompanyID == companyID) &&
+				(jobID == "" || change.JobID == jobID)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, change := range changes.matched {
+			if err = fn(change); err != nil {
+				return err
+			}
+		}
+		cursor = changes.cursor
+		if changes.more {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		case <-poll.C:
+		}
+	}
+}
+
+type jobChangeBatch struct {
+	matched []*entity.JobChange
+	// the last change read, matched or not
+	cursor uint64
+	more   bool
+}
+
+// nextJobChanges reads a batch of changes after the cursor and loads the jobs of the matching ones
+func (u jobService) nextJobChanges(ctx context.Context, cursor uint64, match func(change *entity.JobChange) bool) (*jobChangeBatch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	changes, err := u.repo.GetJobChanges(ctx, cursor, watchBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := jobChangeBatch{
+		cursor: cursor,
+		more:   len(changes) == watchBatchSize,
+	}
+	var ids []string
+	for _, change := range changes {
+		batch.cursor = change.Cursor
+		if !match(change) {
+			continue
+		}
+		batch.matched = append(batch.matched, change)
+		if change.Action != entity.ChangeDeleted {
+			ids = append(ids, change.JobID)
+		}
+	}
+	if len(ids) == 0 {
+		return &batch, nil
+	}
+
+	jobs, err := u.repo.GetJobsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.Job, len(jobs))
+	for _, job := range jobs {
+		byID[job.GUID] = job
+	}
+	for _, change := range batch.matched {
+		if change.Action != entity.ChangeDeleted {
+			change.Job = byID[change.JobID]
+		}
+	}
+
+	return &batch, nil
+}
+
+// PruneJobChanges drops the changes older than the retention from the feed
+func (u jobService) PruneJobChanges(ctx context.Context, retention time.Duration) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "PruneJobChanges")
+	defer span.End()
+
+	return u.repo.PruneJobChanges(ctx, time.Now().UTC().Add(-retention))
+}
+
+// watchCursor checks a cursor against the range of the feed, zero is its end
+func watchCursor(cursor, first, last uint64) (uint64, error) {
+	switch {
+	case cursor == 0:
+		return last, nil
+	case cursor < first:
+		return 0, entity.NewErrPrecondition("the changes after the cursor were pruned, resync and watch without a cursor")
+	case cursor > last:
+		return 0, entity.NewErrPrecondition("the cursor is ahead of the change feed")
+	}
+	return cursor, nil
+}
+
+func watchActions(value string) (map[string]bool, error) {
+	actions := make(map[string]bool)
+	for _, action := range strings.Split(value, ",") {
+		switch action = strings.ToLower(strings.TrimSpace(action)); action {
+		case "":
+		case entity.ChangeCreated, entity.ChangeUpdated, entity.ChangeDeleted:
+			actions[action] = true
+		default:
+			return nil, fmt.Errorf("unknown action %q, expected created, updated or deleted", action)
+		}
+	}
+	return actions, nil
+}
+
+func watchID(name, value string) (string, error) {
+	if value = strings.TrimSpace(value); value == "" {
+		return "", nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("%s should be a uuid", name)
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// slugs are built like in the 000008_companies migration, so "Google", "google"
+// and "Google LLC" of the migrated jobs and of new ones end up as the same company.
+// Postgres' \m and \M count any letter as a word character, Go's \b only latin ones,
+// so legal suffixes are matched as whole words instead
+var (
+	wordRegexp    = regexp.MustCompile(`[\p{L}\p{N}_]+`)
+	slugRegexp    = regexp.MustCompile(`[^a-z0-9]+`)
+	legalSuffixes = map[string]bool{
+		"inc": true, "llc": true, "llp": true, "ltd": true, "corp": true,
+		"corporation": true, "co": true, "gmbh": true, "plc": true,
+	}
+)
+
+const maxSlugLen = 100
+
+type Company interface {
+	CreateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error)
+	UpdateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error)
+	DeleteCompany(ctx context.Context, guid string) error
+	GetCompany(ctx context.Context, params map[string]string) (*entity.Company, error)
+	GetAllCompanies(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Company, error)
+}
+
+type companyService struct {
+	BaseUseCase
+	repo       repository.Companies
+	ctxTimeout time.Duration
+}
+
+func NewCompanyService(ctxTimeout time.Duration, repo repository.Companies) Company {
+	return companyService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (u companyService) CreateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "CreateCompany")
+	defer span.End()
+
+	if err := normalizeCompany(company); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(&company.GUID, &company.CreatedAt, &company.UpdatedAt)
+
+	return u.repo.CreateCompany(ctx, company)
+}
+
+func (u companyService) UpdateCompany(ctx context.Context, company *entity.Company) (*entity.Company, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateCompany")
+	defer span.End()
+
+	if strings.TrimSpace(company.GUID) == "" {
+		return nil, entity.NewErrNoRequiredParameter("id")
+	}
+	if err := normalizeCompany(company); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(nil, nil, &company.UpdatedAt)
+
+	return u.repo.UpdateCompany(ctx, company)
+}
+
+func (u companyService) DeleteCompany(ctx context.Context, guid string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeleteCompany")
+	defer span.End()
+
+	return u.repo.DeleteCompany(ctx, guid)
+}
+
+func (u companyService) GetCompany(ctx context.Context, params map[string]string) (*entity.Company, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetCompany")
+	defer span.End()
+
+	return u.repo.GetCompany(ctx, params)
+}
+
+func (u companyService) GetAllCompanies(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Company, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ListCompanies")
+	defer span.End()
+
+	return u.repo.GetAllCompanies(ctx, limit, offset, filter)
+}
+
+func normalizeCompany(company *entity.Company) error {
+	company.Name = strings.TrimSpace(company.Name)
+	if company.Name == "" {
+		return entity.NewErrNoRequiredParameter("name")
+	}
+
+	if company.Slug = strings.TrimSpace(company.Slug); company.Slug == "" {
+		company.Slug = companySlug(company.Name)
+	}
+
+	validation := entity.NewErrValidation()
+	if slug := slugRegexp.ReplaceAllString(strings.ToLower(company.Slug), "-"); slug != company.Slug || len(slug) > maxSlugLen {
+		validation.Errors["slug"] = fmt.Sprintf("should be at most %d lowercase latin letters, digits and dashes", maxSlugLen)
+	}
+
+	for name, value := range map[string]*string{"logo": &company.Logo, "website": &company.Website} {
+		*value = strings.TrimSpace(*value)
+		if *value == "" {
+			continue
+		}
+		link, err := url.ParseRequestURI(*value)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			validation.Errors[name] = fmt.Sprintf("should be an http(s) URL, got %q", *value)
+		}
+	}
+
+	return validationError(validation)
+}
+
+// companySlug lowercases the name, drops legal suffixes and joins the rest with dashes.
+// Names without latin letters or digits get a slug from their hash.
+func companySlug(name string) string {
+	lower := strings.ToLower(strings.TrimSpace(name))
+
+	slug := wordRegexp.ReplaceAllStringFunc(lower, func(word string) string {
+		if legalSuffixes[word] {
+			return ""
+		}
+		return word
+	})
+	slug = strings.Trim(slugRegexp.ReplaceAllString(slug, "-"), "-")
+	if len(slug) > maxSlugLen {
+		slug = slug[:maxSlugLen]
+	}
+	if slug == "" {
+		sum := md5.Sum([]byte(lower))
+		slug = "company-" + hex.EncodeToString(sum[:])[:8]
+	}
+
+	return slug
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"errors"
+	"job-service/internal/entity"
+	"strings"
+	"testing"
+)
+
+// the expectations are what the SQL of the 000008_companies migration gives for the name
+func TestCompanySlug(t *testing.T) {
+	tests := []struct {
+		name, slug string
+	}{
+		{"Google", "google"},
+		{"Google LLC", "google"},
+		{"  Acme, Inc. ", "acme"},
+		{"AT&T", "at-t"},
+		{"Co-op Bank", "op-bank"},
+		{"Tesco", "tesco"},
+		{"inc_corp", "inc-corp"},
+		{"Café Co", "caf"},
+		{"Ñinc", "inc"},
+		{"Яндекс", "company-d13025ef"},
+		{"LLC", "company-c1524ae6"},
+		{"!!!", "company-6dd07555"},
+		{strings.Repeat("a", 150), strings.Repeat("a", maxSlugLen)},
+	}
+	for _, tt := range tests {
+		if got := companySlug(tt.name); got != tt.slug {
+			t.Errorf("companySlug(%q) = %q, want %q", tt.name, got, tt.slug)
+		}
+	}
+}
+
+func TestNormalizeCompany(t *testing.T) {
+	company := &entity.Company{Name: " Google LLC ", Website: " https://google.com "}
+	if err := normalizeCompany(company); err != nil {
+		t.Fatal(err)
+	}
+	if company.Name != "Google LLC" || company.Slug != "google" || company.Website != "https://google.com" {
+		t.Errorf("company = %+v", company)
+	}
+
+	err := normalizeCompany(&entity.Company{
+		Name:    "Google",
+		Slug:    "Google Inc",
+		Logo:    "ftp://google.com/logo.png",
+		Website: "google.com",
+	})
+	var validation *entity.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+	for _, field := range []string{"slug", "logo", "website"} {
+		if _, ok := validation.Errors[field]; !ok {
+			t.Errorf("errors = %v, want %s", validation.Errors, field)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Dictionary interface {
+	GetDictionaries(ctx context.Context) (entity.Dictionaries, error)
+}
+
+type dictionaryService struct {
+	BaseUseCase
+	repo       repository.Dictionaries
+	ctxTimeout time.Duration
+}
+
+func NewDictionaryService(ctxTimeout time.Duration, repo repository.Dictionaries) Dictionary {
+	return dictionaryService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (u dictionaryService) GetDictionaries(ctx context.Context) (entity.Dictionaries, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetDictionaries")
+	defer span.End()
+
+	return u.repo.GetDictionaries(ctx)
+}
+
+// dictionaryFields are the job fields taking their values from the dictionaries
+var dictionaryFields = []string{entity.DictionaryLevel, entity.DictionaryLocationType, entity.DictionaryEmploymentType}
+
+// normalizeClassification replaces the level, location type and employment type of a job with
+// the codes they stand for, so "full time" and "Full-Time" are the same. All three are required.
+func normalizeClassification(dictionaries entity.Dictionaries, job *entity.Job) error {
+	values := map[string]*string{
+		entity.DictionaryLevel:          &job.Level,
+		entity.DictionaryLocationType:   &job.LocationType,
+		entity.DictionaryEmploymentType: &job.EmploymentType,
+	}
+
+	validation := entity.NewErrValidation()
+	for _, name := range dictionaryFields {
+		value := values[name]
+		if strings.TrimSpace(*value) == "" {
+			validation.Errors[name] = "is required"
+			continue
+		}
+		code, ok := lookupCode(dictionaries[name], *value)
+		if !ok {
+			validation.Errors[name] = unknownValue(dictionaries[name], *value)
+			continue
+		}
+		*value = code
+	}
+
+	return validationError(validation)
+}
+
+func (u jobService) normalizeClassification(ctx context.Context, job *entity.Job) error {
+	dictionaries, err := u.dictionaries.GetDictionaries(ctx)
+	if err != nil {
+		return err
+	}
+	return normalizeClassification(dictionaries, job)
+}
+
+// normalizeClassificationFilter does the same for the filters of the job listing, which may be empty
+func normalizeClassificationFilter(dictionaries entity.Dictionaries, filter map[string]string) error {
+	validation := entity.NewErrValidation()
+	for _, name := range dictionaryFields {
+		value := filter[name]
+		if strings.TrimSpace(value) == "" {
+			delete(filter, name)
+			continue
+		}
+		code, ok := lookupCode(dictionaries[name], value)
+		if !ok {
+			validation.Errors[name] = unknownValue(dictionaries[name], value)
+			continue
+		}
+		filter[name] = code
+	}
+
+	return validationError(validation)
+}
+
+func hasClassificationFilter(filter map[string]string) bool {
+	for _, name := range dictionaryFields {
+		if strings.TrimSpace(filter[name]) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupCode matches the value against the codes and the labels in every language
+func lookupCode(entries []*entity.DictionaryEntry, value string) (string, bool) {
+	value = normalizeValue(value)
+	for _, entry := range entries {
+		if normalizeValue(entry.Code) == value {
+			return entry.Code, true
+		}
+	}
+	for _, entry := range entries {
+		for _, label := range entry.Labels {
+			if normalizeValue(label) == value {
+				return entry.Code, true
+			}
+		}
+	}
+	return "", false
+}
+
+func unknownValue(entries []*entity.DictionaryEntry, value string) string {
+	codes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		codes = append(codes, entry.Code)
+	}
+	return fmt.Sprintf("unknown value %q, should be one of %s", value, strings.Join(codes, ", "))
+}
+
+// validationError is nil when nothing failed, its message names the fields which did
+func validationError(validation *entity.ErrValidation) error {
+	if len(validation.Errors) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(validation.Errors))
+	for field := range validation.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, field+": "+validation.Errors[field])
+	}
+	validation.Err = errors.New(strings.Join(messages, "; "))
+
+	return validation
+}
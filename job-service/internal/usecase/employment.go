@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"sort"
+	"strings"
+	"time"
+)
+
+func (u jobService) GetEmploymentHistory(ctx context.Context, clientID string) (*entity.EmploymentHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetEmploymentHistory")
+	defer span.End()
+
+	if strings.TrimSpace(clientID) == "" {
+		return nil, entity.NewErrNoRequiredParameter("client_id")
+	}
+
+	positions, err := u.repo.GetEmploymentPositions(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := buildEmploymentHistory(positions, time.Now().UTC())
+	history.ClientID = clientID
+
+	return history, nil
+}
+
+// buildEmploymentHistory orders the positions by start date and finds the gaps between them and where they overlap.
+// Gaps shorter than a day are left out, back to back positions rarely meet at the exact second.
+func buildEmploymentHistory(positions []*entity.EmploymentPosition, now time.Time) *entity.EmploymentHistory {
+	sort.SliceStable(positions, func(i, j int) bool {
+		return positions[i].StartDate.Before(positions[j].StartDate)
+	})
+
+	history := &entity.EmploymentHistory{
+		Positions: positions,
+		Gaps:      make([]*entity.EmploymentGap, 0),
+		Overlaps:  make([]*entity.EmploymentOverlap, 0),
+	}
+
+	var (
+		coverStart, coverEnd time.Time
+		coverJobID           string
+		open                 bool
+	)
+	for i, position := range positions {
+		position.Current = position.EndDate.IsZero() || position.EndDate.After(now)
+		position.TenureDays = days(position.StartDate, capAt(position.EndDate, now))
+
+		if i == 0 {
+			coverStart, coverEnd, coverJobID, open = position.StartDate, position.EndDate, position.JobID, position.EndDate.IsZero()
+			continue
+		}
+
+		if !open && position.StartDate.After(coverEnd) {
+			if gap := days(coverEnd, position.StartDate); gap > 0 {
+				history.Gaps = append(history.Gaps, &entity.EmploymentGap{
+					From:        coverEnd,
+					To:          position.StartDate,
+					Days:        gap,
+					AfterJobID:  coverJobID,
+					BeforeJobID: position.JobID,
+				})
+			}
+			// the covered period is broken, what's behind it is counted
+			history.TotalTenureDays += days(coverStart, capAt(coverEnd, now))
+			coverStart = position.StartDate
+			coverEnd = time.Time{}
+		}
+
+		switch {
+		case open:
+		case position.EndDate.IsZero():
+			open, coverJobID = true, position.JobID
+		case position.EndDate.After(coverEnd):
+			coverEnd, coverJobID = position.EndDate, position.JobID
+		}
+	}
+	if len(positions) != 0 {
+		end := now
+		if !open {
+			end = capAt(coverEnd, now)
+		}
+		history.TotalTenureDays += days(coverStart, end)
+	}
+
+	for i, first := range positions {
+		for _, second := range positions[i+1:] {
+			// positions are ordered, so second can't start before first
+			if !first.EndDate.IsZero() && !second.StartDate.Before(first.EndDate) {
+				continue
+			}
+
+			to := first.EndDate
+			if to.IsZero() || (!second.EndDate.IsZero() && second.EndDate.Before(to)) {
+				to = second.EndDate
+			}
+			history.Overlaps = append(history.Overlaps, &entity.EmploymentOverlap{
+				FirstJobID:  first.JobID,
+				SecondJobID: second.JobID,
+				From:        second.StartDate,
+				To:          to,
+				Days:        days(second.StartDate, capAt(to, now)),
+			})
+		}
+	}
+
+	return history
+}
+
+// capAt is the end of a period as far as it has passed, an open end runs until now
+func capAt(end, now time.Time) time.Time {
+	if end.IsZero() || end.After(now) {
+		return now
+	}
+	return end
+}
+
+func days(from, to time.Time) int64 {
+	if !to.After(from) {
+		return 0
+	}
+	return int64(to.Sub(from).Hours() / 24)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"job-service/internal/entity"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestBuildEmploymentHistory(t *testing.T) {
+	now := date(2024, 6, 1)
+	positions := []*entity.EmploymentPosition{
+		{JobID: "c", StartDate: date(2021, 6, 1)},
+		{JobID: "a", StartDate: date(2020, 1, 1), EndDate: date(2021, 1, 1)},
+		{JobID: "b", StartDate: date(2021, 3, 2), EndDate: date(2022, 1, 1)},
+	}
+
+	history := buildEmploymentHistory(positions, now)
+
+	var order []string
+	for _, position := range history.Positions {
+		order = append(order, position.JobID)
+	}
+	if len(order) != 3 || order[0] != "a" || order[1] != "b" || order[2] != "c" {
+		t.Fatalf("positions = %v, want oldest first", order)
+	}
+
+	tenures := map[string]int64{"a": 366, "b": 305, "c": 1096}
+	for _, position := range history.Positions {
+		if position.TenureDays != tenures[position.JobID] {
+			t.Errorf("tenure of %s = %d, want %d", position.JobID, position.TenureDays, tenures[position.JobID])
+		}
+		if current := position.JobID == "c"; position.Current != current {
+			t.Errorf("current of %s = %v, want %v", position.JobID, position.Current, current)
+		}
+	}
+
+	if len(history.Gaps) != 1 {
+		t.Fatalf("gaps = %d, want 1", len(history.Gaps))
+	}
+	gap := history.Gaps[0]
+	if gap.AfterJobID != "a" || gap.BeforeJobID != "b" || gap.Days != 60 {
+		t.Errorf("gap = %+v, want 60 days between a and b", gap)
+	}
+
+	if len(history.Overlaps) != 1 {
+		t.Fatalf("overlaps = %d, want 1", len(history.Overlaps))
+	}
+	overlap := history.Overlaps[0]
+	if overlap.FirstJobID != "b" || overlap.SecondJobID != "c" || !overlap.To.Equal(date(2022, 1, 1)) || overlap.Days != 214 {
+		t.Errorf("overlap = %+v, want 214 days of b and c until b ended", overlap)
+	}
+
+	// overlapping time is counted once
+	if want := int64(366 + 1187); history.TotalTenureDays != want {
+		t.Errorf("total tenure = %d, want %d", history.TotalTenureDays, want)
+	}
+}
+
+func TestBuildEmploymentHistoryOpenOverlap(t *testing.T) {
+	now := date(2024, 6, 1)
+	history := buildEmploymentHistory([]*entity.EmploymentPosition{
+		{JobID: "a", StartDate: date(2024, 1, 1)},
+		{JobID: "b", StartDate: date(2024, 3, 1)},
+	}, now)
+
+	if len(history.Gaps) != 0 {
+		t.Errorf("gaps = %d, want none", len(history.Gaps))
+	}
+	if len(history.Overlaps) != 1 || !history.Overlaps[0].To.IsZero() {
+		t.Fatalf("overlaps = %+v, want one still going on", history.Overlaps)
+	}
+	if want := int64(152); history.TotalTenureDays != want {
+		t.Errorf("total tenure = %d, want %d", history.TotalTenureDays, want)
+	}
+}
+
+func TestBuildEmploymentHistoryEmpty(t *testing.T) {
+	history := buildEmploymentHistory(nil, date(2024, 6, 1))
+	if history.TotalTenureDays != 0 || len(history.Gaps) != 0 || len(history.Overlaps) != 0 {
+		t.Errorf("history = %+v, want an empty one", history)
+	}
+}
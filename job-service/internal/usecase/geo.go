@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/geocoder"
+	"strconv"
+	"strings"
+)
+
+// half the circumference of the earth, no two places are farther apart
+const maxRadiusKm = 20038
+
+// resolveLocation geocodes the address of a job unless the coordinates are given,
+// an address the geocoder doesn't know leaves the job without a location
+func (u jobService) resolveLocation(ctx context.Context, job *entity.Job) error {
+	if job.Latitude != nil || job.Longitude != nil {
+		if job.Latitude == nil || job.Longitude == nil {
+			return entity.NewErrNoRequiredParameter("latitude", "longitude")
+		}
+		return checkCoordinates(*job.Latitude, *job.Longitude)
+	}
+	if strings.TrimSpace(job.Address) == "" {
+		return nil
+	}
+
+	latitude, longitude, ok, err := u.geocoder.Geocode(ctx, job.Address)
+	if err != nil {
+		return err
+	}
+	if ok {
+		job.Latitude, job.Longitude = &latitude, &longitude
+	}
+
+	return nil
+}
+
+// normalizeGeoFilter checks the geo filters of the job listing. A place name in "near" is
+// geocoded into the center, a center needs "radius_km". "bbox" is "south,west,north,east".
+func normalizeGeoFilter(ctx context.Context, geocoder geocoder.Geocoder, filter map[string]string) error {
+	if near := strings.TrimSpace(filter["near"]); near != "" {
+		latitude, longitude, ok, err := geocoder.Geocode(ctx, near)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return entity.NewErrNotFound(fmt.Sprintf("place %q", near))
+		}
+		filter["latitude"] = strconv.FormatFloat(latitude, 'f', -1, 64)
+		filter["longitude"] = strconv.FormatFloat(longitude, 'f', -1, 64)
+	}
+	delete(filter, "near")
+
+	latitude, longitude, radius := filter["latitude"], filter["longitude"], filter["radius_km"]
+	switch {
+	case latitude == "" && longitude == "" && radius == "":
+		delete(filter, "latitude")
+		delete(filter, "longitude")
+		delete(filter, "radius_km")
+	case latitude == "" || longitude == "" || radius == "":
+		return entity.NewErrNoRequiredParameter("latitude", "longitude", "radius_km")
+	default:
+		lat, err := parseCoordinate("latitude", latitude)
+		if err != nil {
+			return err
+		}
+		lon, err := parseCoordinate("longitude", longitude)
+		if err != nil {
+			return err
+		}
+		if err = checkCoordinates(lat, lon); err != nil {
+			return err
+		}
+		km, err := strconv.ParseFloat(radius, 64)
+		if err != nil || km <= 0 || km > maxRadiusKm {
+			return fmt.Errorf("radius_km should be a number of kilometers up to %d, got %q", maxRadiusKm, radius)
+		}
+	}
+
+	bbox := strings.TrimSpace(filter["bbox"])
+	if bbox == "" {
+		delete(filter, "bbox")
+		return nil
+	}
+	edges := strings.Split(bbox, ",")
+	if len(edges) != 4 {
+		return fmt.Errorf("bbox should be south,west,north,east, got %q", bbox)
+	}
+	var values [4]float64
+	for i, name := range []string{"south", "west", "north", "east"} {
+		value, err := parseCoordinate("bbox "+name, strings.TrimSpace(edges[i]))
+		if err != nil {
+			return err
+		}
+		values[i] = value
+		edges[i] = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	if err := checkCoordinates(values[0], values[1]); err != nil {
+		return err
+	}
+	if err := checkCoordinates(values[2], values[3]); err != nil {
+		return err
+	}
+	if values[0] > values[2] {
+		return fmt.Errorf("bbox south %v is north of north %v", values[0], values[2])
+	}
+	filter["bbox"] = strings.Join(edges, ",")
+
+	return nil
+}
+
+func parseCoordinate(name, value string) (float64, error) {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s should be a number of degrees, got %q", name, value)
+	}
+	return number, nil
+}
+
+func checkCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 {
+		return fmt.Errorf("latitude should be between -90 and 90, got %v", latitude)
+	}
+	if longitude < -180 || longitude > 180 {
+		return fmt.Errorf("longitude should be between -180 and 180, got %v", longitude)
+	}
+	return nil
+}
@@ -2,35 +2,66 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"job-service/internal/entity"
+	"job-service/internal/infrastructure/geocoder"
 	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/idempotency"
 	"job-service/internal/pkg/otlp"
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// maxLookupIDs bounds a batch lookup, bigger sets are looked up in several calls
+const maxLookupIDs = 500
+
 type Job interface {
 	CreateJob(ctx context.Context, article *entity.Job) (*entity.Job, error)
 	UpdateJob(ctx context.Context, article *entity.Job) (*entity.Job, error)
 	DeleteJob(ctx context.Context, guid string) error
 	GetJob(ctx context.Context, params map[string]string) (*entity.Job, error)
+	GetJobsByIDs(ctx context.Context, ids []string) (jobs []*entity.Job, missing []string, err error)
 	GetAllJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Job, error)
 	GetAllDeletedJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Job, error)
 	GetClientJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.ClientJob, error)
 	GetJobClients(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.ClientJob, error)
-	AddClientJob(ctx context.Context, job *entity.ClientJob) (*entity.Response, error)
+	GetEmploymentHistory(ctx context.Context, clientID string) (*entity.EmploymentHistory, error)
+	AddClientJob(ctx context.Context, job *entity.ClientJob, checkClient func(ctx context.Context) error) (*entity.Response, error)
 	DeleteClientJob(ctx context.Context, clientJob *entity.ClientJob) error
+	ReassignClientJobs(ctx context.Context, fromClientID, toClientID string) (*entity.Reassignment, error)
+	UndoReassignClientJobs(ctx context.Context, fromClientID, toClientID string, reassignment *entity.Reassignment) (uint64, error)
+	BatchCreateJobs(ctx context.Context, jobs []*entity.Job) ([]*entity.BatchResult, error)
+	StreamJobs(ctx context.Context, scope string, filter map[string]string, fn func(job *entity.Job) error) error
+	StreamClientJobs(ctx context.Context, filter map[string]string, fn func(clientJob *entity.ClientJob) error) error
+	PublishAndCloseDueJobs(ctx context.Context) (published, closed uint64, err error)
+	WatchJobs(ctx context.Context, cursor uint64, filter map[string]string, fn func(change *entity.JobChange) error) error
+	PruneJobChanges(ctx context.Context, retention time.Duration) (uint64, error)
 }
 
 type jobService struct {
 	BaseUseCase
-	repo       repository.Jobs
-	ctxTimeout time.Duration
+	repo         repository.Jobs
+	companies    repository.Companies
+	dictionaries repository.Dictionaries
+	taxonomy     repository.Taxonomy
+	geocoder     geocoder.Geocoder
+	changes      repository.Notifications
+	ctxTimeout   time.Duration
 }
 
-func NewJobService(ctxTimeout time.Duration, repo repository.Jobs) Job {
+func NewJobService(ctxTimeout time.Duration, repo repository.Jobs, companies repository.Companies, dictionaries repository.Dictionaries, taxonomy repository.Taxonomy, geocoder geocoder.Geocoder, changes repository.Notifications) Job {
 	return jobService{
-		ctxTimeout: ctxTimeout,
-		repo:       repo,
+		ctxTimeout:   ctxTimeout,
+		repo:         repo,
+		companies:    companies,
+		dictionaries: dictionaries,
+		taxonomy:     taxonomy,
+		geocoder:     geocoder,
+		changes:      changes,
 	}
 }
 
@@ -41,7 +72,36 @@ func (u jobService) CreateJob(ctx context.Context, job *entity.Job) (*entity.Job
 	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "CreateClient")
 	defer span.End()
 
+	if err := u.normalizeClassification(ctx, job); err != nil {
+		return nil, err
+	}
+	job.Skills = normalizeSkills(job.Skills)
+	if err := normalizeSalary(job); err != nil {
+		return nil, err
+	}
+	if err := normalizeSchedule(job, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if job.Status == entity.JobStatusArchived {
+		return nil, entity.NewErrInvalidTransition("new", job.Status)
+	}
+	if job.CompanyID == "" && strings.TrimSpace(job.Company) == "" {
+		return nil, entity.NewErrNoRequiredParameter("company_id")
+	}
+	if err := u.resolveCompany(ctx, job); err != nil {
+		return nil, err
+	}
+	if err := u.resolveLocation(ctx, job); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeTaxonomy(ctx, job); err != nil {
+		return nil, err
+	}
+
 	u.beforeRequest(&job.GUID, &job.CreatedAt, &job.UpdatedAt)
+	if id, ok := idempotency.ID(ctx, "job"); ok {
+		job.GUID = id
+	}
 
 	return u.repo.CreateJob(ctx, job)
 }
@@ -53,6 +113,29 @@ func (u jobService) UpdateJob(ctx context.Context, job *entity.Job) (*entity.Job
 	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateClient")
 	defer span.End()
 
+	if err := u.normalizeClassification(ctx, job); err != nil {
+		return nil, err
+	}
+	job.Skills = normalizeSkills(job.Skills)
+	if err := normalizeSalary(job); err != nil {
+		return nil, err
+	}
+	// without a status the job keeps its current one and its schedule
+	if job.Status != "" {
+		if err := normalizeSchedule(job, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.resolveCompany(ctx, job); err != nil {
+		return nil, err
+	}
+	if err := u.resolveLocation(ctx, job); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeTaxonomy(ctx, job); err != nil {
+		return nil, err
+	}
+
 	u.beforeRequest(nil, nil, &job.UpdatedAt)
 
 	return u.repo.UpdateJob(ctx, job)
@@ -78,6 +161,44 @@ func (u jobService) GetJob(ctx context.Context, params map[string]string) (*enti
 	return u.repo.GetJob(ctx, params)
 }
 
+// GetJobsByIDs returns the jobs in the order of ids, repeated ids are looked up once
+// and ids without a job, malformed ones included, come back as missing
+func (u jobService) GetJobsByIDs(ctx context.Context, ids []string) ([]*entity.Job, []string, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetJobsByIDs")
+	defer span.End()
+
+	ids, valid, err := lookupIDs(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[string]*entity.Job, len(valid))
+	if len(valid) != 0 {
+		found, err := u.repo.GetJobsByIDs(ctx, valid)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, job := range found {
+			byID[job.GUID] = job
+		}
+	}
+
+	jobs := make([]*entity.Job, 0, len(byID))
+	missing := make([]string, 0)
+	for _, id := range ids {
+		if job, ok := byID[id]; ok {
+			jobs = append(jobs, job)
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	return jobs, missing, nil
+}
+
 func (u jobService) GetAllJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Job, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
@@ -85,9 +206,40 @@ func (u jobService) GetAllJobs(ctx context.Context, limit, offset uint64, filter
 	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ListClients")
 	defer span.End()
 
+	if err := u.normalizeListFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
 	return u.repo.GetAllJobs(ctx, limit, offset, filter)
 }
 
+// normalizeListFilter checks the filters of a job list and brings them to the form of the stored jobs
+func (u jobService) normalizeListFilter(ctx context.Context, filter map[string]string) error {
+	if skills := filter["skills"]; skills != "" {
+		filter["skills"] = strings.Join(normalizeSkills(strings.Split(skills, ",")), ",")
+	}
+	if err := normalizeSalaryFilter(filter); err != nil {
+		return err
+	}
+	if err := normalizeStatusFilter(filter); err != nil {
+		return err
+	}
+	if hasClassificationFilter(filter) {
+		dictionaries, err := u.dictionaries.GetDictionaries(ctx)
+		if err != nil {
+			return err
+		}
+		if err = normalizeClassificationFilter(dictionaries, filter); err != nil {
+			return err
+		}
+	}
+	if err := normalizeGeoFilter(ctx, u.geocoder, filter); err != nil {
+		return err
+	}
+
+	return normalizeTaxonomyFilter(ctx, u.taxonomy, filter)
+}
+
 func (u jobService) GetAllDeletedJobs(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Job, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
@@ -118,14 +270,56 @@ func (u jobService) GetJobClients(ctx context.Context, limit, offset uint64, fil
 	return u.repo.GetClientJobs(ctx, limit, offset, filter)
 }
 
-func (u jobService) AddClientJob(ctx context.Context, clientJob *entity.ClientJob) (*entity.Response, error) {
+// AddClientJob assigns an active client to a job, checkClient asks client-service about the client.
+// An empty end date leaves the assignment open, the periods of a client at the same job can't overlap.
+func (u jobService) AddClientJob(ctx context.Context, clientJob *entity.ClientJob, checkClient func(ctx context.Context) error) (*entity.Response, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
 
 	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "AddClientJob")
-	span.End()
+	defer span.End()
 
-	return u.repo.AddClientJob(ctx, clientJob)
+	var missing []string
+	if strings.TrimSpace(clientJob.ClientID) == "" {
+		missing = append(missing, "client_id")
+	}
+	if strings.TrimSpace(clientJob.JobID) == "" {
+		missing = append(missing, "job_id")
+	}
+	if len(missing) != 0 {
+		return nil, entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	u.beforeRequest(&clientJob.GUID, &clientJob.CreatedAt, &clientJob.UpdatedAt)
+	if id, ok := idempotency.ID(ctx, "client_job"); ok {
+		clientJob.GUID = id
+	}
+	if clientJob.StartDate.IsZero() {
+		clientJob.StartDate = clientJob.CreatedAt
+	}
+	if !clientJob.EndDate.IsZero() && !clientJob.EndDate.After(clientJob.StartDate) {
+		validation := entity.NewErrValidation()
+		validation.Err = fmt.Errorf("end_date should be after start_date")
+		validation.Errors["end_date"] = validation.Err.Error()
+		return nil, validation
+	}
+
+	if _, err := u.repo.GetJob(ctx, map[string]string{"id": clientJob.JobID}); err != nil {
+		if errors.Is(err, entity.ErrorNotFound) {
+			return nil, entity.NewErrNotFound("job")
+		}
+		return nil, err
+	}
+	if err := checkClient(ctx); err != nil {
+		return nil, err
+	}
+
+	response, err := u.repo.AddClientJob(ctx, clientJob)
+	if errors.Is(err, entity.ErrorConflict) {
+		return nil, entity.NewErrConflict("assignment of the client to the job for this period")
+	}
+
+	return response, err
 }
 
 func (u jobService) DeleteClientJob(ctx context.Context, clientJob *entity.ClientJob) error {
@@ -137,3 +331,245 @@ func (u jobService) DeleteClientJob(ctx context.Context, clientJob *entity.Clien
 
 	return u.repo.DeleteClientJob(ctx, clientJob)
 }
+
+func (u jobService) ReassignClientJobs(ctx context.Context, fromClientID, toClientID string) (*entity.Reassignment, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "ReassignClientJobs")
+	defer span.End()
+
+	if fromClientID == "" || toClientID == "" {
+		return nil, entity.NewErrNoRequiredParameter("from_client_id", "to_client_id")
+	}
+	if fromClientID == toClientID {
+		return nil, fmt.Errorf("can't reassign client jobs to the same client")
+	}
+
+	return u.repo.ReassignClientJobs(ctx, fromClientID, toClientID)
+}
+
+// UndoReassignClientJobs reverts a ReassignClientJobs, client-service calls it when the merge it
+// moved the assignments for fails
+func (u jobService) UndoReassignClientJobs(ctx context.Context, fromClientID, toClientID string, reassignment *entity.Reassignment) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UndoReassignClientJobs")
+	defer span.End()
+
+	if fromClientID == "" || toClientID == "" {
+		return 0, entity.NewErrNoRequiredParameter("from_client_id", "to_client_id")
+	}
+	if len(reassignment.Moved) == 0 && len(reassignment.Ended) == 0 {
+		return 0, nil
+	}
+
+	return u.repo.UndoReassignClientJobs(ctx, fromClientID, toClientID, reassignment)
+}
+
+func (u jobService) BatchCreateJobs(ctx context.Context, jobs []*entity.Job) ([]*entity.BatchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "BatchCreateJobs")
+	defer span.End()
+
+	dictionaries, err := u.dictionaries.GetDictionaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := u.taxonomy.GetCategories(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results = make([]*entity.BatchResult, len(jobs))
+		valid   = make([]*entity.Job, 0, len(jobs))
+		indexes = make([]int, 0, len(jobs))
+	)
+	for index, job := range jobs {
+		var missing []string
+		for name, value := range map[string]string{
+			"name":            job.Name,
+			"level":           job.Level,
+			"location_type":   job.LocationType,
+			"employment_type": job.EmploymentType,
+			"address":         job.Address,
+		} {
+			if strings.TrimSpace(value) == "" {
+				missing = append(missing, name)
+			}
+		}
+		if job.CompanyID == "" && strings.TrimSpace(job.Company) == "" {
+			missing = append(missing, "company_id")
+		}
+		if len(missing) != 0 {
+			sort.Strings(missing)
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: entity.NewErrNoRequiredParameter(missing...).Error(),
+			}
+			continue
+		}
+		if err := normalizeClassification(dictionaries, job); err != nil {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: err.Error(),
+			}
+			continue
+		}
+		job.Skills = normalizeSkills(job.Skills)
+		if err := normalizeSalary(job); err != nil {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: err.Error(),
+			}
+			continue
+		}
+		if err := normalizeSchedule(job, time.Now().UTC()); err != nil {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: err.Error(),
+			}
+			continue
+		}
+		if job.Status == entity.JobStatusArchived {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: entity.NewErrInvalidTransition("new", job.Status).Error(),
+			}
+			continue
+		}
+		if err := u.resolveCompany(ctx, job); err != nil {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: err.Error(),
+			}
+			continue
+		}
+		if err := u.resolveLocation(ctx, job); err != nil {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: err.Error(),
+			}
+			continue
+		}
+		if err := normalizeTaxonomy(categories, job); err != nil {
+			results[index] = &entity.BatchResult{
+				Index: uint64(index),
+				Error: err.Error(),
+			}
+			continue
+		}
+
+		u.beforeRequest(&job.GUID, &job.CreatedAt, &job.UpdatedAt)
+		valid = append(valid, job)
+		indexes = append(indexes, index)
+	}
+
+	if len(valid) != 0 {
+		created, err := u.repo.BatchCreateJobs(ctx, valid)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range created {
+			result.Index = uint64(indexes[result.Index])
+			results[result.Index] = result
+		}
+	}
+
+	return results, nil
+}
+
+// StreamJobs has no ctxTimeout, an export lives as long as the caller's stream. The filter is
+// that of GetAllJobs.
+func (u jobService) StreamJobs(ctx context.Context, scope string, filter map[string]string, fn func(job *entity.Job) error) error {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "StreamJobs")
+	defer span.End()
+
+	switch scope {
+	case "":
+		scope = entity.JobScopeActive
+	case entity.JobScopeActive, entity.JobScopeDeleted:
+	default:
+		return fmt.Errorf("unknown scope %q, expected active or deleted", scope)
+	}
+	if err := u.normalizeListFilter(ctx, filter); err != nil {
+		return err
+	}
+
+	return u.repo.StreamJobs(ctx, scope, filter, fn)
+}
+
+func (u jobService) StreamClientJobs(ctx context.Context, filter map[string]string, fn func(clientJob *entity.ClientJob) error) error {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "StreamClientJobs")
+	defer span.End()
+
+	return u.repo.StreamClientJobs(ctx, filter, fn)
+}
+
+// resolveCompany checks company_id of the job. Without one the company is looked up by the slug
+// of the company name and created when it's new, that's how imports and older callers attach jobs.
+func (u jobService) resolveCompany(ctx context.Context, job *entity.Job) error {
+	if job.CompanyID != "" {
+		company, err := u.companies.GetCompany(ctx, map[string]string{"id": job.CompanyID})
+		if err != nil {
+			if errors.Is(err, entity.ErrorNotFound) {
+				return entity.NewErrNotFound("company")
+			}
+			return err
+		}
+		job.Company = company.Name
+		return nil
+	}
+
+	name := strings.TrimSpace(job.Company)
+	if name == "" {
+		return nil
+	}
+
+	company := &entity.Company{
+		Name: name,
+		Slug: companySlug(name),
+	}
+	u.beforeRequest(&company.GUID, &company.CreatedAt, &company.UpdatedAt)
+
+	company, err := u.companies.EnsureCompany(ctx, company)
+	if err != nil {
+		return err
+	}
+	job.CompanyID, job.Company = company.GUID, company.Name
+
+	return nil
+}
+
+// lookupIDs drops blank and repeated ids, uuids are put in their canonical form.
+// valid holds the ids the database can be asked for.
+func lookupIDs(in []string) (ids, valid []string, err error) {
+	if len(in) > maxLookupIDs {
+		validation := entity.NewErrValidation()
+		validation.Errors["ids"] = fmt.Sprintf("at most %d ids can be looked up at once, got %d", maxLookupIDs, len(in))
+		return nil, nil, validationError(validation)
+	}
+
+	seen := make(map[string]bool, len(in))
+	for _, id := range in {
+		id = strings.TrimSpace(id)
+		parsed, err := uuid.Parse(id)
+		if err == nil {
+			id = parsed.String()
+		}
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+		if err == nil {
+			valid = append(valid, id)
+		}
+	}
+
+	return ids, valid, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLookupIDs(t *testing.T) {
+	const (
+		a = "6f1c2a3e-9b1d-4c5e-8f7a-0b1c2d3e4f5a"
+		b = "0a9b8c7d-6e5f-4a3b-9c2d-1e0f9a8b7c6d"
+	)
+	ids, valid, err := lookupIDs([]string{
+		strings.ToUpper(b), " ", "not-a-uuid", a, " " + b + " ", "not-a-uuid", "{" + a + "}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the order of the first appearance is kept, uuids in any form are the same id
+	if want := []string{b, "not-a-uuid", a}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+	if want := []string{b, a}; !reflect.DeepEqual(valid, want) {
+		t.Errorf("valid = %v, want %v", valid, want)
+	}
+
+	_, _, err = lookupIDs(make([]string, maxLookupIDs+1))
+	var validation *entity.ErrValidation
+	if !errors.As(err, &validation) || validation.Errors["ids"] == "" {
+		t.Errorf("lookupIDs of %d ids = %v, want an ids validation error", maxLookupIDs+1, err)
+	}
+}
+
+// foundJobs has some of the jobs, it returns them in no particular order like the database
+type foundJobs struct {
+	repository.Jobs
+	jobs  map[string]*entity.Job
+	asked []string
+}
+
+func (r *foundJobs) GetJobsByIDs(_ context.Context, ids []string) ([]*entity.Job, error) {
+	r.asked = ids
+	var found []*entity.Job
+	for i := len(ids) - 1; i >= 0; i-- {
+		if job, ok := r.jobs[ids[i]]; ok {
+			found = append(found, job)
+		}
+	}
+	return found, nil
+}
+
+func TestGetJobsByIDsOrder(t *testing.T) {
+	const (
+		a = "6f1c2a3e-9b1d-4c5e-8f7a-0b1c2d3e4f5a"
+		b = "0a9b8c7d-6e5f-4a3b-9c2d-1e0f9a8b7c6d"
+		c = "3c2b1a09-8f7e-4d6c-9b5a-4f3e2d1c0b9a"
+	)
+	repo := &foundJobs{jobs: map[string]*entity.Job{a: {GUID: a}, b: {GUID: b}}}
+	service := jobService{repo: repo, ctxTimeout: time.Second}
+
+	jobs, missing, err := service.GetJobsByIDs(context.Background(), []string{b, "bad", c, a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, job := range jobs {
+		got = append(got, job.GUID)
+	}
+	if want := []string{b, a}; !reflect.DeepEqual(got, want) {
+		t.Errorf("jobs = %v, want %v in the request order", got, want)
+	}
+	if want := []string{"bad", c}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("missing = %v, want %v", missing, want)
+	}
+	if want := []string{b, c, a}; !reflect.DeepEqual(repo.asked, want) {
+		t.Errorf("asked the database for %v, want %v", repo.asked, want)
+	}
+
+	repo.asked = nil
+	if jobs, missing, err = service.GetJobsByIDs(context.Background(), []string{"bad"}); err != nil || len(jobs) != 0 || len(missing) != 1 || repo.asked != nil {
+		t.Errorf("only malformed ids = %v, %v, %v, asked %v, want all missing without a query", jobs, missing, err, repo.asked)
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"job-service/internal/infrastructure/broker"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"time"
+)
+
+type Outbox interface {
+	RelayEvents(ctx context.Context) (published uint64, err error)
+	PruneEvents(ctx context.Context, retention time.Duration) (uint64, error)
+}
+
+type outboxService struct {
+	BaseUseCase
+	repo       repository.Outbox
+	broker     broker.Broker
+	batchSize  uint64
+	ctxTimeout time.Duration
+}
+
+// NewOutboxService publishes the events of the outbox through broker, batchSize events a pass
+func NewOutboxService(ctxTimeout time.Duration, repo repository.Outbox, broker broker.Broker, batchSize uint64) Outbox {
+	return outboxService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		broker:     broker,
+		batchSize:  batchSize,
+	}
+}
+
+// RelayEvents publishes the next pending events in order, an event that fails is retried by
+// the next pass before any event after it
+func (u outboxService) RelayEvents(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "RelayEvents")
+	defer span.End()
+
+	published, err := u.repo.RelayEvents(ctx, u.batchSize, u.broker.Publish)
+	if err != nil {
+		return published, u.Error("relay events", err)
+	}
+	return published, nil
+}
+
+// PruneEvents drops the events published longer than the retention ago
+func (u outboxService) PruneEvents(ctx context.Context, retention time.Duration) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "PruneEvents")
+	defer span.End()
+
+	return u.repo.PruneEvents(ctx, time.Now().UTC().Add(-retention))
+}
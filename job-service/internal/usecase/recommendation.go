@@ -0,0 +1,452 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRecommendations = 10
+	maxRecommendations     = 100
+	// history looks at this many of the latest applications of a client
+	historyApplications = 50
+)
+
+// DefaultRecommendationWeights is used for the factors RECOMMENDATION_WEIGHTS leaves out
+var DefaultRecommendationWeights = entity.RecommendationWeights{
+	entity.FactorSkills:         0.4,
+	entity.FactorLevel:          0.15,
+	entity.FactorLocationType:   0.1,
+	entity.FactorEmploymentType: 0.1,
+	entity.FactorSalary:         0.15,
+	entity.FactorHistory:        0.1,
+}
+
+// levels in order of seniority, neighbours match by half
+var levels = []string{"intern", "junior", "middle", "senior", "lead"}
+
+type Recommendation interface {
+	RecommendJobsForClient(ctx context.Context, profile *entity.CandidateProfile, limit uint64) ([]*entity.JobRecommendation, error)
+	RecommendClientsForJob(ctx context.Context, jobID string, limit uint64, listCandidates func(ctx context.Context, skills []string, limit uint64) ([]*entity.CandidateProfile, error)) ([]*entity.ClientRecommendation, error)
+}
+
+type recommendationService struct {
+	BaseUseCase
+	jobs         repository.Jobs
+	applications repository.Applications
+	weights      entity.RecommendationWeights
+	poolSize     uint64
+	ctxTimeout   time.Duration
+}
+
+// NewRecommendationService scores at most poolSize candidates per request
+func NewRecommendationService(ctxTimeout time.Duration, jobs repository.Jobs, applications repository.Applications, weights entity.RecommendationWeights, poolSize uint64) Recommendation {
+	return recommendationService{
+		ctxTimeout:   ctxTimeout,
+		jobs:         jobs,
+		applications: applications,
+		weights:      weights,
+		poolSize:     poolSize,
+	}
+}
+
+// ParseRecommendationWeights reads weights like "skills=0.5,salary=0.2", factors left out keep their default weight
+func ParseRecommendationWeights(value string) (entity.RecommendationWeights, error) {
+	weights := make(entity.RecommendationWeights, len(DefaultRecommendationWeights))
+	for factor, weight := range DefaultRecommendationWeights {
+		weights[factor] = weight
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		factor, number, ok := strings.Cut(pair, "=")
+		factor = strings.TrimSpace(factor)
+		if _, known := DefaultRecommendationWeights[factor]; !ok || !known {
+			return nil, fmt.Errorf("recommendation weight %q should be <factor>=<weight>, factors: skills, level, location_type, employment_type, salary, history", pair)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("recommendation weight of %s should be a non negative number, got %q", factor, number)
+		}
+		weights[factor] = weight
+	}
+
+	return weights, nil
+}
+
+// RecommendJobsForClient scores the published jobs sharing a skill with the client, or any published jobs
+// when the client has no skills yet. Jobs the client already applied to are left out.
+func (u recommendationService) RecommendJobsForClient(ctx context.Context, profile *entity.CandidateProfile, limit uint64) ([]*entity.JobRecommendation, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "RecommendJobsForClient")
+	defer span.End()
+
+	filter := map[string]string{
+		"status": entity.JobStatusPublished,
+	}
+	if len(profile.Skills) != 0 {
+		filter["skills"] = strings.Join(profile.Skills, ",")
+	}
+	jobs, err := u.jobs.GetAllJobs(ctx, u.poolSize, 0, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := u.applications.GetAppliedJobs(ctx, []string{profile.ClientID})
+	if err != nil {
+		return nil, err
+	}
+	appliedTo := make(map[string]bool, len(applied))
+	for _, job := range applied {
+		appliedTo[job.JobID] = true
+	}
+	if len(applied) > historyApplications {
+		applied = applied[:historyApplications]
+	}
+
+	recommendations := make([]*entity.JobRecommendation, 0, len(jobs))
+	for _, job := range jobs {
+		if appliedTo[job.GUID] {
+			continue
+		}
+
+		score, factors := u.score(job, profile, applied)
+		recommendations = append(recommendations, &entity.JobRecommendation{
+			Job:         job,
+			Score:       score,
+			Explanation: explain(factors),
+			Factors:     factors,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].Job.CreatedAt.After(recommendations[j].Job.CreatedAt)
+	})
+
+	return recommendations[:min(len(recommendations), recommendationLimit(limit))], nil
+}
+
+// RecommendClientsForJob shortlists clients for a job, listCandidates asks client-service for the profiles
+// sharing a skill with the job. Clients who already applied to the job are left out.
+func (u recommendationService) RecommendClientsForJob(ctx context.Context, jobID string, limit uint64, listCandidates func(ctx context.Context, skills []string, limit uint64) ([]*entity.CandidateProfile, error)) ([]*entity.ClientRecommendation, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "RecommendClientsForJob")
+	defer span.End()
+
+	if strings.TrimSpace(jobID) == "" {
+		return nil, entity.NewErrNoRequiredParameter("job_id")
+	}
+
+	job, err := u.jobs.GetJob(ctx, map[string]string{"id": jobID})
+	if err != nil {
+		if errors.Is(err, entity.ErrorNotFound) {
+			return nil, entity.NewErrNotFound("job")
+		}
+		return nil, err
+	}
+
+	candidates, err := listCandidates(ctx, job.Skills, u.poolSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return []*entity.ClientRecommendation{}, nil
+	}
+
+	clientIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		clientIDs = append(clientIDs, candidate.ClientID)
+	}
+	applied, err := u.applications.GetAppliedJobs(ctx, clientIDs)
+	if err != nil {
+		return nil, err
+	}
+	history := make(map[string][]*entity.AppliedJob, len(candidates))
+	appliedHere := make(map[string]bool)
+	for _, appliedJob := range applied {
+		if appliedJob.JobID == job.GUID {
+			appliedHere[appliedJob.ClientID] = true
+			continue
+		}
+		if len(history[appliedJob.ClientID]) < historyApplications {
+			history[appliedJob.ClientID] = append(history[appliedJob.ClientID], appliedJob)
+		}
+	}
+
+	recommendations := make([]*entity.ClientRecommendation, 0, len(candidates))
+	for _, candidate := range candidates {
+		if appliedHere[candidate.ClientID] {
+			continue
+		}
+
+		score, factors := u.score(job, candidate, history[candidate.ClientID])
+		recommendations = append(recommendations, &entity.ClientRecommendation{
+			Profile:     candidate,
+			Score:       score,
+			Explanation: explain(factors),
+			Factors:     factors,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+
+	return recommendations[:min(len(recommendations), recommendationLimit(limit))], nil
+}
+
+// score is the weighted mean of the factors with data on both sides, from 0 to 100
+func (u recommendationService) score(job *entity.Job, profile *entity.CandidateProfile, applied []*entity.AppliedJob) (float64, []*entity.RecommendationFactor) {
+	factors := []*entity.RecommendationFactor{
+		skillsFactor(job.Skills, profile.Skills),
+		levelFactor(job.Level, profile.DesiredLevel),
+		preferenceFactor(entity.FactorLocationType, job.LocationType, profile.DesiredLocationType),
+		preferenceFactor(entity.FactorEmploymentType, job.EmploymentType, profile.DesiredEmploymentType),
+		salaryFactor(job, profile),
+		historyFactor(job, applied),
+	}
+
+	var total, weights float64
+	for _, factor := range factors {
+		// a factor without data comes back with a negative score
+		if factor.Score < 0 {
+			factor.Score = 0
+			continue
+		}
+		factor.Weight = u.weights[factor.Name]
+		total += factor.Score * factor.Weight
+		weights += factor.Weight
+	}
+	if weights == 0 {
+		return 0, factors
+	}
+
+	return round(total / weights * 100), factors
+}
+
+func skillsFactor(jobSkills, clientSkills []string) *entity.RecommendationFactor {
+	factor := &entity.RecommendationFactor{Name: entity.FactorSkills}
+	if len(jobSkills) == 0 {
+		factor.Score, factor.Detail = -1, "the job lists no skills"
+		return factor
+	}
+
+	has := make(map[string]bool, len(clientSkills))
+	for _, skill := range clientSkills {
+		has[skill] = true
+	}
+	var matched []string
+	for _, skill := range jobSkills {
+		if has[skill] {
+			matched = append(matched, skill)
+		}
+	}
+
+	factor.Score = float64(len(matched)) / float64(len(jobSkills))
+	factor.Detail = fmt.Sprintf("%d of %d skills match", len(matched), len(jobSkills))
+	if len(matched) != 0 {
+		factor.Detail += ": " + strings.Join(matched, ", ")
+	}
+
+	return factor
+}
+
+func levelFactor(jobLevel, desiredLevel string) *entity.RecommendationFactor {
+	factor := &entity.RecommendationFactor{Name: entity.FactorLevel}
+	if desiredLevel == "" || jobLevel == "" {
+		factor.Score, factor.Detail = -1, "no level preference"
+		return factor
+	}
+
+	jobIndex, desiredIndex := levelIndex(jobLevel), levelIndex(desiredLevel)
+	switch {
+	case normalizeValue(jobLevel) == normalizeValue(desiredLevel):
+		factor.Score, factor.Detail = 1, "the level is "+jobLevel
+	case jobIndex >= 0 && desiredIndex >= 0 && (jobIndex-desiredIndex == 1 || desiredIndex-jobIndex == 1):
+		factor.Score, factor.Detail = 0.5, fmt.Sprintf("the level is %s, next to the wanted %s", jobLevel, desiredLevel)
+	default:
+		factor.Detail = fmt.Sprintf("the level is %s, not the wanted %s", jobLevel, desiredLevel)
+	}
+
+	return factor
+}
+
+func preferenceFactor(name, jobValue, desiredValue string) *entity.RecommendationFactor {
+	factor := &entity.RecommendationFactor{Name: name}
+	label := strings.ReplaceAll(name, "_", " ")
+	if desiredValue == "" || jobValue == "" {
+		factor.Score, factor.Detail = -1, "no "+label+" preference"
+		return factor
+	}
+
+	if normalizeValue(jobValue) == normalizeValue(desiredValue) {
+		factor.Score, factor.Detail = 1, fmt.Sprintf("the %s is %s", label, jobValue)
+	} else {
+		factor.Detail = fmt.Sprintf("the %s is %s, not the wanted %s", label, jobValue, desiredValue)
+	}
+
+	return factor
+}
+
+// salaryFactor compares the top of the range with the expectation, salaries in other
+// currencies or pay periods aren't converted and don't count
+func salaryFactor(job *entity.Job, profile *entity.CandidateProfile) *entity.RecommendationFactor {
+	factor := &entity.RecommendationFactor{Name: entity.FactorSalary}
+
+	top := job.SalaryMax
+	if top == "" {
+		top = job.SalaryMin
+	}
+	expected, err := strconv.ParseFloat(profile.ExpectedSalary, 64)
+	offered, offeredErr := strconv.ParseFloat(top, 64)
+	switch {
+	case err != nil || expected <= 0:
+		factor.Score, factor.Detail = -1, "no salary expectation"
+		return factor
+	case offeredErr != nil:
+		factor.Score, factor.Detail = -1, "the salary isn't disclosed"
+		return factor
+	case job.Currency != profile.ExpectedCurrency || job.PayPeriod != profile.ExpectedPayPeriod:
+		factor.Score = -1
+		factor.Detail = fmt.Sprintf("the salary is in %s per %s, expected in %s per %s",
+			job.Currency, job.PayPeriod, profile.ExpectedCurrency, profile.ExpectedPayPeriod)
+		return factor
+	}
+
+	if offered >= expected {
+		factor.Score = 1
+		factor.Detail = fmt.Sprintf("pays up to %s %s per %s, meets the expected %s", top, job.Currency, job.PayPeriod, profile.ExpectedSalary)
+	} else {
+		factor.Score = offered / expected
+		factor.Detail = fmt.Sprintf("pays up to %s %s per %s, below the expected %s", top, job.Currency, job.PayPeriod, profile.ExpectedSalary)
+	}
+
+	return factor
+}
+
+// historyFactor is how close the job is to the most similar job the client applied to before,
+// rejected applications don't count
+func historyFactor(job *entity.Job, applied []*entity.AppliedJob) *entity.RecommendationFactor {
+	factor := &entity.RecommendationFactor{Name: entity.FactorHistory}
+
+	var similar int
+	for _, past := range applied {
+		if past.Status == entity.ApplicationStatusRejected {
+			continue
+		}
+
+		var similarity float64
+		if past.CompanyID != "" && past.CompanyID == job.CompanyID {
+			similarity += 0.4
+		}
+		if normalizeValue(past.Level) == normalizeValue(job.Level) {
+			similarity += 0.2
+		}
+		if normalizeValue(past.LocationType) == normalizeValue(job.LocationType) {
+			similarity += 0.2
+		}
+		if normalizeValue(past.EmploymentType) == normalizeValue(job.EmploymentType) {
+			similarity += 0.2
+		}
+		if similarity >= 0.6 {
+			similar++
+		}
+		factor.Score = max(factor.Score, similarity)
+	}
+
+	if len(applied) == 0 {
+		factor.Score, factor.Detail = -1, "no past applications"
+	} else {
+		factor.Detail = fmt.Sprintf("%d of %d past applications are to similar jobs", similar, len(applied))
+	}
+
+	return factor
+}
+
+// explain names the factors which matched best, strongest first
+func explain(factors []*entity.RecommendationFactor) string {
+	strong := make([]*entity.RecommendationFactor, 0, len(factors))
+	for _, factor := range factors {
+		if factor.Weight > 0 && factor.Score >= 0.5 {
+			strong = append(strong, factor)
+		}
+	}
+	if len(strong) == 0 {
+		return "no strong match"
+	}
+
+	sort.SliceStable(strong, func(i, j int) bool {
+		return strong[i].Score*strong[i].Weight > strong[j].Score*strong[j].Weight
+	})
+	details := make([]string, 0, len(strong))
+	for _, factor := range strong {
+		details = append(details, factor.Detail)
+	}
+
+	return strings.Join(details, "; ")
+}
+
+func recommendationLimit(limit uint64) int {
+	switch {
+	case limit == 0:
+		return defaultRecommendations
+	case limit > maxRecommendations:
+		return maxRecommendations
+	}
+	return int(limit)
+}
+
+func levelIndex(level string) int {
+	level = normalizeValue(level)
+	for index, known := range levels {
+		if known == level {
+			return index
+		}
+	}
+	return -1
+}
+
+// normalizeValue makes "Full-Time" and "full time" equal
+func normalizeValue(value string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), " ")
+}
+
+// normalizeSkills lowercases and sorts the skills, empty ones and repeats are dropped
+func normalizeSkills(skills []string) []string {
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.Join(strings.Fields(skill), " "))
+		if skill == "" || seen[skill] {
+			continue
+		}
+		seen[skill] = true
+		normalized = append(normalized, skill)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+func round(value float64) float64 {
+	return float64(int64(value*100+0.5)) / 100
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRecommendationWeights(t *testing.T) {
+	weights, err := ParseRecommendationWeights(" skills = 0.5 ,salary=0,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for factor, want := range DefaultRecommendationWeights {
+		switch factor {
+		case entity.FactorSkills:
+			want = 0.5
+		case entity.FactorSalary:
+			want = 0
+		}
+		if weights[factor] != want {
+			t.Errorf("weight of %s = %v, want %v", factor, weights[factor], want)
+		}
+	}
+
+	if weights, err = ParseRecommendationWeights(""); err != nil || len(weights) != len(DefaultRecommendationWeights) {
+		t.Errorf("ParseRecommendationWeights(\"\") = %v, %v, want the defaults", weights, err)
+	}
+
+	for _, value := range []string{"distance=0.3", "skills", "=0.3", "skills=-0.1", "skills=much"} {
+		if _, err := ParseRecommendationWeights(value); err == nil {
+			t.Errorf("ParseRecommendationWeights(%q) = nil error", value)
+		}
+	}
+}
+
+func TestLevelFactor(t *testing.T) {
+	tests := []struct {
+		job, desired string
+		score        float64
+	}{
+		{"Senior", "senior", 1},
+		{"Senior", "Middle", 0.5},
+		{"Lead", "senior", 0.5},
+		{"Intern", "Junior", 0.5},
+		{"Senior", "Junior", 0},
+		{"Lead", "Intern", 0},
+		{"Principal", "Lead", 0},
+		{"", "Senior", -1},
+		{"Senior", "", -1},
+	}
+	for _, tt := range tests {
+		if got := levelFactor(tt.job, tt.desired).Score; got != tt.score {
+			t.Errorf("levelFactor(%q, %q) = %v, want %v", tt.job, tt.desired, got, tt.score)
+		}
+	}
+}
+
+func TestRecommendationFactors(t *testing.T) {
+	job := &entity.Job{
+		CompanyID:      "acme",
+		Skills:         []string{"go", "sql"},
+		Level:          "Senior",
+		LocationType:   "Remote",
+		EmploymentType: "Full-Time",
+		SalaryMin:      "1000",
+		SalaryMax:      "2000",
+		Currency:       "USD",
+		PayPeriod:      "month",
+	}
+	profile := &entity.CandidateProfile{
+		ExpectedSalary:    "4000",
+		ExpectedCurrency:  "USD",
+		ExpectedPayPeriod: "month",
+	}
+
+	tests := []struct {
+		name   string
+		factor *entity.RecommendationFactor
+		score  float64
+	}{
+		{"skills", skillsFactor(job.Skills, []string{"go", "docker"}), 0.5},
+		{"no job skills", skillsFactor(nil, []string{"go"}), -1},
+		{"location", preferenceFactor(entity.FactorLocationType, "Remote", "remote"), 1},
+		{"employment", preferenceFactor(entity.FactorEmploymentType, "Full-Time", "full time"), 1},
+		{"other employment", preferenceFactor(entity.FactorEmploymentType, "Full-Time", "Part-Time"), 0},
+		{"no preference", preferenceFactor(entity.FactorLocationType, "Remote", ""), -1},
+		{"salary below", salaryFactor(job, profile), 0.5},
+		{"salary met", salaryFactor(job, &entity.CandidateProfile{
+			ExpectedSalary: "1500", ExpectedCurrency: "USD", ExpectedPayPeriod: "month",
+		}), 1},
+		{"salary in another currency", salaryFactor(job, &entity.CandidateProfile{
+			ExpectedSalary: "1500", ExpectedCurrency: "EUR", ExpectedPayPeriod: "month",
+		}), -1},
+		{"no salary expectation", salaryFactor(job, &entity.CandidateProfile{}), -1},
+		{"undisclosed salary", salaryFactor(&entity.Job{}, profile), -1},
+		{"similar history", historyFactor(job, []*entity.AppliedJob{
+			{CompanyID: "acme", Level: "senior", LocationType: "Hybrid", EmploymentType: "Contract"},
+			{CompanyID: "other", Level: "Senior", LocationType: "Remote", EmploymentType: "full-time"},
+		}), 0.6},
+		{"rejected history", historyFactor(job, []*entity.AppliedJob{
+			{CompanyID: "acme", Level: "Senior", Status: entity.ApplicationStatusRejected},
+		}), 0},
+		{"no history", historyFactor(job, nil), -1},
+	}
+	for _, tt := range tests {
+		if diff := tt.factor.Score - tt.score; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("%s: score = %v, want %v (%s)", tt.name, tt.factor.Score, tt.score, tt.factor.Detail)
+		}
+	}
+}
+
+func TestScoreAndExplain(t *testing.T) {
+	u := recommendationService{weights: DefaultRecommendationWeights}
+	job := &entity.Job{
+		Skills:         []string{"go", "sql"},
+		Level:          "Senior",
+		LocationType:   "Remote",
+		EmploymentType: "Full-Time",
+		SalaryMax:      "2000",
+		Currency:       "USD",
+		PayPeriod:      "month",
+	}
+	profile := &entity.CandidateProfile{
+		Skills:                []string{"go"},
+		DesiredLevel:          "middle",
+		DesiredLocationType:   "remote",
+		DesiredEmploymentType: "full time",
+		ExpectedSalary:        "1000",
+		ExpectedCurrency:      "USD",
+		ExpectedPayPeriod:     "month",
+	}
+
+	// skills 0.5*0.4, level 0.5*0.15, location 1*0.1, employment 1*0.1, salary 1*0.15,
+	// the history has no data and doesn't count: 0.625 / 0.9
+	score, factors := u.score(job, profile, nil)
+	if score != 69.44 {
+		t.Errorf("score = %v, want 69.44", score)
+	}
+	for _, factor := range factors {
+		if factor.Name == entity.FactorHistory && (factor.Score != 0 || factor.Weight != 0) {
+			t.Errorf("history without data = %+v, want no score and weight", factor)
+		}
+	}
+
+	want := strings.Join([]string{
+		"1 of 2 skills match: go",
+		"pays up to 2000 USD per month, meets the expected 1000",
+		"the location type is Remote",
+		"the employment type is Full-Time",
+		"the level is Senior, next to the wanted middle",
+	}, "; ")
+	if got := explain(factors); got != want {
+		t.Errorf("explain = %q, want %q", got, want)
+	}
+
+	if got := explain([]*entity.RecommendationFactor{{Name: entity.FactorSkills, Score: 0.2, Weight: 0.4}}); got != "no strong match" {
+		t.Errorf("explain of weak factors = %q", got)
+	}
+
+	zero := recommendationService{weights: entity.RecommendationWeights{}}
+	if score, _ := zero.score(job, profile, nil); score != 0 {
+		t.Errorf("score without weights = %v, want 0", score)
+	}
+}
+
+// recommendedJobs has the published jobs of the pool
+type recommendedJobs struct {
+	repository.Jobs
+	jobs   []*entity.Job
+	filter map[string]string
+}
+
+func (r *recommendedJobs) GetAllJobs(_ context.Context, _, _ uint64, filter map[string]string) ([]*entity.Job, error) {
+	r.filter = filter
+	return r.jobs, nil
+}
+
+// appliedJobs has the applications of the clients
+type appliedJobs struct {
+	repository.Applications
+	applied []*entity.AppliedJob
+}
+
+func (r appliedJobs) GetAppliedJobs(context.Context, []string) ([]*entity.AppliedJob, error) {
+	return r.applied, nil
+}
+
+func TestRecommendJobsForClientOrder(t *testing.T) {
+	now := time.Now()
+	jobs := &recommendedJobs{jobs: []*entity.Job{
+		{GUID: "older", Skills: []string{"go", "sql"}, Level: "Junior", CreatedAt: now.Add(-time.Hour)},
+		{GUID: "best", Skills: []string{"go", "sql"}, Level: "Senior", CreatedAt: now.Add(-2 * time.Hour)},
+		{GUID: "newer", Skills: []string{"go", "sql"}, Level: "Junior", CreatedAt: now},
+		{GUID: "applied", Skills: []string{"go"}, Level: "Senior", CreatedAt: now},
+	}}
+	applications := appliedJobs{applied: []*entity.AppliedJob{{ClientID: "c1", JobID: "applied", Status: entity.ApplicationStatusApplied}}}
+	service := NewRecommendationService(time.Second, jobs, applications, DefaultRecommendationWeights, 100)
+
+	recommendations, err := service.RecommendJobsForClient(context.Background(), &entity.CandidateProfile{
+		ClientID:     "c1",
+		Skills:       []string{"go", "sql"},
+		DesiredLevel: "Senior",
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobs.filter["status"] != entity.JobStatusPublished || jobs.filter["skills"] != "go,sql" {
+		t.Errorf("filter = %v, want the published jobs with the skills", jobs.filter)
+	}
+
+	// equal scores come newest first, applied jobs are left out
+	var got []string
+	for _, recommendation := range recommendations {
+		got = append(got, recommendation.Job.GUID)
+	}
+	if strings.Join(got, ",") != "best,newer,older" {
+		t.Errorf("recommended %v, want best, newer, older", got)
+	}
+	if recommendations, _ = service.RecommendJobsForClient(context.Background(), &entity.CandidateProfile{ClientID: "c1"}, 1); len(recommendations) != 1 {
+		t.Errorf("got %d recommendations, want the limit of 1", len(recommendations))
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sagaRepo keeps the last saved state of the saga and records the steps, the methods the job
+// deletion doesn't use panic
+type sagaRepo struct {
+	repository.Sagas
+	saved       entity.Saga
+	steps       []string
+	overlapping uint64
+}
+
+func (r *sagaRepo) CreateSaga(_ context.Context, saga *entity.Saga) error {
+	r.saved = *saga
+	return nil
+}
+
+func (r *sagaRepo) SaveSaga(_ context.Context, saga *entity.Saga) error {
+	r.saved = *saga
+	return nil
+}
+
+func (r *sagaRepo) CloseApplications(context.Context, string, string, string, time.Time) (uint64, error) {
+	r.steps = append(r.steps, "close_applications")
+	return 1, nil
+}
+
+func (r *sagaRepo) ReopenApplications(context.Context, string, string, time.Time) (uint64, error) {
+	r.steps = append(r.steps, "reopen_applications")
+	return 1, nil
+}
+
+func (r *sagaRepo) EndAssignments(context.Context, string, map[string]string, time.Time) (uint64, error) {
+	r.steps = append(r.steps, "end_assignments")
+	return 2, nil
+}
+
+func (r *sagaRepo) ReopenAssignments(context.Context, string, time.Time) (uint64, uint64, error) {
+	r.steps = append(r.steps, "reopen_assignments")
+	return 2 - r.overlapping, r.overlapping, nil
+}
+
+// undeletableJobs has the job, deleting it fails for good
+type undeletableJobs struct {
+	repository.Jobs
+}
+
+func (undeletableJobs) GetJob(_ context.Context, params map[string]string) (*entity.Job, error) {
+	return &entity.Job{GUID: params["id"]}, nil
+}
+
+func (undeletableJobs) DeleteJob(context.Context, string) error {
+	return entity.NewErrPrecondition("the job is referenced")
+}
+
+func TestDeleteJobCompensatesOverlappingAssignments(t *testing.T) {
+	repo := &sagaRepo{overlapping: 1}
+	service := NewSagaService(time.Second, repo, undeletableJobs{})
+
+	err := service.DeleteJob(context.Background(), "j1")
+	var errPrecondition *entity.ErrPrecondition
+	if !errors.As(err, &errPrecondition) {
+		t.Fatalf("DeleteJob = %v, want the error of the failed step", err)
+	}
+
+	want := []string{"close_applications", "end_assignments", "reopen_assignments", "reopen_applications"}
+	if strings.Join(repo.steps, ",") != strings.Join(want, ",") {
+		t.Errorf("steps = %v, want %v", repo.steps, want)
+	}
+
+	// an assignment overlapping a newer one doesn't stop the compensation, it's reported
+	if repo.saved.Status != entity.SagaCompensated {
+		t.Errorf("status = %s, want compensated", repo.saved.Status)
+	}
+	if !strings.Contains(repo.saved.LastError, "delete_job: the job is referenced") ||
+		!strings.Contains(repo.saved.LastError, "1 assignments stay ended") {
+		t.Errorf("last error = %q, want the cause and the overlapping assignments", repo.saved.LastError)
+	}
+}
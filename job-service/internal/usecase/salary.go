@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"fmt"
+	"job-service/internal/entity"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// salaries are NUMERIC(14, 2): up to 12 integer digits and 2 fraction digits
+var decimalRegexp = regexp.MustCompile(`^\d{1,12}(\.\d{1,2})?$`)
+
+// normalizeSalary validates the salary range of a job and brings currency and pay period
+// to their canonical form. A range needs a currency and a pay period, either bound may be empty.
+func normalizeSalary(job *entity.Job) error {
+	job.SalaryMin = strings.TrimSpace(job.SalaryMin)
+	job.SalaryMax = strings.TrimSpace(job.SalaryMax)
+
+	validation := entity.NewErrValidation()
+
+	var err error
+	if job.Currency, err = normalizeCurrency(job.Currency); err != nil {
+		validation.Errors["currency"] = err.Error()
+	}
+	if job.PayPeriod, err = normalizePayPeriod(job.PayPeriod); err != nil {
+		validation.Errors["pay_period"] = err.Error()
+	}
+	if err = validationError(validation); err != nil {
+		return err
+	}
+
+	if job.SalaryMin == "" && job.SalaryMax == "" {
+		return nil
+	}
+
+	var missing []string
+	if job.Currency == "" {
+		missing = append(missing, "currency")
+	}
+	if job.PayPeriod == "" {
+		missing = append(missing, "pay_period")
+	}
+	if len(missing) != 0 {
+		return entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	lower, err := parseDecimal(job.SalaryMin)
+	if err != nil {
+		validation.Errors["salary_min"] = err.Error()
+	}
+	upper, err := parseDecimal(job.SalaryMax)
+	if err != nil {
+		validation.Errors["salary_max"] = err.Error()
+	}
+	if lower != nil && upper != nil && lower.Cmp(upper) > 0 {
+		validation.Errors["salary_min"] = fmt.Sprintf("should not be greater than salary_max %s", job.SalaryMax)
+	}
+
+	return validationError(validation)
+}
+
+// normalizeSalaryFilter checks the salary filters of a job list, empty ones are dropped
+func normalizeSalaryFilter(filter map[string]string) error {
+	validation := entity.NewErrValidation()
+	for key, value := range filter {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			delete(filter, key)
+			continue
+		}
+
+		var err error
+		switch key {
+		case "salary_from", "salary_to":
+			_, err = parseDecimal(value)
+		case "currency":
+			value, err = normalizeCurrency(value)
+		case "pay_period":
+			value, err = normalizePayPeriod(value)
+		}
+		if err != nil {
+			validation.Errors[key] = err.Error()
+			continue
+		}
+		filter[key] = value
+	}
+
+	return validationError(validation)
+}
+
+// parseDecimal, normalizeCurrency and normalizePayPeriod describe what's wrong with the value,
+// the callers report it under the name of the field
+func parseDecimal(value string) (*big.Rat, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if !decimalRegexp.MatchString(value) {
+		return nil, fmt.Errorf("should be a decimal with up to 2 fraction digits, got %q", value)
+	}
+
+	number, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("should be a decimal, got %q", value)
+	}
+
+	return number, nil
+}
+
+func normalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return "", nil
+	}
+
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return "", fmt.Errorf("%q is not an ISO 4217 code", code)
+	}
+
+	return unit.String(), nil
+}
+
+func normalizePayPeriod(period string) (string, error) {
+	period = strings.ToLower(strings.TrimSpace(period))
+	switch period {
+	case "", entity.PayPeriodHour, entity.PayPeriodMonth, entity.PayPeriodYear:
+		return period, nil
+	default:
+		return "", fmt.Errorf("unknown %q, expected hour, month or year", period)
+	}
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"errors"
+	"job-service/internal/entity"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		fails bool
+	}{
+		{value: "", want: ""},
+		{value: "1500", want: "1500/1"},
+		{value: "1500.5", want: "3001/2"},
+		{value: "0.01", want: "1/100"},
+		{value: "999999999999.99", want: "99999999999999/100"},
+		{value: "1000000000000", fails: true},
+		{value: "1.234", fails: true},
+		{value: "-5", fails: true},
+		{value: "1e3", fails: true},
+		{value: "1,5", fails: true},
+	}
+	for _, tt := range tests {
+		number, err := parseDecimal(tt.value)
+		if tt.fails {
+			if err == nil {
+				t.Errorf("parseDecimal(%q) = %v, want an error", tt.value, number)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseDecimal(%q) failed: %v", tt.value, err)
+			continue
+		}
+		got := ""
+		if number != nil {
+			got = number.String()
+		}
+		if got != tt.want {
+			t.Errorf("parseDecimal(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeSalary(t *testing.T) {
+	tests := []struct {
+		name   string
+		job    entity.Job
+		want   entity.Job
+		fields []string
+	}{
+		{
+			name: "canonical form",
+			job:  entity.Job{SalaryMin: " 1000 ", SalaryMax: "2000.50", Currency: " usd", PayPeriod: "Month"},
+			want: entity.Job{SalaryMin: "1000", SalaryMax: "2000.50", Currency: "USD", PayPeriod: "month"},
+		},
+		{
+			name: "no salary",
+			job:  entity.Job{},
+			want: entity.Job{},
+		},
+		{
+			name: "open upper bound",
+			job:  entity.Job{SalaryMin: "1000", Currency: "EUR", PayPeriod: "year"},
+			want: entity.Job{SalaryMin: "1000", Currency: "EUR", PayPeriod: "year"},
+		},
+		{
+			name:   "min greater than max",
+			job:    entity.Job{SalaryMin: "3000", SalaryMax: "2000", Currency: "USD", PayPeriod: "month"},
+			fields: []string{"salary_min"},
+		},
+		{
+			name:   "bad decimals",
+			job:    entity.Job{SalaryMin: "abc", SalaryMax: "1.234", Currency: "USD", PayPeriod: "month"},
+			fields: []string{"salary_max", "salary_min"},
+		},
+		{
+			name:   "bad currency and pay period",
+			job:    entity.Job{SalaryMin: "1000", Currency: "XYZW", PayPeriod: "week"},
+			fields: []string{"currency", "pay_period"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := tt.job
+			err := normalizeSalary(&job)
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("normalizeSalary failed: %v", err)
+				}
+				if job.SalaryMin != tt.want.SalaryMin || job.SalaryMax != tt.want.SalaryMax ||
+					job.Currency != tt.want.Currency || job.PayPeriod != tt.want.PayPeriod {
+					t.Errorf("normalizeSalary = %+v, want %+v", job, tt.want)
+				}
+				return
+			}
+
+			var validation *entity.ErrValidation
+			if !errors.As(err, &validation) {
+				t.Fatalf("normalizeSalary = %v, want a validation error", err)
+			}
+			if len(validation.Errors) != len(tt.fields) {
+				t.Errorf("invalid fields = %v, want %v", validation.Errors, tt.fields)
+			}
+			for _, field := range tt.fields {
+				if _, ok := validation.Errors[field]; !ok {
+					t.Errorf("%s isn't reported in %v", field, validation.Errors)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeSalaryRequiresCurrencyAndPayPeriod(t *testing.T) {
+	job := entity.Job{SalaryMax: "1000"}
+	var missing *entity.ErrNoRequiredParameter
+	if err := normalizeSalary(&job); !errors.As(err, &missing) {
+		t.Fatalf("normalizeSalary = %v, want a missing parameter error", err)
+	}
+}
+
+func TestNormalizeSalaryFilter(t *testing.T) {
+	filter := map[string]string{"salary_from": "100", "salary_to": " ", "currency": "uzs", "pay_period": "HOUR"}
+	if err := normalizeSalaryFilter(filter); err != nil {
+		t.Fatalf("normalizeSalaryFilter failed: %v", err)
+	}
+	if _, ok := filter["salary_to"]; ok || filter["currency"] != "UZS" || filter["pay_period"] != "hour" {
+		t.Errorf("filter = %v", filter)
+	}
+
+	var validation *entity.ErrValidation
+	err := normalizeSalaryFilter(map[string]string{"salary_from": "ten", "currency": "dollars"})
+	if !errors.As(err, &validation) || len(validation.Errors) != 2 {
+		t.Fatalf("normalizeSalaryFilter = %v, want salary_from and currency reported", err)
+	}
+}
@@ -0,0 +1,410 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/geocoder"
+	"job-service/internal/infrastructure/notifier"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"strings"
+	"time"
+)
+
+const (
+	maxSavedSearches = 20
+	// published jobs matched against the saved searches per query
+	alertJobsBatch = 500
+)
+
+type SavedSearch interface {
+	CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, clientID, guid string) error
+	GetSavedSearches(ctx context.Context, clientID string) ([]*entity.SavedSearch, error)
+	EvaluateNewJobs(ctx context.Context) (matched uint64, err error)
+	SendDueAlerts(ctx context.Context) (sent uint64, err error)
+}
+
+type savedSearchService struct {
+	BaseUseCase
+	repo         repository.SavedSearches
+	dictionaries repository.Dictionaries
+	taxonomy     repository.Taxonomy
+	geocoder     geocoder.Geocoder
+	notifier     notifier.Notifier
+	ctxTimeout   time.Duration
+}
+
+// NewSavedSearchService sends alerts through notifier
+func NewSavedSearchService(ctxTimeout time.Duration, repo repository.SavedSearches, dictionaries repository.Dictionaries, taxonomy repository.Taxonomy, geocoder geocoder.Geocoder, notifier notifier.Notifier) SavedSearch {
+	return savedSearchService{
+		ctxTimeout:   ctxTimeout,
+		repo:         repo,
+		dictionaries: dictionaries,
+		taxonomy:     taxonomy,
+		geocoder:     geocoder,
+		notifier:     notifier,
+	}
+}
+
+func (u savedSearchService) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "CreateSavedSearch")
+	defer span.End()
+
+	if err := normalizeSavedSearch(search); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeClassification(ctx, &search.Filter); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeLocationAndTaxonomy(ctx, &search.Filter); err != nil {
+		return nil, err
+	}
+
+	count, err := u.repo.CountSavedSearches(ctx, search.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxSavedSearches {
+		return nil, entity.NewErrPrecondition(fmt.Sprintf("a client can save at most %d searches", maxSavedSearches))
+	}
+
+	u.beforeRequest(&search.GUID, &search.CreatedAt, &search.UpdatedAt)
+
+	created, err := u.repo.CreateSavedSearch(ctx, search)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("client")
+	}
+	return created, err
+}
+
+func (u savedSearchService) UpdateSavedSearch(ctx context.Context, search *entity.SavedSearch) (*entity.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateSavedSearch")
+	defer span.End()
+
+	if search.GUID == "" {
+		return nil, entity.NewErrNoRequiredParameter("id")
+	}
+	if err := normalizeSavedSearch(search); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeClassification(ctx, &search.Filter); err != nil {
+		return nil, err
+	}
+	if err := u.normalizeLocationAndTaxonomy(ctx, &search.Filter); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(nil, nil, &search.UpdatedAt)
+
+	updated, err := u.repo.UpdateSavedSearch(ctx, search)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("saved search")
+	}
+	return updated, err
+}
+
+func (u savedSearchService) DeleteSavedSearch(ctx context.Context, clientID, guid string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeleteSavedSearch")
+	defer span.End()
+
+	if clientID == "" || guid == "" {
+		return entity.NewErrNoRequiredParameter("client_id", "id")
+	}
+
+	err := u.repo.DeleteSavedSearch(ctx, clientID, guid)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrNotFound("saved search")
+	}
+	return err
+}
+
+func (u savedSearchService) GetSavedSearches(ctx context.Context, clientID string) ([]*entity.SavedSearch, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetSavedSearches")
+	defer span.End()
+
+	if clientID == "" {
+		return nil, entity.NewErrNoRequiredParameter("client_id")
+	}
+
+	return u.repo.GetSavedSearches(ctx, clientID)
+}
+
+// EvaluateNewJobs matches the published jobs not seen yet against every saved search and queues
+// the matches. It is run after jobs are created or published and by the scheduler, a job is
+// evaluated once whichever comes first.
+func (u savedSearchService) EvaluateNewJobs(ctx context.Context) (matched uint64, err error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "EvaluateNewJobs")
+	defer span.End()
+
+	var (
+		searches   []*entity.SavedSearch
+		categories entity.CategoryTree
+	)
+	for {
+		jobs, err := u.repo.GetUnevaluatedJobs(ctx, alertJobsBatch)
+		if err != nil {
+			return matched, err
+		}
+		if len(jobs) == 0 {
+			return matched, nil
+		}
+		if searches == nil {
+			if searches, err = u.repo.GetAllSavedSearches(ctx); err != nil {
+				return matched, err
+			}
+			if categories, err = u.categoryTree(ctx, searches); err != nil {
+				return matched, err
+			}
+		}
+
+		jobIDs := make([]string, 0, len(jobs))
+		matches := make([]*entity.SearchMatch, 0)
+		for _, job := range jobs {
+			jobIDs = append(jobIDs, job.GUID)
+			for _, search := range searches {
+				if search.Filter.Matches(job, categories) {
+					matches = append(matches, &entity.SearchMatch{
+						SavedSearchID: search.GUID,
+						Job:           job,
+					})
+				}
+			}
+		}
+
+		if err = u.repo.SaveSearchMatches(ctx, jobIDs, matches, time.Now().UTC()); err != nil {
+			return matched, err
+		}
+		matched += uint64(len(matches))
+
+		if len(jobs) < alertJobsBatch {
+			return matched, nil
+		}
+	}
+}
+
+// SendDueAlerts sends one notification per saved search due, with all the jobs it found since
+// the last one. A failed search is retried on the next run and doesn't stop the others.
+func (u savedSearchService) SendDueAlerts(ctx context.Context) (sent uint64, err error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "SendDueAlerts")
+	defer span.End()
+
+	now := time.Now().UTC()
+	searches, err := u.repo.GetDueSavedSearches(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for _, search := range searches {
+		ok, err := u.sendAlert(ctx, search, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("saved search %s: %w", search.GUID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// sendAlert reports whether a notification went out, matches of jobs closed in the meantime
+// are dropped. Clients who can't be alerted any more are skipped by client-service.
+func (u savedSearchService) sendAlert(ctx context.Context, search *entity.SavedSearch, now time.Time) (bool, error) {
+	matches, err := u.repo.GetUnsentMatches(ctx, search.GUID, now)
+	if err != nil {
+		return false, err
+	}
+	if len(matches) == 0 {
+		return false, u.repo.MarkMatchesSent(ctx, search.GUID, now, now)
+	}
+
+	if err = u.notifier.Send(ctx, alertNotification(search, matches)); err != nil {
+		return false, err
+	}
+
+	return true, u.repo.MarkMatchesSent(ctx, search.GUID, now, now)
+}
+
+// alertNotification is the data of the job_alert template of client-service
+func alertNotification(search *entity.SavedSearch, matches []*entity.SearchMatch) *entity.Notification {
+	jobs := make([]map[string]any, 0, len(matches))
+	for _, match := range matches {
+		job := match.Job
+		jobs = append(jobs, map[string]any{
+			"ID":        job.GUID,
+			"Name":      job.Name,
+			"Company":   job.Company,
+			"Details":   strings.Join(nonEmpty(job.Level, job.LocationType, job.EmploymentType, job.Address), ", "),
+			"SalaryMin": job.SalaryMin,
+			"SalaryMax": job.SalaryMax,
+			"Currency":  job.Currency,
+			"PayPeriod": job.PayPeriod,
+		})
+	}
+
+	return &entity.Notification{
+		ClientID: search.ClientID,
+		Template: entity.TemplateJobAlert,
+		Data: map[string]any{
+			"SearchName": search.Name,
+			"Frequency":  search.Frequency,
+			"Jobs":       jobs,
+		},
+	}
+}
+
+// normalizeClassification stores the codes of the level, location type and employment type
+// filters, the alerts compare them with the jobs as they are
+func (u savedSearchService) normalizeClassification(ctx context.Context, filter *entity.JobSearchFilter) error {
+	values := map[string]string{
+		entity.DictionaryLevel:          filter.Level,
+		entity.DictionaryLocationType:   filter.LocationType,
+		entity.DictionaryEmploymentType: filter.EmploymentType,
+	}
+	if !hasClassificationFilter(values) {
+		return nil
+	}
+
+	dictionaries, err := u.dictionaries.GetDictionaries(ctx)
+	if err != nil {
+		return err
+	}
+	if err = normalizeClassificationFilter(dictionaries, values); err != nil {
+		return err
+	}
+	filter.Level = values[entity.DictionaryLevel]
+	filter.LocationType = values[entity.DictionaryLocationType]
+	filter.EmploymentType = values[entity.DictionaryEmploymentType]
+
+	return nil
+}
+
+// normalizeLocationAndTaxonomy checks the geo and category filters like the job listing does,
+// the place in Near is geocoded once and the alerts match against its coordinates
+func (u savedSearchService) normalizeLocationAndTaxonomy(ctx context.Context, filter *entity.JobSearchFilter) error {
+	values := map[string]string{
+		"near":        filter.Near,
+		"latitude":    strings.TrimSpace(filter.Latitude),
+		"longitude":   strings.TrimSpace(filter.Longitude),
+		"radius_km":   strings.TrimSpace(filter.RadiusKm),
+		"bbox":        filter.Bbox,
+		"category_id": filter.CategoryID,
+		"tags":        strings.Join(filter.Tags, ","),
+	}
+	if err := normalizeGeoFilter(ctx, u.geocoder, values); err != nil {
+		return err
+	}
+	if err := normalizeTaxonomyFilter(ctx, u.taxonomy, values); err != nil {
+		return err
+	}
+
+	filter.Near = strings.TrimSpace(filter.Near)
+	filter.Latitude, filter.Longitude, filter.RadiusKm = values["latitude"], values["longitude"], values["radius_km"]
+	filter.Bbox = values["bbox"]
+	filter.CategoryID = values["category_id"]
+	filter.Tags = nil
+	if values["tags"] != "" {
+		filter.Tags = strings.Split(values["tags"], ",")
+	}
+
+	return nil
+}
+
+// categoryTree loads the categories only when a search filters by one
+func (u savedSearchService) categoryTree(ctx context.Context, searches []*entity.SavedSearch) (entity.CategoryTree, error) {
+	for _, search := range searches {
+		if search.Filter.CategoryID != "" {
+			categories, err := u.taxonomy.GetCategories(ctx, false)
+			if err != nil {
+				return nil, err
+			}
+			return entity.NewCategoryTree(categories), nil
+		}
+	}
+	return nil, nil
+}
+
+// normalizeSavedSearch applies the rules of the job listing filters to the saved ones
+func normalizeSavedSearch(search *entity.SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	search.Frequency = strings.ToLower(strings.TrimSpace(search.Frequency))
+
+	var missing []string
+	if search.ClientID == "" {
+		missing = append(missing, "client_id")
+	}
+	if search.Name == "" {
+		missing = append(missing, "name")
+	}
+	if len(missing) != 0 {
+		return entity.NewErrNoRequiredParameter(missing...)
+	}
+
+	validation := entity.NewErrValidation()
+	if len([]rune(search.Name)) > 64 {
+		validation.Errors["name"] = "should be at most 64 characters"
+	}
+	if search.Frequency == "" {
+		search.Frequency = entity.AlertFrequencyInstant
+	}
+	if _, ok := entity.AlertPeriods[search.Frequency]; !ok {
+		validation.Errors["frequency"] = fmt.Sprintf("should be instant, daily or weekly, got %q", search.Frequency)
+	}
+	if err := validationError(validation); err != nil {
+		return err
+	}
+
+	filter := &search.Filter
+	values := map[string]string{
+		"salary_from": filter.SalaryFrom,
+		"salary_to":   filter.SalaryTo,
+		"currency":    filter.Currency,
+		"pay_period":  filter.PayPeriod,
+	}
+	if err := normalizeSalaryFilter(values); err != nil {
+		return err
+	}
+	filter.SalaryFrom, filter.SalaryTo = values["salary_from"], values["salary_to"]
+	filter.Currency, filter.PayPeriod = values["currency"], values["pay_period"]
+	filter.Level = strings.TrimSpace(filter.Level)
+	filter.LocationType = strings.TrimSpace(filter.LocationType)
+	filter.EmploymentType = strings.TrimSpace(filter.EmploymentType)
+	filter.CompanyID = strings.TrimSpace(filter.CompanyID)
+	filter.Skills = normalizeSkills(filter.Skills)
+
+	return nil
+}
+
+func nonEmpty(values ...string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/notifier"
+	"job-service/internal/infrastructure/repository"
+	"strings"
+	"testing"
+	"time"
+)
+
+// alertRepo serves the due searches and their matches, the methods the alerts don't use panic
+type alertRepo struct {
+	repository.SavedSearches
+	searches []*entity.SavedSearch
+	matches  map[string][]*entity.SearchMatch
+	failing  map[string]bool
+	marked   map[string]bool
+}
+
+func (r *alertRepo) GetDueSavedSearches(context.Context, time.Time) ([]*entity.SavedSearch, error) {
+	return r.searches, nil
+}
+
+func (r *alertRepo) GetUnsentMatches(_ context.Context, savedSearchID string, _ time.Time) ([]*entity.SearchMatch, error) {
+	if r.failing[savedSearchID] {
+		return nil, errors.New("connection reset")
+	}
+	return r.matches[savedSearchID], nil
+}
+
+func (r *alertRepo) MarkMatchesSent(_ context.Context, savedSearchID string, _, _ time.Time) error {
+	r.marked[savedSearchID] = true
+	return nil
+}
+
+func TestSendDueAlerts(t *testing.T) {
+	repo := &alertRepo{
+		searches: []*entity.SavedSearch{
+			{GUID: "s1", ClientID: "c1", Name: "Go in Tashkent", Frequency: "daily"},
+			{GUID: "s2", ClientID: "c2", Name: "Nothing new", Frequency: "weekly"},
+			{GUID: "s3", ClientID: "c3", Name: "Broken", Frequency: "instant"},
+		},
+		matches: map[string][]*entity.SearchMatch{
+			"s1": {
+				{SavedSearchID: "s1", Job: &entity.Job{GUID: "j1", Name: "Go developer", Level: "Senior", LocationType: "Remote"}},
+				{SavedSearchID: "s1", Job: &entity.Job{GUID: "j2", Name: "Backend engineer", SalaryMin: "1000", Currency: "USD"}},
+			},
+		},
+		failing: map[string]bool{"s3": true},
+		marked:  make(map[string]bool),
+	}
+	memory := notifier.NewMemory()
+	service := NewSavedSearchService(time.Second, repo, nil, nil, nil, memory)
+
+	sent, err := service.SendDueAlerts(context.Background())
+	if sent != 1 {
+		t.Errorf("sent = %d, want 1", sent)
+	}
+	if err == nil {
+		t.Error("the failing search isn't reported")
+	}
+
+	notifications := memory.Sent()
+	if len(notifications) != 1 {
+		t.Fatalf("notifications = %d, want 1", len(notifications))
+	}
+	notification := notifications[0]
+	if notification.ClientID != "c1" || notification.Template != entity.TemplateJobAlert {
+		t.Errorf("notification = %+v, want a job alert of c1", notification)
+	}
+	jobs, _ := notification.Data["Jobs"].([]map[string]any)
+	if len(jobs) != 2 || jobs[0]["ID"] != "j1" || jobs[0]["Details"] != "Senior, Remote" {
+		t.Errorf("jobs = %v", jobs)
+	}
+
+	// a search without matches is moved on without a notification, a failed one is retried later
+	if !repo.marked["s1"] || !repo.marked["s2"] || repo.marked["s3"] {
+		t.Errorf("marked = %v, want s1 and s2", repo.marked)
+	}
+}
+
+func TestNormalizeSavedSearch(t *testing.T) {
+	search := &entity.SavedSearch{ClientID: "c1", Name: " Go jobs ", Filter: entity.JobSearchFilter{Skills: []string{"Go", "go"}}}
+	if err := normalizeSavedSearch(search); err != nil {
+		t.Fatal(err)
+	}
+	if search.Name != "Go jobs" || search.Frequency != entity.AlertFrequencyInstant || len(search.Filter.Skills) != 1 {
+		t.Errorf("search = %+v", search)
+	}
+
+	err := normalizeSavedSearch(&entity.SavedSearch{ClientID: "c1", Name: strings.Repeat("a", 65), Frequency: "Hourly"})
+	var validation *entity.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+	for _, field := range []string{"name", "frequency"} {
+		if _, ok := validation.Errors[field]; !ok {
+			t.Errorf("errors = %v, want %s", validation.Errors, field)
+		}
+	}
+}
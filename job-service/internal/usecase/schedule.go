@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/pkg/otlp"
+	"strings"
+	"time"
+)
+
+// normalizeSchedule settles the status of a written job. Drafts, closed and archived jobs keep
+// what they got, any other job is published at publish_at, right away when it's empty or has passed.
+// Whether the job may move to the status is checked against its current one by the repository.
+func normalizeSchedule(job *entity.Job, now time.Time) error {
+	job.Status = strings.ToLower(strings.TrimSpace(job.Status))
+
+	validation := entity.NewErrValidation()
+	switch job.Status {
+	case entity.JobStatusDraft, entity.JobStatusArchived:
+	case entity.JobStatusClosed:
+		if job.CloseAt.IsZero() {
+			job.CloseAt = now
+		}
+	case "", entity.JobStatusScheduled, entity.JobStatusPublished:
+		if job.PublishAt.IsZero() {
+			job.PublishAt = now
+		}
+		job.Status = entity.JobStatusPublished
+		if job.PublishAt.After(now) {
+			job.Status = entity.JobStatusScheduled
+		}
+		if !job.CloseAt.IsZero() && !job.CloseAt.After(now) {
+			validation.Errors["close_at"] = "should be in the future"
+		}
+	default:
+		validation.Errors["status"] = fmt.Sprintf("unknown %q, expected draft, scheduled, published, closed or archived", job.Status)
+	}
+
+	if !job.PublishAt.IsZero() && !job.CloseAt.IsZero() && !job.CloseAt.After(job.PublishAt) {
+		validation.Errors["close_at"] = "should be after publish_at"
+	}
+
+	return validationError(validation)
+}
+
+// normalizeStatusFilter checks the status filter of a job list
+func normalizeStatusFilter(filter map[string]string) error {
+	status := strings.ToLower(strings.TrimSpace(filter["status"]))
+	if status == "" {
+		delete(filter, "status")
+		return nil
+	}
+	if !entity.IsJobStatus(status) {
+		validation := entity.NewErrValidation()
+		validation.Errors["status"] = fmt.Sprintf("unknown %q, expected draft, scheduled, published, closed or archived", status)
+		return validationError(validation)
+	}
+	filter["status"] = status
+
+	return nil
+}
+
+// PublishAndCloseDueJobs is run by the scheduler. Both updates are idempotent,
+// so several job-service instances may run it at the same time.
+func (u jobService) PublishAndCloseDueJobs(ctx context.Context) (published, closed uint64, err error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "PublishAndCloseDueJobs")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	if published, err = u.repo.PublishDueJobs(ctx, now); err != nil {
+		return 0, 0, err
+	}
+	// a job published just now may already be due to close
+	if closed, err = u.repo.CloseDueJobs(ctx, now); err != nil {
+		return published, 0, err
+	}
+
+	return published, closed, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"errors"
+	"job-service/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestNormalizeSchedule(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		job    entity.Job
+		status string
+		fields []string
+	}{
+		{name: "published right away", job: entity.Job{}, status: entity.JobStatusPublished},
+		{name: "scheduled for later", job: entity.Job{Status: "published", PublishAt: now.Add(time.Hour)}, status: entity.JobStatusScheduled},
+		{name: "draft kept", job: entity.Job{Status: " Draft "}, status: entity.JobStatusDraft},
+		{name: "archived kept", job: entity.Job{Status: "archived"}, status: entity.JobStatusArchived},
+		{name: "closed now", job: entity.Job{Status: "closed"}, status: entity.JobStatusClosed},
+		{name: "close_at passed", job: entity.Job{CloseAt: now.Add(-time.Hour)}, fields: []string{"close_at"}},
+		{name: "close_at before publish_at", job: entity.Job{Status: "draft", PublishAt: now.Add(2 * time.Hour), CloseAt: now.Add(time.Hour)}, fields: []string{"close_at"}},
+		{name: "unknown status", job: entity.Job{Status: "hidden"}, fields: []string{"status"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := tt.job
+			err := normalizeSchedule(&job, now)
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("normalizeSchedule failed: %v", err)
+				}
+				if job.Status != tt.status {
+					t.Errorf("status = %q, want %q", job.Status, tt.status)
+				}
+				return
+			}
+
+			var validation *entity.ErrValidation
+			if !errors.As(err, &validation) {
+				t.Fatalf("normalizeSchedule = %v, want a validation error", err)
+			}
+			for _, field := range tt.fields {
+				if _, ok := validation.Errors[field]; !ok {
+					t.Errorf("%s isn't reported in %v", field, validation.Errors)
+				}
+			}
+		})
+	}
+}
+
+func TestCanMoveJob(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{entity.JobStatusDraft, entity.JobStatusPublished, true},
+		{entity.JobStatusScheduled, entity.JobStatusDraft, true},
+		{entity.JobStatusPublished, entity.JobStatusPublished, true},
+		{entity.JobStatusPublished, entity.JobStatusClosed, true},
+		{entity.JobStatusPublished, entity.JobStatusDraft, false},
+		{entity.JobStatusPublished, entity.JobStatusArchived, false},
+		{entity.JobStatusClosed, entity.JobStatusArchived, true},
+		{entity.JobStatusClosed, entity.JobStatusPublished, false},
+		{entity.JobStatusClosed, entity.JobStatusScheduled, false},
+		{entity.JobStatusArchived, entity.JobStatusClosed, false},
+		{entity.JobStatusArchived, entity.JobStatusArchived, true},
+	}
+	for _, tt := range tests {
+		if got := entity.CanMoveJob(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanMoveJob(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeStatusFilter(t *testing.T) {
+	filter := map[string]string{"status": " Archived"}
+	if err := normalizeStatusFilter(filter); err != nil || filter["status"] != entity.JobStatusArchived {
+		t.Fatalf("normalizeStatusFilter = %v, %v", err, filter)
+	}
+
+	var validation *entity.ErrValidation
+	if err := normalizeStatusFilter(map[string]string{"status": "gone"}); !errors.As(err, &validation) {
+		t.Fatalf("normalizeStatusFilter = %v, want a validation error", err)
+	}
+}
@@ -0,0 +1,388 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/pkg/otlp"
+	"strings"
+	"time"
+)
+
+const (
+	maxCategoryDepth = 5
+	maxJobTags       = 20
+	maxTaxonomyName  = 64
+)
+
+type Taxonomy interface {
+	CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	UpdateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	DeleteCategory(ctx context.Context, guid string) error
+	GetCategories(ctx context.Context, withCounts bool) ([]*entity.Category, error)
+	CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
+	UpdateTag(ctx context.Context, slug string, tag *entity.Tag) (*entity.Tag, error)
+	DeleteTag(ctx context.Context, slug string) error
+	GetTags(ctx context.Context) ([]*entity.Tag, error)
+}
+
+type taxonomyService struct {
+	BaseUseCase
+	repo       repository.Taxonomy
+	ctxTimeout time.Duration
+}
+
+func NewTaxonomyService(ctxTimeout time.Duration, repo repository.Taxonomy) Taxonomy {
+	return taxonomyService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (u taxonomyService) CreateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "CreateCategory")
+	defer span.End()
+
+	u.beforeRequest(&category.GUID, &category.CreatedAt, &category.UpdatedAt)
+
+	if err := u.checkCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	created, err := u.repo.CreateCategory(ctx, category)
+	if errors.Is(err, entity.ErrorConflict) {
+		return nil, entity.NewErrConflict(fmt.Sprintf("category %q", category.Name))
+	}
+	return created, err
+}
+
+func (u taxonomyService) UpdateCategory(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateCategory")
+	defer span.End()
+
+	if category.GUID == "" {
+		return nil, entity.NewErrNoRequiredParameter("id")
+	}
+
+	u.beforeRequest(nil, nil, &category.UpdatedAt)
+
+	if err := u.checkCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	updated, err := u.repo.UpdateCategory(ctx, category)
+	switch {
+	case errors.Is(err, entity.ErrorNotFound):
+		return nil, entity.NewErrNotFound("category")
+	case errors.Is(err, entity.ErrorConflict):
+		return nil, entity.NewErrConflict(fmt.Sprintf("category %q", category.Name))
+	}
+	return updated, err
+}
+
+// DeleteCategory unlinks its jobs, categories with subcategories are kept until those are moved or deleted
+func (u taxonomyService) DeleteCategory(ctx context.Context, guid string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeleteCategory")
+	defer span.End()
+
+	if guid == "" {
+		return entity.NewErrNoRequiredParameter("id")
+	}
+
+	categories, err := u.repo.GetCategories(ctx, false)
+	if err != nil {
+		return err
+	}
+	for _, category := range categories {
+		if category.ParentID == guid {
+			return entity.NewErrPrecondition("the category has subcategories, move or delete them first")
+		}
+	}
+
+	if err = u.repo.DeleteCategory(ctx, guid); errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrNotFound("category")
+	}
+	return err
+}
+
+func (u taxonomyService) GetCategories(ctx context.Context, withCounts bool) ([]*entity.Category, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetCategories")
+	defer span.End()
+
+	categories, err := u.repo.GetCategories(ctx, withCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return treeOrder(categories), nil
+}
+
+func (u taxonomyService) CreateTag(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "CreateTag")
+	defer span.End()
+
+	if err := normalizeTag(tag); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(nil, &tag.CreatedAt, &tag.UpdatedAt)
+
+	created, err := u.repo.CreateTag(ctx, tag)
+	if errors.Is(err, entity.ErrorConflict) {
+		return nil, entity.NewErrConflict(fmt.Sprintf("tag %q", tag.Slug))
+	}
+	return created, err
+}
+
+// UpdateTag renames the tag with the slug, the jobs keep it under its new name
+func (u taxonomyService) UpdateTag(ctx context.Context, slug string, tag *entity.Tag) (*entity.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateTag")
+	defer span.End()
+
+	if slug == "" {
+		return nil, entity.NewErrNoRequiredParameter("slug")
+	}
+	if err := normalizeTag(tag); err != nil {
+		return nil, err
+	}
+
+	u.beforeRequest(nil, nil, &tag.UpdatedAt)
+
+	updated, err := u.repo.UpdateTag(ctx, slug, tag)
+	switch {
+	case errors.Is(err, entity.ErrorNotFound):
+		return nil, entity.NewErrNotFound("tag")
+	case errors.Is(err, entity.ErrorConflict):
+		return nil, entity.NewErrConflict(fmt.Sprintf("tag %q", tag.Slug))
+	}
+	return updated, err
+}
+
+func (u taxonomyService) DeleteTag(ctx context.Context, slug string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeleteTag")
+	defer span.End()
+
+	if slug == "" {
+		return entity.NewErrNoRequiredParameter("slug")
+	}
+
+	err := u.repo.DeleteTag(ctx, slug)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrNotFound("tag")
+	}
+	return err
+}
+
+func (u taxonomyService) GetTags(ctx context.Context) ([]*entity.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetTags")
+	defer span.End()
+
+	return u.repo.GetTags(ctx)
+}
+
+// checkCategory validates the name and the parent, a category can't be moved under itself
+// or one of its subcategories and the tree is at most maxCategoryDepth levels deep
+func (u taxonomyService) checkCategory(ctx context.Context, category *entity.Category) error {
+	category.Name = strings.Join(strings.Fields(category.Name), " ")
+	category.ParentID = strings.TrimSpace(category.ParentID)
+	if category.Name == "" {
+		return entity.NewErrNoRequiredParameter("name")
+	}
+	if len([]rune(category.Name)) > maxTaxonomyName {
+		return fmt.Errorf("name should be at most %d characters", maxTaxonomyName)
+	}
+	if category.ParentID == "" {
+		return nil
+	}
+	if category.ParentID == category.GUID {
+		return entity.NewErrPrecondition("a category can't be its own parent")
+	}
+
+	categories, err := u.repo.GetCategories(ctx, false)
+	if err != nil {
+		return err
+	}
+	parents := make(map[string]string, len(categories))
+	children := make(map[string][]string, len(categories))
+	for _, existing := range categories {
+		parents[existing.GUID] = existing.ParentID
+		children[existing.ParentID] = append(children[existing.ParentID], existing.GUID)
+	}
+	if _, ok := parents[category.ParentID]; !ok {
+		return entity.NewErrNotFound("parent category")
+	}
+
+	// the levels of the category and its subcategories, then of its new ancestors
+	depth := subtreeHeight(children, category.GUID)
+	for id := category.ParentID; id != ""; id = parents[id] {
+		if id == category.GUID {
+			return entity.NewErrPrecondition("a category can't be moved under one of its subcategories")
+		}
+		if depth++; depth > maxCategoryDepth {
+			return entity.NewErrPrecondition(fmt.Sprintf("categories are at most %d levels deep", maxCategoryDepth))
+		}
+	}
+
+	return nil
+}
+
+// treeOrder puts every category right after its parent, siblings keep their order
+func treeOrder(categories []*entity.Category) []*entity.Category {
+	children := make(map[string][]*entity.Category, len(categories))
+	for _, category := range categories {
+		children[category.ParentID] = append(children[category.ParentID], category)
+	}
+
+	ordered := make([]*entity.Category, 0, len(categories))
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		for _, category := range children[parentID] {
+			ordered = append(ordered, category)
+			walk(category.GUID)
+		}
+	}
+	walk("")
+
+	return ordered
+}
+
+func subtreeHeight(children map[string][]string, guid string) int {
+	height := 0
+	for _, child := range children[guid] {
+		if childHeight := subtreeHeight(children, child); childHeight > height {
+			height = childHeight
+		}
+	}
+	return height + 1
+}
+
+func normalizeTag(tag *entity.Tag) error {
+	tag.Name = strings.Join(strings.Fields(tag.Name), " ")
+	tag.Slug = entity.TagSlug(tag.Name)
+	if tag.Slug == "" {
+		return entity.NewErrNoRequiredParameter("name")
+	}
+	if len([]rune(tag.Name)) > maxTaxonomyName {
+		return fmt.Errorf("name should be at most %d characters", maxTaxonomyName)
+	}
+	return nil
+}
+
+// normalizeTaxonomy drops repeated categories and tags, tags are the same when their slugs are.
+// Every category has to exist.
+func normalizeTaxonomy(categories []*entity.Category, job *entity.Job) error {
+	known := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		known[category.GUID] = true
+	}
+
+	categoryIDs := make([]string, 0, len(job.CategoryIDs))
+	seen := make(map[string]bool, len(job.CategoryIDs))
+	for _, id := range job.CategoryIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		if !known[id] {
+			return entity.NewErrNotFound(fmt.Sprintf("category %s", id))
+		}
+		seen[id] = true
+		categoryIDs = append(categoryIDs, id)
+	}
+	job.CategoryIDs = categoryIDs
+
+	tags := make([]string, 0, len(job.Tags))
+	slugs := make(map[string]bool, len(job.Tags))
+	for _, name := range job.Tags {
+		tag := &entity.Tag{Name: name}
+		if err := normalizeTag(tag); err != nil {
+			var errNoParameter *entity.ErrNoRequiredParameter
+			if errors.As(err, &errNoParameter) {
+				continue
+			}
+			return fmt.Errorf("tag %q: %w", name, err)
+		}
+		if slugs[tag.Slug] {
+			continue
+		}
+		slugs[tag.Slug] = true
+		tags = append(tags, tag.Name)
+	}
+	if len(tags) > maxJobTags {
+		return fmt.Errorf("a job can have at most %d tags", maxJobTags)
+	}
+	job.Tags = tags
+
+	return nil
+}
+
+func (u jobService) normalizeTaxonomy(ctx context.Context, job *entity.Job) error {
+	var categories []*entity.Category
+	if len(job.CategoryIDs) != 0 {
+		var err error
+		if categories, err = u.taxonomy.GetCategories(ctx, false); err != nil {
+			return err
+		}
+	}
+	return normalizeTaxonomy(categories, job)
+}
+
+// normalizeTaxonomyFilter checks the category of the job listing and turns the tags into slugs
+func normalizeTaxonomyFilter(ctx context.Context, taxonomy repository.Taxonomy, filter map[string]string) error {
+	if id := strings.TrimSpace(filter["category_id"]); id != "" {
+		categories, err := taxonomy.GetCategories(ctx, false)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, category := range categories {
+			found = found || category.GUID == id
+		}
+		if !found {
+			return entity.NewErrNotFound("category")
+		}
+		filter["category_id"] = id
+	} else {
+		delete(filter, "category_id")
+	}
+
+	var slugs []string
+	for _, name := range strings.Split(filter["tags"], ",") {
+		if slug := entity.TagSlug(name); slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+	if len(slugs) == 0 {
+		delete(filter, "tags")
+	} else {
+		filter["tags"] = strings.Join(slugs, ",")
+	}
+
+	return nil
+}
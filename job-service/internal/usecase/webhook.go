@@ -0,0 +1,387 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"job-service/internal/infrastructure/webhook"
+	"job-service/internal/pkg/otlp"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	webhookBatchSize   = 50
+	webhookParallelism = 8
+	// a delivery is dead after its attempts, the delay between them doubles from 30s up to 6h
+	webhookMaxAttempts = 8
+	webhookFirstRetry  = 30 * time.Second
+	webhookMaxRetry    = 6 * time.Hour
+	// a claimed delivery is taken again after the lease, when its instance died while sending
+	webhookLease     = 5 * time.Minute
+	minWebhookSecret = 16
+)
+
+type Webhook interface {
+	CreateWebhook(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, guid string) error
+	GetWebhook(ctx context.Context, guid string) (*entity.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*entity.Webhook, error)
+	GetWebhookDeliveries(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, guid string) (*entity.WebhookDelivery, []*entity.WebhookAttempt, error)
+	RedeliverWebhook(ctx context.Context, guid string) (*entity.WebhookDelivery, error)
+	DeliverDueWebhooks(ctx context.Context) (delivered, failed uint64, err error)
+}
+
+type webhookService struct {
+	BaseUseCase
+	repo       repository.Webhooks
+	sender     webhook.Sender
+	ctxTimeout time.Duration
+}
+
+func NewWebhookService(ctxTimeout time.Duration, repo repository.Webhooks, sender webhook.Sender) Webhook {
+	return webhookService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		sender:     sender,
+	}
+}
+
+// CreateWebhook generates the secret when there is none, it's returned only here
+func (u webhookService) CreateWebhook(ctx context.Context, hook *entity.Webhook) (*entity.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "CreateWebhook")
+	defer span.End()
+
+	u.beforeRequest(&hook.GUID, &hook.CreatedAt, &hook.UpdatedAt)
+
+	if hook.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		hook.Secret = secret
+	}
+	if err := normalizeWebhook(hook); err != nil {
+		return nil, err
+	}
+
+	return u.repo.CreateWebhook(ctx, hook)
+}
+
+// UpdateWebhook keeps the secret when none is given
+func (u webhookService) UpdateWebhook(ctx context.Context, hook *entity.Webhook) (*entity.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "UpdateWebhook")
+	defer span.End()
+
+	if hook.GUID == "" {
+		return nil, entity.NewErrNoRequiredParameter("id")
+	}
+
+	u.beforeRequest(nil, nil, &hook.UpdatedAt)
+
+	if err := normalizeWebhook(hook); err != nil {
+		return nil, err
+	}
+
+	updated, err := u.repo.UpdateWebhook(ctx, hook)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("webhook")
+	}
+	if err != nil {
+		return nil, err
+	}
+	updated.Secret = ""
+	return updated, nil
+}
+
+func (u webhookService) DeleteWebhook(ctx context.Context, guid string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeleteWebhook")
+	defer span.End()
+
+	if guid == "" {
+		return entity.NewErrNoRequiredParameter("id")
+	}
+
+	if err := u.repo.DeleteWebhook(ctx, guid); errors.Is(err, entity.ErrorNotFound) {
+		return entity.NewErrNotFound("webhook")
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u webhookService) GetWebhook(ctx context.Context, guid string) (*entity.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetWebhook")
+	defer span.End()
+
+	if guid == "" {
+		return nil, entity.NewErrNoRequiredParameter("id")
+	}
+
+	hook, err := u.repo.GetWebhook(ctx, guid)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("webhook")
+	}
+	if err != nil {
+		return nil, err
+	}
+	hook.Secret = ""
+	return hook, nil
+}
+
+func (u webhookService) GetWebhooks(ctx context.Context) ([]*entity.Webhook, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetWebhooks")
+	defer span.End()
+
+	hooks, err := u.repo.GetWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range hooks {
+		hook.Secret = ""
+	}
+	return hooks, nil
+}
+
+// GetWebhookDeliveries filters by webhook_id, status and event_type, the newest come first
+func (u webhookService) GetWebhookDeliveries(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetWebhookDeliveries")
+	defer span.End()
+
+	switch filter["status"] {
+	case "", entity.WebhookDeliveryPending, entity.WebhookDeliveryDelivered, entity.WebhookDeliveryDead:
+	default:
+		validation := entity.NewErrValidation()
+		validation.Errors["status"] = "should be pending, delivered or dead"
+		return nil, validationError(validation)
+	}
+
+	deliveries, err := u.repo.GetWebhookDeliveries(ctx, limit, offset, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, delivery := range deliveries {
+		delivery.Secret = ""
+	}
+	return deliveries, nil
+}
+
+// GetWebhookDelivery returns the delivery with its log, the oldest attempt first
+func (u webhookService) GetWebhookDelivery(ctx context.Context, guid string) (*entity.WebhookDelivery, []*entity.WebhookAttempt, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "GetWebhookDelivery")
+	defer span.End()
+
+	if guid == "" {
+		return nil, nil, entity.NewErrNoRequiredParameter("id")
+	}
+
+	delivery, err := u.repo.GetWebhookDelivery(ctx, guid)
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, nil, entity.NewErrNotFound("webhook delivery")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	delivery.Secret = ""
+
+	attempts, err := u.repo.GetWebhookAttempts(ctx, guid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return delivery, attempts, nil
+}
+
+// RedeliverWebhook queues a delivery again right away with a fresh set of attempts, dead and
+// delivered ones too
+func (u webhookService) RedeliverWebhook(ctx context.Context, guid string) (*entity.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "RedeliverWebhook")
+	defer span.End()
+
+	if guid == "" {
+		return nil, entity.NewErrNoRequiredParameter("id")
+	}
+
+	delivery, err := u.repo.RedeliverWebhook(ctx, guid, time.Now().UTC())
+	if errors.Is(err, entity.ErrorNotFound) {
+		return nil, entity.NewErrNotFound("webhook delivery")
+	}
+	if err != nil {
+		return nil, err
+	}
+	delivery.Secret = ""
+	return delivery, nil
+}
+
+// DeliverDueWebhooks sends the due deliveries, a few at a time. A failed delivery is retried
+// later with a growing delay and is dead once its attempts are used up.
+func (u webhookService) DeliverDueWebhooks(ctx context.Context) (delivered, failed uint64, err error) {
+	ctx, span := otlp.Start(ctx, "user_grpc-usercase", "DeliverDueWebhooks")
+	defer span.End()
+
+	claimCtx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	deliveries, err := u.repo.ClaimDueWebhookDeliveries(claimCtx, time.Now().UTC(), webhookLease, webhookBatchSize)
+	cancel()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		saveErr  error
+		parallel = make(chan struct{}, webhookParallelism)
+	)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		parallel <- struct{}{}
+		go func(delivery *entity.WebhookDelivery) {
+			defer func() {
+				<-parallel
+				wg.Done()
+			}()
+
+			ok, err := u.deliver(ctx, delivery)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				saveErr = err
+			}
+			if ok {
+				delivered++
+			} else {
+				failed++
+			}
+		}(delivery)
+	}
+	wg.Wait()
+
+	return delivered, failed, saveErr
+}
+
+// deliver sends the delivery once and saves the attempt, the error is that of saving it
+func (u webhookService) deliver(ctx context.Context, delivery *entity.WebhookDelivery) (bool, error) {
+	started := time.Now().UTC()
+	statusCode, sendErr := u.sender.Send(ctx, delivery)
+	if sendErr != nil && ctx.Err() != nil {
+		// shutting down, the delivery is taken again once the lease is over
+		return false, nil
+	}
+
+	attempt := &entity.WebhookAttempt{
+		DeliveryID:  delivery.GUID,
+		StatusCode:  statusCode,
+		Duration:    time.Since(started),
+		AttemptedAt: started,
+	}
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedAt = now
+	if sendErr == nil {
+		delivery.Status = entity.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = now
+	} else {
+		attempt.Error = sendErr.Error()
+		delivery.LastError = attempt.Error
+		delivery.NextAttemptAt = now.Add(webhookRetryDelay(delivery.Attempts))
+		if delivery.Attempts >= webhookMaxAttempts {
+			delivery.Status = entity.WebhookDeliveryDead
+		}
+	}
+
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), u.ctxTimeout)
+	defer cancel()
+	if err := u.repo.SaveWebhookAttempt(saveCtx, delivery, attempt); err != nil {
+		return false, u.Error("save webhook attempt", err)
+	}
+	return sendErr == nil, nil
+}
+
+// webhookRetryDelay is the delay after the attempts, doubled with every attempt
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookFirstRetry
+	for i := 1; i < attempts && delay < webhookMaxRetry; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxRetry)
+}
+
+// normalizeWebhook checks the URL, the event types and the secret, and removes duplicate types
+func normalizeWebhook(hook *entity.Webhook) error {
+	validation := entity.NewErrValidation()
+
+	hook.URL = strings.TrimSpace(hook.URL)
+	parsed, err := url.Parse(hook.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		validation.Errors["url"] = "should be an absolute http or https URL"
+	}
+
+	known := make(map[string]bool, len(entity.WebhookEventTypes))
+	for _, eventType := range entity.WebhookEventTypes {
+		known[eventType] = true
+	}
+	seen := make(map[string]bool, len(hook.EventTypes))
+	eventTypes := make([]string, 0, len(hook.EventTypes))
+	for _, eventType := range hook.EventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !known[eventType] {
+			validation.Errors["event_types"] = "should be some of " + strings.Join(entity.WebhookEventTypes, ", ")
+			continue
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	if len(hook.EventTypes) == 0 {
+		validation.Errors["event_types"] = "is required"
+	}
+	hook.EventTypes = eventTypes
+
+	if hook.Secret != "" && len(hook.Secret) < minWebhookSecret {
+		validation.Errors["secret"] = "should be at least 16 characters"
+	}
+
+	return validationError(validation)
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"job-service/internal/entity"
+	"job-service/internal/infrastructure/repository"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWebhookRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 30 * time.Second},
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 3, want: 2 * time.Minute},
+		{attempts: 8, want: 64 * time.Minute},
+		{attempts: 11, want: 6 * time.Hour},
+		{attempts: 1000, want: 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := webhookRetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("webhookRetryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// deliveryRepo serves claimed deliveries and keeps what was saved, the methods deliveries
+// don't use panic
+type deliveryRepo struct {
+	repository.Webhooks
+	mu       sync.Mutex
+	claimed  []*entity.WebhookDelivery
+	saved    map[string]*entity.WebhookDelivery
+	attempts map[string][]*entity.WebhookAttempt
+}
+
+func (r *deliveryRepo) ClaimDueWebhookDeliveries(context.Context, time.Time, time.Duration, uint64) ([]*entity.WebhookDelivery, error) {
+	return r.claimed, nil
+}
+
+func (r *deliveryRepo) SaveWebhookAttempt(_ context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *delivery
+	r.saved[delivery.GUID] = &saved
+	r.attempts[delivery.GUID] = append(r.attempts[delivery.GUID], attempt)
+	return nil
+}
+
+func (r *deliveryRepo) RedeliverWebhook(_ context.Context, guid string, now time.Time) (*entity.WebhookDelivery, error) {
+	for _, delivery := range r.claimed {
+		if delivery.GUID == guid {
+			redelivered := *delivery
+			redelivered.Status = entity.WebhookDeliveryPending
+			redelivered.Attempts = 0
+			redelivered.NextAttemptAt = now
+			return &redelivered, nil
+		}
+	}
+	return nil, entity.ErrorNotFound
+}
+
+// statusSender answers with the status of the URL, a status of 0 is a connection error
+type statusSender map[string]int
+
+func (s statusSender) Send(_ context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	status := s[delivery.URL]
+	if status == 0 {
+		return 0, errors.New("connection refused")
+	}
+	if status > 299 {
+		return status, errors.New("unexpected status")
+	}
+	return status, nil
+}
+
+func TestDeliverDueWebhooks(t *testing.T) {
+	repo := &deliveryRepo{
+		claimed: []*entity.WebhookDelivery{
+			{GUID: "ok", URL: "https://ok.example", Status: entity.WebhookDeliveryPending, Attempts: 2, LastError: "timeout"},
+			{GUID: "retry", URL: "https://down.example", Status: entity.WebhookDeliveryPending, Attempts: 2},
+			{GUID: "dead", URL: "https://gone.example", Status: entity.WebhookDeliveryPending, Attempts: webhookMaxAttempts - 1},
+		},
+		saved:    make(map[string]*entity.WebhookDelivery),
+		attempts: make(map[string][]*entity.WebhookAttempt),
+	}
+	sender := statusSender{"https://ok.example": 200, "https://gone.example": 500}
+	service := NewWebhookService(time.Second, repo, sender)
+
+	before := time.Now().UTC()
+	delivered, failed, err := service.DeliverDueWebhooks(context.Background())
+	if err != nil || delivered != 1 || failed != 2 {
+		t.Fatalf("DeliverDueWebhooks = %d, %d, %v, want 1, 2, nil", delivered, failed, err)
+	}
+
+	ok := repo.saved["ok"]
+	if ok.Status != entity.WebhookDeliveryDelivered || ok.Attempts != 3 || ok.LastError != "" || ok.DeliveredAt.IsZero() {
+		t.Errorf("delivered = %+v", ok)
+	}
+
+	retry := repo.saved["retry"]
+	if retry.Status != entity.WebhookDeliveryPending || retry.Attempts != 3 || retry.LastError == "" {
+		t.Errorf("retried = %+v", retry)
+	}
+	if delay := retry.NextAttemptAt.Sub(before); delay < webhookRetryDelay(3) || delay > webhookRetryDelay(3)+time.Minute {
+		t.Errorf("retried in %v, want %v", delay, webhookRetryDelay(3))
+	}
+
+	// the last attempt moves the delivery to the dead letters
+	dead := repo.saved["dead"]
+	if dead.Status != entity.WebhookDeliveryDead || dead.Attempts != webhookMaxAttempts || dead.LastStatusCode != 500 {
+		t.Errorf("dead = %+v", dead)
+	}
+
+	for guid, attempts := range repo.attempts {
+		if len(attempts) != 1 || attempts[0].DeliveryID != guid {
+			t.Errorf("attempts of %s = %+v, want one", guid, attempts)
+		}
+	}
+	if attempt := repo.attempts["dead"][0]; attempt.StatusCode != 500 || attempt.Error == "" {
+		t.Errorf("attempt of dead = %+v", attempt)
+	}
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	repo := &deliveryRepo{
+		claimed: []*entity.WebhookDelivery{
+			{GUID: "dead", Status: entity.WebhookDeliveryDead, Attempts: webhookMaxAttempts, Secret: "whsec-0123456789abcdef"},
+		},
+	}
+	service := NewWebhookService(time.Second, repo, statusSender{})
+
+	delivery, err := service.RedeliverWebhook(context.Background(), "dead")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Status != entity.WebhookDeliveryPending || delivery.Attempts != 0 {
+		t.Errorf("redelivered = %+v, want a pending one with fresh attempts", delivery)
+	}
+	if delivery.Secret != "" {
+		t.Error("the secret of the webhook is returned")
+	}
+
+	var notFound *entity.ErrNotFound
+	if _, err = service.RedeliverWebhook(context.Background(), "missing"); !errors.As(err, &notFound) || err.Error() != "webhook delivery not found" {
+		t.Errorf("RedeliverWebhook of a missing delivery = %v", err)
+	}
+
+	var noParameter *entity.ErrNoRequiredParameter
+	if _, err = service.RedeliverWebhook(context.Background(), ""); !errors.As(err, &noParameter) {
+		t.Errorf("RedeliverWebhook without an id = %v", err)
+	}
+}